@@ -0,0 +1,115 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type AccountFreezeCommand struct {
+	*BaseCommand
+	OperationFlags
+	Targets []AddressFlag `arg:"" name:"target" help:"target address" required:"true"`
+	freeze  bool
+	targets []base.Address
+}
+
+func NewAccountFreezeCommand() AccountFreezeCommand {
+	return AccountFreezeCommand{
+		BaseCommand: NewBaseCommand("account-freeze-operation"),
+		freeze:      true,
+	}
+}
+
+func NewAccountUnfreezeCommand() AccountFreezeCommand {
+	return AccountFreezeCommand{
+		BaseCommand: NewBaseCommand("account-unfreeze-operation"),
+	}
+}
+
+func (cmd *AccountFreezeCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Wrap(err, "failed to initialize command")
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	} else if err := op.IsValid(cmd.NetworkID.NetworkID()); err != nil {
+		return errors.Wrap(err, "invalid operation")
+	}
+
+	bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.NetworkID(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create operation.Seal")
+	}
+	PrettyPrint(cmd.Out, cmd.Pretty, bs)
+
+	return nil
+}
+
+func (cmd *AccountFreezeCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if len(cmd.Targets) < 1 {
+		return errors.Errorf("empty targets")
+	}
+
+	targets := make([]base.Address, len(cmd.Targets))
+	for i := range cmd.Targets {
+		a, err := cmd.Targets[i].Encode(jenc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid target format, %q", cmd.Targets[i].String())
+		}
+		targets[i] = a
+	}
+	cmd.targets = targets
+
+	return nil
+}
+
+func (cmd *AccountFreezeCommand) createOperation() (operation.Operation, error) {
+	var fact base.Fact
+	if cmd.freeze {
+		fact = currency.NewAccountFreezeFact([]byte(cmd.Token), cmd.targets)
+	} else {
+		fact = currency.NewAccountUnfreezeFact([]byte(cmd.Token), cmd.targets)
+	}
+
+	var fs []base.FactSign
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs = append(fs, base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+
+	if cmd.freeze {
+		op, err := currency.NewAccountFreeze(fact.(currency.AccountFreezeFact), fs, cmd.Memo)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create account-freeze operation")
+		}
+
+		return op, nil
+	}
+
+	op, err := currency.NewAccountUnfreeze(fact.(currency.AccountUnfreezeFact), fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create account-unfreeze operation")
+	}
+
+	return op, nil
+}
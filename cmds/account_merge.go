@@ -0,0 +1,87 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type AccountMergeCommand struct {
+	*BaseCommand
+	OperationFlags
+	Sender AddressFlag `arg:"" name:"sender" help:"sender address, which will be closed" required:"true"`
+	Target AddressFlag `arg:"" name:"target" help:"target address, which receives balances" required:"true"`
+	sender base.Address
+	target base.Address
+}
+
+func NewAccountMergeCommand() AccountMergeCommand {
+	return AccountMergeCommand{
+		BaseCommand: NewBaseCommand("account-merge-operation"),
+	}
+}
+
+func (cmd *AccountMergeCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Wrap(err, "failed to initialize command")
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.NetworkID(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create operation.Seal")
+	}
+	PrettyPrint(cmd.Out, cmd.Pretty, bs)
+
+	return nil
+}
+
+func (cmd *AccountMergeCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(jenc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	} else if b, err := cmd.Target.Encode(jenc); err != nil {
+		return errors.Wrapf(err, "invalid target format, %q", cmd.Target.String())
+	} else {
+		cmd.sender = a
+		cmd.target = b
+	}
+
+	return nil
+}
+
+func (cmd *AccountMergeCommand) createOperation() (operation.Operation, error) {
+	fact := currency.NewAccountMergeFact([]byte(cmd.Token), cmd.sender, cmd.target)
+
+	var fs []base.FactSign
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs = append(fs, base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+
+	op, err := currency.NewAccountMerge(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create account-merge operation")
+	}
+	return op, nil
+}
@@ -0,0 +1,100 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type AccountMetadataCommand struct {
+	*BaseCommand
+	OperationFlags
+	Target   AddressFlag    `arg:"" name:"target" help:"target address" required:"true"`
+	Currency CurrencyIDFlag `arg:"" name:"currency" help:"currency id for fee" required:"true"`
+	Metadata []MetadataFlag `name:"metadata" help:"metadata (ex: \"<key>=<value>\"); without metadata, metadata is removed" sep:"@"` // nolint lll
+	target   base.Address
+	metadata map[string]string
+}
+
+func NewAccountMetadataCommand() AccountMetadataCommand {
+	return AccountMetadataCommand{
+		BaseCommand: NewBaseCommand("account-metadata-operation"),
+	}
+}
+
+func (cmd *AccountMetadataCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Wrap(err, "failed to initialize command")
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.NetworkID(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create operation.Seal")
+	}
+	PrettyPrint(cmd.Out, cmd.Pretty, bs)
+
+	return nil
+}
+
+func (cmd *AccountMetadataCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Target.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid target format, %q", cmd.Target.String())
+	}
+	cmd.target = a
+
+	md := map[string]string{}
+	for i := range cmd.Metadata {
+		f := cmd.Metadata[i]
+		if _, found := md[f.Key]; found {
+			return errors.Errorf("duplicated metadata key, %q", f.Key)
+		}
+
+		md[f.Key] = f.Value
+	}
+
+	if err := currency.IsValidAccountMetadata(md); err != nil {
+		return err
+	}
+	cmd.metadata = md
+
+	return nil
+}
+
+func (cmd *AccountMetadataCommand) createOperation() (operation.Operation, error) {
+	fact := currency.NewAccountMetadataUpdaterFact([]byte(cmd.Token), cmd.target, cmd.metadata, cmd.Currency.CID)
+
+	var fs []base.FactSign
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs = append(fs, base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+
+	op, err := currency.NewAccountMetadataUpdater(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create account-metadata operation")
+	}
+	return op, nil
+}
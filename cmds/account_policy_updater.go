@@ -0,0 +1,92 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+)
+
+type AccountPolicyUpdaterCommand struct {
+	*BaseCommand
+	OperationFlags
+	MaxKeys   uint `name:"max-keys" help:"maximum number of keys in account keys" required:"true"`
+	MinWeight uint `name:"min-weight" help:"minimum weight of key" default:"1"`
+	MaxWeight uint `name:"max-weight" help:"maximum weight of key" default:"100"`
+	po        currency.AccountPolicy
+}
+
+func NewAccountPolicyUpdaterCommand() AccountPolicyUpdaterCommand {
+	return AccountPolicyUpdaterCommand{
+		BaseCommand: NewBaseCommand("account-policy-updater-operation"),
+	}
+}
+
+func (cmd *AccountPolicyUpdaterCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Wrap(err, "failed to initialize command")
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op operation.Operation
+	if i, err := cmd.createOperation(); err != nil {
+		return errors.Wrap(err, "failed to create account-policy-updater operation")
+	} else if err := i.IsValid([]byte(cmd.OperationFlags.NetworkID)); err != nil {
+		return errors.Wrap(err, "invalid account-policy-updater operation")
+	} else {
+		cmd.Log().Debug().Interface("operation", i).Msg("operation loaded")
+
+		op = i
+	}
+
+	i, err := operation.NewBaseSeal(
+		cmd.OperationFlags.Privatekey,
+		[]operation.Operation{op},
+		[]byte(cmd.OperationFlags.NetworkID),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create operation.Seal")
+	}
+	cmd.Log().Debug().Interface("seal", i).Msg("seal loaded")
+
+	PrettyPrint(cmd.Out, cmd.Pretty, i)
+
+	return nil
+}
+
+func (cmd *AccountPolicyUpdaterCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	cmd.po = currency.NewAccountPolicy(cmd.MaxKeys, cmd.MinWeight, cmd.MaxWeight)
+	if err := cmd.po.IsValid(nil); err != nil {
+		return err
+	}
+
+	cmd.Log().Debug().Interface("account-policy", cmd.po).Msg("account policy loaded")
+
+	return nil
+}
+
+func (cmd *AccountPolicyUpdaterCommand) createOperation() (currency.AccountPolicyUpdater, error) {
+	fact := currency.NewAccountPolicyUpdaterFact([]byte(cmd.Token), cmd.po)
+
+	var fs []base.FactSign
+	sig, err := base.NewFactSignature(
+		cmd.OperationFlags.Privatekey,
+		fact,
+		[]byte(cmd.OperationFlags.NetworkID),
+	)
+	if err != nil {
+		return currency.AccountPolicyUpdater{}, err
+	}
+	fs = append(fs, base.NewBaseFactSign(cmd.OperationFlags.Privatekey.Publickey(), sig))
+
+	return currency.NewAccountPolicyUpdater(fact, fs, cmd.OperationFlags.Memo)
+}
@@ -0,0 +1,186 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type ApproveCommand struct {
+	*BaseCommand
+	OperationFlags
+	CurrencyDecimalsFlags
+	Sender  AddressFlag        `arg:"" name:"sender" help:"sender address" required:"true"`
+	Spender AddressFlag        `arg:"" name:"spender" help:"spender address" required:"true"`
+	Amount  CurrencyAmountFlag `arg:"" name:"currency-amount" help:"allowance; zero revokes the allowance (ex: \"<currency>,<amount>\" or \"<decimal amount><currency>\")"` // revive:disable-line:line-length-limit
+	sender  base.Address
+	spender base.Address
+}
+
+func NewApproveCommand() ApproveCommand {
+	return ApproveCommand{
+		BaseCommand: NewBaseCommand("approve-operation"),
+	}
+}
+
+func (cmd *ApproveCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Wrap(err, "failed to initialize command")
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.NetworkID(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create operation.Seal")
+	}
+	PrettyPrint(cmd.Out, cmd.Pretty, bs)
+
+	return nil
+}
+
+func (cmd *ApproveCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	} else if err := cmd.CurrencyDecimalsFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(jenc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	} else if b, err := cmd.Spender.Encode(jenc); err != nil {
+		return errors.Wrapf(err, "invalid spender format, %q", cmd.Spender.String())
+	} else {
+		cmd.sender = a
+		cmd.spender = b
+	}
+
+	return nil
+}
+
+func (cmd *ApproveCommand) createOperation() (operation.Operation, error) {
+	am, err := cmd.CurrencyDecimalsFlags.amount(cmd.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	fact := currency.NewApproveFact([]byte(cmd.Token), cmd.sender, cmd.spender, am)
+
+	var fs []base.FactSign
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs = append(fs, base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+
+	op, err := currency.NewApprove(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create approve operation")
+	}
+	return op, nil
+}
+
+type TransferFromCommand struct {
+	*BaseCommand
+	OperationFlags
+	CurrencyDecimalsFlags
+	Sender   AddressFlag        `arg:"" name:"sender" help:"sender address, spender of allowance" required:"true"`
+	Owner    AddressFlag        `arg:"" name:"owner" help:"owner address of allowance" required:"true"`
+	Receiver AddressFlag        `arg:"" name:"receiver" help:"receiver address" required:"true"`
+	Amount   CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\" or \"<decimal amount><currency>\")"`
+	sender   base.Address
+	owner    base.Address
+	receiver base.Address
+}
+
+func NewTransferFromCommand() TransferFromCommand {
+	return TransferFromCommand{
+		BaseCommand: NewBaseCommand("transfer-from-operation"),
+	}
+}
+
+func (cmd *TransferFromCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Wrap(err, "failed to initialize command")
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.NetworkID(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create operation.Seal")
+	}
+	PrettyPrint(cmd.Out, cmd.Pretty, bs)
+
+	return nil
+}
+
+func (cmd *TransferFromCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	} else if err := cmd.CurrencyDecimalsFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(jenc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	} else if b, err := cmd.Owner.Encode(jenc); err != nil {
+		return errors.Wrapf(err, "invalid owner format, %q", cmd.Owner.String())
+	} else if c, err := cmd.Receiver.Encode(jenc); err != nil {
+		return errors.Wrapf(err, "invalid receiver format, %q", cmd.Receiver.String())
+	} else {
+		cmd.sender = a
+		cmd.owner = b
+		cmd.receiver = c
+	}
+
+	return nil
+}
+
+func (cmd *TransferFromCommand) createOperation() (operation.Operation, error) {
+	am, err := cmd.CurrencyDecimalsFlags.amount(cmd.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	fact := currency.NewTransferFromFact([]byte(cmd.Token), cmd.sender, cmd.owner, cmd.receiver, am)
+
+	var fs []base.FactSign
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs = append(fs, base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+
+	op, err := currency.NewTransferFrom(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create transfer-from operation")
+	}
+	return op, nil
+}
@@ -0,0 +1,104 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+// AtomicSwapCommand creates AtomicSwap signed by sender; the counterparty
+// should also sign the fact by "sign-fact" command.
+type AtomicSwapCommand struct {
+	*BaseCommand
+	OperationFlags
+	CurrencyDecimalsFlags
+	Sender        AddressFlag        `arg:"" name:"sender" help:"sender address" required:"true"`
+	Amount        CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount paid by sender (ex: \"<currency>,<amount>\" or \"<decimal amount><currency>\")"` // revive:disable-line:line-length-limit
+	Counterparty  AddressFlag        `arg:"" name:"counterparty" help:"counterparty address" required:"true"`
+	CounterAmount CurrencyAmountFlag `arg:"" name:"counter-currency-amount" help:"amount paid by counterparty (ex: \"<currency>,<amount>\" or \"<decimal amount><currency>\")"` // revive:disable-line:line-length-limit
+	sender        base.Address
+	counterparty  base.Address
+}
+
+func NewAtomicSwapCommand() AtomicSwapCommand {
+	return AtomicSwapCommand{
+		BaseCommand: NewBaseCommand("atomic-swap-operation"),
+	}
+}
+
+func (cmd *AtomicSwapCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Wrap(err, "failed to initialize command")
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.NetworkID(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create operation.Seal")
+	}
+	PrettyPrint(cmd.Out, cmd.Pretty, bs)
+
+	return nil
+}
+
+func (cmd *AtomicSwapCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	} else if err := cmd.CurrencyDecimalsFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(jenc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	} else if b, err := cmd.Counterparty.Encode(jenc); err != nil {
+		return errors.Wrapf(err, "invalid counterparty format, %q", cmd.Counterparty.String())
+	} else {
+		cmd.sender = a
+		cmd.counterparty = b
+	}
+
+	return nil
+}
+
+func (cmd *AtomicSwapCommand) createOperation() (operation.Operation, error) {
+	am, err := cmd.CurrencyDecimalsFlags.amount(cmd.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	cam, err := cmd.CurrencyDecimalsFlags.amount(cmd.CounterAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	fact := currency.NewAtomicSwapFact([]byte(cmd.Token), cmd.sender, am, cmd.counterparty, cam)
+
+	var fs []base.FactSign
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs = append(fs, base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+
+	op, err := currency.NewAtomicSwap(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create atomic-swap operation")
+	}
+	return op, nil
+}
@@ -154,6 +154,47 @@ func AttachProposalProcessor(
 		return nil, err
 	} else if _, err := opr.SetProcessor(currency.TransfersHinter, currency.NewTransfersProcessor(cp)); err != nil {
 		return nil, err
+	} else if _, err := opr.SetProcessor(currency.BalanceUnlockHinter, currency.NewBalanceUnlockProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.EscrowCreateHinter, currency.NewEscrowCreateProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.EscrowReleaseHinter, currency.NewEscrowReleaseProcessor()); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.EscrowRefundHinter, currency.NewEscrowRefundProcessor()); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.HTLCLockHinter, currency.NewHTLCLockProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.HTLCClaimHinter, currency.NewHTLCClaimProcessor()); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.HTLCRefundHinter, currency.NewHTLCRefundProcessor()); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.SchedulePaymentHinter, currency.NewSchedulePaymentProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.CancelScheduleHinter, currency.NewCancelScheduleProcessor()); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.ApproveHinter, currency.NewApproveProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.TransferFromHinter, currency.NewTransferFromProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.AtomicSwapHinter, currency.NewAtomicSwapProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.AccountMergeHinter, currency.NewAccountMergeProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.RecoveryUpdaterHinter, currency.NewRecoveryUpdaterProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.RecoveryInitiateHinter, currency.NewRecoveryInitiateProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.RecoveryCancelHinter, currency.NewRecoveryCancelProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.RecoveryFinalizeHinter, currency.NewRecoveryFinalizeProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(currency.RegisterAliasHinter, currency.NewRegisterAliasProcessor(cp)); err != nil {
+		return nil, err
+	} else if _, err := opr.SetProcessor(
+		currency.AccountMetadataUpdaterHinter,
+		currency.NewAccountMetadataUpdaterProcessor(cp),
+	); err != nil {
+		return nil, err
 	}
 
 	threshold, err := base.NewThreshold(uint(len(suffrage.Nodes())), policy.ThresholdRatio())
@@ -183,12 +224,36 @@ func AttachProposalProcessor(
 		return nil, err
 	}
 
+	if _, err := opr.SetProcessor(currency.MemoPolicyUpdaterHinter,
+		currency.NewMemoPolicyUpdaterProcessor(pubs, threshold),
+	); err != nil {
+		return nil, err
+	}
+
+	if _, err := opr.SetProcessor(currency.AccountPolicyUpdaterHinter,
+		currency.NewAccountPolicyUpdaterProcessor(pubs, threshold),
+	); err != nil {
+		return nil, err
+	}
+
 	if _, err := opr.SetProcessor(currency.SuffrageInflationHinter,
 		currency.NewSuffrageInflationProcessor(cp, pubs, threshold),
 	); err != nil {
 		return nil, err
 	}
 
+	if _, err := opr.SetProcessor(currency.AccountFreezeHinter,
+		currency.NewAccountFreezeProcessor(pubs, threshold),
+	); err != nil {
+		return nil, err
+	}
+
+	if _, err := opr.SetProcessor(currency.AccountUnfreezeHinter,
+		currency.NewAccountUnfreezeProcessor(pubs, threshold),
+	); err != nil {
+		return nil, err
+	}
+
 	return opr, nil
 }
 
@@ -210,9 +275,32 @@ func InitializeProposalProcessor(ctx context.Context, opr *currency.OperationPro
 		currency.CreateAccountsHinter,
 		currency.KeyUpdaterHinter,
 		currency.TransfersHinter,
+		currency.BalanceUnlockHinter,
+		currency.EscrowCreateHinter,
+		currency.EscrowReleaseHinter,
+		currency.EscrowRefundHinter,
+		currency.HTLCLockHinter,
+		currency.HTLCClaimHinter,
+		currency.HTLCRefundHinter,
+		currency.SchedulePaymentHinter,
+		currency.CancelScheduleHinter,
+		currency.ApproveHinter,
+		currency.TransferFromHinter,
+		currency.AtomicSwapHinter,
+		currency.AccountMergeHinter,
+		currency.RecoveryUpdaterHinter,
+		currency.RecoveryInitiateHinter,
+		currency.RecoveryCancelHinter,
+		currency.RecoveryFinalizeHinter,
+		currency.RegisterAliasHinter,
+		currency.AccountMetadataUpdaterHinter,
 		currency.CurrencyPolicyUpdaterHinter,
 		currency.CurrencyRegisterHinter,
+		currency.MemoPolicyUpdaterHinter,
+		currency.AccountPolicyUpdaterHinter,
 		currency.SuffrageInflationHinter,
+		currency.AccountFreezeHinter,
+		currency.AccountUnfreezeHinter,
 	} {
 		if err := oprs.Add(hinter, opr); err != nil {
 			return ctx, err
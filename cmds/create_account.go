@@ -18,11 +18,12 @@ import (
 type CreateAccountCommand struct {
 	*BaseCommand
 	OperationFlags
+	CurrencyDecimalsFlags
 	Sender    AddressFlag          `arg:"" name:"sender" help:"sender address" required:"true"`
 	Threshold uint                 `help:"threshold for keys (default: ${create_account_threshold})" default:"${create_account_threshold}"` // nolint
 	Keys      []KeyFlag            `name:"key" help:"key for new account (ex: \"<public key>,<weight>\")" sep:"@"`
 	Seal      mitumcmds.FileLoad   `help:"seal" optional:""`
-	Amounts   []CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")"`
+	Amounts   []CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\" or \"<decimal amount><currency>\")"`
 	sender    base.Address
 	keys      currency.BaseAccountKeys
 }
@@ -64,6 +65,8 @@ func (cmd *CreateAccountCommand) Run(version util.Version) error { // nolint:dup
 func (cmd *CreateAccountCommand) parseFlags() error {
 	if err := cmd.OperationFlags.IsValid(nil); err != nil {
 		return err
+	} else if err := cmd.CurrencyDecimalsFlags.IsValid(nil); err != nil {
+		return err
 	}
 
 	a, err := cmd.Sender.Encode(jenc)
@@ -112,8 +115,11 @@ func (cmd *CreateAccountCommand) createOperation() (operation.Operation, error)
 
 	ams := make([]currency.Amount, len(cmd.Amounts))
 	for i := range cmd.Amounts {
-		a := cmd.Amounts[i]
-		am := currency.NewAmount(a.Big, a.CID)
+		am, e := cmd.CurrencyDecimalsFlags.amount(cmd.Amounts[i])
+		if e != nil {
+			return nil, e
+		}
+
 		if err = am.IsValid(nil); err != nil {
 			return nil, err
 		}
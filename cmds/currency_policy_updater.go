@@ -12,12 +12,13 @@ import (
 type CurrencyPolicyUpdaterCommand struct {
 	*BaseCommand
 	OperationFlags
-	Currency                CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
-	CurrencyPolicyFlags     `prefix:"policy-" help:"currency policy" required:"true"`
-	FeeerString             string `name:"feeer" help:"feeer type, {nil, fixed, ratio}" required:"true"`
-	CurrencyFixedFeeerFlags `prefix:"feeer-fixed-" help:"fixed feeer"`
-	CurrencyRatioFeeerFlags `prefix:"feeer-ratio-" help:"ratio feeer"`
-	po                      currency.CurrencyPolicy
+	Currency                 CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	CurrencyPolicyFlags      `prefix:"policy-" help:"currency policy" required:"true"`
+	FeeerString              string `name:"feeer" help:"feeer type, {nil, fixed, ratio, tiered}" required:"true"`
+	CurrencyFixedFeeerFlags  `prefix:"feeer-fixed-" help:"fixed feeer"`
+	CurrencyRatioFeeerFlags  `prefix:"feeer-ratio-" help:"ratio feeer"`
+	CurrencyTieredFeeerFlags `prefix:"feeer-tiered-" help:"tiered feeer"`
+	po                       currency.CurrencyPolicy
 }
 
 func NewCurrencyPolicyUpdaterCommand() CurrencyPolicyUpdaterCommand {
@@ -72,6 +73,8 @@ func (cmd *CurrencyPolicyUpdaterCommand) parseFlags() error {
 		return err
 	} else if err := cmd.CurrencyRatioFeeerFlags.IsValid(nil); err != nil {
 		return err
+	} else if err := cmd.CurrencyTieredFeeerFlags.IsValid(nil); err != nil {
+		return err
 	}
 
 	var feeer currency.Feeer
@@ -82,6 +85,8 @@ func (cmd *CurrencyPolicyUpdaterCommand) parseFlags() error {
 		feeer = cmd.CurrencyFixedFeeerFlags.feeer
 	case currency.FeeerRatio:
 		feeer = cmd.CurrencyRatioFeeerFlags.feeer
+	case currency.FeeerTiered:
+		feeer = cmd.CurrencyTieredFeeerFlags.feeer
 	default:
 		return errors.Errorf("unknown feeer type, %q", t)
 	}
@@ -92,7 +97,7 @@ func (cmd *CurrencyPolicyUpdaterCommand) parseFlags() error {
 		return err
 	}
 
-	cmd.po = currency.NewCurrencyPolicy(cmd.CurrencyPolicyFlags.NewAccountMinBalance.Big, feeer)
+	cmd.po = cmd.CurrencyPolicyFlags.policy(feeer)
 	if err := cmd.po.IsValid(nil); err != nil {
 		return err
 	}
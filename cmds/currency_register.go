@@ -59,32 +59,120 @@ func (fl *CurrencyRatioFeeerFlags) IsValid([]byte) error {
 	return fl.feeer.IsValid(nil)
 }
 
+type CurrencyTieredFeeerFlags struct {
+	Receiver AddressFlag      `name:"receiver" help:"fee receiver account address"`
+	Brackets []FeeBracketFlag `name:"bracket" help:"fee bracket, ordered by upper; -1 upper is unlimited (ex: \"<upper>,<amount>,<ratio>\")" sep:"@"` // nolint lll
+	feeer    currency.Feeer
+}
+
+func (fl *CurrencyTieredFeeerFlags) IsValid([]byte) error {
+	if len(fl.Receiver.String()) < 1 {
+		return nil
+	}
+
+	var receiver base.Address
+	if a, err := fl.Receiver.Encode(jenc); err != nil {
+		return isvalid.InvalidError.Errorf("invalid receiver format, %q: %w", fl.Receiver.String(), err)
+	} else if err := a.IsValid(nil); err != nil {
+		return isvalid.InvalidError.Errorf("invalid receiver address, %q: %w", fl.Receiver.String(), err)
+	} else {
+		receiver = a
+	}
+
+	brackets := make([]currency.FeeBracket, len(fl.Brackets))
+	for i := range fl.Brackets {
+		brackets[i] = fl.Brackets[i].Bracket
+	}
+
+	fl.feeer = currency.NewTieredFeeer(receiver, brackets)
+	return fl.feeer.IsValid(nil)
+}
+
 type CurrencyPolicyFlags struct {
-	NewAccountMinBalance BigFlag `name:"new-account-min-balance" help:"minimum balance for new account"` // nolint lll
+	NewAccountMinBalance BigFlag           `name:"new-account-min-balance" help:"minimum balance for new account"`                              // nolint lll
+	FeeReceivers         []FeeReceiverFlag `name:"fee-receiver" help:"weighted receiver of collected fee (ex: \"<address>,<weight>\")" sep:"@"` // nolint lll
+	FeeBurnRatio         float64           `name:"fee-burn-ratio" help:"ratio of collected fee to be burned, 0 >=, <= 1"`                       // nolint lll
+	MaxSupply            BigFlag           `name:"max-supply" help:"maximum supply of currency; without it, unlimited"`                         // nolint lll
+	FeeCurrency          CurrencyIDFlag    `name:"fee-currency" help:"currency, in which fee is paid"`                                          // nolint lll
+	FeeRate              float64           `name:"fee-rate" help:"conversion rate of fee to fee currency; 0 means no conversion"`               // nolint lll
+	FeeExemptions        []AddressFlag     `name:"fee-exemption" help:"address, which does not pay fee" sep:"@"`                                // nolint lll
+	feeExemptions        []base.Address
 }
 
-func (*CurrencyPolicyFlags) IsValid([]byte) error {
+func (fl *CurrencyPolicyFlags) IsValid([]byte) error {
+	if fl.FeeBurnRatio < 0 || fl.FeeBurnRatio > 1 {
+		return errors.Errorf("invalid fee burn ratio, %v", fl.FeeBurnRatio)
+	}
+
+	if len(fl.FeeExemptions) > 0 {
+		as := make([]base.Address, len(fl.FeeExemptions))
+		for i := range fl.FeeExemptions {
+			a, err := fl.FeeExemptions[i].Encode(jenc)
+			if err != nil {
+				return errors.Wrapf(err, "invalid fee exemption address, %q", fl.FeeExemptions[i].String())
+			}
+
+			as[i] = a
+		}
+
+		fl.feeExemptions = as
+	}
+
 	return nil
 }
 
+func (fl *CurrencyPolicyFlags) policy(feeer currency.Feeer) currency.CurrencyPolicy {
+	po := currency.NewCurrencyPolicy(fl.NewAccountMinBalance.Big, feeer).SetFeeBurnRatio(fl.FeeBurnRatio)
+	if fl.MaxSupply.Int != nil {
+		po = po.SetMaxSupply(fl.MaxSupply.Big)
+	}
+
+	if len(fl.FeeCurrency.CID) > 0 {
+		po = po.SetFeeCurrency(fl.FeeCurrency.CID, fl.FeeRate)
+	}
+
+	if len(fl.feeExemptions) > 0 {
+		po = po.SetFeeExemptions(fl.feeExemptions)
+	}
+
+	if len(fl.FeeReceivers) < 1 {
+		return po
+	}
+
+	frs := make([]currency.FeeReceiver, len(fl.FeeReceivers))
+	for i := range fl.FeeReceivers {
+		frs[i] = fl.FeeReceivers[i].Receiver
+	}
+
+	return po.SetFeeReceivers(frs)
+}
+
 type CurrencyDesignFlags struct {
-	Currency                CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
-	GenesisAmount           BigFlag        `arg:"" name:"genesis-amount" help:"genesis amount" required:"true"`
-	GenesisAccount          AddressFlag    `arg:"" name:"genesis-account" help:"genesis-account address for genesis balance" required:"true"` // nolint lll
-	CurrencyPolicyFlags     `prefix:"policy-" help:"currency policy" required:"true"`
-	FeeerString             string `name:"feeer" help:"feeer type, {nil, fixed, ratio}" required:"true"`
-	CurrencyFixedFeeerFlags `prefix:"feeer-fixed-" help:"fixed feeer"`
-	CurrencyRatioFeeerFlags `prefix:"feeer-ratio-" help:"ratio feeer"`
-	currencyDesign          currency.CurrencyDesign
+	Currency                 CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	GenesisAmount            BigFlag        `arg:"" name:"genesis-amount" help:"genesis amount" required:"true"`
+	GenesisAccount           AddressFlag    `arg:"" name:"genesis-account" help:"genesis-account address for genesis balance" required:"true"` // nolint lll
+	CurrencyPolicyFlags      `prefix:"policy-" help:"currency policy" required:"true"`
+	FeeerString              string `name:"feeer" help:"feeer type, {nil, fixed, ratio, tiered}" required:"true"`
+	CurrencyFixedFeeerFlags  `prefix:"feeer-fixed-" help:"fixed feeer"`
+	CurrencyRatioFeeerFlags  `prefix:"feeer-ratio-" help:"ratio feeer"`
+	CurrencyTieredFeeerFlags `prefix:"feeer-tiered-" help:"tiered feeer"`
+	Decimals                 uint `name:"decimals" help:"decimals of currency amount; it can not be updated"`
+	currencyDesign           currency.CurrencyDesign
 }
 
 func (fl *CurrencyDesignFlags) IsValid([]byte) error {
+	if fl.Decimals > currency.MaxCurrencyDecimals {
+		return isvalid.InvalidError.Errorf("decimals over max, %d > %d", fl.Decimals, currency.MaxCurrencyDecimals)
+	}
+
 	if err := fl.CurrencyPolicyFlags.IsValid(nil); err != nil {
 		return err
 	} else if err := fl.CurrencyFixedFeeerFlags.IsValid(nil); err != nil {
 		return err
 	} else if err := fl.CurrencyRatioFeeerFlags.IsValid(nil); err != nil {
 		return err
+	} else if err := fl.CurrencyTieredFeeerFlags.IsValid(nil); err != nil {
+		return err
 	}
 
 	var feeer currency.Feeer
@@ -95,6 +183,8 @@ func (fl *CurrencyDesignFlags) IsValid([]byte) error {
 		feeer = fl.CurrencyFixedFeeerFlags.feeer
 	case currency.FeeerRatio:
 		feeer = fl.CurrencyRatioFeeerFlags.feeer
+	case currency.FeeerTiered:
+		feeer = fl.CurrencyTieredFeeerFlags.feeer
 	default:
 		return isvalid.InvalidError.Errorf("unknown feeer type, %q", t)
 	}
@@ -105,7 +195,7 @@ func (fl *CurrencyDesignFlags) IsValid([]byte) error {
 		return err
 	}
 
-	po := currency.NewCurrencyPolicy(fl.CurrencyPolicyFlags.NewAccountMinBalance.Big, feeer)
+	po := fl.CurrencyPolicyFlags.policy(feeer)
 	if err := po.IsValid(nil); err != nil {
 		return err
 	}
@@ -124,7 +214,7 @@ func (fl *CurrencyDesignFlags) IsValid([]byte) error {
 		return err
 	}
 
-	fl.currencyDesign = currency.NewCurrencyDesign(am, genesisAccount, po)
+	fl.currencyDesign = currency.NewCurrencyDesign(am, genesisAccount, po).SetDecimals(fl.Decimals)
 	return fl.currencyDesign.IsValid(nil)
 }
 
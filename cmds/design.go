@@ -3,6 +3,8 @@ package cmds
 import (
 	"context"
 	"net/url"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -114,8 +116,15 @@ type CurrencyDesign struct {
 	BalanceString              *string         `yaml:"balance"`
 	NewAccountMinBalanceString *string         `yaml:"new-account-min-balance"`
 	Feeer                      *FeeerDesign    `yaml:"feeer"`
+	FeeBurnRatio               float64         `yaml:"fee-burn-ratio"`
+	MaxSupplyString            *string         `yaml:"max-supply"`
+	Decimals                   uint            `yaml:"decimals"`
+	FeeCurrencyString          *string         `yaml:"fee-currency"`
+	FeeRate                    float64         `yaml:"fee-rate"`
+	FeeExemptions              []string        `yaml:"fee-exemptions"`
 	Balance                    currency.Amount `yaml:"-"`
 	NewAccountMinBalance       currency.Big    `yaml:"-"`
+	MaxSupply                  currency.Big    `yaml:"-"`
 }
 
 func (de *CurrencyDesign) IsValid([]byte) error {
@@ -149,12 +158,34 @@ func (de *CurrencyDesign) IsValid([]byte) error {
 		de.NewAccountMinBalance = b
 	}
 
+	if de.FeeCurrencyString != nil {
+		if err := currency.CurrencyID(*de.FeeCurrencyString).IsValid(nil); err != nil {
+			return errors.Wrap(err, "invalid fee-currency")
+		} else if *de.FeeCurrencyString == *de.CurrencyString {
+			return errors.Errorf("fee-currency, %q same with currency", *de.FeeCurrencyString)
+		}
+	}
+
+	if de.MaxSupplyString != nil {
+		b, err := currency.NewBigFromString(*de.MaxSupplyString)
+		if err != nil {
+			return isvalid.InvalidError.Wrap(err)
+		} else if !b.OverZero() {
+			return errors.Errorf("max-supply should be over zero")
+		}
+		de.MaxSupply = b
+	}
+
 	if de.Feeer == nil {
 		de.Feeer = &FeeerDesign{}
 	} else if err := de.Feeer.IsValid(nil); err != nil {
 		return err
 	}
 
+	if de.FeeBurnRatio < 0 || de.FeeBurnRatio > 1 {
+		return errors.Errorf("invalid fee-burn-ratio, %v", de.FeeBurnRatio)
+	}
+
 	return nil
 }
 
@@ -175,6 +206,10 @@ func (no *FeeerDesign) IsValid([]byte) error {
 		if err := no.checkRatio(no.Extras); err != nil {
 			return err
 		}
+	case currency.FeeerTiered:
+		if err := no.checkTiered(no.Extras); err != nil {
+			return err
+		}
 	default:
 		return errors.Errorf("unknown type of feeer, %v", t)
 	}
@@ -224,9 +259,66 @@ func (no FeeerDesign) checkRatio(c map[string]interface{}) error {
 	return nil
 }
 
+func (no FeeerDesign) checkTiered(c map[string]interface{}) error {
+	a, found := c["brackets"]
+	if !found {
+		return errors.Errorf("tiered needs `brackets`")
+	}
+
+	l, ok := a.([]interface{})
+	if !ok {
+		return errors.Errorf("invalid brackets value type, %T of tiered; should be list", a)
+	}
+
+	brackets := make([]currency.FeeBracket, len(l))
+	for i := range l {
+		m, ok := l[i].(map[string]interface{})
+		if !ok {
+			return errors.Errorf("invalid bracket value type, %T of tiered", l[i])
+		}
+
+		upper := currency.UnlimitedMaxFeeAmount
+		if b, found := m["upper"]; found {
+			n, err := currency.NewBigFromInterface(b)
+			if err != nil {
+				return errors.Wrapf(err, "invalid upper value, %v of tiered", b)
+			}
+			upper = n
+		}
+
+		amount := currency.ZeroBig
+		if b, found := m["amount"]; found {
+			n, err := currency.NewBigFromInterface(b)
+			if err != nil {
+				return errors.Wrapf(err, "invalid amount value, %v of tiered", b)
+			}
+			amount = n
+		}
+
+		var ratio float64
+		if b, found := m["ratio"]; found {
+			switch t := b.(type) {
+			case float64:
+				ratio = t
+			case int:
+				ratio = float64(t)
+			default:
+				return errors.Errorf("invalid ratio value type, %T of tiered; should be float64", b)
+			}
+		}
+
+		brackets[i] = currency.NewFeeBracket(upper, amount, ratio)
+	}
+
+	no.Extras["tiered_brackets"] = brackets
+
+	return nil
+}
+
 type DigestDesign struct {
 	NetworkYAML *yamlconfig.LocalNetwork `yaml:"network,omitempty"`
 	CacheYAML   *string                  `yaml:"cache,omitempty"`
+	WebhookYAML *DigestWebhookDesign     `yaml:"webhook,omitempty"`
 	network     config.LocalNetwork
 	cache       *url.URL
 }
@@ -293,6 +385,12 @@ func (no *DigestDesign) Set(ctx context.Context) (context.Context, error) {
 		no.cache = u
 	}
 
+	if no.WebhookYAML != nil {
+		if err := no.WebhookYAML.IsValid(nil); err != nil {
+			return ctx, err
+		}
+	}
+
 	return ctx, nil
 }
 
@@ -303,3 +401,42 @@ func (no *DigestDesign) Network() config.LocalNetwork {
 func (no *DigestDesign) Cache() *url.URL {
 	return no.cache
 }
+
+// Webhook returns the webhook design; if nil, webhook is disabled.
+func (no *DigestDesign) Webhook() *DigestWebhookDesign {
+	return no.WebhookYAML
+}
+
+// DigestWebhookDesign enables webhook of digest. The webhook handlers are
+// authenticated by the bearer token.
+type DigestWebhookDesign struct {
+	Token               string  `yaml:"token"`
+	MaxAttempts         uint    `yaml:"max-attempts,omitempty"`
+	RetryIntervalString *string `yaml:"retry-interval,omitempty"`
+	retryInterval       time.Duration
+}
+
+func (wd *DigestWebhookDesign) IsValid([]byte) error {
+	if len(strings.TrimSpace(wd.Token)) < 1 {
+		return errors.Errorf("empty webhook token")
+	}
+
+	if wd.RetryIntervalString != nil {
+		d, err := time.ParseDuration(*wd.RetryIntervalString)
+		if err != nil {
+			return errors.Wrap(err, "invalid webhook retry-interval")
+		} else if d <= 0 {
+			return errors.Errorf("webhook retry-interval should be over zero")
+		}
+
+		wd.retryInterval = d
+	}
+
+	return nil
+}
+
+// RetryInterval returns the first interval between delivery attempts; zero
+// means default.
+func (wd *DigestWebhookDesign) RetryInterval() time.Duration {
+	return wd.retryInterval
+}
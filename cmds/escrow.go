@@ -0,0 +1,202 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type EscrowCreateCommand struct {
+	*BaseCommand
+	OperationFlags
+	CurrencyDecimalsFlags
+	Sender      AddressFlag        `arg:"" name:"sender" help:"sender address" required:"true"`
+	Beneficiary AddressFlag        `arg:"" name:"beneficiary" help:"beneficiary address" required:"true"`
+	Arbiter     AddressFlag        `arg:"" name:"arbiter" help:"arbiter address" required:"true"`
+	Amount      CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\" or \"<decimal amount><currency>\")"`
+	Expiry      int64              `arg:"" name:"expiry" help:"expiry height; after the height, escrow can be refunded" required:"true"`
+	sender      base.Address
+	beneficiary base.Address
+	arbiter     base.Address
+}
+
+func NewEscrowCreateCommand() EscrowCreateCommand {
+	return EscrowCreateCommand{
+		BaseCommand: NewBaseCommand("escrow-create-operation"),
+	}
+}
+
+func (cmd *EscrowCreateCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Wrap(err, "failed to initialize command")
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.NetworkID(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create operation.Seal")
+	}
+	PrettyPrint(cmd.Out, cmd.Pretty, bs)
+
+	return nil
+}
+
+func (cmd *EscrowCreateCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	} else if err := cmd.CurrencyDecimalsFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(jenc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	} else if b, err := cmd.Beneficiary.Encode(jenc); err != nil {
+		return errors.Wrapf(err, "invalid beneficiary format, %q", cmd.Beneficiary.String())
+	} else if c, err := cmd.Arbiter.Encode(jenc); err != nil {
+		return errors.Wrapf(err, "invalid arbiter format, %q", cmd.Arbiter.String())
+	} else {
+		cmd.sender = a
+		cmd.beneficiary = b
+		cmd.arbiter = c
+	}
+
+	return nil
+}
+
+func (cmd *EscrowCreateCommand) createOperation() (operation.Operation, error) {
+	am, err := cmd.CurrencyDecimalsFlags.amount(cmd.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	fact := currency.NewEscrowCreateFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.beneficiary,
+		cmd.arbiter,
+		am,
+		base.Height(cmd.Expiry),
+	)
+
+	var fs []base.FactSign
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs = append(fs, base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+
+	op, err := currency.NewEscrowCreate(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create escrow-create operation")
+	}
+	return op, nil
+}
+
+type EscrowSettleCommand struct {
+	*BaseCommand
+	OperationFlags
+	Sender AddressFlag `arg:"" name:"sender" help:"sender or arbiter address of escrow" required:"true"`
+	Escrow HashFlag    `arg:"" name:"escrow" help:"escrow id, fact hash of escrow-create operation" required:"true"`
+	refund bool
+	sender base.Address
+}
+
+func NewEscrowReleaseCommand() EscrowSettleCommand {
+	return EscrowSettleCommand{
+		BaseCommand: NewBaseCommand("escrow-release-operation"),
+	}
+}
+
+func NewEscrowRefundCommand() EscrowSettleCommand {
+	return EscrowSettleCommand{
+		BaseCommand: NewBaseCommand("escrow-refund-operation"),
+		refund:      true,
+	}
+}
+
+func (cmd *EscrowSettleCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Wrap(err, "failed to initialize command")
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.NetworkID(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create operation.Seal")
+	}
+	PrettyPrint(cmd.Out, cmd.Pretty, bs)
+
+	return nil
+}
+
+func (cmd *EscrowSettleCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	return nil
+}
+
+func (cmd *EscrowSettleCommand) createOperation() (operation.Operation, error) {
+	var fact base.Fact
+	if cmd.refund {
+		fact = currency.NewEscrowRefundFact([]byte(cmd.Token), cmd.sender, cmd.Escrow.Hash)
+	} else {
+		fact = currency.NewEscrowReleaseFact([]byte(cmd.Token), cmd.sender, cmd.Escrow.Hash)
+	}
+
+	var fs []base.FactSign
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs = append(fs, base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+
+	if cmd.refund {
+		op, e := currency.NewEscrowRefund(fact.(currency.EscrowRefundFact), fs, cmd.Memo)
+		if e != nil {
+			return nil, errors.Wrap(e, "failed to create escrow-refund operation")
+		}
+		return op, nil
+	}
+
+	op, err := currency.NewEscrowRelease(fact.(currency.EscrowReleaseFact), fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create escrow-release operation")
+	}
+	return op, nil
+}
@@ -2,9 +2,14 @@ package cmds
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -12,6 +17,8 @@ import (
 	"github.com/spikeekips/mitum/base/key"
 	mitumcmds "github.com/spikeekips/mitum/launch/cmds"
 	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
 
 	"github.com/spikeekips/mitum-currency/currency"
 )
@@ -128,6 +135,28 @@ func (v *AddressFlag) Encode(enc encoder.Encoder) (base.Address, error) {
 	return base.DecodeAddressFromString(v.s, enc)
 }
 
+type HashFlag struct {
+	Hash valuehash.Hash
+}
+
+func (v *HashFlag) UnmarshalText(b []byte) error {
+	h := valuehash.NewBytesFromString(strings.TrimSpace(string(b)))
+	if err := h.IsValid(nil); err != nil {
+		return errors.Wrapf(err, "invalid hash string, %q", string(b))
+	}
+	v.Hash = h
+
+	return nil
+}
+
+func (v *HashFlag) String() string {
+	if v.Hash == nil {
+		return ""
+	}
+
+	return v.Hash.String()
+}
+
 type BigFlag struct {
 	currency.Big
 }
@@ -162,12 +191,29 @@ func (v *CurrencyIDFlag) String() string {
 	return v.CID.String()
 }
 
+var reDecimalCurrencyAmount = regexp.MustCompile(`^([0-9]+(?:\.[0-9]*)?)([A-Z].*)$`)
+
+// CurrencyAmountFlag accepts the raw amount, "<currency>,<amount>" or the
+// decimal amount, "<amount><currency>", like "12.5MCC". The decimal amount is
+// converted to raw amount by the decimals of currency.
 type CurrencyAmountFlag struct {
-	CID currency.CurrencyID
-	Big currency.Big
+	CID     currency.CurrencyID
+	Big     currency.Big
+	decimal string
 }
 
 func (v *CurrencyAmountFlag) UnmarshalText(b []byte) error {
+	if m := reDecimalCurrencyAmount.FindStringSubmatch(string(b)); len(m) == 3 {
+		cid := currency.CurrencyID(m[2])
+		if err := cid.IsValid(nil); err != nil {
+			return err
+		}
+		v.CID = cid
+		v.decimal = m[1]
+
+		return nil
+	}
+
 	l := strings.SplitN(string(b), ",", 2)
 	if len(l) != 2 {
 		return fmt.Errorf("invalid currency-amount, %q", string(b))
@@ -193,5 +239,242 @@ func (v *CurrencyAmountFlag) UnmarshalText(b []byte) error {
 }
 
 func (v *CurrencyAmountFlag) String() string {
+	if len(v.decimal) > 0 {
+		return v.decimal + v.CID.String()
+	}
+
 	return v.CID.String() + "," + v.Big.String()
 }
+
+// IsDecimal returns true when the amount is given as decimal amount.
+func (v *CurrencyAmountFlag) IsDecimal() bool {
+	return len(v.decimal) > 0
+}
+
+// Amount converts the flag to currency.Amount. The decimal amount should be
+// converted exactly with the given decimals.
+func (v *CurrencyAmountFlag) Amount(decimals uint) (currency.Amount, error) {
+	if !v.IsDecimal() {
+		return currency.NewAmount(v.Big, v.CID), nil
+	}
+
+	b, err := currency.NewBigFromDecimalString(v.decimal, decimals)
+	if err != nil {
+		return currency.Amount{}, errors.Wrapf(err, "invalid decimal amount, %q", v.String())
+	}
+
+	return currency.NewAmount(b, v.CID), nil
+}
+
+type CurrencyDecimalsFlag struct {
+	CID      currency.CurrencyID
+	Decimals uint
+}
+
+func (v *CurrencyDecimalsFlag) UnmarshalText(b []byte) error {
+	l := strings.SplitN(string(b), ",", 2)
+	if len(l) != 2 {
+		return errors.Errorf(`wrong formatted; "<currency>,<decimals>"`)
+	}
+
+	cid := currency.CurrencyID(l[0])
+	if err := cid.IsValid(nil); err != nil {
+		return err
+	}
+
+	d, err := strconv.ParseUint(l[1], 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "invalid decimals, %q", l[1])
+	}
+
+	v.CID = cid
+	v.Decimals = uint(d)
+
+	return v.IsValid(nil)
+}
+
+func (v *CurrencyDecimalsFlag) IsValid([]byte) error {
+	if v.Decimals > currency.MaxCurrencyDecimals {
+		return isvalid.InvalidError.Errorf(
+			"decimals of currency, %q over max, %d > %d", v.CID, v.Decimals, currency.MaxCurrencyDecimals)
+	}
+
+	return nil
+}
+
+// CurrencyDecimalsFlags gives the decimals of currency for decimal amount; when
+// the decimals of currency is not given, it is fetched from the digest api,
+// "/currency/{currencyid}".
+type CurrencyDecimalsFlags struct {
+	Decimals []CurrencyDecimalsFlag `name:"currency-decimals" help:"decimals of currency for decimal amount (ex: \"<currency>,<decimals>\")" sep:"@"` // nolint lll
+	Digest   *url.URL               `name:"digest-url" help:"digest api url to fetch decimals of currency for decimal amount"`                        // nolint lll
+	fetched  map[currency.CurrencyID]uint
+}
+
+func (fl *CurrencyDecimalsFlags) IsValid([]byte) error {
+	founds := map[currency.CurrencyID]struct{}{}
+	for i := range fl.Decimals {
+		d := fl.Decimals[i]
+		if err := d.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[d.CID]; found {
+			return isvalid.InvalidError.Errorf("duplicated decimals of currency, %q", d.CID)
+		}
+		founds[d.CID] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fl *CurrencyDecimalsFlags) amount(a CurrencyAmountFlag) (currency.Amount, error) {
+	if !a.IsDecimal() {
+		return a.Amount(0)
+	}
+
+	for i := range fl.Decimals {
+		if fl.Decimals[i].CID == a.CID {
+			return a.Amount(fl.Decimals[i].Decimals)
+		}
+	}
+
+	if fl.Digest == nil {
+		return currency.Amount{}, errors.Errorf(
+			"decimals of currency, %q not given for decimal amount, %q", a.CID, a.String())
+	}
+
+	d, found := fl.fetched[a.CID]
+	if !found {
+		i, err := fetchCurrencyDecimals(fl.Digest, a.CID)
+		if err != nil {
+			return currency.Amount{}, errors.Wrapf(err, "failed to fetch decimals of currency, %q", a.CID)
+		}
+
+		if fl.fetched == nil {
+			fl.fetched = map[currency.CurrencyID]uint{}
+		}
+		fl.fetched[a.CID] = i
+		d = i
+	}
+
+	return a.Amount(d)
+}
+
+// fetchCurrencyDecimals requests the currency design to the digest api.
+func fetchCurrencyDecimals(u *url.URL, cid currency.CurrencyID) (uint, error) {
+	cu := *u
+	cu.Path = strings.TrimRight(cu.Path, "/") + "/currency/" + url.PathEscape(cid.String())
+
+	client := &http.Client{Timeout: time.Second * 10}
+	res, err := client.Get(cu.String())
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode != http.StatusOK {
+		return 0, errors.Errorf("digest api responds %d", res.StatusCode)
+	}
+
+	var hal struct {
+		Embedded *struct {
+			Decimals uint `json:"decimals"`
+		} `json:"_embedded"`
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(&hal); err != nil {
+		return 0, errors.Wrap(err, "invalid currency design")
+	}
+
+	switch {
+	case hal.Embedded == nil:
+		return 0, errors.Errorf("empty currency design")
+	case hal.Embedded.Decimals > currency.MaxCurrencyDecimals:
+		return 0, errors.Errorf("decimals over max, %d > %d", hal.Embedded.Decimals, currency.MaxCurrencyDecimals)
+	}
+
+	return hal.Embedded.Decimals, nil
+}
+
+type FeeBracketFlag struct {
+	Bracket currency.FeeBracket
+}
+
+func (v *FeeBracketFlag) UnmarshalText(b []byte) error {
+	l := strings.SplitN(string(b), ",", 3)
+	if len(l) != 3 {
+		return errors.Errorf(`wrong formatted; "<big upper>,<big amount>,<float ratio>"`)
+	}
+
+	upper, err := currency.NewBigFromString(l[0])
+	if err != nil {
+		return errors.Wrapf(err, "invalid upper, %q for fee bracket", l[0])
+	}
+
+	amount, err := currency.NewBigFromString(l[1])
+	if err != nil {
+		return errors.Wrapf(err, "invalid amount, %q for fee bracket", l[1])
+	}
+
+	ratio, err := strconv.ParseFloat(l[2], 64)
+	if err != nil {
+		return errors.Wrapf(err, "invalid ratio, %q for fee bracket", l[2])
+	}
+
+	fb := currency.NewFeeBracket(upper, amount, ratio)
+	if err := fb.IsValid(nil); err != nil {
+		return err
+	}
+	v.Bracket = fb
+
+	return nil
+}
+
+type FeeReceiverFlag struct {
+	Receiver currency.FeeReceiver
+}
+
+func (v *FeeReceiverFlag) UnmarshalText(b []byte) error {
+	l := strings.SplitN(string(b), ",", 2)
+	if len(l) != 2 {
+		return errors.Errorf(`wrong formatted; "<string address>,<uint weight>"`)
+	}
+
+	a, err := base.DecodeAddressFromString(l[0], jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid address, %q for fee receiver", l[0])
+	}
+
+	w, err := strconv.ParseUint(l[1], 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "invalid weight, %q for fee receiver", l[1])
+	}
+
+	fr := currency.NewFeeReceiver(a, uint(w))
+	if err := fr.IsValid(nil); err != nil {
+		return err
+	}
+	v.Receiver = fr
+
+	return nil
+}
+
+type MetadataFlag struct {
+	Key   string
+	Value string
+}
+
+func (v *MetadataFlag) UnmarshalText(b []byte) error {
+	l := strings.SplitN(string(b), "=", 2)
+	if len(l) != 2 {
+		return errors.Errorf(`wrong formatted; "<key>=<value>"`)
+	}
+
+	v.Key = strings.TrimSpace(l[0])
+	v.Value = l[1]
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package cmds
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/alecthomas/kong"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/launch/cmds"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type testCurrencyAmountFlag struct {
+	suite.Suite
+}
+
+func (t *testCurrencyAmountFlag) TestRaw() {
+	var fl CurrencyAmountFlag
+	t.NoError(fl.UnmarshalText([]byte("MCC,125")))
+	t.False(fl.IsDecimal())
+
+	am, err := (&CurrencyDecimalsFlags{}).amount(fl)
+	t.NoError(err)
+	t.Equal(currency.CurrencyID("MCC"), am.Currency())
+	t.Equal("125", am.Big().String())
+}
+
+func (t *testCurrencyAmountFlag) TestDecimal() {
+	var fl CurrencyAmountFlag
+	t.NoError(fl.UnmarshalText([]byte("12.5MCC")))
+	t.True(fl.IsDecimal())
+	t.Equal("12.5MCC", fl.String())
+
+	var dfl CurrencyDecimalsFlag
+	t.NoError(dfl.UnmarshalText([]byte("MCC,3")))
+
+	am, err := (&CurrencyDecimalsFlags{Decimals: []CurrencyDecimalsFlag{dfl}}).amount(fl)
+	t.NoError(err)
+	t.Equal(currency.CurrencyID("MCC"), am.Currency())
+	t.Equal("12500", am.Big().String())
+}
+
+func (t *testCurrencyAmountFlag) TestDecimalNotExact() {
+	var fl CurrencyAmountFlag
+	t.NoError(fl.UnmarshalText([]byte("12.5MCC")))
+
+	_, err := (&CurrencyDecimalsFlags{Decimals: []CurrencyDecimalsFlag{{CID: "MCC"}}}).amount(fl)
+	t.Contains(err.Error(), "too many decimal places")
+}
+
+func (t *testCurrencyAmountFlag) TestDecimalWithoutDecimals() {
+	var fl CurrencyAmountFlag
+	t.NoError(fl.UnmarshalText([]byte("12.5MCC")))
+
+	_, err := (&CurrencyDecimalsFlags{}).amount(fl)
+	t.Contains(err.Error(), "decimals of currency")
+}
+
+func (t *testCurrencyAmountFlag) digestServer(decimals uint) (*httptest.Server, *int) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/currency/MCC" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		requests++
+
+		_, _ = fmt.Fprintf(w, `{"_embedded":{"currency":"MCC","decimals":%d}}`, decimals)
+	}))
+
+	return ts, &requests
+}
+
+func (t *testCurrencyAmountFlag) TestDecimalFromDigest() {
+	ts, requests := t.digestServer(3)
+	defer ts.Close()
+
+	u, err := url.Parse(ts.URL)
+	t.NoError(err)
+
+	fl := &CurrencyDecimalsFlags{Digest: u}
+
+	for s, expected := range map[string]string{"12.5MCC": "12500", "1.125MCC": "1125"} {
+		var afl CurrencyAmountFlag
+		t.NoError(afl.UnmarshalText([]byte(s)))
+
+		am, err := fl.amount(afl)
+		t.NoError(err)
+		t.Equal(currency.CurrencyID("MCC"), am.Currency())
+		t.Equal(expected, am.Big().String())
+	}
+
+	t.Equal(1, *requests) // NOTE fetched once
+
+	// NOTE unknown currency
+	var afl CurrencyAmountFlag
+	t.NoError(afl.UnmarshalText([]byte("12.5ABC")))
+
+	_, err = fl.amount(afl)
+	t.Contains(err.Error(), "failed to fetch decimals")
+}
+
+func (t *testCurrencyAmountFlag) TestDecimalFromDigestNotExact() {
+	ts, _ := t.digestServer(0)
+	defer ts.Close()
+
+	u, err := url.Parse(ts.URL)
+	t.NoError(err)
+
+	var afl CurrencyAmountFlag
+	t.NoError(afl.UnmarshalText([]byte("12.5MCC")))
+
+	_, err = (&CurrencyDecimalsFlags{Digest: u}).amount(afl)
+	t.Contains(err.Error(), "too many decimal places")
+}
+
+func (t *testCurrencyAmountFlag) TestDecimalsOverMax() {
+	var dfl CurrencyDecimalsFlag
+	t.NoError(dfl.UnmarshalText([]byte("MCC,36")))
+
+	err := dfl.UnmarshalText([]byte("MCC,37"))
+	t.Contains(err.Error(), "over max")
+}
+
+func (t *testCurrencyAmountFlag) transferCommand(args ...string) (TransferCommand, error) {
+	cli := NewTransferCommand()
+	parser, err := kong.New(&cli, cmds.LogVars, cmds.PprofVars)
+	t.NoError(err)
+
+	_, err = parser.Parse(append([]string{
+		"--network-id=showme",
+		"KzFERQKNQbPA8cdsX5tCiCZvR4KgBou41cgtPk69XueFbaEjrczbmpr",
+		currency.NewTestAddress().String(),
+		currency.NewTestAddress().String(),
+	}, args...))
+
+	return cli, err
+}
+
+func (t *testCurrencyAmountFlag) TestCommandDecimals() {
+	cli, err := t.transferCommand("--currency-decimals=MCC,3", "12.5MCC")
+	t.NoError(err)
+
+	var buf bytes.Buffer
+	cli.Out = &buf
+
+	t.NoError(cli.Run(util.Version("0.1.1")))
+	t.Contains(buf.String(), `"12500"`)
+}
+
+func (t *testCurrencyAmountFlag) TestCommandDecimalsFromDigest() {
+	ts, _ := t.digestServer(3)
+	defer ts.Close()
+
+	cli, err := t.transferCommand("--digest-url="+ts.URL, "12.5MCC")
+	t.NoError(err)
+
+	var buf bytes.Buffer
+	cli.Out = &buf
+
+	t.NoError(cli.Run(util.Version("0.1.1")))
+	t.Contains(buf.String(), `"12500"`)
+}
+
+func (t *testCurrencyAmountFlag) TestCommandDecimalsOverMax() {
+	_, err := t.transferCommand("--currency-decimals=MCC,37", "12.5MCC")
+	t.Error(err)
+	t.Contains(err.Error(), "over max")
+}
+
+func (t *testCurrencyAmountFlag) TestCommandDuplicatedDecimals() {
+	cli, err := t.transferCommand("--currency-decimals=MCC,3@MCC,4", "12.5MCC")
+	t.NoError(err)
+
+	cli.Out = &bytes.Buffer{}
+
+	err = cli.Run(util.Version("0.1.1"))
+	t.Error(err)
+	t.Contains(err.Error(), "duplicated decimals")
+}
+
+func TestCurrencyAmountFlag(t *testing.T) {
+	suite.Run(t, new(testCurrencyAmountFlag))
+}
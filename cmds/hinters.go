@@ -15,8 +15,24 @@ var (
 
 var types = []hint.Type{
 	currency.AccountType,
+	currency.AccountFreezeFactType,
+	currency.AccountMergeFactType,
+	currency.AccountMergeType,
+	currency.AccountMetadataType,
+	currency.AccountMetadataUpdaterFactType,
+	currency.AccountMetadataUpdaterType,
+	currency.AccountFreezeType,
+	currency.AccountUnfreezeFactType,
+	currency.AccountUnfreezeType,
 	currency.AddressType,
+	currency.AliasType,
+	currency.AliasAddressType,
+	currency.AllowanceType,
 	currency.AmountType,
+	currency.ApproveFactType,
+	currency.ApproveType,
+	currency.BalanceUnlockFactType,
+	currency.BalanceUnlockType,
 	currency.CreateAccountsFactType,
 	currency.CreateAccountsItemMultiAmountsType,
 	currency.CreateAccountsItemSingleAmountType,
@@ -25,22 +41,70 @@ var types = []hint.Type{
 	currency.CurrencyPolicyType,
 	currency.CurrencyPolicyUpdaterFactType,
 	currency.CurrencyPolicyUpdaterType,
+	currency.MemoPolicyType,
+	currency.MemoPolicyUpdaterFactType,
+	currency.MemoPolicyUpdaterType,
+	currency.AccountPolicyType,
+	currency.AccountPolicyUpdaterFactType,
+	currency.AccountPolicyUpdaterType,
 	currency.CurrencyRegisterFactType,
 	currency.CurrencyRegisterType,
+	currency.EscrowType,
+	currency.EscrowCreateFactType,
+	currency.EscrowCreateType,
+	currency.EscrowRefundFactType,
+	currency.EscrowRefundType,
+	currency.EscrowReleaseFactType,
+	currency.EscrowReleaseType,
+	currency.HTLCType,
+	currency.HTLCLockFactType,
+	currency.HTLCLockType,
+	currency.HTLCClaimFactType,
+	currency.HTLCClaimType,
+	currency.HTLCRefundFactType,
+	currency.HTLCRefundType,
 	currency.FeeOperationFactType,
 	currency.FeeOperationType,
+	currency.PaymentScheduleType,
+	currency.ScheduleQueueType,
+	currency.SchedulePaymentFactType,
+	currency.SchedulePaymentType,
+	currency.CancelScheduleFactType,
+	currency.CancelScheduleType,
+	currency.ScheduleRunType,
+	currency.ScheduleRunOperationFactType,
+	currency.ScheduleRunOperationType,
+	currency.AtomicSwapFactType,
+	currency.AtomicSwapType,
 	currency.FixedFeeerType,
 	currency.GenesisCurrenciesFactType,
 	currency.GenesisCurrenciesType,
 	currency.AccountKeyType,
+	currency.AccountIndexType,
 	currency.KeyUpdaterFactType,
 	currency.KeyUpdaterType,
 	currency.AccountKeysType,
+	currency.LockedAmountType,
 	currency.NilFeeerType,
 	currency.RatioFeeerType,
+	currency.AccountRecoveryType,
+	currency.RecoveryCancelFactType,
+	currency.RecoveryCancelType,
+	currency.RecoveryFinalizeFactType,
+	currency.RecoveryFinalizeType,
+	currency.RecoveryInitiateFactType,
+	currency.RecoveryInitiateType,
+	currency.RecoveryUpdaterFactType,
+	currency.RecoveryUpdaterType,
+	currency.RegisterAliasFactType,
+	currency.RegisterAliasType,
+	currency.TieredFeeerType,
 	currency.SuffrageInflationFactType,
 	currency.SuffrageInflationType,
+	currency.TransferFromFactType,
+	currency.TransferFromType,
 	currency.TransfersFactType,
+	currency.TransfersItemLockedAmountsType,
 	currency.TransfersItemMultiAmountsType,
 	currency.TransfersItemSingleAmountType,
 	currency.TransfersType,
@@ -49,12 +113,39 @@ var types = []hint.Type{
 	digest.BaseHalType,
 	digest.AccountValueType,
 	digest.OperationValueType,
+	digest.EscrowValueType,
+	digest.HTLCValueType,
+	digest.ScheduleValueType,
+	digest.AllowanceValueType,
+	digest.AliasValueType,
+	digest.AccountMetadataValueType,
+	digest.WebhookType,
+	digest.WebhookDeliveryType,
+	digest.BalanceHistoryValueType,
+	digest.AccountBalanceValueType,
+	digest.HolderValueType,
 }
 
 var hinters = []hint.Hinter{
 	currency.AccountHinter,
+	currency.AccountFreezeFactHinter,
+	currency.AccountFreezeHinter,
+	currency.AccountMergeFactHinter,
+	currency.AccountMergeHinter,
+	currency.AccountMetadataHinter,
+	currency.AccountMetadataUpdaterFactHinter,
+	currency.AccountMetadataUpdaterHinter,
+	currency.AccountUnfreezeFactHinter,
+	currency.AccountUnfreezeHinter,
 	currency.AddressHinter,
+	currency.AliasHinter,
+	currency.AliasAddressHinter,
+	currency.AllowanceHinter,
 	currency.AmountHinter,
+	currency.ApproveFactHinter,
+	currency.ApproveHinter,
+	currency.BalanceUnlockFactHinter,
+	currency.BalanceUnlockHinter,
 	currency.CreateAccountsFactHinter,
 	currency.CreateAccountsItemMultiAmountsHinter,
 	currency.CreateAccountsItemSingleAmountHinter,
@@ -63,10 +154,41 @@ var hinters = []hint.Hinter{
 	currency.CurrencyPolicyUpdaterFactHinter,
 	currency.CurrencyPolicyUpdaterHinter,
 	currency.CurrencyPolicyHinter,
+	currency.MemoPolicyUpdaterFactHinter,
+	currency.MemoPolicyUpdaterHinter,
+	currency.MemoPolicyHinter,
+	currency.AccountPolicyUpdaterFactHinter,
+	currency.AccountPolicyUpdaterHinter,
+	currency.AccountPolicyHinter,
 	currency.CurrencyRegisterFactHinter,
 	currency.CurrencyRegisterHinter,
+	currency.EscrowHinter,
+	currency.EscrowCreateFactHinter,
+	currency.EscrowCreateHinter,
+	currency.EscrowRefundFactHinter,
+	currency.EscrowRefundHinter,
+	currency.EscrowReleaseFactHinter,
+	currency.EscrowReleaseHinter,
+	currency.HTLCHinter,
+	currency.HTLCLockFactHinter,
+	currency.HTLCLockHinter,
+	currency.HTLCClaimFactHinter,
+	currency.HTLCClaimHinter,
+	currency.HTLCRefundFactHinter,
+	currency.HTLCRefundHinter,
 	currency.FeeOperationFactHinter,
 	currency.FeeOperationHinter,
+	currency.PaymentScheduleHinter,
+	currency.ScheduleQueueHinter,
+	currency.SchedulePaymentFactHinter,
+	currency.SchedulePaymentHinter,
+	currency.CancelScheduleFactHinter,
+	currency.CancelScheduleHinter,
+	currency.ScheduleRunHinter,
+	currency.ScheduleRunOperationFactHinter,
+	currency.ScheduleRunOperationHinter,
+	currency.AtomicSwapFactHinter,
+	currency.AtomicSwapHinter,
 	currency.FixedFeeerHinter,
 	currency.GenesisCurrenciesFactHinter,
 	currency.GenesisCurrenciesHinter,
@@ -74,19 +196,47 @@ var hinters = []hint.Hinter{
 	currency.KeyUpdaterHinter,
 	currency.AccountKeysHinter,
 	currency.AccountKeyHinter,
+	currency.AccountIndexHinter,
+	currency.LockedAmountHinter,
 	currency.NilFeeerHinter,
 	currency.RatioFeeerHinter,
+	currency.AccountRecoveryHinter,
+	currency.RecoveryCancelFactHinter,
+	currency.RecoveryCancelHinter,
+	currency.RecoveryFinalizeFactHinter,
+	currency.RecoveryFinalizeHinter,
+	currency.RecoveryInitiateFactHinter,
+	currency.RecoveryInitiateHinter,
+	currency.RecoveryUpdaterFactHinter,
+	currency.RecoveryUpdaterHinter,
+	currency.RegisterAliasFactHinter,
+	currency.RegisterAliasHinter,
+	currency.TieredFeeerHinter,
 	currency.SuffrageInflationFactHinter,
 	currency.SuffrageInflationHinter,
+	currency.TransferFromFactHinter,
+	currency.TransferFromHinter,
 	currency.TransfersFactHinter,
+	currency.TransfersItemLockedAmountsHinter,
 	currency.TransfersItemMultiAmountsHinter,
 	currency.TransfersItemSingleAmountHinter,
 	currency.TransfersHinter,
 	digest.AccountValue{},
+	digest.AllowanceValue{},
+	digest.AliasValue{},
+	digest.AccountMetadataValue{},
 	digest.BaseHal{},
+	digest.EscrowValue{},
+	digest.HTLCValue{},
+	digest.ScheduleValue{},
 	digest.NodeInfo{},
 	digest.OperationValue{},
 	digest.Problem{},
+	digest.Webhook{},
+	digest.WebhookDelivery{},
+	digest.BalanceHistoryValue{},
+	digest.AccountBalanceValue{},
+	digest.HolderValue{},
 }
 
 func init() {
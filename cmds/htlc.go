@@ -0,0 +1,250 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type HTLCLockCommand struct {
+	*BaseCommand
+	OperationFlags
+	CurrencyDecimalsFlags
+	Sender   AddressFlag        `arg:"" name:"sender" help:"sender address" required:"true"`
+	Receiver AddressFlag        `arg:"" name:"receiver" help:"receiver address" required:"true"`
+	Amount   CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\" or \"<decimal amount><currency>\")"`
+	Hashlock HashFlag           `arg:"" name:"hashlock" help:"sha256 hash of preimage" required:"true"`
+	Expiry   int64              `arg:"" name:"expiry" help:"expiry height; after the height, htlc can be refunded" required:"true"`
+	sender   base.Address
+	receiver base.Address
+}
+
+func NewHTLCLockCommand() HTLCLockCommand {
+	return HTLCLockCommand{
+		BaseCommand: NewBaseCommand("htlc-lock-operation"),
+	}
+}
+
+func (cmd *HTLCLockCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Wrap(err, "failed to initialize command")
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.NetworkID(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create operation.Seal")
+	}
+	PrettyPrint(cmd.Out, cmd.Pretty, bs)
+
+	return nil
+}
+
+func (cmd *HTLCLockCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	} else if err := cmd.CurrencyDecimalsFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(jenc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	} else if b, err := cmd.Receiver.Encode(jenc); err != nil {
+		return errors.Wrapf(err, "invalid receiver format, %q", cmd.Receiver.String())
+	} else {
+		cmd.sender = a
+		cmd.receiver = b
+	}
+
+	return nil
+}
+
+func (cmd *HTLCLockCommand) createOperation() (operation.Operation, error) {
+	am, err := cmd.CurrencyDecimalsFlags.amount(cmd.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	fact := currency.NewHTLCLockFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.receiver,
+		am,
+		cmd.Hashlock.Hash.Bytes(),
+		base.Height(cmd.Expiry),
+	)
+
+	var fs []base.FactSign
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs = append(fs, base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+
+	op, err := currency.NewHTLCLock(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create htlc-lock operation")
+	}
+	return op, nil
+}
+
+type HTLCClaimCommand struct {
+	*BaseCommand
+	OperationFlags
+	Sender   AddressFlag `arg:"" name:"sender" help:"receiver address of htlc" required:"true"`
+	HTLC     HashFlag    `arg:"" name:"htlc" help:"htlc id, fact hash of htlc-lock operation" required:"true"`
+	Preimage string      `arg:"" name:"preimage" help:"preimage of hashlock" required:"true"`
+	sender   base.Address
+}
+
+func NewHTLCClaimCommand() HTLCClaimCommand {
+	return HTLCClaimCommand{
+		BaseCommand: NewBaseCommand("htlc-claim-operation"),
+	}
+}
+
+func (cmd *HTLCClaimCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Wrap(err, "failed to initialize command")
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.NetworkID(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create operation.Seal")
+	}
+	PrettyPrint(cmd.Out, cmd.Pretty, bs)
+
+	return nil
+}
+
+func (cmd *HTLCClaimCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	return nil
+}
+
+func (cmd *HTLCClaimCommand) createOperation() (operation.Operation, error) {
+	fact := currency.NewHTLCClaimFact([]byte(cmd.Token), cmd.sender, cmd.HTLC.Hash, []byte(cmd.Preimage))
+
+	var fs []base.FactSign
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs = append(fs, base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+
+	op, err := currency.NewHTLCClaim(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create htlc-claim operation")
+	}
+	return op, nil
+}
+
+type HTLCRefundCommand struct {
+	*BaseCommand
+	OperationFlags
+	Sender AddressFlag `arg:"" name:"sender" help:"sender address of htlc" required:"true"`
+	HTLC   HashFlag    `arg:"" name:"htlc" help:"htlc id, fact hash of htlc-lock operation" required:"true"`
+	sender base.Address
+}
+
+func NewHTLCRefundCommand() HTLCRefundCommand {
+	return HTLCRefundCommand{
+		BaseCommand: NewBaseCommand("htlc-refund-operation"),
+	}
+}
+
+func (cmd *HTLCRefundCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Wrap(err, "failed to initialize command")
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.NetworkID(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create operation.Seal")
+	}
+	PrettyPrint(cmd.Out, cmd.Pretty, bs)
+
+	return nil
+}
+
+func (cmd *HTLCRefundCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	return nil
+}
+
+func (cmd *HTLCRefundCommand) createOperation() (operation.Operation, error) {
+	fact := currency.NewHTLCRefundFact([]byte(cmd.Token), cmd.sender, cmd.HTLC.Hash)
+
+	var fs []base.FactSign
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs = append(fs, base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+
+	op, err := currency.NewHTLCRefund(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create htlc-refund operation")
+	}
+	return op, nil
+}
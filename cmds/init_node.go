@@ -135,9 +135,30 @@ func loadCurrencyDesign(de CurrencyDesign, ga base.Address) (currency.CurrencyDe
 	if err != nil {
 		return currency.CurrencyDesign{}, err
 	}
-	po := currency.NewCurrencyPolicy(de.NewAccountMinBalance, j)
+	po := currency.NewCurrencyPolicy(de.NewAccountMinBalance, j).SetFeeBurnRatio(de.FeeBurnRatio)
+	if de.MaxSupplyString != nil {
+		po = po.SetMaxSupply(de.MaxSupply)
+	}
+
+	if de.FeeCurrencyString != nil {
+		po = po.SetFeeCurrency(currency.CurrencyID(*de.FeeCurrencyString), de.FeeRate)
+	}
+
+	if len(de.FeeExemptions) > 0 {
+		as := make([]base.Address, len(de.FeeExemptions))
+		for i := range de.FeeExemptions {
+			a, err := base.DecodeAddressFromString(de.FeeExemptions[i], jenc)
+			if err != nil {
+				return currency.CurrencyDesign{}, errors.Wrapf(err, "invalid fee exemption address, %q", de.FeeExemptions[i])
+			}
+
+			as[i] = a
+		}
+
+		po = po.SetFeeExemptions(as)
+	}
 
-	cd := currency.NewCurrencyDesign(de.Balance, nil, po)
+	cd := currency.NewCurrencyDesign(de.Balance, nil, po).SetDecimals(de.Decimals)
 	if err := cd.IsValid(nil); err != nil {
 		return currency.CurrencyDesign{}, err
 	}
@@ -166,6 +187,8 @@ func loadGenesisCurrenciesFeeer(de FeeerDesign, ga base.Address) (currency.Feeer
 			de.Extras["ratio_min"].(currency.Big),
 			max,
 		)
+	case currency.FeeerTiered:
+		feeer = currency.NewTieredFeeer(ga, de.Extras["tiered_brackets"].([]currency.FeeBracket))
 	default:
 		return nil, errors.Errorf("unknown type of feeer, %q", de.Type)
 	}
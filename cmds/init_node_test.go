@@ -88,6 +88,135 @@ currencies:
 	t.True(genesisAccount.Equal(feeer.Receiver()))
 }
 
+func (t *testGenesisCurrencies) TestLoadTieredFeeer() {
+	encs := encoder.NewEncoders()
+	encs.TestAddHinter(key.BasePrivatekey{})
+	encs.TestAddHinter(key.BasePublickey{})
+
+	enc := jsonenc.NewEncoder()
+	encs.AddEncoder(enc)
+
+	conf := config.NewBaseLocalNode(enc, nil)
+
+	pub := key.NewBasePrivatekey().Publickey()
+
+	t.NoError(conf.SetPrivatekey(key.NewBasePrivatekey().String()))
+	t.NoError(conf.SetNetworkID("Fri 29 Jan 2001 12:00:02 AM KST"))
+
+	ctx := context.WithValue(context.Background(), config.ContextValueConfig, conf)
+
+	y := fmt.Sprintf(`
+account-keys:
+  keys:
+    - publickey: %s
+      weight: 100
+  threshold: 100
+
+currencies:
+  - currency: SHOW*ME
+    balance: "9999999999999999999999999999999999"
+    feeer:
+      type: tiered
+      brackets:
+        - upper: 1000
+          amount: 1
+        - upper: 1000000
+          ratio: 0.005
+        - amount: 5000
+`, pub.String())
+
+	var m map[string]interface{}
+	t.NoError(yaml.Unmarshal([]byte(y), &m))
+
+	op, err := GenesisOperationsHandlerGenesisCurrencies(ctx, m)
+	t.NoError(err)
+	t.NoError(op.IsValid(conf.NetworkID()))
+
+	fact := op.Fact().(currency.GenesisCurrenciesFact)
+
+	feeer := fact.Currencies()[0].Policy().Feeer()
+	t.Equal(currency.TieredFeeerType, feeer.Hint().Type())
+
+	brackets := feeer.(currency.TieredFeeer).Brackets()
+	t.Equal(3, len(brackets))
+	t.Equal("1000", brackets[0].Upper().String())
+	t.Equal("1", brackets[0].Amount().String())
+	t.Equal(0.005, brackets[1].Ratio())
+	t.True(brackets[2].Upper().Equal(currency.UnlimitedMaxFeeAmount))
+
+	fee, err := feeer.Fee(currency.NewBig(2000))
+	t.NoError(err)
+	t.Equal("10", fee.String())
+}
+
+func (t *testGenesisCurrencies) TestLoadMaxSupply() {
+	encs := encoder.NewEncoders()
+	encs.TestAddHinter(key.BasePrivatekey{})
+	encs.TestAddHinter(key.BasePublickey{})
+
+	enc := jsonenc.NewEncoder()
+	encs.AddEncoder(enc)
+
+	conf := config.NewBaseLocalNode(enc, nil)
+
+	pub := key.NewBasePrivatekey().Publickey()
+
+	t.NoError(conf.SetPrivatekey(key.NewBasePrivatekey().String()))
+	t.NoError(conf.SetNetworkID("Fri 29 Jan 2001 12:00:02 AM KST"))
+
+	ctx := context.WithValue(context.Background(), config.ContextValueConfig, conf)
+
+	y := fmt.Sprintf(`
+account-keys:
+  keys:
+    - publickey: %s
+      weight: 100
+  threshold: 100
+
+currencies:
+  - currency: SHOW*ME
+    balance: "99"
+    max-supply: "100"
+  - currency: FINDME
+    balance: "99"
+`, pub.String())
+
+	var m map[string]interface{}
+	t.NoError(yaml.Unmarshal([]byte(y), &m))
+
+	op, err := GenesisOperationsHandlerGenesisCurrencies(ctx, m)
+	t.NoError(err)
+	t.NoError(op.IsValid(conf.NetworkID()))
+
+	fact := op.Fact().(currency.GenesisCurrenciesFact)
+
+	ms, found := fact.Currencies()[0].Policy().MaxSupply()
+	t.True(found)
+	t.Equal("100", ms.String())
+
+	_, found = fact.Currencies()[1].Policy().MaxSupply()
+	t.False(found)
+
+	y = fmt.Sprintf(`
+account-keys:
+  keys:
+    - publickey: %s
+      weight: 100
+  threshold: 100
+
+currencies:
+  - currency: SHOW*ME
+    balance: "99"
+    max-supply: "98"
+`, pub.String())
+
+	t.NoError(yaml.Unmarshal([]byte(y), &m))
+
+	_, err = GenesisOperationsHandlerGenesisCurrencies(ctx, m)
+	t.Error(err)
+	t.Contains(err.Error(), "over max supply")
+}
+
 func TestGenesisCurrencies(t *testing.T) {
 	suite.Run(t, new(testGenesisCurrencies))
 }
@@ -0,0 +1,105 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+)
+
+type MemoPolicyUpdaterCommand struct {
+	*BaseCommand
+	OperationFlags
+	MaxSize           uint          `name:"max-size" help:"maximum size of memo" required:"true"`
+	Charset           string        `name:"charset" help:"allowed charset of memo, {utf8, ascii, numeric}" default:"utf8"` // nolint lll
+	RequiredReceivers []AddressFlag `name:"required-receiver" help:"receiver address, which requires memo" sep:"@"`        // nolint lll
+	po                currency.MemoPolicy
+}
+
+func NewMemoPolicyUpdaterCommand() MemoPolicyUpdaterCommand {
+	return MemoPolicyUpdaterCommand{
+		BaseCommand: NewBaseCommand("memo-policy-updater-operation"),
+	}
+}
+
+func (cmd *MemoPolicyUpdaterCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Wrap(err, "failed to initialize command")
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op operation.Operation
+	if i, err := cmd.createOperation(); err != nil {
+		return errors.Wrap(err, "failed to create memo-policy-updater operation")
+	} else if err := i.IsValid([]byte(cmd.OperationFlags.NetworkID)); err != nil {
+		return errors.Wrap(err, "invalid memo-policy-updater operation")
+	} else {
+		cmd.Log().Debug().Interface("operation", i).Msg("operation loaded")
+
+		op = i
+	}
+
+	i, err := operation.NewBaseSeal(
+		cmd.OperationFlags.Privatekey,
+		[]operation.Operation{op},
+		[]byte(cmd.OperationFlags.NetworkID),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create operation.Seal")
+	}
+	cmd.Log().Debug().Interface("seal", i).Msg("seal loaded")
+
+	PrettyPrint(cmd.Out, cmd.Pretty, i)
+
+	return nil
+}
+
+func (cmd *MemoPolicyUpdaterCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	var rs []base.Address
+	if len(cmd.RequiredReceivers) > 0 {
+		rs = make([]base.Address, len(cmd.RequiredReceivers))
+		for i := range cmd.RequiredReceivers {
+			a, err := cmd.RequiredReceivers[i].Encode(jenc)
+			if err != nil {
+				return errors.Wrapf(err, "invalid required receiver address, %q", cmd.RequiredReceivers[i].String())
+			}
+
+			rs[i] = a
+		}
+	}
+
+	cmd.po = currency.NewMemoPolicy(cmd.MaxSize, currency.MemoCharset(cmd.Charset), rs)
+	if err := cmd.po.IsValid(nil); err != nil {
+		return err
+	}
+
+	cmd.Log().Debug().Interface("memo-policy", cmd.po).Msg("memo policy loaded")
+
+	return nil
+}
+
+func (cmd *MemoPolicyUpdaterCommand) createOperation() (currency.MemoPolicyUpdater, error) {
+	fact := currency.NewMemoPolicyUpdaterFact([]byte(cmd.Token), cmd.po)
+
+	var fs []base.FactSign
+	sig, err := base.NewFactSignature(
+		cmd.OperationFlags.Privatekey,
+		fact,
+		[]byte(cmd.OperationFlags.NetworkID),
+	)
+	if err != nil {
+		return currency.MemoPolicyUpdater{}, err
+	}
+	fs = append(fs, base.NewBaseFactSign(cmd.OperationFlags.Privatekey.Publickey(), sig))
+
+	return currency.NewMemoPolicyUpdater(fact, fs, cmd.OperationFlags.Memo)
+}
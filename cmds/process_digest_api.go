@@ -36,7 +36,7 @@ var (
 )
 
 func init() {
-	if i, err := pm.NewProcess(ProcessNameDigestAPI, []string{ProcessNameDigestDatabase}, ProcessDigestAPI); err != nil {
+	if i, err := pm.NewProcess(ProcessNameDigestAPI, []string{ProcessNameDigestDatabase, ProcessNameDigester}, ProcessDigestAPI); err != nil {
 		panic(err)
 	} else {
 		ProcessorDigestAPI = i
@@ -69,6 +69,15 @@ func ProcessDigester(ctx context.Context) (context.Context, error) {
 	di := digest.NewDigester(st, nil)
 	_ = di.SetLogging(log)
 
+	var design DigestDesign
+	if err := LoadDigestDesignContextValue(ctx, &design); err == nil && design.Webhook() != nil {
+		wd := digest.NewWebhookDispatcher(st).
+			SetRetry(design.Webhook().MaxAttempts, design.Webhook().RetryInterval())
+		_ = wd.SetLogging(log)
+
+		_ = di.SetWebhookDispatcher(wd)
+	}
+
 	return context.WithValue(ctx, ContextValueDigester, di), nil
 }
 
@@ -82,6 +91,12 @@ func ProcessStartDigester(ctx context.Context) (context.Context, error) {
 		return ctx, err
 	}
 
+	if wd := di.WebhookDispatcher(); wd != nil {
+		if err := wd.Start(); err != nil {
+			return ctx, err
+		}
+	}
+
 	return ctx, di.Start()
 }
 
@@ -0,0 +1,304 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type RecoveryUpdaterCommand struct {
+	*BaseCommand
+	OperationFlags
+	Target    AddressFlag    `arg:"" name:"target" help:"target address" required:"true"`
+	Currency  CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	Threshold uint           `help:"threshold for recovery keys (default: ${create_account_threshold})" default:"${create_account_threshold}"` // nolint
+	Keys      []KeyFlag      `name:"key" help:"recovery key (ex: \"<public key>,<weight>\"); without key, recovery is removed" sep:"@"`
+	Delay     uint64         `name:"delay" help:"number of blocks before finalizing recovery"`
+	target    base.Address
+	keys      currency.AccountKeys
+}
+
+func NewRecoveryUpdaterCommand() RecoveryUpdaterCommand {
+	return RecoveryUpdaterCommand{
+		BaseCommand: NewBaseCommand("recovery-updater-operation"),
+	}
+}
+
+func (cmd *RecoveryUpdaterCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Wrap(err, "failed to initialize command")
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.NetworkID(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create operation.Seal")
+	}
+	PrettyPrint(cmd.Out, cmd.Pretty, bs)
+
+	return nil
+}
+
+func (cmd *RecoveryUpdaterCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Target.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid target format, %q", cmd.Target.String())
+	}
+	cmd.target = a
+
+	if len(cmd.Keys) < 1 {
+		return nil
+	}
+
+	kys, err := parseRecoveryKeys(cmd.Keys, cmd.Threshold)
+	if err != nil {
+		return err
+	}
+	cmd.keys = kys
+
+	return nil
+}
+
+func (cmd *RecoveryUpdaterCommand) createOperation() (operation.Operation, error) {
+	fact := currency.NewRecoveryUpdaterFact(
+		[]byte(cmd.Token),
+		cmd.target,
+		cmd.keys,
+		cmd.Delay,
+		cmd.Currency.CID,
+	)
+
+	var fs []base.FactSign
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs = append(fs, base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+
+	op, err := currency.NewRecoveryUpdater(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create recovery-updater operation")
+	}
+	return op, nil
+}
+
+type RecoveryInitiateCommand struct {
+	*BaseCommand
+	OperationFlags
+	Target    AddressFlag    `arg:"" name:"target" help:"target address" required:"true"`
+	Currency  CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	Threshold uint           `help:"threshold for new keys (default: ${create_account_threshold})" default:"${create_account_threshold}"` // nolint
+	Keys      []KeyFlag      `name:"key" help:"new key for account (ex: \"<public key>,<weight>\")" sep:"@"`
+	target    base.Address
+	keys      currency.AccountKeys
+}
+
+func NewRecoveryInitiateCommand() RecoveryInitiateCommand {
+	return RecoveryInitiateCommand{
+		BaseCommand: NewBaseCommand("recovery-initiate-operation"),
+	}
+}
+
+func (cmd *RecoveryInitiateCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Wrap(err, "failed to initialize command")
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.NetworkID(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create operation.Seal")
+	}
+	PrettyPrint(cmd.Out, cmd.Pretty, bs)
+
+	return nil
+}
+
+func (cmd *RecoveryInitiateCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if len(cmd.Keys) < 1 {
+		return errors.Errorf("--key must be given at least one")
+	}
+
+	a, err := cmd.Target.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid target format, %q", cmd.Target.String())
+	}
+	cmd.target = a
+
+	kys, err := parseRecoveryKeys(cmd.Keys, cmd.Threshold)
+	if err != nil {
+		return err
+	}
+	cmd.keys = kys
+
+	return nil
+}
+
+func (cmd *RecoveryInitiateCommand) createOperation() (operation.Operation, error) {
+	fact := currency.NewRecoveryInitiateFact(
+		[]byte(cmd.Token),
+		cmd.target,
+		cmd.keys,
+		cmd.Currency.CID,
+	)
+
+	var fs []base.FactSign
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs = append(fs, base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+
+	op, err := currency.NewRecoveryInitiate(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create recovery-initiate operation")
+	}
+	return op, nil
+}
+
+type RecoverySettleCommand struct {
+	*BaseCommand
+	OperationFlags
+	Target   AddressFlag    `arg:"" name:"target" help:"target address" required:"true"`
+	Currency CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	finalize bool
+	target   base.Address
+}
+
+func NewRecoveryCancelCommand() RecoverySettleCommand {
+	return RecoverySettleCommand{
+		BaseCommand: NewBaseCommand("recovery-cancel-operation"),
+	}
+}
+
+func NewRecoveryFinalizeCommand() RecoverySettleCommand {
+	return RecoverySettleCommand{
+		BaseCommand: NewBaseCommand("recovery-finalize-operation"),
+		finalize:    true,
+	}
+}
+
+func (cmd *RecoverySettleCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Wrap(err, "failed to initialize command")
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.NetworkID(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create operation.Seal")
+	}
+	PrettyPrint(cmd.Out, cmd.Pretty, bs)
+
+	return nil
+}
+
+func (cmd *RecoverySettleCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Target.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid target format, %q", cmd.Target.String())
+	}
+	cmd.target = a
+
+	return nil
+}
+
+func (cmd *RecoverySettleCommand) createOperation() (operation.Operation, error) {
+	var fact base.Fact
+	if cmd.finalize {
+		fact = currency.NewRecoveryFinalizeFact([]byte(cmd.Token), cmd.target, cmd.Currency.CID)
+	} else {
+		fact = currency.NewRecoveryCancelFact([]byte(cmd.Token), cmd.target, cmd.Currency.CID)
+	}
+
+	var fs []base.FactSign
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs = append(fs, base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+
+	if cmd.finalize {
+		op, e := currency.NewRecoveryFinalize(fact.(currency.RecoveryFinalizeFact), fs, cmd.Memo)
+		if e != nil {
+			return nil, errors.Wrap(e, "failed to create recovery-finalize operation")
+		}
+		return op, nil
+	}
+
+	op, err := currency.NewRecoveryCancel(fact.(currency.RecoveryCancelFact), fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create recovery-cancel operation")
+	}
+	return op, nil
+}
+
+func parseRecoveryKeys(keys []KeyFlag, threshold uint) (currency.AccountKeys, error) {
+	ks := make([]currency.AccountKey, len(keys))
+	for i := range keys {
+		ks[i] = keys[i].Key
+	}
+
+	kys, err := currency.NewBaseAccountKeys(ks, threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := kys.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	return kys, nil
+}
@@ -0,0 +1,84 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type RegisterAliasCommand struct {
+	*BaseCommand
+	OperationFlags
+	Sender   AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Alias    string         `arg:"" name:"alias" help:"alias name" required:"true"`
+	Currency CurrencyIDFlag `arg:"" name:"currency" help:"currency id for fee" required:"true"`
+	sender   base.Address
+}
+
+func NewRegisterAliasCommand() RegisterAliasCommand {
+	return RegisterAliasCommand{
+		BaseCommand: NewBaseCommand("register-alias-operation"),
+	}
+}
+
+func (cmd *RegisterAliasCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Wrap(err, "failed to initialize command")
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.NetworkID(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create operation.Seal")
+	}
+	PrettyPrint(cmd.Out, cmd.Pretty, bs)
+
+	return nil
+}
+
+func (cmd *RegisterAliasCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	return currency.IsValidAliasName(cmd.Alias)
+}
+
+func (cmd *RegisterAliasCommand) createOperation() (operation.Operation, error) {
+	fact := currency.NewRegisterAliasFact([]byte(cmd.Token), cmd.sender, cmd.Alias, cmd.Currency.CID)
+
+	var fs []base.FactSign
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs = append(fs, base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+
+	op, err := currency.NewRegisterAlias(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create register-alias operation")
+	}
+	return op, nil
+}
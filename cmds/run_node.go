@@ -240,6 +240,18 @@ func (cmd *RunCommand) setDigestHandlers(
 	handlers := digest.NewHandlers(conf.NetworkID(), encs, jenc, st, cache, cp).
 		SetNodeInfoHandler(nt.NodeInfoHandler())
 
+	var di *digest.Digester
+	switch err := LoadDigesterContextValue(ctx, &di); {
+	case err == nil:
+		handlers = handlers.SetStreamer(di.Streamer())
+	case !errors.Is(err, util.ContextValueNotFoundError):
+		return nil, err
+	}
+
+	if wd := design.Webhook(); wd != nil {
+		handlers = handlers.SetWebhookToken(wd.Token)
+	}
+
 	i, err := cmd.setDigestSendHandler(ctx, conf, handlers)
 	if err != nil {
 		return nil, err
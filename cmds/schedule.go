@@ -0,0 +1,179 @@
+package cmds
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type SchedulePaymentCommand struct {
+	*BaseCommand
+	OperationFlags
+	CurrencyDecimalsFlags
+	Sender   AddressFlag        `arg:"" name:"sender" help:"sender address" required:"true"`
+	Receiver AddressFlag        `arg:"" name:"receiver" help:"receiver address" required:"true"`
+	Amount   CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount of each run (ex: \"<currency>,<amount>\" or \"<decimal amount><currency>\")"` // revive:disable-line:line-length-limit
+	Start    int64              `arg:"" name:"start" help:"height of the first run" required:"true"`
+	Interval uint64             `name:"interval" help:"blocks between runs" default:"1"`
+	Count    uint               `name:"count" help:"number of runs" default:"1"`
+	sender   base.Address
+	receiver base.Address
+}
+
+func NewSchedulePaymentCommand() SchedulePaymentCommand {
+	return SchedulePaymentCommand{
+		BaseCommand: NewBaseCommand("schedule-payment-operation"),
+	}
+}
+
+func (cmd *SchedulePaymentCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Wrap(err, "failed to initialize command")
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.NetworkID(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create operation.Seal")
+	}
+	PrettyPrint(cmd.Out, cmd.Pretty, bs)
+
+	return nil
+}
+
+func (cmd *SchedulePaymentCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	} else if err := cmd.CurrencyDecimalsFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(jenc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	} else if b, err := cmd.Receiver.Encode(jenc); err != nil {
+		return errors.Wrapf(err, "invalid receiver format, %q", cmd.Receiver.String())
+	} else {
+		cmd.sender = a
+		cmd.receiver = b
+	}
+
+	return nil
+}
+
+func (cmd *SchedulePaymentCommand) createOperation() (operation.Operation, error) {
+	am, err := cmd.CurrencyDecimalsFlags.amount(cmd.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	fact := currency.NewSchedulePaymentFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.receiver,
+		am,
+		base.Height(cmd.Start),
+		cmd.Interval,
+		cmd.Count,
+	)
+
+	var fs []base.FactSign
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs = append(fs, base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+
+	op, err := currency.NewSchedulePayment(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create schedule-payment operation")
+	}
+	return op, nil
+}
+
+type CancelScheduleCommand struct {
+	*BaseCommand
+	OperationFlags
+	Sender   AddressFlag `arg:"" name:"sender" help:"sender address of schedule" required:"true"`
+	Schedule HashFlag    `arg:"" name:"schedule" help:"schedule id, fact hash of schedule-payment operation" required:"true"`
+	sender   base.Address
+}
+
+func NewCancelScheduleCommand() CancelScheduleCommand {
+	return CancelScheduleCommand{
+		BaseCommand: NewBaseCommand("cancel-schedule-operation"),
+	}
+}
+
+func (cmd *CancelScheduleCommand) Run(version util.Version) error { // nolint:dupl
+	if err := cmd.Initialize(cmd, version); err != nil {
+		return errors.Wrap(err, "failed to initialize command")
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	bs, err := operation.NewBaseSeal(
+		cmd.Privatekey,
+		[]operation.Operation{op},
+		cmd.NetworkID.NetworkID(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create operation.Seal")
+	}
+	PrettyPrint(cmd.Out, cmd.Pretty, bs)
+
+	return nil
+}
+
+func (cmd *CancelScheduleCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(jenc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	return nil
+}
+
+func (cmd *CancelScheduleCommand) createOperation() (operation.Operation, error) {
+	fact := currency.NewCancelScheduleFact([]byte(cmd.Token), cmd.sender, cmd.Schedule.Hash)
+
+	var fs []base.FactSign
+	sig, err := base.NewFactSignature(cmd.Privatekey, fact, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, err
+	}
+	fs = append(fs, base.NewBaseFactSign(cmd.Privatekey.Publickey(), sig))
+
+	op, err := currency.NewCancelSchedule(fact, fs, cmd.Memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cancel-schedule operation")
+	}
+	return op, nil
+}
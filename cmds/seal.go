@@ -5,9 +5,32 @@ type SealCommand struct {
 	CreateAccount         CreateAccountCommand         `cmd:"" name:"create-account" help:"create new account"`
 	Transfer              TransferCommand              `cmd:"" name:"transfer" help:"transfer big"`
 	KeyUpdater            KeyUpdaterCommand            `cmd:"" name:"key-updater" help:"update keys"`
+	BalanceUnlock         BalanceUnlockCommand         `cmd:"" name:"balance-unlock" help:"unlock locked balance"`
+	EscrowCreate          EscrowCreateCommand          `cmd:"" name:"escrow-create" help:"create escrow"`
+	EscrowRelease         EscrowSettleCommand          `cmd:"" name:"escrow-release" help:"release escrow to beneficiary before expiry"`
+	EscrowRefund          EscrowSettleCommand          `cmd:"" name:"escrow-refund" help:"refund expired escrow to sender"`
+	HTLCLock              HTLCLockCommand              `cmd:"" name:"htlc-lock" help:"lock amount under hashlock"`
+	HTLCClaim             HTLCClaimCommand             `cmd:"" name:"htlc-claim" help:"claim htlc by preimage"`
+	HTLCRefund            HTLCRefundCommand            `cmd:"" name:"htlc-refund" help:"refund expired htlc to sender"`
+	SchedulePayment       SchedulePaymentCommand       `cmd:"" name:"schedule-payment" help:"schedule recurring payment"`
+	CancelSchedule        CancelScheduleCommand        `cmd:"" name:"cancel-schedule" help:"cancel payment schedule"`
+	Approve               ApproveCommand               `cmd:"" name:"approve" help:"approve allowance to spender"`
+	TransferFrom          TransferFromCommand          `cmd:"" name:"transfer-from" help:"transfer from owner by allowance"`
+	AtomicSwap            AtomicSwapCommand            `cmd:"" name:"atomic-swap" help:"swap currencies between accounts"`
+	AccountMerge          AccountMergeCommand          `cmd:"" name:"account-merge" help:"merge balances into target and close account"`
+	RecoveryUpdater       RecoveryUpdaterCommand       `cmd:"" name:"recovery-updater" help:"update recovery keys"`
+	RecoveryInitiate      RecoveryInitiateCommand      `cmd:"" name:"recovery-initiate" help:"initiate recovery by recovery keys"` // revive:disable-line:line-length-limit
+	RecoveryCancel        RecoverySettleCommand        `cmd:"" name:"recovery-cancel" help:"cancel initiated recovery"`
+	RecoveryFinalize      RecoverySettleCommand        `cmd:"" name:"recovery-finalize" help:"finalize initiated recovery"`
+	RegisterAlias         RegisterAliasCommand         `cmd:"" name:"register-alias" help:"register alias of account"`
+	AccountMetadata       AccountMetadataCommand       `cmd:"" name:"account-metadata" help:"update metadata of account"`
 	CurrencyRegister      CurrencyRegisterCommand      `cmd:"" name:"currency-register" help:"register new currency"`
 	CurrencyPolicyUpdater CurrencyPolicyUpdaterCommand `cmd:"" name:"currency-policy-updater" help:"update currency policy"`  // revive:disable-line:line-length-limit
+	MemoPolicyUpdater     MemoPolicyUpdaterCommand     `cmd:"" name:"memo-policy-updater" help:"update memo policy"`          // revive:disable-line:line-length-limit
+	AccountPolicyUpdater  AccountPolicyUpdaterCommand  `cmd:"" name:"account-policy-updater" help:"update account policy"`    // revive:disable-line:line-length-limit
 	SuffrageInflation     SuffrageInflationCommand     `cmd:"" name:"suffrage-inflation" help:"suffrage inflation operation"` // revive:disable-line:line-length-limit
+	AccountFreeze         AccountFreezeCommand         `cmd:"" name:"account-freeze" help:"freeze accounts by suffrage"`      // revive:disable-line:line-length-limit
+	AccountUnfreeze       AccountFreezeCommand         `cmd:"" name:"account-unfreeze" help:"unfreeze accounts by suffrage"`  // revive:disable-line:line-length-limit
 	Sign                  SignSealCommand              `cmd:"" name:"sign" help:"sign seal"`
 	SignFact              SignFactCommand              `cmd:"" name:"sign-fact" help:"sign facts of operation seal"`
 }
@@ -18,9 +41,32 @@ func NewSealCommand() SealCommand {
 		CreateAccount:         NewCreateAccountCommand(),
 		Transfer:              NewTransferCommand(),
 		KeyUpdater:            NewKeyUpdaterCommand(),
+		BalanceUnlock:         NewBalanceUnlockCommand(),
+		EscrowCreate:          NewEscrowCreateCommand(),
+		EscrowRelease:         NewEscrowReleaseCommand(),
+		EscrowRefund:          NewEscrowRefundCommand(),
+		HTLCLock:              NewHTLCLockCommand(),
+		HTLCClaim:             NewHTLCClaimCommand(),
+		HTLCRefund:            NewHTLCRefundCommand(),
+		SchedulePayment:       NewSchedulePaymentCommand(),
+		CancelSchedule:        NewCancelScheduleCommand(),
+		Approve:               NewApproveCommand(),
+		TransferFrom:          NewTransferFromCommand(),
+		AtomicSwap:            NewAtomicSwapCommand(),
+		AccountMerge:          NewAccountMergeCommand(),
+		RecoveryUpdater:       NewRecoveryUpdaterCommand(),
+		RecoveryInitiate:      NewRecoveryInitiateCommand(),
+		RecoveryCancel:        NewRecoveryCancelCommand(),
+		RecoveryFinalize:      NewRecoveryFinalizeCommand(),
+		RegisterAlias:         NewRegisterAliasCommand(),
+		AccountMetadata:       NewAccountMetadataCommand(),
 		CurrencyRegister:      NewCurrencyRegisterCommand(),
 		CurrencyPolicyUpdater: NewCurrencyPolicyUpdaterCommand(),
+		MemoPolicyUpdater:     NewMemoPolicyUpdaterCommand(),
+		AccountPolicyUpdater:  NewAccountPolicyUpdaterCommand(),
 		SuffrageInflation:     NewSuffrageInflationCommand(),
+		AccountFreeze:         NewAccountFreezeCommand(),
+		AccountUnfreeze:       NewAccountUnfreezeCommand(),
 		Sign:                  NewSignSealCommand(),
 		SignFact:              NewSignFactCommand(),
 	}
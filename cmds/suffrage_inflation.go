@@ -13,9 +13,10 @@ import (
 )
 
 type SuffrageInflationItemFlag struct {
-	s        string
-	receiver base.Address
-	amount   currency.Amount
+	s              string
+	receiver       base.Address
+	currencyAmount CurrencyAmountFlag
+	amount         currency.Amount
 }
 
 func (v *SuffrageInflationItemFlag) String() string {
@@ -25,12 +26,12 @@ func (v *SuffrageInflationItemFlag) String() string {
 func (v *SuffrageInflationItemFlag) UnmarshalText(b []byte) error {
 	v.s = string(b)
 
-	l := strings.SplitN(string(b), ",", 3)
-	if len(l) != 3 {
+	l := strings.SplitN(string(b), ",", 2)
+	if len(l) != 2 {
 		return isvalid.InvalidError.Errorf("invalid inflation amount, %q", string(b))
 	}
 
-	a, c := l[0], l[1]+","+l[2]
+	a, c := l[0], l[1]
 
 	af := &AddressFlag{}
 	if err := af.UnmarshalText([]byte(a)); err != nil {
@@ -48,7 +49,7 @@ func (v *SuffrageInflationItemFlag) UnmarshalText(b []byte) error {
 	if err := cf.UnmarshalText([]byte(c)); err != nil {
 		return isvalid.InvalidError.Errorf("invalid inflation amount: %w", err)
 	}
-	v.amount = currency.NewAmount(cf.Big, cf.CID)
+	v.currencyAmount = *cf
 
 	return nil
 }
@@ -68,7 +69,8 @@ func (v *SuffrageInflationItemFlag) IsValid([]byte) error {
 type SuffrageInflationCommand struct {
 	*BaseCommand
 	OperationFlags
-	Items []SuffrageInflationItemFlag `arg:"" name:"inflation item" help:"ex: \"<receiver address>,<currency>,<amount>\""`
+	CurrencyDecimalsFlags
+	Items []SuffrageInflationItemFlag `arg:"" name:"inflation item" help:"ex: \"<receiver address>,<currency>,<amount>\" or \"<receiver address>,<decimal amount><currency>\""` // nolint lll
 	items []currency.SuffrageInflationItem
 }
 
@@ -116,6 +118,8 @@ func (cmd *SuffrageInflationCommand) Run(version util.Version) error { // nolint
 func (cmd *SuffrageInflationCommand) parseFlags() error {
 	if err := cmd.OperationFlags.IsValid(nil); err != nil {
 		return err
+	} else if err := cmd.CurrencyDecimalsFlags.IsValid(nil); err != nil {
+		return err
 	}
 
 	if len(cmd.Items) < 1 {
@@ -125,6 +129,13 @@ func (cmd *SuffrageInflationCommand) parseFlags() error {
 	items := make([]currency.SuffrageInflationItem, len(cmd.Items))
 	for i := range cmd.Items {
 		item := cmd.Items[i]
+
+		am, err := cmd.CurrencyDecimalsFlags.amount(item.currencyAmount)
+		if err != nil {
+			return isvalid.InvalidError.Errorf("invalid inflation amount: %w", err)
+		}
+		item.amount = am
+
 		if err := item.IsValid(nil); err != nil {
 			return err
 		}
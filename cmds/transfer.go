@@ -16,10 +16,12 @@ import (
 type TransferCommand struct {
 	*BaseCommand
 	OperationFlags
+	CurrencyDecimalsFlags
 	Sender   AddressFlag          `arg:"" name:"sender" help:"sender address" required:"true"`
 	Receiver AddressFlag          `arg:"" name:"receiver" help:"receiver address" required:"true"`
 	Seal     mitumcmds.FileLoad   `help:"seal" optional:""`
-	Amounts  []CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")"`
+	Amounts  []CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\" or \"<decimal amount><currency>\")"`
+	Unlock   int64                `name:"unlock-height" help:"lock amounts until the height" optional:""`
 	sender   base.Address
 	receiver base.Address
 }
@@ -61,6 +63,8 @@ func (cmd *TransferCommand) Run(version util.Version) error {
 func (cmd *TransferCommand) parseFlags() error {
 	if err := cmd.OperationFlags.IsValid(nil); err != nil {
 		return err
+	} else if err := cmd.CurrencyDecimalsFlags.IsValid(nil); err != nil {
+		return err
 	}
 
 	if len(cmd.Amounts) < 1 {
@@ -94,8 +98,11 @@ func (cmd *TransferCommand) createOperation() (operation.Operation, error) { //
 
 	ams := make([]currency.Amount, len(cmd.Amounts))
 	for i := range cmd.Amounts {
-		a := cmd.Amounts[i]
-		am := currency.NewAmount(a.Big, a.CID)
+		am, e := cmd.CurrencyDecimalsFlags.amount(cmd.Amounts[i])
+		if e != nil {
+			return nil, e
+		}
+
 		if err = am.IsValid(nil); err != nil {
 			return nil, err
 		}
@@ -103,7 +110,13 @@ func (cmd *TransferCommand) createOperation() (operation.Operation, error) { //
 		ams[i] = am
 	}
 
-	item := currency.NewTransfersItemMultiAmounts(cmd.receiver, ams)
+	var item currency.TransfersItem
+	if cmd.Unlock > 0 {
+		item = currency.NewTransfersItemLockedAmounts(cmd.receiver, ams, base.Height(cmd.Unlock))
+	} else {
+		item = currency.NewTransfersItemMultiAmounts(cmd.receiver, ams)
+	}
+
 	if err = item.IsValid(nil); err != nil {
 		return nil, err
 	}
@@ -15,9 +15,12 @@ var (
 
 type Account struct {
 	hint.BaseHinter
-	h       valuehash.Hash
-	address base.Address
-	keys    AccountKeys
+	h        valuehash.Hash
+	address  base.Address
+	keys     AccountKeys
+	frozen   bool
+	closed   bool
+	recovery AccountRecovery
 }
 
 func NewAccount(address base.Address, keys AccountKeys) (Account, error) {
@@ -54,6 +57,18 @@ func (ac Account) Bytes() []byte {
 		bs[1] = ac.keys.Bytes()
 	}
 
+	if ac.frozen {
+		bs = append(bs, []byte{1})
+	}
+
+	if ac.closed {
+		bs = append(bs, []byte{2})
+	}
+
+	if ac.HasRecovery() {
+		bs = append(bs, ac.recovery.Bytes())
+	}
+
 	return util.ConcatBytesSlice(bs...)
 }
 
@@ -83,6 +98,56 @@ func (ac Account) SetKeys(keys AccountKeys) (Account, error) {
 	return ac, nil
 }
 
+// IsFrozen indicates the account is frozen by suffrage; frozen account can not
+// send any operation.
+func (ac Account) IsFrozen() bool {
+	return ac.frozen
+}
+
+func (ac Account) SetFrozen(frozen bool) Account {
+	ac.frozen = frozen
+	ac.h = ac.GenerateHash()
+
+	return ac
+}
+
+// IsClosed indicates the account is closed by AccountMerge; closed account can
+// not send or receive any operation.
+func (ac Account) IsClosed() bool {
+	return ac.closed
+}
+
+func (ac Account) SetClosed(closed bool) Account {
+	ac.closed = closed
+	ac.h = ac.GenerateHash()
+
+	return ac
+}
+
+// Recovery returns the recovery setting of account; see AccountRecovery.
+func (ac Account) Recovery() AccountRecovery {
+	return ac.recovery
+}
+
+func (ac Account) HasRecovery() bool {
+	return ac.recovery.keys != nil
+}
+
+// SetRecovery sets the recovery setting; the empty AccountRecovery removes the
+// recovery setting.
+func (ac Account) SetRecovery(ar AccountRecovery) (Account, error) {
+	if ar.keys != nil {
+		if err := ar.IsValid(nil); err != nil {
+			return Account{}, err
+		}
+	}
+
+	ac.recovery = ar
+	ac.h = ac.GenerateHash()
+
+	return ac, nil
+}
+
 func (ac Account) IsEmpty() bool {
 	return ac.h == nil || ac.h.IsEmpty()
 }
@@ -9,20 +9,28 @@ import (
 )
 
 func (ac Account) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(bsonenc.MergeBSONM(
-		bsonenc.NewHintedDoc(ac.Hint()),
-		bson.M{
-			"hash":    ac.h,
-			"address": ac.address,
-			"keys":    ac.keys,
-		},
-	))
+	m := bson.M{
+		"hash":    ac.h,
+		"address": ac.address,
+		"keys":    ac.keys,
+		"frozen":  ac.frozen,
+		"closed":  ac.closed,
+	}
+
+	if ac.HasRecovery() {
+		m["recovery"] = ac.recovery
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(ac.Hint()), m))
 }
 
 type AccountBSONUnpacker struct {
 	H  valuehash.Bytes     `bson:"hash"`
 	AD base.AddressDecoder `bson:"address"`
 	KS bson.Raw            `bson:"keys"`
+	FR bool                `bson:"frozen"`
+	CL bool                `bson:"closed"`
+	RC bson.Raw            `bson:"recovery,omitempty"`
 }
 
 func (ac *Account) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -31,5 +39,5 @@ func (ac *Account) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		return err
 	}
 
-	return ac.unpack(enc, uac.H, uac.AD, uac.KS)
+	return ac.unpack(enc, uac.H, uac.AD, uac.KS, uac.FR, uac.CL, uac.RC)
 }
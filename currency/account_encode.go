@@ -6,7 +6,7 @@ import (
 	"github.com/spikeekips/mitum/util/valuehash"
 )
 
-func (ac *Account) unpack(enc encoder.Encoder, h valuehash.Hash, bad base.AddressDecoder, bks []byte) error {
+func (ac *Account) unpack(enc encoder.Encoder, h valuehash.Hash, bad base.AddressDecoder, bks []byte, frozen, closed bool, brc []byte) error {
 	a, err := bad.Encode(enc)
 	if err != nil {
 		return err
@@ -17,6 +17,12 @@ func (ac *Account) unpack(enc encoder.Encoder, h valuehash.Hash, bad base.Addres
 		return err
 	}
 
+	if err := encoder.Decode(brc, enc, &ac.recovery); err != nil {
+		return err
+	}
+
+	ac.frozen = frozen
+	ac.closed = closed
 	ac.h = h
 
 	return nil
@@ -0,0 +1,207 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AccountFreezeFactType     = hint.Type("mitum-currency-account-freeze-operation-fact")
+	AccountFreezeFactHint     = hint.NewHint(AccountFreezeFactType, "v0.0.1")
+	AccountFreezeFactHinter   = AccountFreezeFact{BaseHinter: hint.NewBaseHinter(AccountFreezeFactHint)}
+	AccountFreezeType         = hint.Type("mitum-currency-account-freeze-operation")
+	AccountFreezeHint         = hint.NewHint(AccountFreezeType, "v0.0.1")
+	AccountFreezeHinter       = AccountFreeze{BaseOperation: operationHinter(AccountFreezeHint)}
+	AccountUnfreezeFactType   = hint.Type("mitum-currency-account-unfreeze-operation-fact")
+	AccountUnfreezeFactHint   = hint.NewHint(AccountUnfreezeFactType, "v0.0.1")
+	AccountUnfreezeFactHinter = AccountUnfreezeFact{BaseHinter: hint.NewBaseHinter(AccountUnfreezeFactHint)}
+	AccountUnfreezeType       = hint.Type("mitum-currency-account-unfreeze-operation")
+	AccountUnfreezeHint       = hint.NewHint(AccountUnfreezeType, "v0.0.1")
+	AccountUnfreezeHinter     = AccountUnfreeze{BaseOperation: operationHinter(AccountUnfreezeHint)}
+)
+
+var maxAccountFreezeTargets = 10
+
+type AccountFreezeFact struct {
+	hint.BaseHinter
+	h       valuehash.Hash
+	token   []byte
+	targets []base.Address
+}
+
+func NewAccountFreezeFact(token []byte, targets []base.Address) AccountFreezeFact {
+	fact := AccountFreezeFact{
+		BaseHinter: hint.NewBaseHinter(AccountFreezeFactHint),
+		token:      token,
+		targets:    targets,
+	}
+
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact AccountFreezeFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact AccountFreezeFact) Bytes() []byte {
+	return accountFreezeFactBytes(fact.token, fact.targets, true)
+}
+
+func (fact AccountFreezeFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := isValidAccountFreezeTargets(fact.targets); err != nil {
+		return isvalid.InvalidError.Errorf("invalid AccountFreezeFact: %w", err)
+	}
+
+	return nil
+}
+
+func (fact AccountFreezeFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AccountFreezeFact) Token() []byte {
+	return fact.token
+}
+
+func (fact AccountFreezeFact) Targets() []base.Address {
+	return fact.targets
+}
+
+func (fact AccountFreezeFact) Addresses() ([]base.Address, error) {
+	return fact.targets, nil
+}
+
+type AccountFreeze struct {
+	BaseOperation
+}
+
+func NewAccountFreeze(fact AccountFreezeFact, fs []base.FactSign, memo string) (AccountFreeze, error) {
+	bo, err := NewBaseOperationFromFact(AccountFreezeHint, fact, fs, memo)
+	if err != nil {
+		return AccountFreeze{}, err
+	}
+
+	return AccountFreeze{BaseOperation: bo}, nil
+}
+
+type AccountUnfreezeFact struct {
+	hint.BaseHinter
+	h       valuehash.Hash
+	token   []byte
+	targets []base.Address
+}
+
+func NewAccountUnfreezeFact(token []byte, targets []base.Address) AccountUnfreezeFact {
+	fact := AccountUnfreezeFact{
+		BaseHinter: hint.NewBaseHinter(AccountUnfreezeFactHint),
+		token:      token,
+		targets:    targets,
+	}
+
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact AccountUnfreezeFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact AccountUnfreezeFact) Bytes() []byte {
+	return accountFreezeFactBytes(fact.token, fact.targets, false)
+}
+
+func (fact AccountUnfreezeFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := isValidAccountFreezeTargets(fact.targets); err != nil {
+		return isvalid.InvalidError.Errorf("invalid AccountUnfreezeFact: %w", err)
+	}
+
+	return nil
+}
+
+func (fact AccountUnfreezeFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AccountUnfreezeFact) Token() []byte {
+	return fact.token
+}
+
+func (fact AccountUnfreezeFact) Targets() []base.Address {
+	return fact.targets
+}
+
+func (fact AccountUnfreezeFact) Addresses() ([]base.Address, error) {
+	return fact.targets, nil
+}
+
+type AccountUnfreeze struct {
+	BaseOperation
+}
+
+func NewAccountUnfreeze(fact AccountUnfreezeFact, fs []base.FactSign, memo string) (AccountUnfreeze, error) {
+	bo, err := NewBaseOperationFromFact(AccountUnfreezeHint, fact, fs, memo)
+	if err != nil {
+		return AccountUnfreeze{}, err
+	}
+
+	return AccountUnfreeze{BaseOperation: bo}, nil
+}
+
+// accountFreezeFactBytes appends the frozen status, so AccountFreezeFact and
+// AccountUnfreezeFact with same token and targets have different hash.
+func accountFreezeFactBytes(token []byte, targets []base.Address, frozen bool) []byte {
+	bs := make([][]byte, len(targets)+2)
+	bs[0] = token
+
+	for i := range targets {
+		if targets[i] != nil {
+			bs[i+1] = targets[i].Bytes()
+		}
+	}
+
+	if frozen {
+		bs[len(bs)-1] = []byte{1}
+	} else {
+		bs[len(bs)-1] = []byte{0}
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func isValidAccountFreezeTargets(targets []base.Address) error {
+	switch l := len(targets); {
+	case l < 1:
+		return isvalid.InvalidError.Errorf("empty targets")
+	case l > maxAccountFreezeTargets:
+		return isvalid.InvalidError.Errorf("too many targets; %d > %d", l, maxAccountFreezeTargets)
+	}
+
+	founds := map[string]struct{}{}
+	for i := range targets {
+		if err := isvalid.Check(nil, false, targets[i]); err != nil {
+			return err
+		}
+
+		k := targets[i].String()
+		if _, found := founds[k]; found {
+			return isvalid.InvalidError.Errorf("duplicated target, %q found", k)
+		}
+		founds[k] = struct{}{}
+	}
+
+	return nil
+}
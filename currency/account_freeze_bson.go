@@ -0,0 +1,95 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type AccountFreezeFactBSONUnpacker struct {
+	H  valuehash.Bytes       `bson:"hash"`
+	TK []byte                `bson:"token"`
+	TG []base.AddressDecoder `bson:"targets"`
+}
+
+func (fact AccountFreezeFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":    fact.h,
+				"token":   fact.token,
+				"targets": fact.targets,
+			}),
+	)
+}
+
+func (fact *AccountFreezeFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uf AccountFreezeFactBSONUnpacker
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return err
+	}
+
+	targets, err := unpackAccountFreezeTargets(enc, uf.TG)
+	if err != nil {
+		return err
+	}
+
+	fact.h = uf.H
+	fact.token = uf.TK
+	fact.targets = targets
+
+	return nil
+}
+
+func (op *AccountFreeze) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
+
+func (fact AccountUnfreezeFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":    fact.h,
+				"token":   fact.token,
+				"targets": fact.targets,
+			}),
+	)
+}
+
+func (fact *AccountUnfreezeFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uf AccountFreezeFactBSONUnpacker
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return err
+	}
+
+	targets, err := unpackAccountFreezeTargets(enc, uf.TG)
+	if err != nil {
+		return err
+	}
+
+	fact.h = uf.H
+	fact.token = uf.TK
+	fact.targets = targets
+
+	return nil
+}
+
+func (op *AccountUnfreeze) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
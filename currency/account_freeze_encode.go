@@ -0,0 +1,19 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func unpackAccountFreezeTargets(enc encoder.Encoder, bts []base.AddressDecoder) ([]base.Address, error) {
+	targets := make([]base.Address, len(bts))
+	for i := range bts {
+		a, err := bts[i].Encode(enc)
+		if err != nil {
+			return nil, err
+		}
+		targets[i] = a
+	}
+
+	return targets, nil
+}
@@ -0,0 +1,96 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type AccountFreezeFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	TG []base.Address `json:"targets"`
+}
+
+type AccountFreezeFactJSONUnpacker struct {
+	H  valuehash.Bytes       `json:"hash"`
+	TK []byte                `json:"token"`
+	TG []base.AddressDecoder `json:"targets"`
+}
+
+func (fact AccountFreezeFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(AccountFreezeFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		TG:         fact.targets,
+	})
+}
+
+func (fact *AccountFreezeFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uf AccountFreezeFactJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &uf); err != nil {
+		return err
+	}
+
+	targets, err := unpackAccountFreezeTargets(enc, uf.TG)
+	if err != nil {
+		return err
+	}
+
+	fact.h = uf.H
+	fact.token = uf.TK
+	fact.targets = targets
+
+	return nil
+}
+
+func (op *AccountFreeze) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
+
+func (fact AccountUnfreezeFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(AccountFreezeFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		TG:         fact.targets,
+	})
+}
+
+func (fact *AccountUnfreezeFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uf AccountFreezeFactJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &uf); err != nil {
+		return err
+	}
+
+	targets, err := unpackAccountFreezeTargets(enc, uf.TG)
+	if err != nil {
+		return err
+	}
+
+	fact.h = uf.H
+	fact.token = uf.TK
+	fact.targets = targets
+
+	return nil
+}
+
+func (op *AccountUnfreeze) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
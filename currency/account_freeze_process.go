@@ -0,0 +1,203 @@
+package currency
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var accountFreezeProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AccountFreezeProcessor)
+	},
+}
+
+var accountUnfreezeProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AccountUnfreezeProcessor)
+	},
+}
+
+func (AccountFreeze) Process(
+	func(string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+func (AccountUnfreeze) Process(
+	func(string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type AccountFreezeProcessor struct {
+	AccountFreeze
+	pubs      []key.Publickey
+	threshold base.Threshold
+	sts       []state.State
+}
+
+func NewAccountFreezeProcessor(pubs []key.Publickey, threshold base.Threshold) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(AccountFreeze)
+		if !ok {
+			return nil, errors.Errorf("not AccountFreeze, %T", op)
+		}
+
+		opp := accountFreezeProcessorPool.Get().(*AccountFreezeProcessor)
+
+		opp.AccountFreeze = i
+		opp.pubs = pubs
+		opp.threshold = threshold
+		opp.sts = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *AccountFreezeProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	if len(opp.pubs) < 1 {
+		return nil, operation.NewBaseReasonError("empty publickeys for operation signs")
+	} else if err := checkFactSignsByPubs(opp.pubs, opp.threshold, opp.Signs()); err != nil {
+		return nil, err
+	}
+
+	sts, err := preProcessAccountFreeze(opp.Fact().(AccountFreezeFact).targets, true, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	opp.sts = sts
+
+	return opp, nil
+}
+
+func (opp *AccountFreezeProcessor) Process(
+	_ func(string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	return setState(opp.Fact().Hash(), opp.sts...)
+}
+
+func (opp *AccountFreezeProcessor) Close() error {
+	opp.AccountFreeze = AccountFreeze{}
+	opp.pubs = nil
+	opp.threshold = base.Threshold{}
+	opp.sts = nil
+
+	accountFreezeProcessorPool.Put(opp)
+
+	return nil
+}
+
+type AccountUnfreezeProcessor struct {
+	AccountUnfreeze
+	pubs      []key.Publickey
+	threshold base.Threshold
+	sts       []state.State
+}
+
+func NewAccountUnfreezeProcessor(pubs []key.Publickey, threshold base.Threshold) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(AccountUnfreeze)
+		if !ok {
+			return nil, errors.Errorf("not AccountUnfreeze, %T", op)
+		}
+
+		opp := accountUnfreezeProcessorPool.Get().(*AccountUnfreezeProcessor)
+
+		opp.AccountUnfreeze = i
+		opp.pubs = pubs
+		opp.threshold = threshold
+		opp.sts = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *AccountUnfreezeProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	if len(opp.pubs) < 1 {
+		return nil, operation.NewBaseReasonError("empty publickeys for operation signs")
+	} else if err := checkFactSignsByPubs(opp.pubs, opp.threshold, opp.Signs()); err != nil {
+		return nil, err
+	}
+
+	sts, err := preProcessAccountFreeze(opp.Fact().(AccountUnfreezeFact).targets, false, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	opp.sts = sts
+
+	return opp, nil
+}
+
+func (opp *AccountUnfreezeProcessor) Process(
+	_ func(string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	return setState(opp.Fact().Hash(), opp.sts...)
+}
+
+func (opp *AccountUnfreezeProcessor) Close() error {
+	opp.AccountUnfreeze = AccountUnfreeze{}
+	opp.pubs = nil
+	opp.threshold = base.Threshold{}
+	opp.sts = nil
+
+	accountUnfreezeProcessorPool.Put(opp)
+
+	return nil
+}
+
+// preProcessAccountFreeze loads the account states of targets and returns them
+// with the new frozen status.
+func preProcessAccountFreeze(
+	targets []base.Address,
+	frozen bool,
+	getState func(string) (state.State, bool, error),
+) ([]state.State, error) {
+	sts := make([]state.State, len(targets))
+	for i := range targets {
+		st, err := existsState(StateKeyAccount(targets[i]), "target account", getState)
+		if err != nil {
+			return nil, err
+		}
+
+		ac, err := LoadStateAccountValue(st)
+		if err != nil {
+			return nil, operation.NewBaseReasonErrorFromError(err)
+		}
+
+		switch {
+		case frozen && ac.IsFrozen():
+			return nil, operation.NewBaseReasonError("account, %q already frozen", targets[i])
+		case !frozen && !ac.IsFrozen():
+			return nil, operation.NewBaseReasonError("account, %q not frozen", targets[i])
+		}
+
+		nst, err := SetStateAccountValue(st, ac.SetFrozen(frozen))
+		if err != nil {
+			return nil, operation.NewBaseReasonErrorFromError(err)
+		}
+		sts[i] = nst
+	}
+
+	return sts, nil
+}
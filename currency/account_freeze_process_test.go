@@ -0,0 +1,233 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type testAccountFreezeOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testAccountFreezeOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testAccountFreezeOperations) signs(keys []key.Privatekey, fact base.Fact) []base.FactSign {
+	var fs []base.FactSign
+	for _, pk := range keys {
+		sig, err := base.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, base.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	return fs
+}
+
+func (t *testAccountFreezeOperations) newFreeze(keys []key.Privatekey, targets []base.Address) AccountFreeze {
+	fact := NewAccountFreezeFact(util.UUID().Bytes(), targets)
+
+	op, err := NewAccountFreeze(fact, t.signs(keys, fact), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testAccountFreezeOperations) newUnfreeze(keys []key.Privatekey, targets []base.Address) AccountUnfreeze {
+	fact := NewAccountUnfreezeFact(util.UUID().Bytes(), targets)
+
+	op, err := NewAccountUnfreeze(fact, t.signs(keys, fact), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testAccountFreezeOperations) processor(n int, pool *storage.Statepool) ([]key.Privatekey, prprocessor.OperationProcessor) {
+	privs, opr := t.prototype(n)
+
+	return privs, opr.New(pool)
+}
+
+func (t *testAccountFreezeOperations) prototype(n int) ([]key.Privatekey, *OperationProcessor) {
+	privs := make([]key.Privatekey, n)
+	for i := 0; i < n; i++ {
+		privs[i] = key.NewBasePrivatekey()
+	}
+
+	pubs := make([]key.Publickey, len(privs))
+	for i := range privs {
+		pubs[i] = privs[i].Publickey()
+	}
+	threshold, err := base.NewThreshold(uint(len(privs)), 100)
+	t.NoError(err)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(NewTestAddress(), ZeroBig))))
+
+	opr := NewOperationProcessor(cp)
+	_, err = opr.SetProcessor(AccountFreezeHinter, NewAccountFreezeProcessor(pubs, threshold))
+	t.NoError(err)
+	_, err = opr.SetProcessor(AccountUnfreezeHinter, NewAccountUnfreezeProcessor(pubs, threshold))
+	t.NoError(err)
+	_, err = opr.SetProcessor(KeyUpdaterHinter, NewKeyUpdaterProcessor(cp))
+	t.NoError(err)
+
+	return privs, opr
+}
+
+func (t *testAccountFreezeOperations) frozen(pool *storage.Statepool, a base.Address) bool {
+	var nst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyAccount(a) {
+			nst = st.GetState()
+		}
+	}
+	t.NotNil(nst)
+
+	ac, err := LoadStateAccountValue(nst)
+	t.NoError(err)
+
+	return ac.IsFrozen()
+}
+
+func (t *testAccountFreezeOperations) TestFreeze() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	pool, _ := t.statepool(st0, st1)
+	privs, opr := t.processor(2, pool)
+
+	t.NoError(opr.Process(t.newFreeze(privs, []base.Address{sa.Address, ra.Address})))
+	t.True(t.frozen(pool, sa.Address))
+	t.True(t.frozen(pool, ra.Address))
+}
+
+func (t *testAccountFreezeOperations) TestAlreadyFrozen() {
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	pool, _ := t.statepool(t.freezeAccountState(sa.Address, sts))
+	privs, opr := t.processor(2, pool)
+
+	err := opr.Process(t.newFreeze(privs, []base.Address{sa.Address}))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "already frozen")
+}
+
+func (t *testAccountFreezeOperations) TestUnfreeze() {
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	pool, _ := t.statepool(t.freezeAccountState(sa.Address, sts))
+	privs, opr := t.processor(2, pool)
+
+	t.NoError(opr.Process(t.newUnfreeze(privs, []base.Address{sa.Address})))
+	t.False(t.frozen(pool, sa.Address))
+}
+
+func (t *testAccountFreezeOperations) TestUnfreezeNotFrozen() {
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	pool, _ := t.statepool(sts)
+	privs, opr := t.processor(2, pool)
+
+	err := opr.Process(t.newUnfreeze(privs, []base.Address{sa.Address}))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "not frozen")
+}
+
+func (t *testAccountFreezeOperations) TestUnknownTarget() {
+	pool, _ := t.statepool()
+	privs, opr := t.processor(2, pool)
+
+	err := opr.Process(t.newFreeze(privs, []base.Address{base.RandomStringAddress()}))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "target account does not exist")
+}
+
+func (t *testAccountFreezeOperations) TestNotEnoughSigns() {
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	pool, _ := t.statepool(sts)
+	privs, opr := t.processor(3, pool)
+
+	err := opr.Process(t.newFreeze(privs[:1], []base.Address{sa.Address}))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "not enough suffrage signs")
+}
+
+func (t *testAccountFreezeOperations) newKeyUpdater(sa *account) KeyUpdater {
+	nkeys, err := NewBaseAccountKeys([]AccountKey{t.newKey(key.NewBasePrivatekey().Publickey(), 100)}, 100)
+	t.NoError(err)
+
+	fact := NewKeyUpdaterFact(util.UUID().Bytes(), sa.Address, nkeys, t.cid)
+
+	op, err := NewKeyUpdater(fact, t.signs(sa.Privs(), fact), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testAccountFreezeOperations) TestFreezeAfterKeyUpdater() {
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	pool, _ := t.statepool(sts)
+	privs, copr := t.prototype(2)
+
+	// NOTE mitum creates OperationProcessor by operation hint
+	kopr := copr.New(pool)
+	fopr := copr.New(pool)
+
+	t.NoError(kopr.Process(t.newKeyUpdater(sa)))
+
+	err := fopr.Process(t.newFreeze(privs, []base.Address{sa.Address}))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "already updated in proposal")
+
+	t.NoError(kopr.Close())
+	t.NoError(fopr.Close())
+}
+
+func (t *testAccountFreezeOperations) TestKeyUpdaterAfterFreeze() {
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	pool, _ := t.statepool(sts)
+	privs, copr := t.prototype(2)
+
+	fopr := copr.New(pool)
+	kopr := copr.New(pool)
+
+	t.NoError(fopr.Process(t.newFreeze(privs, []base.Address{sa.Address})))
+
+	err := kopr.Process(t.newKeyUpdater(sa))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "already updated in proposal")
+}
+
+func TestAccountFreezeOperations(t *testing.T) {
+	suite.Run(t, new(testAccountFreezeOperations))
+}
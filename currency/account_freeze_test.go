@@ -0,0 +1,185 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/stretchr/testify/suite"
+)
+
+type testAccountFreeze struct {
+	baseTest
+}
+
+func (t *testAccountFreeze) signs(fact base.Fact) []base.FactSign {
+	var fs []base.FactSign
+
+	for _, pk := range []key.Privatekey{
+		key.NewBasePrivatekey(),
+		key.NewBasePrivatekey(),
+		key.NewBasePrivatekey(),
+	} {
+		sig, err := base.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, base.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	return fs
+}
+
+func (t *testAccountFreeze) TestNew() {
+	fact := NewAccountFreezeFact(util.UUID().Bytes(), []base.Address{base.RandomStringAddress()})
+
+	op, err := NewAccountFreeze(fact, t.signs(fact), "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+
+	t.Equal(fact, op.Fact())
+}
+
+func (t *testAccountFreeze) TestNewUnfreeze() {
+	fact := NewAccountUnfreezeFact(util.UUID().Bytes(), []base.Address{base.RandomStringAddress()})
+
+	op, err := NewAccountUnfreeze(fact, t.signs(fact), "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+
+	t.Equal(fact, op.Fact())
+}
+
+func (t *testAccountFreeze) TestEmptyTargets() {
+	fact := NewAccountFreezeFact(util.UUID().Bytes(), nil)
+
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "empty targets")
+}
+
+func (t *testAccountFreeze) TestTooManyTargets() {
+	targets := make([]base.Address, maxAccountFreezeTargets+1)
+	for i := range targets {
+		targets[i] = base.RandomStringAddress()
+	}
+
+	fact := NewAccountUnfreezeFact(util.UUID().Bytes(), targets)
+
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "too many targets")
+}
+
+func (t *testAccountFreeze) TestDuplicatedTargets() {
+	a := base.RandomStringAddress()
+	fact := NewAccountFreezeFact(util.UUID().Bytes(), []base.Address{a, base.RandomStringAddress(), a})
+
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "duplicated target")
+}
+
+func (t *testAccountFreeze) TestDifferentHash() {
+	token := util.UUID().Bytes()
+	targets := []base.Address{base.RandomStringAddress()}
+
+	fact := NewAccountFreezeFact(token, targets)
+	ufact := NewAccountUnfreezeFact(token, targets)
+
+	t.False(fact.Hash().Equal(ufact.Hash()))
+}
+
+func TestAccountFreeze(t *testing.T) {
+	suite.Run(t, new(testAccountFreeze))
+}
+
+func testAccountFreezeEncode(enc encoder.Encoder, freeze bool) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+	t.enc = enc
+	t.newObject = func() interface{} {
+		token := util.UUID().Bytes()
+		targets := []base.Address{base.RandomStringAddress(), base.RandomStringAddress()}
+
+		var fact base.Fact
+		if freeze {
+			fact = NewAccountFreezeFact(token, targets)
+		} else {
+			fact = NewAccountUnfreezeFact(token, targets)
+		}
+
+		var fs []base.FactSign
+		for _, pk := range []key.Privatekey{
+			key.NewBasePrivatekey(),
+			key.NewBasePrivatekey(),
+		} {
+			sig, err := base.NewFactSignature(pk, fact, nil)
+			t.NoError(err)
+
+			fs = append(fs, base.NewBaseFactSign(pk.Publickey(), sig))
+		}
+
+		var op operation.Operation
+		var err error
+		if freeze {
+			op, err = NewAccountFreeze(fact.(AccountFreezeFact), fs, "findme")
+		} else {
+			op, err = NewAccountUnfreeze(fact.(AccountUnfreezeFact), fs, "findme")
+		}
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		oa := a.(operation.Operation)
+		ob := b.(operation.Operation)
+
+		t.True(oa.Hint().Equal(ob.Hint()))
+		t.True(oa.Fact().Hint().Equal(ob.Fact().Hint()))
+		t.True(oa.Fact().Hash().Equal(ob.Fact().Hash()))
+
+		var ta, tb []base.Address
+		switch fact := oa.Fact().(type) {
+		case AccountFreezeFact:
+			ta = fact.targets
+			tb = ob.Fact().(AccountFreezeFact).targets
+		case AccountUnfreezeFact:
+			ta = fact.targets
+			tb = ob.Fact().(AccountUnfreezeFact).targets
+		}
+
+		t.Equal(len(ta), len(tb))
+		for i := range ta {
+			t.True(ta[i].Equal(tb[i]))
+		}
+	}
+
+	return t
+}
+
+func TestAccountFreezeEncodeJSON(t *testing.T) {
+	suite.Run(t, testAccountFreezeEncode(jsonenc.NewEncoder(), true))
+	suite.Run(t, testAccountFreezeEncode(jsonenc.NewEncoder(), false))
+}
+
+func TestAccountFreezeEncodeBSON(t *testing.T) {
+	suite.Run(t, testAccountFreezeEncode(bsonenc.NewEncoder(), true))
+	suite.Run(t, testAccountFreezeEncode(bsonenc.NewEncoder(), false))
+}
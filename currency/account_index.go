@@ -0,0 +1,126 @@
+package currency
+
+import (
+	"sort"
+
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AccountIndexType   = hint.Type("mitum-currency-account-index")
+	AccountIndexHint   = hint.NewHint(AccountIndexType, "v0.0.1")
+	AccountIndexHinter = AccountIndex{BaseHinter: hint.NewBaseHinter(AccountIndexHint)}
+)
+
+// AccountIndex is the state value of the state keys, which depend on the
+// account; the open escrows, locked htlcs and active payment schedules of which
+// the account is sender, and the allowances approved by the account. The keys
+// are sorted, so the same keys have the same hash.
+type AccountIndex struct {
+	hint.BaseHinter
+	keys []string
+}
+
+func NewAccountIndex(keys []string) AccountIndex {
+	ai := AccountIndex{BaseHinter: hint.NewBaseHinter(AccountIndexHint)}
+
+	return ai.setKeys(keys)
+}
+
+func (ai AccountIndex) Bytes() []byte {
+	bs := make([][]byte, len(ai.keys))
+	for i := range ai.keys {
+		bs[i] = []byte(ai.keys[i])
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (ai AccountIndex) Hash() valuehash.Hash {
+	return ai.GenerateHash()
+}
+
+func (ai AccountIndex) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(ai.Bytes())
+}
+
+func (ai AccountIndex) IsValid([]byte) error {
+	if err := ai.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for i := range ai.keys {
+		k := ai.keys[i]
+		if len(k) < 1 {
+			return isvalid.InvalidError.Errorf("empty key found in AccountIndex")
+		}
+
+		if _, found := founds[k]; found {
+			return isvalid.InvalidError.Errorf("duplicated key found in AccountIndex, %q", k)
+		}
+		founds[k] = struct{}{}
+	}
+
+	return nil
+}
+
+func (ai AccountIndex) Keys() []string {
+	return ai.keys
+}
+
+// Filter returns the keys, which match with f.
+func (ai AccountIndex) Filter(f func(string) bool) []string {
+	var keys []string
+	for i := range ai.keys {
+		if f(ai.keys[i]) {
+			keys = append(keys, ai.keys[i])
+		}
+	}
+
+	return keys
+}
+
+func (ai AccountIndex) Add(keys ...string) AccountIndex {
+	nkeys := make([]string, len(ai.keys), len(ai.keys)+len(keys))
+	copy(nkeys, ai.keys)
+
+	return ai.setKeys(append(nkeys, keys...))
+}
+
+func (ai AccountIndex) Remove(keys ...string) AccountIndex {
+	removed := map[string]struct{}{}
+	for i := range keys {
+		removed[keys[i]] = struct{}{}
+	}
+
+	nkeys := make([]string, 0, len(ai.keys))
+	for i := range ai.keys {
+		if _, found := removed[ai.keys[i]]; !found {
+			nkeys = append(nkeys, ai.keys[i])
+		}
+	}
+
+	return ai.setKeys(nkeys)
+}
+
+func (ai AccountIndex) setKeys(keys []string) AccountIndex {
+	founds := map[string]struct{}{}
+	nkeys := make([]string, 0, len(keys))
+	for i := range keys {
+		if _, found := founds[keys[i]]; found {
+			continue
+		}
+		founds[keys[i]] = struct{}{}
+		nkeys = append(nkeys, keys[i])
+	}
+
+	sort.Strings(nkeys)
+
+	ai.keys = nkeys
+
+	return ai
+}
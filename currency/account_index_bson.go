@@ -0,0 +1,31 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (ai AccountIndex) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(ai.Hint()),
+		bson.M{
+			"keys": ai.keys,
+		},
+	))
+}
+
+type AccountIndexBSONUnpacker struct {
+	KS []string `bson:"keys"`
+}
+
+func (ai *AccountIndex) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uai AccountIndexBSONUnpacker
+	if err := enc.Unmarshal(b, &uai); err != nil {
+		return err
+	}
+
+	ai.keys = uai.KS
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package currency
+
+import jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+
+type AccountIndexJSONPacker struct {
+	jsonenc.HintedHead
+	KS []string `json:"keys"`
+}
+
+func (ai AccountIndex) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(AccountIndexJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(ai.Hint()),
+		KS:         ai.keys,
+	})
+}
+
+type AccountIndexJSONUnpacker struct {
+	KS []string `json:"keys"`
+}
+
+func (ai *AccountIndex) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uai AccountIndexJSONUnpacker
+	if err := enc.Unmarshal(b, &uai); err != nil {
+		return err
+	}
+
+	ai.keys = uai.KS
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package currency
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	accountIndexStateType = hint.Type("mitum-currency-account-index-state")
+	accountIndexStateHint = hint.NewHint(accountIndexStateType, "v0.0.1")
+)
+
+// AccountIndexState keeps the added and removed keys of AccountIndex like
+// AmountState, so the index can be updated by multiple operations in one block;
+// for example, the escrows of same sender can be released by the different
+// arbiters.
+type AccountIndexState struct {
+	state.State
+	added   []string
+	removed []string
+}
+
+func NewAccountIndexState(st state.State) AccountIndexState {
+	if sst, ok := st.(AccountIndexState); ok {
+		return sst
+	}
+
+	return AccountIndexState{State: st}
+}
+
+func (AccountIndexState) Hint() hint.Hint {
+	return accountIndexStateHint
+}
+
+func (st AccountIndexState) Merge(b state.State) (state.State, error) {
+	var ai AccountIndex
+	if i, err := StateAccountIndexValue(b); err != nil {
+		if !errors.Is(err, util.NotFoundError) {
+			return nil, err
+		}
+		ai = NewAccountIndex(nil)
+	} else {
+		ai = i
+	}
+
+	return SetStateAccountIndexValue(st, ai.Remove(st.removed...).Add(st.added...))
+}
+
+func (st AccountIndexState) Add(keys ...string) AccountIndexState {
+	added := make([]string, len(st.added), len(st.added)+len(keys))
+	copy(added, st.added)
+	st.added = append(added, keys...)
+
+	return st
+}
+
+func (st AccountIndexState) Remove(keys ...string) AccountIndexState {
+	removed := make([]string, len(st.removed), len(st.removed)+len(keys))
+	copy(removed, st.removed)
+	st.removed = append(removed, keys...)
+
+	return st
+}
+
+func (st AccountIndexState) SetValue(v state.Value) (state.State, error) {
+	s, err := st.State.SetValue(v)
+	if err != nil {
+		return nil, err
+	}
+	st.State = s
+
+	return st, nil
+}
+
+func (st AccountIndexState) SetHash(h valuehash.Hash) (state.State, error) {
+	s, err := st.State.SetHash(h)
+	if err != nil {
+		return nil, err
+	}
+	st.State = s
+
+	return st, nil
+}
+
+func (st AccountIndexState) SetHeight(h base.Height) state.State {
+	st.State = st.State.SetHeight(h)
+
+	return st
+}
+
+func (st AccountIndexState) SetPreviousHeight(h base.Height) (state.State, error) {
+	s, err := st.State.SetPreviousHeight(h)
+	if err != nil {
+		return nil, err
+	}
+	st.State = s
+
+	return st, nil
+}
+
+func (st AccountIndexState) SetOperation(ops []valuehash.Hash) state.State {
+	st.State = st.State.SetOperation(ops)
+
+	return st
+}
+
+func (st AccountIndexState) Clear() state.State {
+	st.State = st.State.Clear()
+
+	st.added = nil
+	st.removed = nil
+
+	return st
+}
+
+// loadAccountIndexState returns the AccountIndexState of the account; the
+// state of account, which has no index yet, is also returned.
+func loadAccountIndexState(
+	a base.Address,
+	getState func(key string) (state.State, bool, error),
+) (AccountIndexState, error) {
+	st, _, err := getState(StateKeyAccountIndex(a))
+	if err != nil {
+		return AccountIndexState{}, err
+	}
+
+	return NewAccountIndexState(st), nil
+}
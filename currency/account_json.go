@@ -13,15 +13,26 @@ type AccountPackerJSON struct {
 	H  valuehash.Hash `json:"hash"`
 	AD base.Address   `json:"address"`
 	KS AccountKeys    `json:"keys"`
+	FR bool           `json:"frozen"`
+	CL bool           `json:"closed"`
+	RC interface{}    `json:"recovery,omitempty"`
 }
 
 func (ac Account) PackerJSON() AccountPackerJSON {
-	return AccountPackerJSON{
+	p := AccountPackerJSON{
 		HintedHead: jsonenc.NewHintedHead(ac.Hint()),
 		H:          ac.h,
 		AD:         ac.address,
 		KS:         ac.keys,
+		FR:         ac.frozen,
+		CL:         ac.closed,
 	}
+
+	if ac.HasRecovery() {
+		p.RC = ac.recovery
+	}
+
+	return p
 }
 
 func (ac Account) MarshalJSON() ([]byte, error) {
@@ -32,6 +43,9 @@ type AccountJSONUnpacker struct {
 	H  valuehash.Bytes     `json:"hash"`
 	AD base.AddressDecoder `json:"address"`
 	KS json.RawMessage     `json:"keys"`
+	FR bool                `json:"frozen"`
+	CL bool                `json:"closed"`
+	RC json.RawMessage     `json:"recovery,omitempty"`
 }
 
 func (ac *Account) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -40,5 +54,5 @@ func (ac *Account) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 		return err
 	}
 
-	return ac.unpack(enc, uac.H, uac.AD, uac.KS)
+	return ac.unpack(enc, uac.H, uac.AD, uac.KS, uac.FR, uac.CL, uac.RC)
 }
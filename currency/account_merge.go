@@ -0,0 +1,104 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AccountMergeFactType   = hint.Type("mitum-currency-account-merge-operation-fact")
+	AccountMergeFactHint   = hint.NewHint(AccountMergeFactType, "v0.0.1")
+	AccountMergeFactHinter = AccountMergeFact{BaseHinter: hint.NewBaseHinter(AccountMergeFactHint)}
+	AccountMergeType       = hint.Type("mitum-currency-account-merge-operation")
+	AccountMergeHint       = hint.NewHint(AccountMergeType, "v0.0.1")
+	AccountMergeHinter     = AccountMerge{BaseOperation: operationHinter(AccountMergeHint)}
+)
+
+// AccountMergeFact moves the every balance of sender to target and closes the
+// sender account. The fee is deducted from the moved balance.
+type AccountMergeFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	target base.Address
+}
+
+func NewAccountMergeFact(token []byte, sender, target base.Address) AccountMergeFact {
+	fact := AccountMergeFact{
+		BaseHinter: hint.NewBaseHinter(AccountMergeFactHint),
+		token:      token,
+		sender:     sender,
+		target:     target,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact AccountMergeFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact AccountMergeFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AccountMergeFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.target.Bytes(),
+	)
+}
+
+func (fact AccountMergeFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := isvalid.Check(nil, false,
+		fact.sender,
+		fact.target,
+	); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.target) {
+		return isvalid.InvalidError.Errorf("target is same with sender, %q", fact.sender)
+	}
+
+	return nil
+}
+
+func (fact AccountMergeFact) Token() []byte {
+	return fact.token
+}
+
+func (fact AccountMergeFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact AccountMergeFact) Target() base.Address {
+	return fact.target
+}
+
+func (fact AccountMergeFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.target}, nil
+}
+
+type AccountMerge struct {
+	BaseOperation
+}
+
+func NewAccountMerge(fact AccountMergeFact, fs []base.FactSign, memo string) (AccountMerge, error) {
+	bo, err := NewBaseOperationFromFact(AccountMergeHint, fact, fs, memo)
+	if err != nil {
+		return AccountMerge{}, err
+	}
+
+	return AccountMerge{BaseOperation: bo}, nil
+}
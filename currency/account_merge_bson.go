@@ -0,0 +1,47 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact AccountMergeFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":   fact.h,
+				"token":  fact.token,
+				"sender": fact.sender,
+				"target": fact.target,
+			}))
+}
+
+type AccountMergeFactBSONUnpacker struct {
+	H  valuehash.Bytes     `bson:"hash"`
+	TK []byte              `bson:"token"`
+	SD base.AddressDecoder `bson:"sender"`
+	TG base.AddressDecoder `bson:"target"`
+}
+
+func (fact *AccountMergeFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ufact AccountMergeFactBSONUnpacker
+	if err := bson.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, ufact.TG)
+}
+
+func (op *AccountMerge) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *AccountMergeFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bsender,
+	btarget base.AddressDecoder,
+) error {
+	sender, err := bsender.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	target, err := btarget.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.sender = sender
+	fact.target = target
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type AccountMergeFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	SD base.Address   `json:"sender"`
+	TG base.Address   `json:"target"`
+}
+
+func (fact AccountMergeFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(AccountMergeFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		TG:         fact.target,
+	})
+}
+
+type AccountMergeFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	TG base.AddressDecoder `json:"target"`
+}
+
+func (fact *AccountMergeFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact AccountMergeFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, ufact.TG)
+}
+
+func (op *AccountMerge) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,324 @@
+package currency
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var accountMergeProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AccountMergeProcessor)
+	},
+}
+
+func (AccountMerge) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+// AccountMergeProcessor moves the balances of every registered currency from
+// sender to target. The fee of each balance is deducted from the balance of
+// fee currency. When the fee is paid in the same currency and the balance is
+// not enough for the fee, the whole balance is collected as fee, so the dust
+// can be cleaned up; the balance of another fee currency should cover the fee.
+//
+// The open escrows, locked htlcs and active payment schedules of sender should
+// be settled before merging, and the allowances approved by sender are revoked.
+type AccountMergeProcessor struct {
+	cp *CurrencyPool
+	AccountMerge
+	sa  state.State
+	si  AccountIndexState
+	as  []state.State
+	sb  map[CurrencyID]AmountState
+	rb  map[CurrencyID]AmountState
+	fee map[CurrencyID]Big
+}
+
+func NewAccountMergeProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(AccountMerge)
+		if !ok {
+			return nil, errors.Errorf("not AccountMerge, %T", op)
+		}
+
+		opp := accountMergeProcessorPool.Get().(*AccountMergeProcessor)
+
+		opp.cp = cp
+		opp.AccountMerge = i
+		opp.sa = nil
+		opp.si = AccountIndexState{}
+		opp.as = nil
+		opp.sb = nil
+		opp.rb = nil
+		opp.fee = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *AccountMergeProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(AccountMergeFact)
+
+	sa, err := existsState(StateKeyAccount(fact.sender), "sender", getState)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkActiveAccountState(fact.sender, getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, errors.Wrap(err, "invalid signing")
+	}
+
+	if _, err := existsState(StateKeyAccount(fact.target), "target", getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkNotClosedState(fact.target, getState); err != nil {
+		return nil, err
+	}
+
+	si, as, err := opp.loadAccountIndex(getState)
+	if err != nil {
+		return nil, err
+	}
+
+	sb := map[CurrencyID]AmountState{}
+	rb := map[CurrencyID]AmountState{}
+	fee := map[CurrencyID]Big{}
+	feeCurrencies := map[CurrencyID]struct{}{} // NOTE fee currencies, which are paid for the other currencies
+
+	cids := opp.cp.CIDs()
+	for i := range cids {
+		cid := cids[i]
+
+		if err := checkNoLockedBalance(StateKeyLockedBalance(fact.sender, cid), getState); err != nil {
+			return nil, err
+		}
+
+		st, found, err := getState(StateKeyBalance(fact.sender, cid))
+		switch {
+		case err != nil:
+			return nil, err
+		case !found:
+			continue
+		}
+
+		am, err := StateBalanceValue(st)
+		if err != nil {
+			return nil, operation.NewBaseReasonErrorFromError(err)
+		}
+		sb[cid] = NewAmountState(st, cid)
+
+		rst, _, err := getState(StateKeyBalance(fact.target, cid))
+		if err != nil {
+			return nil, err
+		}
+		rb[cid] = NewAmountState(rst, cid)
+
+		if !am.Big().OverZero() {
+			continue
+		}
+
+		policy, found := opp.cp.Policy(cid)
+		if !found {
+			return nil, operation.NewBaseReasonError("currency, %q not found of AccountMerge", cid)
+		}
+
+		if policy.IsFeeExempted(fact.sender) {
+			continue
+		}
+
+		k, err := policy.Feeer().Fee(am.Big())
+		if err != nil {
+			return nil, operation.NewBaseReasonErrorFromError(err)
+		}
+
+		if fcid, k := policy.PayFee(cid, k); k.OverZero() {
+			if f, found := fee[fcid]; found {
+				k = f.Add(k)
+			}
+			fee[fcid] = k
+
+			if fcid != cid {
+				feeCurrencies[fcid] = struct{}{}
+			}
+		}
+	}
+
+	for fcid := range feeCurrencies {
+		fb, found := sb[fcid]
+		if !found {
+			return nil, operation.NewBaseReasonError("balance of fee currency, %q does not exist", fcid)
+		}
+
+		switch b, err := StateBalanceValue(fb); {
+		case err != nil:
+			return nil, operation.NewBaseReasonErrorFromError(err)
+		case b.Big().Compare(fee[fcid]) < 0:
+			return nil, operation.NewBaseReasonError("insufficient balance of fee currency, %q", fcid)
+		}
+	}
+
+	opp.sa = sa
+	opp.si = si
+	opp.as = as
+	opp.sb = sb
+	opp.rb = rb
+	opp.fee = fee
+
+	return opp, nil
+}
+
+func (opp *AccountMergeProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(AccountMergeFact)
+
+	ac, err := LoadStateAccountValue(opp.sa)
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	sa, err := SetStateAccountValue(opp.sa, ac.SetClosed(true))
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	sts := []state.State{sa}
+
+	if len(opp.as) > 0 {
+		keys := make([]string, len(opp.as))
+		for i := range opp.as {
+			al, err := StateAllowanceValue(opp.as[i])
+			if err != nil {
+				return operation.NewBaseReasonErrorFromError(err)
+			}
+
+			st, err := SetStateAllowanceValue(opp.as[i], al.WithBig(ZeroBig))
+			if err != nil {
+				return operation.NewBaseReasonErrorFromError(err)
+			}
+
+			sts = append(sts, st)
+			keys[i] = st.Key()
+		}
+
+		sts = append(sts, opp.si.Remove(keys...))
+	}
+
+	for cid := range opp.sb {
+		am, err := StateBalanceValue(opp.sb[cid])
+		if err != nil {
+			return operation.NewBaseReasonErrorFromError(err)
+		}
+
+		fee := ZeroBig
+		if k, found := opp.fee[cid]; found {
+			fee = k
+		}
+
+		if am.Big().Compare(fee) < 0 {
+			fee = am.Big()
+		}
+
+		sts = append(sts,
+			opp.sb[cid].Sub(am.Big()).AddFee(fee),
+			opp.rb[cid].Add(am.Big().Sub(fee)),
+		)
+	}
+
+	return setState(fact.Hash(), sts...)
+}
+
+func (opp *AccountMergeProcessor) Close() error {
+	opp.cp = nil
+	opp.AccountMerge = AccountMerge{}
+	opp.sa = nil
+	opp.si = AccountIndexState{}
+	opp.as = nil
+	opp.sb = nil
+	opp.rb = nil
+	opp.fee = nil
+
+	accountMergeProcessorPool.Put(opp)
+
+	return nil
+}
+
+// loadAccountIndex checks sender has no open escrows, locked htlcs and active
+// payment schedules, and returns the account index state of sender and the
+// allowance states, which will be revoked.
+func (opp *AccountMergeProcessor) loadAccountIndex(
+	getState func(key string) (state.State, bool, error),
+) (AccountIndexState, []state.State, error) {
+	sender := opp.Fact().(AccountMergeFact).sender
+
+	st, found, err := getState(StateKeyAccountIndex(sender))
+	switch {
+	case err != nil:
+		return AccountIndexState{}, nil, err
+	case !found:
+		return NewAccountIndexState(st), nil, nil
+	}
+
+	ai, err := StateAccountIndexValue(st)
+	if err != nil {
+		return AccountIndexState{}, nil, operation.NewBaseReasonErrorFromError(err)
+	}
+
+	if keys := ai.Filter(func(k string) bool {
+		return IsStateEscrowKey(k) || IsStateHTLCKey(k) || IsStateScheduleKey(k)
+	}); len(keys) > 0 {
+		return AccountIndexState{}, nil, operation.NewBaseReasonError(
+			"open escrows, htlcs or payment schedules of sender remain, %q", keys)
+	}
+
+	keys := ai.Filter(IsStateAllowanceKey)
+	as := make([]state.State, len(keys))
+	for i := range keys {
+		ast, err := existsState(keys[i], "allowance", getState)
+		if err != nil {
+			return AccountIndexState{}, nil, err
+		}
+		as[i] = ast
+	}
+
+	return NewAccountIndexState(st), as, nil
+}
+
+// checkNoLockedBalance checks the locked balance of key is empty; the locked
+// balance should be unlocked before closing account.
+func checkNoLockedBalance(key string, getState func(key string) (state.State, bool, error)) error {
+	switch st, found, err := getState(key); {
+	case err != nil:
+		return err
+	case !found:
+		return nil
+	default:
+		la, err := StateLockedBalanceValue(st)
+		if err != nil {
+			return operation.NewBaseReasonErrorFromError(err)
+		}
+
+		if la.Total().OverZero() {
+			return operation.NewBaseReasonError("locked balance remains, %v%s", la.Total(), la.Currency())
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,447 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testAccountMergeOperations struct {
+	baseTestOperationProcessor
+}
+
+func (t *testAccountMergeOperations) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr := NewOperationProcessor(cp)
+
+	_, err := copr.SetProcessor(AccountMergeHinter, NewAccountMergeProcessor(cp))
+	t.NoError(err)
+	_, err = copr.SetProcessor(TransfersHinter, NewTransfersProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testAccountMergeOperations) newMerge(sender, target base.Address, pks []key.Privatekey) AccountMerge {
+	fact := NewAccountMergeFact(util.UUID().Bytes(), sender, target)
+
+	fs := make([]base.FactSign, len(pks))
+	for i := range pks {
+		sig, err := base.NewFactSignature(pks[i], fact, nil)
+		t.NoError(err)
+
+		fs[i] = base.NewBaseFactSign(pks[i].Publickey(), sig)
+	}
+
+	op, err := NewAccountMerge(fact, fs, "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testAccountMergeOperations) TestMerge() {
+	cid2 := CurrencyID("SHOWME2")
+
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid), NewAmount(NewBig(2), cid2)})
+	ta, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+	fa, st2 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1, st2)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, NewBig(3)))))
+	// NOTE fee is over balance
+	t.NoError(cp.Set(t.newCurrencyDesignState(cid2, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, NewBig(5)))))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newMerge(sa.Address, ta.Address, sa.Privs())))
+	t.NoError(opr.Close())
+
+	var ast, sst0, sst1, tst0, tst1 state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyAccount(sa.Address):
+			ast = st.GetState()
+		case StateKeyBalance(sa.Address, t.cid):
+			sst0 = st.GetState()
+		case StateKeyBalance(sa.Address, cid2):
+			sst1 = st.GetState()
+		case StateKeyBalance(ta.Address, t.cid):
+			tst0 = st.GetState()
+		case StateKeyBalance(ta.Address, cid2):
+			tst1 = st.GetState()
+		}
+	}
+
+	ac, err := LoadStateAccountValue(ast)
+	t.NoError(err)
+	t.True(ac.IsClosed())
+
+	sb, err := StateBalanceValue(sst0)
+	t.NoError(err)
+	t.True(sb.Big().IsZero())
+	t.Equal(NewBig(3), sst0.(AmountState).Fee())
+
+	sb, err = StateBalanceValue(sst1)
+	t.NoError(err)
+	t.True(sb.Big().IsZero())
+	t.Equal(NewBig(2), sst1.(AmountState).Fee())
+
+	tb, err := StateBalanceValue(tst0)
+	t.NoError(err)
+	t.Equal(NewBig(31), tb.Big())
+
+	tb, err = StateBalanceValue(tst1)
+	t.NoError(err)
+	t.True(tb.Big().IsZero())
+}
+
+func (t *testAccountMergeOperations) TestLockedBalance() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ta, st1 := t.newAccount(true, nil)
+
+	la := NewZeroLockedAmount(t.cid).Lock(NewBig(10), base.Height(100))
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newStateLockedAmount(sa.Address, la)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(NewTestAddress(), ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newMerge(sa.Address, ta.Address, sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "locked balance remains")
+}
+
+func (t *testAccountMergeOperations) TestOpenContracts() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ta, st1 := t.newAccount(true, nil)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(NewTestAddress(), ZeroBig))))
+
+	for _, key := range []string{
+		StateKeyEscrow(valuehash.RandomSHA256()),
+		StateKeyHTLC(valuehash.RandomSHA256()),
+		StateKeySchedule(valuehash.RandomSHA256()),
+	} {
+		pool, _ := t.statepool(st0, st1, []state.State{t.newStateAccountIndex(sa.Address, key)})
+
+		opr := t.processor(cp, pool)
+
+		err := opr.Process(t.newMerge(sa.Address, ta.Address, sa.Privs()))
+
+		var oper operation.ReasonError
+		t.True(errors.As(err, &oper), key)
+		t.Contains(err.Error(), "open escrows, htlcs or payment schedules of sender remain", key)
+	}
+}
+
+func (t *testAccountMergeOperations) TestRevokeAllowances() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ta, st1 := t.newAccount(true, nil)
+	pa, st2 := t.newAccount(true, nil)
+
+	al := NewAllowance(sa.Address, pa.Address, NewAmount(NewBig(10), t.cid))
+	st, err := state.NewStateV0(StateKeyAllowance(sa.Address, pa.Address, t.cid), nil, base.NilHeight)
+	t.NoError(err)
+	ast, err := SetStateAllowanceValue(st, al)
+	t.NoError(err)
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{ast, t.newStateAccountIndex(sa.Address, ast.Key())})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(NewTestAddress(), ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newMerge(sa.Address, ta.Address, sa.Privs())))
+	t.NoError(opr.Close())
+
+	var ust, ist state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case ast.Key():
+			ust = st.GetState()
+		case StateKeyAccountIndex(sa.Address):
+			ist = st.GetState()
+		}
+	}
+
+	ual, err := StateAllowanceValue(ust)
+	t.NoError(err)
+	t.True(ual.Amount().Big().IsZero())
+
+	ai, err := StateAccountIndexValue(ist)
+	t.NoError(err)
+	t.Empty(ai.Keys())
+}
+
+func (t *testAccountMergeOperations) TestClosedTarget() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ta, st1 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, t.closeAccountState(ta.Address, st1))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(NewTestAddress(), ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newMerge(sa.Address, ta.Address, sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "closed")
+}
+
+func (t *testAccountMergeOperations) TestClosedSender() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(t.closeAccountState(sa.Address, st0), st1)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(NewTestAddress(), ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	item := NewTransfersItemSingleAmount(ra.Address, NewAmount(NewBig(3), t.cid))
+	fact := NewTransfersFact(util.UUID().Bytes(), sa.Address, []TransfersItem{item})
+	sig, err := base.NewFactSignature(sa.Privs()[0], fact, nil)
+	t.NoError(err)
+	tf, err := NewTransfers(fact, []base.FactSign{base.NewBaseFactSign(sa.Privs()[0].Publickey(), sig)}, "")
+	t.NoError(err)
+
+	err = opr.Process(tf)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "closed")
+}
+
+func (t *testAccountMergeOperations) TestClosedReceiver() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, t.closeAccountState(ra.Address, st1))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(NewTestAddress(), ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	item := NewTransfersItemSingleAmount(ra.Address, NewAmount(NewBig(3), t.cid))
+	fact := NewTransfersFact(util.UUID().Bytes(), sa.Address, []TransfersItem{item})
+	sig, err := base.NewFactSignature(sa.Privs()[0], fact, nil)
+	t.NoError(err)
+	tf, err := NewTransfers(fact, []base.FactSign{base.NewBaseFactSign(sa.Privs()[0].Publickey(), sig)}, "")
+	t.NoError(err)
+
+	err = opr.Process(tf)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "closed")
+}
+
+func (t *testAccountMergeOperations) TestFeeCurrency() {
+	fcid := CurrencyID("FEE")
+
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid), NewAmount(NewBig(10), fcid)})
+	ta, st1 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1)
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(NewTestAddress(), NewBig(4))).SetFeeCurrency(fcid, 0)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignStateWithPolicy(t.cid, NewBig(99), NewTestAddress(), po)))
+	t.NoError(cp.Set(t.newCurrencyDesignState(fcid, NewBig(99), NewTestAddress(), NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newMerge(sa.Address, ta.Address, sa.Privs())))
+
+	var sst, fst, tst0, tst1 state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyBalance(sa.Address, fcid):
+			fst = st.GetState()
+		case StateKeyBalance(ta.Address, t.cid):
+			tst0 = st.GetState()
+		case StateKeyBalance(ta.Address, fcid):
+			tst1 = st.GetState()
+		}
+	}
+
+	t.True(sst.(AmountState).Fee().IsZero())
+	t.Equal(NewBig(4), fst.(AmountState).Fee())
+
+	tb, err := StateBalanceValue(tst0)
+	t.NoError(err)
+	t.Equal(NewBig(33), tb.Big())
+
+	tb, err = StateBalanceValue(tst1)
+	t.NoError(err)
+	t.Equal(NewBig(6), tb.Big())
+}
+
+func (t *testAccountMergeOperations) TestFeeCurrencyInsufficientBalance() {
+	fcid := CurrencyID("FEE")
+
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid), NewAmount(NewBig(3), fcid)})
+	ta, st1 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1)
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(NewTestAddress(), NewBig(4))).SetFeeCurrency(fcid, 0)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignStateWithPolicy(t.cid, NewBig(99), NewTestAddress(), po)))
+	t.NoError(cp.Set(t.newCurrencyDesignState(fcid, NewBig(99), NewTestAddress(), NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newMerge(sa.Address, ta.Address, sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "insufficient balance of fee currency")
+}
+
+func (t *testAccountMergeOperations) TestFeeCurrencyWithoutBalance() {
+	fcid := CurrencyID("FEE")
+
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ta, st1 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1)
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(NewTestAddress(), NewBig(4))).SetFeeCurrency(fcid, 0)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignStateWithPolicy(t.cid, NewBig(99), NewTestAddress(), po)))
+	t.NoError(cp.Set(t.newCurrencyDesignState(fcid, NewBig(99), NewTestAddress(), NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newMerge(sa.Address, ta.Address, sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "balance of fee currency, \"FEE\" does not exist")
+}
+
+func (t *testAccountMergeOperations) TestTransfersAndMergeInSameBlock() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ta, st1 := t.newAccount(true, nil)
+	ra, st2 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1, st2)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(NewTestAddress(), ZeroBig))))
+
+	// NOTE Transfers and AccountMerge are processed by the different operation
+	// processors of same block
+	copr := t.processor(cp, nil)
+	topr := copr.New(pool)
+	mopr := copr.New(pool)
+
+	t.NoError(topr.Process(t.newTransfers(sa.Address, ra.Address, sa.Privs())))
+
+	err := mopr.Process(t.newMerge(sa.Address, ta.Address, sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "violates only one sender")
+
+	t.NoError(topr.Close())
+	t.NoError(mopr.Close())
+
+	for _, st := range pool.Updates() {
+		if st.Key() != StateKeyBalance(sa.Address, t.cid) {
+			continue
+		}
+
+		sb, err := StateBalanceValue(st.GetState())
+		t.NoError(err)
+		t.Equal(NewBig(30), sb.Big())
+	}
+}
+
+func (t *testAccountMergeOperations) newTransfers(sender, receiver base.Address, pks []key.Privatekey) Transfers {
+	item := NewTransfersItemSingleAmount(receiver, NewAmount(NewBig(3), t.cid))
+	fact := NewTransfersFact(util.UUID().Bytes(), sender, []TransfersItem{item})
+	sig, err := base.NewFactSignature(pks[0], fact, nil)
+	t.NoError(err)
+	tf, err := NewTransfers(fact, []base.FactSign{base.NewBaseFactSign(pks[0].Publickey(), sig)}, "")
+	t.NoError(err)
+
+	return tf
+}
+
+func (t *testAccountMergeOperations) TestTransfersToMergedAccountInSameBlock() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ta, st1 := t.newAccount(true, nil)
+	ra, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	oa, st3 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(NewTestAddress(), ZeroBig))))
+
+	{ // NOTE merge and then transfer to merged account
+		pool, _ := t.statepool(st0, st1, st2, st3)
+		opr := t.processor(cp, pool)
+
+		t.NoError(opr.Process(t.newMerge(sa.Address, ta.Address, sa.Privs())))
+
+		err := opr.Process(t.newTransfers(ra.Address, sa.Address, ra.Privs()))
+
+		var oper operation.ReasonError
+		t.True(errors.As(err, &oper))
+		t.Contains(err.Error(), "already updated in proposal")
+		t.NoError(opr.Close())
+	}
+
+	{ // NOTE transfer to account and then merge it
+		pool, _ := t.statepool(st0, st1, st2, st3)
+		opr := t.processor(cp, pool)
+
+		t.NoError(opr.Process(t.newTransfers(ra.Address, sa.Address, ra.Privs())))
+		// NOTE the same receiver can receive by the multiple Transfers
+		t.NoError(opr.Process(t.newTransfers(oa.Address, sa.Address, oa.Privs())))
+
+		err := opr.Process(t.newMerge(sa.Address, ta.Address, sa.Privs()))
+
+		var oper operation.ReasonError
+		t.True(errors.As(err, &oper))
+		t.Contains(err.Error(), "already updated in proposal")
+		t.NoError(opr.Close())
+	}
+}
+
+func TestAccountMergeOperations(t *testing.T) {
+	suite.Run(t, new(testAccountMergeOperations))
+}
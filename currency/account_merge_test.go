@@ -0,0 +1,79 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+type testAccountMerge struct {
+	baseTest
+}
+
+func (t *testAccountMerge) TestNew() {
+	fact := NewAccountMergeFact(util.UUID().Bytes(), NewTestAddress(), NewTestAddress())
+	t.NoError(fact.IsValid(nil))
+
+	as, err := fact.Addresses()
+	t.NoError(err)
+	t.Equal(2, len(as))
+}
+
+func (t *testAccountMerge) TestSameTarget() {
+	sender := NewTestAddress()
+
+	fact := NewAccountMergeFact(util.UUID().Bytes(), sender, sender)
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "target is same with sender")
+}
+
+func TestAccountMerge(t *testing.T) {
+	suite.Run(t, new(testAccountMerge))
+}
+
+func testAccountMergeEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.NewBasePrivatekey()
+
+		fact := NewAccountMergeFact(util.UUID().Bytes(), NewTestAddress(), NewTestAddress())
+		sig, err := base.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		op, err := NewAccountMerge(fact, []base.FactSign{base.NewBaseFactSign(pk.Publickey(), sig)}, "")
+		t.NoError(err)
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		fact := a.(AccountMerge).Fact().(AccountMergeFact)
+		ufact := b.(AccountMerge).Fact().(AccountMergeFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.target.Equal(ufact.target))
+	}
+
+	return t
+}
+
+func TestAccountMergeEncodeJSON(t *testing.T) {
+	suite.Run(t, testAccountMergeEncode(jsonenc.NewEncoder()))
+}
+
+func TestAccountMergeEncodeBSON(t *testing.T) {
+	suite.Run(t, testAccountMergeEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,138 @@
+package currency
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AccountMetadataType   = hint.Type("mitum-currency-account-metadata")
+	AccountMetadataHint   = hint.NewHint(AccountMetadataType, "v0.0.1")
+	AccountMetadataHinter = AccountMetadata{BaseHinter: hint.NewBaseHinter(AccountMetadataHint)}
+)
+
+var MaxAccountMetadataSize = 300
+
+var reAccountMetadataKey = regexp.MustCompile(`^[a-z0-9][a-z0-9_\-]*$`)
+
+// IsValidAccountMetadata checks the keys of metadata and the total size of
+// keys and values.
+func IsValidAccountMetadata(data map[string]string) error {
+	var size int
+	for k := range data {
+		if !reAccountMetadataKey.MatchString(k) {
+			return isvalid.InvalidError.Errorf("invalid metadata key, %q", k)
+		}
+
+		size += len(k) + len(data[k])
+	}
+
+	if size > MaxAccountMetadataSize {
+		return isvalid.InvalidError.Errorf("metadata over max size, %d > %d", size, MaxAccountMetadataSize)
+	}
+
+	return nil
+}
+
+// AccountMetadata is the small key-value data of account, like display name
+// or contact url. AccountMetadata is stored apart from Account.
+type AccountMetadata struct {
+	hint.BaseHinter
+	address base.Address
+	data    map[string]string
+}
+
+func NewAccountMetadata(address base.Address, data map[string]string) AccountMetadata {
+	return AccountMetadata{
+		BaseHinter: hint.NewBaseHinter(AccountMetadataHint),
+		address:    address,
+		data:       data,
+	}
+}
+
+func (am AccountMetadata) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		am.address.Bytes(),
+		accountMetadataBytes(am.data),
+	)
+}
+
+func (am AccountMetadata) Hash() valuehash.Hash {
+	return am.GenerateHash()
+}
+
+func (am AccountMetadata) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(am.Bytes())
+}
+
+func (am AccountMetadata) IsValid([]byte) error {
+	if err := isvalid.Check(nil, false, am.BaseHinter, am.address); err != nil {
+		return isvalid.InvalidError.Errorf("invalid AccountMetadata: %w", err)
+	}
+
+	return IsValidAccountMetadata(am.data)
+}
+
+func (am AccountMetadata) Address() base.Address {
+	return am.address
+}
+
+func (am AccountMetadata) Data() map[string]string {
+	return am.data
+}
+
+func (am AccountMetadata) Get(k string) (string, bool) {
+	v, found := am.data[k]
+
+	return v, found
+}
+
+func (am AccountMetadata) IsEmpty() bool {
+	return len(am.data) < 1
+}
+
+func (am AccountMetadata) Equal(b AccountMetadata) bool {
+	if !am.address.Equal(b.address) || len(am.data) != len(b.data) {
+		return false
+	}
+
+	for k := range am.data {
+		if v, found := b.data[k]; !found || v != am.data[k] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func accountMetadataKeys(data map[string]string) []string {
+	ks := make([]string, len(data))
+
+	var i int
+	for k := range data {
+		ks[i] = k
+		i++
+	}
+
+	sort.Strings(ks)
+
+	return ks
+}
+
+func accountMetadataBytes(data map[string]string) []byte {
+	ks := accountMetadataKeys(data)
+
+	bs := make([][]byte, len(ks))
+	for i := range ks {
+		bs[i] = []byte(ks[i] + "=" + strconv.Quote(data[ks[i]]))
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
@@ -0,0 +1,31 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (am AccountMetadata) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(am.Hint()),
+			bson.M{
+				"address": am.address,
+				"data":    am.data,
+			}))
+}
+
+type AccountMetadataBSONUnpacker struct {
+	AD base.AddressDecoder `bson:"address"`
+	DT map[string]string   `bson:"data"`
+}
+
+func (am *AccountMetadata) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uam AccountMetadataBSONUnpacker
+	if err := bson.Unmarshal(b, &uam); err != nil {
+		return err
+	}
+
+	return am.unpack(enc, uam.AD, uam.DT)
+}
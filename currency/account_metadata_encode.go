@@ -0,0 +1,22 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (am *AccountMetadata) unpack(
+	enc encoder.Encoder,
+	baddress base.AddressDecoder,
+	data map[string]string,
+) error {
+	address, err := baddress.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	am.address = address
+	am.data = data
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type AccountMetadataJSONPacker struct {
+	jsonenc.HintedHead
+	AD base.Address      `json:"address"`
+	DT map[string]string `json:"data"`
+}
+
+func (am AccountMetadata) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(AccountMetadataJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(am.Hint()),
+		AD:         am.address,
+		DT:         am.data,
+	})
+}
+
+type AccountMetadataJSONUnpacker struct {
+	AD base.AddressDecoder `json:"address"`
+	DT map[string]string   `json:"data"`
+}
+
+func (am *AccountMetadata) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uam AccountMetadataJSONUnpacker
+	if err := enc.Unmarshal(b, &uam); err != nil {
+		return err
+	}
+
+	return am.unpack(enc, uam.AD, uam.DT)
+}
@@ -0,0 +1,133 @@
+package currency
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+type testAccountMetadata struct {
+	baseTest
+}
+
+func (t *testAccountMetadata) TestNew() {
+	md := map[string]string{"name": "showme", "url": "https://findme.org"}
+
+	fact := NewAccountMetadataUpdaterFact(util.UUID().Bytes(), NewTestAddress(), md, t.cid)
+	t.NoError(fact.IsValid(nil))
+
+	// NOTE empty metadata removes metadata
+	fact = NewAccountMetadataUpdaterFact(util.UUID().Bytes(), NewTestAddress(), nil, t.cid)
+	t.NoError(fact.IsValid(nil))
+}
+
+func (t *testAccountMetadata) TestBytesNotOrdered() {
+	a := NewTestAddress()
+
+	ma := NewAccountMetadata(a, map[string]string{"a": "1", "b": "2", "c": "3"})
+	mb := NewAccountMetadata(a, map[string]string{"c": "3", "b": "2", "a": "1"})
+	t.Equal(ma.Bytes(), mb.Bytes())
+	t.True(ma.Equal(mb))
+
+	mc := NewAccountMetadata(a, map[string]string{"a": "12", "b": "3"})
+	md := NewAccountMetadata(a, map[string]string{"a": "1", "b": "23"})
+	t.NotEqual(mc.Bytes(), md.Bytes())
+	t.False(mc.Equal(md))
+}
+
+func (t *testAccountMetadata) TestWrongKey() {
+	for _, k := range []string{"", "Name", "-name", "display name", "a:b", "a.b"} {
+		md := map[string]string{k: "showme"}
+
+		err := IsValidAccountMetadata(md)
+		t.True(errors.Is(err, isvalid.InvalidError), k)
+		t.Contains(err.Error(), "invalid metadata key")
+	}
+}
+
+func (t *testAccountMetadata) TestOverMaxSize() {
+	md := map[string]string{"name": strings.Repeat("a", MaxAccountMetadataSize-len("name"))}
+	t.NoError(IsValidAccountMetadata(md))
+
+	md["url"] = "a"
+
+	fact := NewAccountMetadataUpdaterFact(util.UUID().Bytes(), NewTestAddress(), md, t.cid)
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "metadata over max size")
+}
+
+func TestAccountMetadata(t *testing.T) {
+	suite.Run(t, new(testAccountMetadata))
+}
+
+func testAccountMetadataEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		return NewAccountMetadata(NewTestAddress(), map[string]string{"name": "showme", "kyc": "findme"})
+	}
+
+	t.compare = func(a, b interface{}) {
+		ma := a.(AccountMetadata)
+		mb := b.(AccountMetadata)
+
+		t.True(ma.Hint().Equal(mb.Hint()))
+		t.True(ma.Equal(mb))
+		t.True(ma.Hash().Equal(mb.Hash()))
+	}
+
+	return t
+}
+
+func TestAccountMetadataEncodeJSON(t *testing.T) {
+	suite.Run(t, testAccountMetadataEncode(jsonenc.NewEncoder()))
+}
+
+func TestAccountMetadataEncodeBSON(t *testing.T) {
+	suite.Run(t, testAccountMetadataEncode(bsonenc.NewEncoder()))
+}
+
+func testAccountMetadataUpdaterEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		md := map[string]string{"name": "showme", "url": "https://findme.org"}
+		fact := NewAccountMetadataUpdaterFact(util.UUID().Bytes(), NewTestAddress(), md, CurrencyID("SHOWME"))
+
+		op, err := NewAccountMetadataUpdater(fact, newTestRecoveryFactSigns(t, fact), "")
+		t.NoError(err)
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		fact := a.(AccountMetadataUpdater).Fact().(AccountMetadataUpdaterFact)
+		ufact := b.(AccountMetadataUpdater).Fact().(AccountMetadataUpdaterFact)
+
+		t.True(fact.target.Equal(ufact.target))
+		t.Equal(fact.metadata, ufact.metadata)
+		t.Equal(fact.currency, ufact.currency)
+	}
+
+	return t
+}
+
+func TestAccountMetadataUpdaterEncodeJSON(t *testing.T) {
+	suite.Run(t, testAccountMetadataUpdaterEncode(jsonenc.NewEncoder()))
+}
+
+func TestAccountMetadataUpdaterEncodeBSON(t *testing.T) {
+	suite.Run(t, testAccountMetadataUpdaterEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,118 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AccountMetadataUpdaterFactType   = hint.Type("mitum-currency-account-metadata-updater-operation-fact")
+	AccountMetadataUpdaterFactHint   = hint.NewHint(AccountMetadataUpdaterFactType, "v0.0.1")
+	AccountMetadataUpdaterFactHinter = AccountMetadataUpdaterFact{
+		BaseHinter: hint.NewBaseHinter(AccountMetadataUpdaterFactHint),
+	}
+	AccountMetadataUpdaterType   = hint.Type("mitum-currency-account-metadata-updater-operation")
+	AccountMetadataUpdaterHint   = hint.NewHint(AccountMetadataUpdaterType, "v0.0.1")
+	AccountMetadataUpdaterHinter = AccountMetadataUpdater{BaseOperation: operationHinter(AccountMetadataUpdaterHint)}
+)
+
+// AccountMetadataUpdaterFact replaces the metadata of target account; empty
+// metadata removes the existing one.
+type AccountMetadataUpdaterFact struct {
+	hint.BaseHinter
+	h        valuehash.Hash
+	token    []byte
+	target   base.Address
+	metadata map[string]string
+	currency CurrencyID
+}
+
+func NewAccountMetadataUpdaterFact(
+	token []byte,
+	target base.Address,
+	metadata map[string]string,
+	currency CurrencyID,
+) AccountMetadataUpdaterFact {
+	fact := AccountMetadataUpdaterFact{
+		BaseHinter: hint.NewBaseHinter(AccountMetadataUpdaterFactHint),
+		token:      token,
+		target:     target,
+		metadata:   metadata,
+		currency:   currency,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact AccountMetadataUpdaterFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact AccountMetadataUpdaterFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AccountMetadataUpdaterFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.target.Bytes(),
+		accountMetadataBytes(fact.metadata),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact AccountMetadataUpdaterFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := isvalid.Check(nil, false,
+		fact.target,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	return IsValidAccountMetadata(fact.metadata)
+}
+
+func (fact AccountMetadataUpdaterFact) Token() []byte {
+	return fact.token
+}
+
+func (fact AccountMetadataUpdaterFact) Target() base.Address {
+	return fact.target
+}
+
+func (fact AccountMetadataUpdaterFact) Metadata() map[string]string {
+	return fact.metadata
+}
+
+func (fact AccountMetadataUpdaterFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact AccountMetadataUpdaterFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.target}, nil
+}
+
+type AccountMetadataUpdater struct {
+	BaseOperation
+}
+
+func NewAccountMetadataUpdater(
+	fact AccountMetadataUpdaterFact,
+	fs []base.FactSign,
+	memo string,
+) (AccountMetadataUpdater, error) {
+	bo, err := NewBaseOperationFromFact(AccountMetadataUpdaterHint, fact, fs, memo)
+	if err != nil {
+		return AccountMetadataUpdater{}, err
+	}
+
+	return AccountMetadataUpdater{BaseOperation: bo}, nil
+}
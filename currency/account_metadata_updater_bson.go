@@ -0,0 +1,49 @@
+package currency // nolint: dupl
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact AccountMetadataUpdaterFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":     fact.h,
+				"token":    fact.token,
+				"target":   fact.target,
+				"metadata": fact.metadata,
+				"currency": fact.currency,
+			}))
+}
+
+type AccountMetadataUpdaterFactBSONUnpacker struct {
+	H  valuehash.Bytes     `bson:"hash"`
+	TK []byte              `bson:"token"`
+	TG base.AddressDecoder `bson:"target"`
+	MD map[string]string   `bson:"metadata"`
+	CR string              `bson:"currency"`
+}
+
+func (fact *AccountMetadataUpdaterFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ufact AccountMetadataUpdaterFactBSONUnpacker
+	if err := bson.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.TG, ufact.MD, ufact.CR)
+}
+
+func (op *AccountMetadataUpdater) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
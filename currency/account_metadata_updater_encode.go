@@ -0,0 +1,29 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *AccountMetadataUpdaterFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	btarget base.AddressDecoder,
+	metadata map[string]string,
+	cr string,
+) error {
+	target, err := btarget.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.target = target
+	fact.metadata = metadata
+	fact.currency = CurrencyID(cr)
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package currency // nolint: dupl
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type AccountMetadataUpdaterFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash    `json:"hash"`
+	TK []byte            `json:"token"`
+	TG base.Address      `json:"target"`
+	MD map[string]string `json:"metadata"`
+	CR CurrencyID        `json:"currency"`
+}
+
+func (fact AccountMetadataUpdaterFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(AccountMetadataUpdaterFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		TG:         fact.target,
+		MD:         fact.metadata,
+		CR:         fact.currency,
+	})
+}
+
+type AccountMetadataUpdaterFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	TG base.AddressDecoder `json:"target"`
+	MD map[string]string   `json:"metadata"`
+	CR string              `json:"currency"`
+}
+
+func (fact *AccountMetadataUpdaterFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact AccountMetadataUpdaterFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.TG, ufact.MD, ufact.CR)
+}
+
+func (op *AccountMetadataUpdater) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package currency
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var accountMetadataUpdaterProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AccountMetadataUpdaterProcessor)
+	},
+}
+
+func (AccountMetadataUpdater) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type AccountMetadataUpdaterProcessor struct {
+	cp *CurrencyPool
+	AccountMetadataUpdater
+	sm  state.State
+	sb  AmountState
+	fee feePayment
+}
+
+func NewAccountMetadataUpdaterProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(AccountMetadataUpdater)
+		if !ok {
+			return nil, errors.Errorf("not AccountMetadataUpdater, %T", op)
+		}
+
+		opp := accountMetadataUpdaterProcessorPool.Get().(*AccountMetadataUpdaterProcessor)
+
+		opp.cp = cp
+		opp.AccountMetadataUpdater = i
+		opp.sm = nil
+		opp.sb = AmountState{}
+		opp.fee = feePayment{}
+
+		return opp, nil
+	}
+}
+
+func (opp *AccountMetadataUpdaterProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(AccountMetadataUpdaterFact)
+
+	if err := checkExistsState(StateKeyAccount(fact.target), getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkActiveAccountState(fact.target, getState); err != nil {
+		return nil, err
+	}
+
+	st, found, err := getState(StateKeyAccountMetadata(fact.target))
+	if err != nil {
+		return nil, err
+	}
+
+	nmd := NewAccountMetadata(fact.target, fact.metadata)
+	if found {
+		if md, e := StateAccountMetadataValue(st); e != nil {
+			return nil, operation.NewBaseReasonErrorFromError(e)
+		} else if md.Equal(nmd) {
+			return nil, operation.NewBaseReasonError("same metadata with the existing")
+		}
+	} else if nmd.IsEmpty() {
+		return nil, operation.NewBaseReasonError("metadata does not exist")
+	}
+	opp.sm = st
+
+	st, err = existsState(StateKeyBalance(fact.target, fact.currency), "balance of target", getState)
+	if err != nil {
+		return nil, err
+	}
+	opp.sb = NewAmountState(st, fact.currency)
+
+	if err = checkFactSignsByState(fact.target, opp.Signs(), getState); err != nil {
+		return nil, errors.Wrap(err, "invalid signing")
+	}
+
+	policy, found := opp.cp.Policy(fact.currency)
+	if !found {
+		return nil, operation.NewBaseReasonError("currency, %q not found of AccountMetadataUpdater", fact.currency)
+	}
+
+	fee, err := loadFeePayment(opp.cp, policy, fact.target, fact.currency, ZeroBig, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	switch b, err := StateBalanceValue(opp.sb); {
+	case err != nil:
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	case b.Big().Compare(fee.required(ZeroBig)) < 0:
+		return nil, operation.NewBaseReasonError("insufficient balance with fee")
+	default:
+		opp.fee = fee
+	}
+
+	return opp, nil
+}
+
+func (opp *AccountMetadataUpdaterProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(AccountMetadataUpdaterFact)
+
+	st, err := SetStateAccountMetadataValue(opp.sm, NewAccountMetadata(fact.target, fact.metadata))
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	return setState(fact.Hash(), append([]state.State{st}, opp.fee.pay(opp.sb, ZeroBig)...)...)
+}
+
+func (opp *AccountMetadataUpdaterProcessor) Close() error {
+	opp.cp = nil
+	opp.AccountMetadataUpdater = AccountMetadataUpdater{}
+	opp.sm = nil
+	opp.sb = AmountState{}
+	opp.fee = feePayment{}
+
+	accountMetadataUpdaterProcessorPool.Put(opp)
+
+	return nil
+}
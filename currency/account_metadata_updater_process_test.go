@@ -0,0 +1,211 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testAccountMetadataUpdaterOperation struct {
+	baseTestOperationProcessor
+}
+
+func (t *testAccountMetadataUpdaterOperation) processor(
+	cp *CurrencyPool,
+	pool *storage.Statepool,
+) prprocessor.OperationProcessor {
+	copr := NewOperationProcessor(cp)
+
+	_, err := copr.SetProcessor(AccountMetadataUpdaterHinter, NewAccountMetadataUpdaterProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testAccountMetadataUpdaterOperation) newOperation(
+	target base.Address,
+	md map[string]string,
+	pks []key.Privatekey,
+) AccountMetadataUpdater {
+	fact := NewAccountMetadataUpdaterFact(util.UUID().Bytes(), target, md, t.cid)
+
+	var fs []base.FactSign
+	for _, pk := range pks {
+		sig, err := base.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, base.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewAccountMetadataUpdater(fact, fs, "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testAccountMetadataUpdaterOperation) newStateMetadata(md AccountMetadata) state.State {
+	st, err := state.NewStateV0(StateKeyAccountMetadata(md.Address()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateAccountMetadataValue(st, md)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *testAccountMetadataUpdaterOperation) metadataState(pool *storage.Statepool, a base.Address) state.State {
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyAccountMetadata(a) {
+			return st.GetState()
+		}
+	}
+
+	return nil
+}
+
+func (t *testAccountMetadataUpdaterOperation) TestUpdate() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	fa, st1 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1)
+
+	fee := NewBig(3)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, fee))))
+
+	opr := t.processor(cp, pool)
+
+	md := map[string]string{"name": "showme"}
+	t.NoError(opr.Process(t.newOperation(sa.Address, md, sa.Privs())))
+	t.NoError(opr.Close())
+
+	var sst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyBalance(sa.Address, t.cid) {
+			sst = st.GetState()
+		}
+	}
+
+	sb, err := StateBalanceValue(sst)
+	t.NoError(err)
+	t.True(NewBig(33).Sub(fee).Equal(sb.Big()))
+
+	umd, err := StateAccountMetadataValue(t.metadataState(pool, sa.Address))
+	t.NoError(err)
+	t.NoError(umd.IsValid(nil))
+	t.True(umd.Address().Equal(sa.Address))
+	t.Equal(md, umd.Data())
+
+	// NOTE Account is not touched
+	for _, st := range pool.Updates() {
+		t.NotEqual(StateKeyAccount(sa.Address), st.Key())
+	}
+}
+
+func (t *testAccountMetadataUpdaterOperation) TestRemove() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	existing := NewAccountMetadata(sa.Address, map[string]string{"name": "showme"})
+	pool, _ := t.statepool(st0, []state.State{t.newStateMetadata(existing)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newOperation(sa.Address, nil, sa.Privs())))
+	t.NoError(opr.Close())
+
+	umd, err := StateAccountMetadataValue(t.metadataState(pool, sa.Address))
+	t.NoError(err)
+	t.True(umd.IsEmpty())
+}
+
+func (t *testAccountMetadataUpdaterOperation) TestRemoveNotExisting() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	pool, _ := t.statepool(st0)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newOperation(sa.Address, nil, sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "metadata does not exist")
+}
+
+func (t *testAccountMetadataUpdaterOperation) TestSameWithExisting() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	md := map[string]string{"name": "showme"}
+	pool, _ := t.statepool(st0, []state.State{t.newStateMetadata(NewAccountMetadata(sa.Address, md))})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newOperation(sa.Address, md, sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "same metadata with the existing")
+}
+
+func (t *testAccountMetadataUpdaterOperation) TestWrongSigning() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	oa, st1 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newOperation(sa.Address, map[string]string{"name": "showme"}, oa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "invalid signing")
+}
+
+func (t *testAccountMetadataUpdaterOperation) TestFrozenTarget() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	pool, _ := t.statepool(t.freezeAccountState(sa.Address, st0))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newOperation(sa.Address, map[string]string{"name": "showme"}, sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "frozen")
+}
+
+func TestAccountMetadataUpdaterOperation(t *testing.T) {
+	suite.Run(t, new(testAccountMetadataUpdaterOperation))
+}
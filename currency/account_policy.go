@@ -0,0 +1,139 @@
+package currency
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AccountPolicyType   = hint.Type("mitum-currency-account-policy")
+	AccountPolicyHint   = hint.NewHint(AccountPolicyType, "v0.0.1")
+	AccountPolicyHinter = AccountPolicy{BaseHinter: hint.NewBaseHinter(AccountPolicyHint)}
+)
+
+// DefaultAccountPolicy is used when no AccountPolicy is stored in state.
+var DefaultAccountPolicy = NewAccountPolicy(10, 1, 100)
+
+// AccountPolicy is the network-wide rules of account keys. AccountPolicy is
+// updated by AccountPolicyUpdater.
+type AccountPolicy struct {
+	hint.BaseHinter
+	maxKeys   uint
+	minWeight uint
+	maxWeight uint
+}
+
+func NewAccountPolicy(maxKeys, minWeight, maxWeight uint) AccountPolicy {
+	return AccountPolicy{
+		BaseHinter: hint.NewBaseHinter(AccountPolicyHint),
+		maxKeys:    maxKeys,
+		minWeight:  minWeight,
+		maxWeight:  maxWeight,
+	}
+}
+
+func (po AccountPolicy) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		util.UintToBytes(po.maxKeys),
+		util.UintToBytes(po.minWeight),
+		util.UintToBytes(po.maxWeight),
+	)
+}
+
+func (po AccountPolicy) Hash() valuehash.Hash {
+	return po.GenerateHash()
+}
+
+func (po AccountPolicy) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(po.Bytes())
+}
+
+func (po AccountPolicy) IsValid([]byte) error {
+	if err := po.BaseHinter.IsValid(nil); err != nil {
+		return isvalid.InvalidError.Errorf("invalid account policy: %w", err)
+	}
+
+	if po.maxKeys < 1 || po.maxKeys > uint(MaxAccountKeyInKeys) {
+		return isvalid.InvalidError.Errorf(
+			"invalid max keys of account policy, 1 <= max keys <= %d, %d", MaxAccountKeyInKeys, po.maxKeys)
+	}
+
+	if po.minWeight < 1 || po.maxWeight > MaxAccountKeyWeight || po.minWeight > po.maxWeight {
+		return isvalid.InvalidError.Errorf(
+			"invalid weight range of account policy, 1 <= min weight(%d) <= max weight(%d) <= %d",
+			po.minWeight, po.maxWeight, MaxAccountKeyWeight,
+		)
+	}
+
+	return nil
+}
+
+func (po AccountPolicy) MaxKeys() uint {
+	return po.maxKeys
+}
+
+func (po AccountPolicy) MinWeight() uint {
+	return po.minWeight
+}
+
+func (po AccountPolicy) MaxWeight() uint {
+	return po.maxWeight
+}
+
+// IsValidKeys checks the keys by policy.
+func (po AccountPolicy) IsValidKeys(keys AccountKeys) error {
+	if n := uint(len(keys.Keys())); n > po.maxKeys {
+		return isvalid.InvalidError.Errorf("keys over %d, %d", po.maxKeys, n)
+	}
+
+	for i := range keys.Keys() {
+		if w := keys.Keys()[i].Weight(); w < po.minWeight || w > po.maxWeight {
+			return isvalid.InvalidError.Errorf(
+				"invalid key weight, %d <= weight <= %d, %d", po.minWeight, po.maxWeight, w)
+		}
+	}
+
+	return nil
+}
+
+func loadAccountPolicy(getState func(key string) (state.State, bool, error)) (AccountPolicy, error) {
+	switch st, found, err := getState(StateKeyAccountPolicy); {
+	case err != nil:
+		return AccountPolicy{}, err
+	case !found:
+		return DefaultAccountPolicy, nil
+	default:
+		po, err := StateAccountPolicyValue(st)
+		if err != nil {
+			if errors.Is(err, util.NotFoundError) {
+				return DefaultAccountPolicy, nil
+			}
+
+			return AccountPolicy{}, err
+		}
+
+		return po, nil
+	}
+}
+
+// checkKeysByAccountPolicy checks the new keys by the active AccountPolicy in
+// state. The keys, which are already stored in state are not checked, so
+// changing policy does not lock the existing accounts.
+func checkKeysByAccountPolicy(keys AccountKeys, getState func(key string) (state.State, bool, error)) error {
+	po, err := loadAccountPolicy(getState)
+	if err != nil {
+		return err
+	}
+
+	if err := po.IsValidKeys(keys); err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	return nil
+}
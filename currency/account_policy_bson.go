@@ -0,0 +1,33 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (po AccountPolicy) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(po.Hint()),
+			bson.M{
+				"max_keys":   po.maxKeys,
+				"min_weight": po.minWeight,
+				"max_weight": po.maxWeight,
+			}),
+	)
+}
+
+type AccountPolicyBSONUnpacker struct {
+	MK uint `bson:"max_keys"`
+	MI uint `bson:"min_weight"`
+	MA uint `bson:"max_weight"`
+}
+
+func (po *AccountPolicy) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var upo AccountPolicyBSONUnpacker
+	if err := enc.Unmarshal(b, &upo); err != nil {
+		return err
+	}
+
+	return po.unpack(upo.MK, upo.MI, upo.MA)
+}
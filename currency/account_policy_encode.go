@@ -0,0 +1,9 @@
+package currency
+
+func (po *AccountPolicy) unpack(mk, mi, ma uint) error {
+	po.maxKeys = mk
+	po.minWeight = mi
+	po.maxWeight = ma
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package currency
+
+import (
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type AccountPolicyJSONPacker struct {
+	jsonenc.HintedHead
+	MK uint `json:"max_keys"`
+	MI uint `json:"min_weight"`
+	MA uint `json:"max_weight"`
+}
+
+func (po AccountPolicy) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(AccountPolicyJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(po.Hint()),
+		MK:         po.maxKeys,
+		MI:         po.minWeight,
+		MA:         po.maxWeight,
+	})
+}
+
+type AccountPolicyJSONUnpacker struct {
+	MK uint `json:"max_keys"`
+	MI uint `json:"min_weight"`
+	MA uint `json:"max_weight"`
+}
+
+func (po *AccountPolicy) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var upo AccountPolicyJSONUnpacker
+	if err := enc.Unmarshal(b, &upo); err != nil {
+		return err
+	}
+
+	return po.unpack(upo.MK, upo.MI, upo.MA)
+}
@@ -0,0 +1,137 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+type testAccountPolicy struct {
+	suite.Suite
+}
+
+func (t *testAccountPolicy) newKeys(n int, w, threshold uint) AccountKeys {
+	ks := make([]AccountKey, n)
+	for i := range ks {
+		k, err := NewBaseAccountKey(key.NewBasePrivatekey().Publickey(), w)
+		t.NoError(err)
+
+		ks[i] = k
+	}
+
+	keys, err := NewBaseAccountKeys(ks, threshold)
+	t.NoError(err)
+
+	return keys
+}
+
+func (t *testAccountPolicy) TestNew() {
+	t.NoError(DefaultAccountPolicy.IsValid(nil))
+
+	po := NewAccountPolicy(uint(MaxAccountKeyInKeys), 1, MaxAccountKeyWeight)
+	t.NoError(po.IsValid(nil))
+}
+
+func (t *testAccountPolicy) TestInvalid() {
+	cases := []struct {
+		name string
+		po   AccountPolicy
+		err  string
+	}{
+		{name: "zero max keys", po: NewAccountPolicy(0, 1, 100), err: "invalid max keys"},
+		{name: "over max keys", po: NewAccountPolicy(uint(MaxAccountKeyInKeys)+1, 1, 100), err: "invalid max keys"},
+		{name: "zero min weight", po: NewAccountPolicy(10, 0, 100), err: "invalid weight range"},
+		{name: "over max weight", po: NewAccountPolicy(10, 1, MaxAccountKeyWeight+1), err: "invalid weight range"},
+		{name: "min over max", po: NewAccountPolicy(10, 50, 40), err: "invalid weight range"},
+	}
+
+	for i, c := range cases {
+		i := i
+		c := c
+		t.Run(c.name, func() {
+			err := c.po.IsValid(nil)
+			t.True(errors.Is(err, isvalid.InvalidError), "%d: %v", i, c.name)
+			t.Contains(err.Error(), c.err, "%d: %v", i, c.name)
+		})
+	}
+}
+
+func (t *testAccountPolicy) TestIsValidKeys() {
+	po := NewAccountPolicy(3, 10, 50)
+
+	t.NoError(po.IsValidKeys(t.newKeys(3, 40, 100)))
+
+	err := po.IsValidKeys(t.newKeys(4, 40, 100))
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "keys over 3")
+
+	err = po.IsValidKeys(t.newKeys(1, 9, 9))
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "invalid key weight")
+
+	err = po.IsValidKeys(t.newKeys(2, 51, 100))
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "invalid key weight")
+}
+
+func (t *testAccountPolicy) TestKeysOverDefaultPolicy() {
+	// NOTE keys over DefaultAccountPolicy is still valid without state
+	keys := t.newKeys(int(DefaultAccountPolicy.MaxKeys())+1, 10, 100)
+	t.NoError(keys.IsValid(nil))
+
+	err := DefaultAccountPolicy.IsValidKeys(keys)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "keys over")
+}
+
+func TestAccountPolicy(t *testing.T) {
+	suite.Run(t, new(testAccountPolicy))
+}
+
+func testAccountPolicyUpdaterEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		fact := NewAccountPolicyUpdaterFact(util.UUID().Bytes(), NewAccountPolicy(33, 2, 44))
+
+		op, err := NewAccountPolicyUpdater(fact, newTestRecoveryFactSigns(t, fact), "findme")
+		t.NoError(err)
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(AccountPolicyUpdater)
+		tb := b.(AccountPolicyUpdater)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		pa := ta.Fact().(AccountPolicyUpdaterFact).Policy()
+		pb := tb.Fact().(AccountPolicyUpdaterFact).Policy()
+
+		t.True(pa.Hint().Equal(pb.Hint()))
+		t.Equal(pa.MaxKeys(), pb.MaxKeys())
+		t.Equal(pa.MinWeight(), pb.MinWeight())
+		t.Equal(pa.MaxWeight(), pb.MaxWeight())
+	}
+
+	return t
+}
+
+func TestAccountPolicyUpdaterEncodeJSON(t *testing.T) {
+	suite.Run(t, testAccountPolicyUpdaterEncode(jsonenc.NewEncoder()))
+}
+
+func TestAccountPolicyUpdaterEncodeBSON(t *testing.T) {
+	suite.Run(t, testAccountPolicyUpdaterEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,91 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AccountPolicyUpdaterFactType   = hint.Type("mitum-currency-account-policy-updater-operation-fact")
+	AccountPolicyUpdaterFactHint   = hint.NewHint(AccountPolicyUpdaterFactType, "v0.0.1")
+	AccountPolicyUpdaterFactHinter = AccountPolicyUpdaterFact{
+		BaseHinter: hint.NewBaseHinter(AccountPolicyUpdaterFactHint),
+	}
+	AccountPolicyUpdaterType   = hint.Type("mitum-currency-account-policy-updater-operation")
+	AccountPolicyUpdaterHint   = hint.NewHint(AccountPolicyUpdaterType, "v0.0.1")
+	AccountPolicyUpdaterHinter = AccountPolicyUpdater{BaseOperation: operationHinter(AccountPolicyUpdaterHint)}
+)
+
+type AccountPolicyUpdaterFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	policy AccountPolicy
+}
+
+func NewAccountPolicyUpdaterFact(token []byte, policy AccountPolicy) AccountPolicyUpdaterFact {
+	fact := AccountPolicyUpdaterFact{
+		BaseHinter: hint.NewBaseHinter(AccountPolicyUpdaterFactHint),
+		token:      token,
+		policy:     policy,
+	}
+
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact AccountPolicyUpdaterFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact AccountPolicyUpdaterFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.policy.Bytes(),
+	)
+}
+
+func (fact AccountPolicyUpdaterFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := isvalid.Check(nil, false, fact.policy); err != nil {
+		return isvalid.InvalidError.Errorf("invalid fact: %w", err)
+	}
+
+	return nil
+}
+
+func (fact AccountPolicyUpdaterFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AccountPolicyUpdaterFact) Token() []byte {
+	return fact.token
+}
+
+func (fact AccountPolicyUpdaterFact) Policy() AccountPolicy {
+	return fact.policy
+}
+
+type AccountPolicyUpdater struct {
+	BaseOperation
+}
+
+func NewAccountPolicyUpdater(
+	fact AccountPolicyUpdaterFact,
+	fs []base.FactSign,
+	memo string,
+) (AccountPolicyUpdater, error) {
+	bo, err := NewBaseOperationFromFact(AccountPolicyUpdaterHint, fact, fs, memo)
+	if err != nil {
+		return AccountPolicyUpdater{}, err
+	}
+
+	return AccountPolicyUpdater{BaseOperation: bo}, nil
+}
@@ -0,0 +1,44 @@
+package currency
+
+import (
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (fact AccountPolicyUpdaterFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":   fact.h,
+				"token":  fact.token,
+				"policy": fact.policy,
+			}),
+	)
+}
+
+type AccountPolicyUpdaterFactBSONUnpacker struct {
+	H  valuehash.Bytes `bson:"hash"`
+	TK []byte          `bson:"token"`
+	PO bson.Raw        `bson:"policy"`
+}
+
+func (fact *AccountPolicyUpdaterFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ufact AccountPolicyUpdaterFactBSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.PO)
+}
+
+func (op *AccountPolicyUpdater) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
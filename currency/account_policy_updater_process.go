@@ -0,0 +1,95 @@
+package currency
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var accountPolicyUpdaterProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AccountPolicyUpdaterProcessor)
+	},
+}
+
+func (AccountPolicyUpdater) Process(
+	func(string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type AccountPolicyUpdaterProcessor struct {
+	AccountPolicyUpdater
+	pubs      []key.Publickey
+	threshold base.Threshold
+	st        state.State
+}
+
+func NewAccountPolicyUpdaterProcessor(pubs []key.Publickey, threshold base.Threshold) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(AccountPolicyUpdater)
+		if !ok {
+			return nil, errors.Errorf("not AccountPolicyUpdater, %T", op)
+		}
+
+		opp := accountPolicyUpdaterProcessorPool.Get().(*AccountPolicyUpdaterProcessor)
+
+		opp.AccountPolicyUpdater = i
+		opp.pubs = pubs
+		opp.threshold = threshold
+
+		return opp, nil
+	}
+}
+
+func (opp *AccountPolicyUpdaterProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	if len(opp.pubs) < 1 {
+		return nil, operation.NewBaseReasonError("empty publickeys for operation signs")
+	} else if err := checkFactSignsByPubs(opp.pubs, opp.threshold, opp.Signs()); err != nil {
+		return nil, err
+	}
+
+	st, _, err := getState(StateKeyAccountPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	opp.st = st
+
+	return opp, nil
+}
+
+func (opp *AccountPolicyUpdaterProcessor) Process(
+	_ func(string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(AccountPolicyUpdaterFact)
+
+	i, err := SetStateAccountPolicyValue(opp.st, fact.Policy())
+	if err != nil {
+		return err
+	}
+	return setState(fact.Hash(), i)
+}
+
+func (opp *AccountPolicyUpdaterProcessor) Close() error {
+	opp.AccountPolicyUpdater = AccountPolicyUpdater{}
+	opp.pubs = nil
+	opp.threshold = base.Threshold{}
+	opp.st = nil
+
+	accountPolicyUpdaterProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,239 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testAccountPolicyUpdaterOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testAccountPolicyUpdaterOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testAccountPolicyUpdaterOperations) signs(fact base.Fact, pks []key.Privatekey) []base.FactSign {
+	var fs []base.FactSign
+	for _, pk := range pks {
+		sig, err := base.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, base.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	return fs
+}
+
+func (t *testAccountPolicyUpdaterOperations) newOperation(pks []key.Privatekey, po AccountPolicy) AccountPolicyUpdater {
+	fact := NewAccountPolicyUpdaterFact(util.UUID().Bytes(), po)
+
+	op, err := NewAccountPolicyUpdater(fact, t.signs(fact, pks), "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testAccountPolicyUpdaterOperations) newKeys(n int, w, threshold uint) AccountKeys {
+	ks := make([]AccountKey, n)
+	for i := range ks {
+		ks[i] = t.newKey(key.NewBasePrivatekey().Publickey(), w)
+	}
+
+	keys, err := NewBaseAccountKeys(ks, threshold)
+	t.NoError(err)
+
+	return keys
+}
+
+func (t *testAccountPolicyUpdaterOperations) newKeyUpdater(target base.Address, keys AccountKeys, pks []key.Privatekey) KeyUpdater {
+	fact := NewKeyUpdaterFact(util.UUID().Bytes(), target, keys, t.cid)
+
+	op, err := NewKeyUpdater(fact, t.signs(fact, pks), "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testAccountPolicyUpdaterOperations) newCreateAccounts(sender base.Address, keys AccountKeys, pks []key.Privatekey) CreateAccounts {
+	items := []CreateAccountsItem{NewCreateAccountsItemSingleAmount(keys, NewAmount(NewBig(1), t.cid))}
+	fact := NewCreateAccountsFact(util.UUID().Bytes(), sender, items)
+
+	op, err := NewCreateAccounts(fact, t.signs(fact, pks), "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testAccountPolicyUpdaterOperations) processor(cp *CurrencyPool, n int) ([]key.Privatekey, *OperationProcessor) {
+	privs := make([]key.Privatekey, n)
+	for i := 0; i < n; i++ {
+		privs[i] = key.NewBasePrivatekey()
+	}
+
+	pubs := make([]key.Publickey, len(privs))
+	for i := range privs {
+		pubs[i] = privs[i].Publickey()
+	}
+	threshold, err := base.NewThreshold(uint(len(privs)), 100)
+	t.NoError(err)
+
+	opr := NewOperationProcessor(cp)
+	_, err = opr.SetProcessor(AccountPolicyUpdaterHinter, NewAccountPolicyUpdaterProcessor(pubs, threshold))
+	t.NoError(err)
+
+	_, err = opr.SetProcessor(KeyUpdaterHinter, NewKeyUpdaterProcessor(cp))
+	t.NoError(err)
+
+	_, err = opr.SetProcessor(CreateAccountsHinter, NewCreateAccountsProcessor(cp))
+	t.NoError(err)
+
+	return privs, opr
+}
+
+func (t *testAccountPolicyUpdaterOperations) newStateAccountPolicy(po AccountPolicy) state.State {
+	st, err := state.NewStateV0(StateKeyAccountPolicy, nil, base.Height(33))
+	t.NoError(err)
+
+	nst, err := SetStateAccountPolicyValue(st, po)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *testAccountPolicyUpdaterOperations) TestNew() {
+	pool, _ := t.statepool()
+
+	privs, copr := t.processor(nil, 3)
+	opr := copr.New(pool)
+
+	po := NewAccountPolicy(33, 2, 44)
+	t.NoError(opr.Process(t.newOperation(privs, po)))
+
+	var upo AccountPolicy
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyAccountPolicy {
+			i, err := StateAccountPolicyValue(st.GetState())
+			t.NoError(err)
+
+			upo = i
+		}
+	}
+
+	t.Equal(po.Bytes(), upo.Bytes())
+}
+
+func (t *testAccountPolicyUpdaterOperations) TestNotEnoughSigns() {
+	pool, _ := t.statepool()
+
+	privs, copr := t.processor(nil, 3)
+	opr := copr.New(pool)
+
+	err := opr.Process(t.newOperation(privs[:2], DefaultAccountPolicy))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "not enough suffrage signs")
+}
+
+func (t *testAccountPolicyUpdaterOperations) TestDuplicated() {
+	pool, _ := t.statepool()
+
+	privs, copr := t.processor(nil, 3)
+	opr := copr.New(pool)
+
+	t.NoError(opr.Process(t.newOperation(privs, DefaultAccountPolicy)))
+
+	err := opr.Process(t.newOperation(privs, NewAccountPolicy(20, 1, 100)))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "duplicated account policy")
+}
+
+func (t *testAccountPolicyUpdaterOperations) TestKeyUpdaterByDefaultPolicy() {
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	pool, _ := t.statepool(st)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	_, copr := t.processor(cp, 1)
+	opr := copr.New(pool)
+
+	keys := t.newKeys(int(DefaultAccountPolicy.MaxKeys())+1, 10, 100)
+	err := opr.Process(t.newKeyUpdater(sa.Address, keys, sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "keys over")
+}
+
+func (t *testAccountPolicyUpdaterOperations) TestKeyUpdaterByActivePolicy() {
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	po := NewAccountPolicy(30, 1, 100)
+	pool, _ := t.statepool(st, []state.State{t.newStateAccountPolicy(po)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	_, copr := t.processor(cp, 1)
+	opr := copr.New(pool)
+
+	keys := t.newKeys(int(DefaultAccountPolicy.MaxKeys())+10, 5, 100)
+	t.NoError(opr.Process(t.newKeyUpdater(sa.Address, keys, sa.Privs())))
+	t.NoError(opr.Close())
+
+	var ukeys AccountKeys
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyAccount(sa.Address) {
+			i, err := StateKeysValue(st.GetState())
+			t.NoError(err)
+
+			ukeys = i
+		}
+	}
+
+	t.NotNil(ukeys)
+	t.True(keys.Equal(ukeys))
+}
+
+func (t *testAccountPolicyUpdaterOperations) TestCreateAccountsWeightByActivePolicy() {
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	po := NewAccountPolicy(10, 20, 100)
+	pool, _ := t.statepool(st, []state.State{t.newStateAccountPolicy(po)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	_, copr := t.processor(cp, 1)
+	opr := copr.New(pool)
+
+	err := opr.Process(t.newCreateAccounts(sa.Address, t.newKeys(10, 10, 100), sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "invalid key weight")
+}
+
+func TestAccountPolicyUpdaterOperations(t *testing.T) {
+	suite.Run(t, new(testAccountPolicyUpdaterOperations))
+}
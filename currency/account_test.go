@@ -59,6 +59,43 @@ func (t *testAccount) TestZeroAccount() {
 	t.True(isZeroAddress(cid, ac.Address()))
 }
 
+func (t *testAccount) TestFrozen() {
+	priv := key.NewBasePrivatekey()
+	key, err := NewBaseAccountKey(priv.Publickey(), 100)
+	t.NoError(err)
+	keys, err := NewBaseAccountKeys([]AccountKey{key}, 100)
+	t.NoError(err)
+
+	ac, err := NewAccountFromKeys(keys)
+	t.NoError(err)
+	t.False(ac.IsFrozen())
+
+	fac := ac.SetFrozen(true)
+	t.True(fac.IsFrozen())
+	t.False(ac.Hash().Equal(fac.Hash()))
+
+	t.True(ac.Hash().Equal(fac.SetFrozen(false).Hash()))
+}
+
+func (t *testAccount) TestClosed() {
+	priv := key.NewBasePrivatekey()
+	key, err := NewBaseAccountKey(priv.Publickey(), 100)
+	t.NoError(err)
+	keys, err := NewBaseAccountKeys([]AccountKey{key}, 100)
+	t.NoError(err)
+
+	ac, err := NewAccountFromKeys(keys)
+	t.NoError(err)
+	t.False(ac.IsClosed())
+
+	cac := ac.SetClosed(true)
+	t.True(cac.IsClosed())
+	t.False(ac.Hash().Equal(cac.Hash()))
+	t.False(ac.SetFrozen(true).Hash().Equal(cac.Hash()))
+
+	t.True(ac.Hash().Equal(cac.SetClosed(false).Hash()))
+}
+
 func TestAccount(t *testing.T) {
 	suite.Run(t, new(testAccount))
 }
@@ -76,6 +113,7 @@ func testAccountEncode(enc encoder.Encoder) suite.TestingSuite {
 
 		ac, err := NewAccountFromKeys(keys)
 		t.NoError(err)
+		ac = ac.SetFrozen(true).SetClosed(true)
 		ac.BaseHinter = hint.NewBaseHinter(hint.NewHint(AccountType, "v0.0.9"))
 
 		return ac
@@ -86,8 +124,11 @@ func testAccountEncode(enc encoder.Encoder) suite.TestingSuite {
 		cb := b.(Account)
 
 		t.True(ca.Hint().Equal(cb.Hint()))
+		t.True(ca.Hash().Equal(cb.Hash()))
 		t.True(ca.Address().Equal(cb.Address()))
 		t.True(ca.Keys().Equal(cb.Keys()))
+		t.Equal(ca.IsFrozen(), cb.IsFrozen())
+		t.Equal(ca.IsClosed(), cb.IsClosed())
 	}
 
 	return t
@@ -0,0 +1,100 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AliasType   = hint.Type("mitum-currency-alias")
+	AliasHint   = hint.NewHint(AliasType, "v0.0.1")
+	AliasHinter = Alias{BaseHinter: hint.NewBaseHinter(AliasHint)}
+)
+
+// Alias is the human-readable name, which is registered for account by
+// RegisterAlias.
+type Alias struct {
+	hint.BaseHinter
+	name    string
+	address base.Address
+}
+
+func NewAlias(name string, address base.Address) Alias {
+	return Alias{
+		BaseHinter: hint.NewBaseHinter(AliasHint),
+		name:       name,
+		address:    address,
+	}
+}
+
+func (al Alias) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		[]byte(al.name),
+		al.address.Bytes(),
+	)
+}
+
+func (al Alias) Hash() valuehash.Hash {
+	return al.GenerateHash()
+}
+
+func (al Alias) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(al.Bytes())
+}
+
+func (al Alias) IsValid([]byte) error {
+	if err := isvalid.Check(nil, false, al.BaseHinter, al.address); err != nil {
+		return isvalid.InvalidError.Errorf("invalid Alias: %w", err)
+	}
+
+	if err := IsValidAliasName(al.name); err != nil {
+		return err
+	}
+
+	if _, ok := al.address.(AliasAddress); ok {
+		return isvalid.InvalidError.Errorf("alias can not refer alias address, %q", al.address)
+	}
+
+	return nil
+}
+
+func (al Alias) Name() string {
+	return al.name
+}
+
+func (al Alias) Address() base.Address {
+	return al.address
+}
+
+func (al Alias) AliasAddress() AliasAddress {
+	return NewAliasAddress(al.name)
+}
+
+// resolveAddress returns the registered account address of AliasAddress; the
+// other addresses are returned as they are.
+func resolveAddress(
+	a base.Address,
+	getState func(key string) (state.State, bool, error),
+) (base.Address, error) {
+	aa, ok := a.(AliasAddress)
+	if !ok {
+		return a, nil
+	}
+
+	st, err := existsState(StateKeyAlias(aa.Name()), "alias", getState)
+	if err != nil {
+		return nil, err
+	}
+
+	al, err := StateAliasValue(st)
+	if err != nil {
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	}
+
+	return al.Address(), nil
+}
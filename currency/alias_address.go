@@ -0,0 +1,56 @@
+package currency
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	AliasAddressType   = hint.Type("mal")
+	AliasAddressHint   = hint.NewHint(AliasAddressType, "v0.0.1")
+	AliasAddressHinter = AliasAddress{StringAddress: base.NewStringAddressWithHint(AliasAddressHint, "")}
+)
+
+var reAliasName = regexp.MustCompile(`^[a-z0-9][a-z0-9_\-]{1,30}[a-z0-9]$`)
+
+// AliasAddress is the address, which refers the account registered by
+// RegisterAlias. AliasAddress is resolved to the registered account address
+// while processing operation.
+type AliasAddress struct {
+	base.StringAddress
+}
+
+func NewAliasAddress(name string) AliasAddress {
+	return AliasAddress{StringAddress: base.NewStringAddressWithHint(AliasAddressHint, name)}
+}
+
+func (aa AliasAddress) IsValid([]byte) error {
+	if err := aa.StringAddress.IsValid(nil); err != nil {
+		return isvalid.InvalidError.Errorf("invalid alias address: %w", err)
+	}
+
+	return IsValidAliasName(aa.Name())
+}
+
+func (aa AliasAddress) SetHint(ht hint.Hint) hint.Hinter {
+	aa.StringAddress = aa.StringAddress.SetHint(ht).(base.StringAddress)
+
+	return aa
+}
+
+// Name returns the alias name without address type.
+func (aa AliasAddress) Name() string {
+	return strings.TrimSuffix(aa.String(), AliasAddressType.String())
+}
+
+func IsValidAliasName(name string) error {
+	if !reAliasName.MatchString(name) {
+		return isvalid.InvalidError.Errorf("invalid alias name, %q", name)
+	}
+
+	return nil
+}
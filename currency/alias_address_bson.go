@@ -0,0 +1,17 @@
+package currency
+
+import (
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+func (aa AliasAddress) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bsontype.String, bsoncore.AppendString(nil, aa.String()), nil
+}
+
+func (aa *AliasAddress) UnpackBSON(b []byte, _ *bsonenc.Encoder) error {
+	*aa = NewAliasAddress(string(b))
+
+	return nil
+}
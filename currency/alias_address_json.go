@@ -0,0 +1,15 @@
+package currency
+
+import (
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+func (aa AliasAddress) MarshalText() ([]byte, error) {
+	return aa.Bytes(), nil
+}
+
+func (aa *AliasAddress) UnpackJSON(b []byte, _ *jsonenc.Encoder) error {
+	*aa = NewAliasAddress(string(b))
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (al Alias) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(al.Hint()),
+			bson.M{
+				"name":    al.name,
+				"address": al.address,
+			}))
+}
+
+type AliasBSONUnpacker struct {
+	NM string              `bson:"name"`
+	AD base.AddressDecoder `bson:"address"`
+}
+
+func (al *Alias) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ual AliasBSONUnpacker
+	if err := bson.Unmarshal(b, &ual); err != nil {
+		return err
+	}
+
+	return al.unpack(enc, ual.NM, ual.AD)
+}
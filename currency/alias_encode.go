@@ -0,0 +1,22 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (al *Alias) unpack(
+	enc encoder.Encoder,
+	name string,
+	baddress base.AddressDecoder,
+) error {
+	address, err := baddress.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	al.name = name
+	al.address = address
+
+	return nil
+}
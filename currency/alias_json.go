@@ -0,0 +1,34 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type AliasJSONPacker struct {
+	jsonenc.HintedHead
+	NM string       `json:"name"`
+	AD base.Address `json:"address"`
+}
+
+func (al Alias) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(AliasJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(al.Hint()),
+		NM:         al.name,
+		AD:         al.address,
+	})
+}
+
+type AliasJSONUnpacker struct {
+	NM string              `json:"name"`
+	AD base.AddressDecoder `json:"address"`
+}
+
+func (al *Alias) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ual AliasJSONUnpacker
+	if err := enc.Unmarshal(b, &ual); err != nil {
+		return err
+	}
+
+	return al.unpack(enc, ual.NM, ual.AD)
+}
@@ -0,0 +1,278 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testAliasOperations struct {
+	baseTestOperationProcessor
+}
+
+func (t *testAliasOperations) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr := NewOperationProcessor(cp)
+
+	_, err := copr.SetProcessor(RegisterAliasHinter, NewRegisterAliasProcessor(cp))
+	t.NoError(err)
+	_, err = copr.SetProcessor(TransfersHinter, NewTransfersProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testAliasOperations) signs(fact base.Fact, pks []key.Privatekey) []base.FactSign {
+	fs := make([]base.FactSign, len(pks))
+	for i := range pks {
+		sig, err := base.NewFactSignature(pks[i], fact, nil)
+		t.NoError(err)
+
+		fs[i] = base.NewBaseFactSign(pks[i].Publickey(), sig)
+	}
+
+	return fs
+}
+
+func (t *testAliasOperations) newRegisterAlias(sender base.Address, alias string, pks []key.Privatekey) RegisterAlias {
+	fact := NewRegisterAliasFact(util.UUID().Bytes(), sender, alias, t.cid)
+
+	op, err := NewRegisterAlias(fact, t.signs(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testAliasOperations) newTransfers(sender, receiver base.Address, big Big, pks []key.Privatekey) Transfers {
+	items := []TransfersItem{NewTransfersItemSingleAmount(receiver, NewAmount(big, t.cid))}
+	fact := NewTransfersFact(util.UUID().Bytes(), sender, items)
+
+	op, err := NewTransfers(fact, t.signs(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testAliasOperations) newStateAlias(al Alias) state.State {
+	st, err := state.NewStateV0(StateKeyAlias(al.Name()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateAliasValue(st, al)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *testAliasOperations) TestRegister() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	fa, st1 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1)
+
+	fee := NewBig(3)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, fee))))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newRegisterAlias(sa.Address, "showme", sa.Privs())))
+	t.NoError(opr.Close())
+
+	var sst, lst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyAlias("showme"):
+			lst = st.GetState()
+		}
+	}
+
+	sb, err := StateBalanceValue(sst)
+	t.NoError(err)
+	t.True(NewBig(33).Sub(fee).Equal(sb.Big()))
+
+	al, err := StateAliasValue(lst)
+	t.NoError(err)
+	t.NoError(al.IsValid(nil))
+	t.Equal("showme", al.Name())
+	t.True(al.Address().Equal(sa.Address))
+}
+
+func (t *testAliasOperations) TestRegisterAlreadyTaken() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	oa, st1 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newStateAlias(NewAlias("showme", oa.Address))})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(oa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newRegisterAlias(sa.Address, "showme", sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "alias already exists")
+}
+
+func (t *testAliasOperations) TestRegisterSameAliasMultipleOperations() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sb, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	pool, _ := t.statepool(st0, st1)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newRegisterAlias(sa.Address, "showme", sa.Privs())))
+
+	err := opr.Process(t.newRegisterAlias(sb.Address, "showme", sb.Privs()))
+	t.Contains(err.Error(), "new address already processed")
+}
+
+func (t *testAliasOperations) TestRegisterInsufficientBalance() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(2), t.cid)})
+	fa, st1 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, NewBig(3)))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newRegisterAlias(sa.Address, "showme", sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "insufficient balance")
+}
+
+func (t *testAliasOperations) TestRegisterFrozenSender() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	pool, _ := t.statepool(t.freezeAccountState(sa.Address, st0))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newRegisterAlias(sa.Address, "showme", sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "frozen")
+}
+
+func (t *testAliasOperations) TestTransferToAlias() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newStateAlias(NewAlias("showme", ra.Address))})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newTransfers(sa.Address, NewAliasAddress("showme"), NewBig(10), sa.Privs())))
+	t.NoError(opr.Close())
+
+	var rst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(ra.Address, t.cid):
+			rst = st.GetState()
+		case StateKeyBalance(NewAliasAddress("showme"), t.cid):
+			t.Fail("alias address should not have balance")
+		}
+	}
+
+	rb, err := StateBalanceValue(rst)
+	t.NoError(err)
+	t.True(NewBig(11).Equal(rb.Big()))
+}
+
+func (t *testAliasOperations) TestTransferToUnknownAlias() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	pool, _ := t.statepool(st0)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newTransfers(sa.Address, NewAliasAddress("showme"), NewBig(10), sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "alias does not exist")
+}
+
+func (t *testAliasOperations) TestTransferToAliasOfSender() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+
+	pool, _ := t.statepool(st0, []state.State{t.newStateAlias(NewAlias("showme", sa.Address))})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newTransfers(sa.Address, NewAliasAddress("showme"), NewBig(10), sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "receiver is same with sender")
+}
+
+func (t *testAliasOperations) TestTransferToAliasAndAddress() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newStateAlias(NewAlias("showme", ra.Address))})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{
+		NewTransfersItemSingleAmount(ra.Address, NewAmount(NewBig(1), t.cid)),
+		NewTransfersItemSingleAmount(NewAliasAddress("showme"), NewAmount(NewBig(1), t.cid)),
+	}
+	fact := NewTransfersFact(util.UUID().Bytes(), sa.Address, items)
+	op, err := NewTransfers(fact, t.signs(fact, sa.Privs()), "")
+	t.NoError(err)
+
+	err = opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "duplicated receiver found")
+}
+
+func TestAliasOperations(t *testing.T) {
+	suite.Run(t, new(testAliasOperations))
+}
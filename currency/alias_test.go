@@ -0,0 +1,182 @@
+package currency
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+type testAlias struct {
+	baseTest
+}
+
+func (t *testAlias) TestAliasAddress() {
+	aa := NewAliasAddress("show-me_1")
+	t.NoError(aa.IsValid(nil))
+	t.Equal("show-me_1", aa.Name())
+	t.Equal("show-me_1"+AliasAddressType.String(), aa.String())
+
+	t.False(aa.Equal(NewAddress("show-me_1")))
+}
+
+func (t *testAlias) TestAliasName() {
+	for _, name := range []string{
+		"ab",
+		"-abc",
+		"abc_",
+		"ABC",
+		"a b c",
+		"abc:def",
+		strings.Repeat("a", 33),
+	} {
+		err := IsValidAliasName(name)
+		t.True(errors.Is(err, isvalid.InvalidError), name)
+
+		t.Error(NewAliasAddress(name).IsValid(nil), name)
+	}
+
+	t.NoError(IsValidAliasName("abc"))
+	t.NoError(IsValidAliasName(strings.Repeat("a", 32)))
+}
+
+func (t *testAlias) TestNewRegisterAlias() {
+	fact := NewRegisterAliasFact(util.UUID().Bytes(), NewTestAddress(), "showme", t.cid)
+	t.NoError(fact.IsValid(nil))
+
+	as, err := fact.Addresses()
+	t.NoError(err)
+	t.Equal(1, len(as))
+}
+
+func (t *testAlias) TestRegisterAliasWrongName() {
+	fact := NewRegisterAliasFact(util.UUID().Bytes(), NewTestAddress(), "Show Me", t.cid)
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "invalid alias name")
+}
+
+func (t *testAlias) TestRegisterAliasAliasSender() {
+	fact := NewRegisterAliasFact(util.UUID().Bytes(), NewAliasAddress("findme"), "showme", t.cid)
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "sender can not be alias address")
+}
+
+func (t *testAlias) TestAliasReferAlias() {
+	al := NewAlias("showme", NewAliasAddress("findme"))
+	err := al.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "alias can not refer alias address")
+}
+
+func TestAlias(t *testing.T) {
+	suite.Run(t, new(testAlias))
+}
+
+func testAliasEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		return NewAlias("showme", NewTestAddress())
+	}
+
+	t.compare = func(a, b interface{}) {
+		aa := a.(Alias)
+		ab := b.(Alias)
+
+		t.True(aa.Hint().Equal(ab.Hint()))
+		t.Equal(aa.Name(), ab.Name())
+		t.True(aa.Address().Equal(ab.Address()))
+		t.True(aa.Hash().Equal(ab.Hash()))
+	}
+
+	return t
+}
+
+func TestAliasEncodeJSON(t *testing.T) {
+	suite.Run(t, testAliasEncode(jsonenc.NewEncoder()))
+}
+
+func TestAliasEncodeBSON(t *testing.T) {
+	suite.Run(t, testAliasEncode(bsonenc.NewEncoder()))
+}
+
+func testRegisterAliasEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		fact := NewRegisterAliasFact(util.UUID().Bytes(), NewTestAddress(), "showme", CurrencyID("SHOWME"))
+
+		op, err := NewRegisterAlias(fact, newTestRecoveryFactSigns(t, fact), "")
+		t.NoError(err)
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		fact := a.(RegisterAlias).Fact().(RegisterAliasFact)
+		ufact := b.(RegisterAlias).Fact().(RegisterAliasFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.Equal(fact.alias, ufact.alias)
+		t.Equal(fact.currency, ufact.currency)
+	}
+
+	return t
+}
+
+func TestRegisterAliasEncodeJSON(t *testing.T) {
+	suite.Run(t, testRegisterAliasEncode(jsonenc.NewEncoder()))
+}
+
+func TestRegisterAliasEncodeBSON(t *testing.T) {
+	suite.Run(t, testRegisterAliasEncode(bsonenc.NewEncoder()))
+}
+
+func testTransfersAliasReceiverEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		items := []TransfersItem{
+			NewTransfersItemSingleAmount(NewAliasAddress("showme"), NewAmount(NewBig(10), CurrencyID("SHOWME"))),
+		}
+		fact := NewTransfersFact(util.UUID().Bytes(), NewTestAddress(), items)
+
+		op, err := NewTransfers(fact, newTestRecoveryFactSigns(t, fact), "")
+		t.NoError(err)
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		fact := a.(Transfers).Fact().(TransfersFact)
+		ufact := b.(Transfers).Fact().(TransfersFact)
+
+		receiver := ufact.items[0].Receiver()
+		t.IsType(AliasAddress{}, receiver)
+		t.True(fact.items[0].Receiver().Equal(receiver))
+	}
+
+	return t
+}
+
+func TestTransfersAliasReceiverEncodeJSON(t *testing.T) {
+	suite.Run(t, testTransfersAliasReceiverEncode(jsonenc.NewEncoder()))
+}
+
+func TestTransfersAliasReceiverEncodeBSON(t *testing.T) {
+	suite.Run(t, testTransfersAliasReceiverEncode(bsonenc.NewEncoder()))
+}
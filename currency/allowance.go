@@ -0,0 +1,92 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AllowanceType   = hint.Type("mitum-currency-allowance")
+	AllowanceHint   = hint.NewHint(AllowanceType, "v0.0.1")
+	AllowanceHinter = Allowance{BaseHinter: hint.NewBaseHinter(AllowanceHint)}
+)
+
+// Allowance is the amount, which spender can transfer from the balance of
+// owner by TransferFrom.
+type Allowance struct {
+	hint.BaseHinter
+	owner   base.Address
+	spender base.Address
+	amount  Amount
+}
+
+func NewAllowance(owner, spender base.Address, amount Amount) Allowance {
+	return Allowance{
+		BaseHinter: hint.NewBaseHinter(AllowanceHint),
+		owner:      owner,
+		spender:    spender,
+		amount:     amount,
+	}
+}
+
+func (al Allowance) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		al.owner.Bytes(),
+		al.spender.Bytes(),
+		al.amount.Bytes(),
+	)
+}
+
+func (al Allowance) Hash() valuehash.Hash {
+	return al.GenerateHash()
+}
+
+func (al Allowance) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(al.Bytes())
+}
+
+func (al Allowance) IsValid([]byte) error {
+	if err := isvalid.Check(nil, false,
+		al.BaseHinter,
+		al.owner,
+		al.spender,
+		al.amount,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid Allowance: %w", err)
+	}
+
+	if !al.amount.Big().OverNil() {
+		return isvalid.InvalidError.Errorf("allowance amount should be over nil")
+	}
+
+	if al.owner.Equal(al.spender) {
+		return isvalid.InvalidError.Errorf("spender is same with owner, %q", al.owner)
+	}
+
+	return nil
+}
+
+func (al Allowance) Owner() base.Address {
+	return al.owner
+}
+
+func (al Allowance) Spender() base.Address {
+	return al.spender
+}
+
+func (al Allowance) Amount() Amount {
+	return al.amount
+}
+
+func (al Allowance) Addresses() []base.Address {
+	return []base.Address{al.owner, al.spender}
+}
+
+func (al Allowance) WithBig(big Big) Allowance {
+	al.amount = al.amount.WithBig(big)
+
+	return al
+}
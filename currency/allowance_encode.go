@@ -0,0 +1,29 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (al *Allowance) unpack(
+	enc encoder.Encoder,
+	bowner,
+	bspender base.AddressDecoder,
+	am Amount,
+) error {
+	owner, err := bowner.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	spender, err := bspender.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	al.owner = owner
+	al.spender = spender
+	al.amount = am
+
+	return nil
+}
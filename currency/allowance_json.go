@@ -0,0 +1,37 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type AllowanceJSONPacker struct {
+	jsonenc.HintedHead
+	OW base.Address `json:"owner"`
+	SP base.Address `json:"spender"`
+	AM Amount       `json:"amount"`
+}
+
+func (al Allowance) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(AllowanceJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(al.Hint()),
+		OW:         al.owner,
+		SP:         al.spender,
+		AM:         al.amount,
+	})
+}
+
+type AllowanceJSONUnpacker struct {
+	OW base.AddressDecoder `json:"owner"`
+	SP base.AddressDecoder `json:"spender"`
+	AM Amount              `json:"amount"`
+}
+
+func (al *Allowance) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ual AllowanceJSONUnpacker
+	if err := enc.Unmarshal(b, &ual); err != nil {
+		return err
+	}
+
+	return al.unpack(enc, ual.OW, ual.SP, ual.AM)
+}
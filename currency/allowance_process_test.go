@@ -0,0 +1,399 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testAllowanceOperations struct {
+	baseTestOperationProcessor
+}
+
+func (t *testAllowanceOperations) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr := NewOperationProcessor(cp)
+
+	_, err := copr.SetProcessor(ApproveHinter, NewApproveProcessor(cp))
+	t.NoError(err)
+	_, err = copr.SetProcessor(TransferFromHinter, NewTransferFromProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testAllowanceOperations) signs(fact base.Fact, pks []key.Privatekey) []base.FactSign {
+	fs := make([]base.FactSign, len(pks))
+	for i := range pks {
+		sig, err := base.NewFactSignature(pks[i], fact, nil)
+		t.NoError(err)
+
+		fs[i] = base.NewBaseFactSign(pks[i].Publickey(), sig)
+	}
+
+	return fs
+}
+
+func (t *testAllowanceOperations) newApprove(sender, spender base.Address, am Amount, pks []key.Privatekey) Approve {
+	fact := NewApproveFact(util.UUID().Bytes(), sender, spender, am)
+
+	op, err := NewApprove(fact, t.signs(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testAllowanceOperations) newTransferFrom(
+	sender, owner, receiver base.Address,
+	am Amount,
+	pks []key.Privatekey,
+) TransferFrom {
+	fact := NewTransferFromFact(util.UUID().Bytes(), sender, owner, receiver, am)
+
+	op, err := NewTransferFrom(fact, t.signs(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testAllowanceOperations) newStateAllowance(al Allowance) state.State {
+	st, err := state.NewStateV0(
+		StateKeyAllowance(al.Owner(), al.Spender(), al.Amount().Currency()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateAllowanceValue(st, al)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *testAllowanceOperations) TestApprove() {
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, st1 := t.newAccount(true, nil)
+	fa, st2 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1, st2)
+
+	fee := NewBig(3)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, fee))))
+
+	opr := t.processor(cp, pool)
+
+	am := NewAmount(NewBig(100), t.cid)
+	t.NoError(opr.Process(t.newApprove(oa.Address, sa.Address, am, oa.Privs())))
+	t.NoError(opr.Close())
+
+	var ost, ast state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(oa.Address, t.cid):
+			ost = st.GetState()
+		case StateKeyAllowance(oa.Address, sa.Address, t.cid):
+			ast = st.GetState()
+		}
+	}
+
+	ob, err := StateBalanceValue(ost)
+	t.NoError(err)
+	t.True(NewBig(33).Sub(fee).Equal(ob.Big()))
+
+	al, err := StateAllowanceValue(ast)
+	t.NoError(err)
+	t.NoError(al.IsValid(nil))
+	t.True(al.Owner().Equal(oa.Address))
+	t.True(al.Spender().Equal(sa.Address))
+	t.True(al.Amount().Equal(am))
+}
+
+func (t *testAllowanceOperations) TestApproveSpenderNotExist() {
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, _ := t.newAccount(false, nil)
+
+	pool, _ := t.statepool(st0)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(oa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newApprove(oa.Address, sa.Address, NewAmount(NewBig(10), t.cid), oa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "spender does not exist")
+}
+
+func (t *testAllowanceOperations) TestTransferFrom() {
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, st1 := t.newAccount(true, nil)
+	ra, st2 := t.newAccount(true, nil)
+	fa, st3 := t.newAccount(true, nil)
+
+	al := NewAllowance(oa.Address, sa.Address, NewAmount(NewBig(20), t.cid))
+
+	pool, _ := t.statepool(st0, st1, st2, st3, []state.State{t.newStateAllowance(al)})
+
+	fee := NewBig(3)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, fee))))
+
+	opr := t.processor(cp, pool)
+
+	am := NewAmount(NewBig(10), t.cid)
+	t.NoError(opr.Process(t.newTransferFrom(sa.Address, oa.Address, ra.Address, am, sa.Privs())))
+	t.NoError(opr.Close())
+
+	var ost, rst, ast state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(oa.Address, t.cid):
+			ost = st.GetState()
+		case StateKeyBalance(ra.Address, t.cid):
+			rst = st.GetState()
+		case StateKeyAllowance(oa.Address, sa.Address, t.cid):
+			ast = st.GetState()
+		}
+	}
+
+	ob, err := StateBalanceValue(ost)
+	t.NoError(err)
+	t.True(NewBig(33).Sub(am.Big()).Sub(fee).Equal(ob.Big()))
+
+	rb, err := StateBalanceValue(rst)
+	t.NoError(err)
+	t.True(am.Big().Equal(rb.Big()))
+
+	ual, err := StateAllowanceValue(ast)
+	t.NoError(err)
+	t.True(NewBig(20).Sub(am.Big()).Sub(fee).Equal(ual.Amount().Big()))
+}
+
+func (t *testAllowanceOperations) TestTransferFromWithoutAllowance() {
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, st1 := t.newAccount(true, nil)
+	ra, st2 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1, st2)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(oa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newTransferFrom(sa.Address, oa.Address, ra.Address, NewAmount(NewBig(10), t.cid), sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "allowance does not exist")
+}
+
+func (t *testAllowanceOperations) TestTransferFromInsufficientAllowance() {
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, st1 := t.newAccount(true, nil)
+	ra, st2 := t.newAccount(true, nil)
+
+	al := NewAllowance(oa.Address, sa.Address, NewAmount(NewBig(10), t.cid))
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{t.newStateAllowance(al)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(NewTestAddress(), NewBig(1)))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newTransferFrom(sa.Address, oa.Address, ra.Address, NewAmount(NewBig(10), t.cid), sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "insufficient allowance")
+}
+
+func (t *testAllowanceOperations) TestTransferFromInsufficientBalance() {
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(5), t.cid)})
+	sa, st1 := t.newAccount(true, nil)
+	ra, st2 := t.newAccount(true, nil)
+
+	al := NewAllowance(oa.Address, sa.Address, NewAmount(NewBig(20), t.cid))
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{t.newStateAllowance(al)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(NewTestAddress(), ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newTransferFrom(sa.Address, oa.Address, ra.Address, NewAmount(NewBig(10), t.cid), sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "insufficient balance of owner")
+}
+
+func (t *testAllowanceOperations) TestApproveAndTransferFromInSameProposal() {
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, st1 := t.newAccount(true, nil)
+	ra, st2 := t.newAccount(true, nil)
+
+	al := NewAllowance(oa.Address, sa.Address, NewAmount(NewBig(20), t.cid))
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{t.newStateAllowance(al)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(NewTestAddress(), ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newApprove(oa.Address, sa.Address, NewAmount(ZeroBig, t.cid), oa.Privs())))
+
+	err := opr.Process(t.newTransferFrom(sa.Address, oa.Address, ra.Address, NewAmount(NewBig(10), t.cid), sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "duplicated allowance")
+}
+
+func (t *testAllowanceOperations) TestTransferFromAndApproveInSameBlock() {
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	sa, st1 := t.newAccount(true, nil)
+	ra, st2 := t.newAccount(true, nil)
+
+	al := NewAllowance(oa.Address, sa.Address, NewAmount(NewBig(20), t.cid))
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{t.newStateAllowance(al)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(NewTestAddress(), ZeroBig))))
+
+	// NOTE TransferFrom and Approve are processed by the different operation
+	// processors of same block
+	copr := t.processor(cp, nil)
+	topr := copr.New(pool)
+	aopr := copr.New(pool)
+
+	t.NoError(topr.Process(t.newTransferFrom(sa.Address, oa.Address, ra.Address, NewAmount(NewBig(10), t.cid), sa.Privs())))
+
+	err := aopr.Process(t.newApprove(oa.Address, sa.Address, NewAmount(NewBig(100), t.cid), oa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "duplicated allowance")
+
+	t.NoError(topr.Close())
+	t.NoError(aopr.Close())
+
+	var ast state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyAllowance(oa.Address, sa.Address, t.cid) {
+			ast = st.GetState()
+		}
+	}
+
+	ual, err := StateAllowanceValue(ast)
+	t.NoError(err)
+	t.True(NewBig(10).Equal(ual.Amount().Big()))
+}
+
+func (t *testAllowanceOperations) TestApproveFeeCurrency() {
+	fcid := CurrencyID("FEE")
+
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid), NewAmount(NewBig(10), fcid)})
+	sa, st1 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1)
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(NewTestAddress(), NewBig(4))).SetFeeCurrency(fcid, 0)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignStateWithPolicy(t.cid, NewBig(99), NewTestAddress(), po)))
+	t.NoError(cp.Set(t.newCurrencyDesignState(fcid, NewBig(99), NewTestAddress(), NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newApprove(oa.Address, sa.Address, NewAmount(NewBig(100), t.cid), oa.Privs())))
+
+	var fst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(oa.Address, t.cid):
+			t.Fail("balance of currency should not be updated")
+		case StateKeyBalance(oa.Address, fcid):
+			fst = st.GetState()
+		}
+	}
+
+	fb, err := StateBalanceValue(fst)
+	t.NoError(err)
+	t.Equal(NewBig(6), fb.Big())
+	t.Equal(NewBig(4), fst.(AmountState).Fee())
+}
+
+func (t *testAllowanceOperations) TestTransferFromFeeCurrency() {
+	fcid := CurrencyID("FEE")
+
+	oa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid), NewAmount(NewBig(10), fcid)})
+	sa, st1 := t.newAccount(true, nil)
+	ra, st2 := t.newAccount(true, nil)
+
+	al := NewAllowance(oa.Address, sa.Address, NewAmount(NewBig(10), t.cid))
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{t.newStateAllowance(al)})
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(NewTestAddress(), NewBig(4))).SetFeeCurrency(fcid, 0)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignStateWithPolicy(t.cid, NewBig(99), NewTestAddress(), po)))
+	t.NoError(cp.Set(t.newCurrencyDesignState(fcid, NewBig(99), NewTestAddress(), NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	am := NewAmount(NewBig(10), t.cid)
+	t.NoError(opr.Process(t.newTransferFrom(sa.Address, oa.Address, ra.Address, am, sa.Privs())))
+
+	var ost, fst, ast state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(oa.Address, t.cid):
+			ost = st.GetState()
+		case StateKeyBalance(oa.Address, fcid):
+			fst = st.GetState()
+		case StateKeyAllowance(oa.Address, sa.Address, t.cid):
+			ast = st.GetState()
+		}
+	}
+
+	ob, err := StateBalanceValue(ost)
+	t.NoError(err)
+	t.Equal(NewBig(23), ob.Big())
+	t.True(ost.(AmountState).Fee().IsZero())
+
+	fb, err := StateBalanceValue(fst)
+	t.NoError(err)
+	t.Equal(NewBig(6), fb.Big())
+	t.Equal(NewBig(4), fst.(AmountState).Fee())
+
+	// NOTE the fee in fee currency is not counted in allowance
+	ual, err := StateAllowanceValue(ast)
+	t.NoError(err)
+	t.True(ual.Amount().Big().IsZero())
+}
+
+func TestAllowanceOperations(t *testing.T) {
+	suite.Run(t, new(testAllowanceOperations))
+}
@@ -0,0 +1,192 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+type testAllowance struct {
+	baseTest
+}
+
+func (t *testAllowance) TestNewApprove() {
+	fact := NewApproveFact(util.UUID().Bytes(), NewTestAddress(), NewTestAddress(), NewAmount(NewBig(10), t.cid))
+	t.NoError(fact.IsValid(nil))
+
+	// NOTE zero amount revokes allowance
+	fact = NewApproveFact(util.UUID().Bytes(), NewTestAddress(), NewTestAddress(), NewAmount(ZeroBig, t.cid))
+	t.NoError(fact.IsValid(nil))
+}
+
+func (t *testAllowance) TestApproveSameSpender() {
+	sender := NewTestAddress()
+
+	fact := NewApproveFact(util.UUID().Bytes(), sender, sender, NewAmount(NewBig(10), t.cid))
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "spender is same with sender")
+}
+
+func (t *testAllowance) TestNewTransferFrom() {
+	sender := NewTestAddress()
+
+	fact := NewTransferFromFact(util.UUID().Bytes(), sender, NewTestAddress(), NewTestAddress(), NewAmount(NewBig(10), t.cid))
+	t.NoError(fact.IsValid(nil))
+
+	as, err := fact.Addresses()
+	t.NoError(err)
+	t.Equal(3, len(as))
+
+	// NOTE spender can be receiver
+	fact = NewTransferFromFact(util.UUID().Bytes(), sender, NewTestAddress(), sender, NewAmount(NewBig(10), t.cid))
+	t.NoError(fact.IsValid(nil))
+
+	as, err = fact.Addresses()
+	t.NoError(err)
+	t.Equal(2, len(as))
+}
+
+func (t *testAllowance) TestTransferFromWrongAddresses() {
+	owner := NewTestAddress()
+
+	fact := NewTransferFromFact(util.UUID().Bytes(), owner, owner, NewTestAddress(), NewAmount(NewBig(10), t.cid))
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "owner is same with sender")
+
+	fact = NewTransferFromFact(util.UUID().Bytes(), NewTestAddress(), owner, owner, NewAmount(NewBig(10), t.cid))
+	err = fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "receiver is same with owner")
+}
+
+func (t *testAllowance) TestTransferFromZeroAmount() {
+	fact := NewTransferFromFact(util.UUID().Bytes(),
+		NewTestAddress(), NewTestAddress(), NewTestAddress(), NewAmount(ZeroBig, t.cid))
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "over zero")
+}
+
+func TestAllowance(t *testing.T) {
+	suite.Run(t, new(testAllowance))
+}
+
+func testAllowanceEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		return NewAllowance(NewTestAddress(), NewTestAddress(), NewAmount(NewBig(10), CurrencyID("SHOWME")))
+	}
+
+	t.compare = func(a, b interface{}) {
+		aa := a.(Allowance)
+		ab := b.(Allowance)
+
+		t.True(aa.Hint().Equal(ab.Hint()))
+		t.True(aa.Owner().Equal(ab.Owner()))
+		t.True(aa.Spender().Equal(ab.Spender()))
+		t.True(aa.Amount().Equal(ab.Amount()))
+		t.True(aa.Hash().Equal(ab.Hash()))
+	}
+
+	return t
+}
+
+func TestAllowanceEncodeJSON(t *testing.T) {
+	suite.Run(t, testAllowanceEncode(jsonenc.NewEncoder()))
+}
+
+func TestAllowanceEncodeBSON(t *testing.T) {
+	suite.Run(t, testAllowanceEncode(bsonenc.NewEncoder()))
+}
+
+func testApproveEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.NewBasePrivatekey()
+
+		fact := NewApproveFact(util.UUID().Bytes(),
+			NewTestAddress(), NewTestAddress(), NewAmount(NewBig(10), CurrencyID("SHOWME")))
+		sig, err := base.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		op, err := NewApprove(fact, []base.FactSign{base.NewBaseFactSign(pk.Publickey(), sig)}, "")
+		t.NoError(err)
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		fact := a.(Approve).Fact().(ApproveFact)
+		ufact := b.(Approve).Fact().(ApproveFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.spender.Equal(ufact.spender))
+		t.True(fact.amount.Equal(ufact.amount))
+	}
+
+	return t
+}
+
+func TestApproveEncodeJSON(t *testing.T) {
+	suite.Run(t, testApproveEncode(jsonenc.NewEncoder()))
+}
+
+func TestApproveEncodeBSON(t *testing.T) {
+	suite.Run(t, testApproveEncode(bsonenc.NewEncoder()))
+}
+
+func testTransferFromEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.NewBasePrivatekey()
+
+		fact := NewTransferFromFact(util.UUID().Bytes(),
+			NewTestAddress(), NewTestAddress(), NewTestAddress(), NewAmount(NewBig(10), CurrencyID("SHOWME")))
+		sig, err := base.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		op, err := NewTransferFrom(fact, []base.FactSign{base.NewBaseFactSign(pk.Publickey(), sig)}, "")
+		t.NoError(err)
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		fact := a.(TransferFrom).Fact().(TransferFromFact)
+		ufact := b.(TransferFrom).Fact().(TransferFromFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.owner.Equal(ufact.owner))
+		t.True(fact.receiver.Equal(ufact.receiver))
+		t.True(fact.amount.Equal(ufact.amount))
+	}
+
+	return t
+}
+
+func TestTransferFromEncodeJSON(t *testing.T) {
+	suite.Run(t, testTransferFromEncode(jsonenc.NewEncoder()))
+}
+
+func TestTransferFromEncodeBSON(t *testing.T) {
+	suite.Run(t, testTransferFromEncode(bsonenc.NewEncoder()))
+}
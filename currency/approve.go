@@ -0,0 +1,116 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	ApproveFactType   = hint.Type("mitum-currency-approve-operation-fact")
+	ApproveFactHint   = hint.NewHint(ApproveFactType, "v0.0.1")
+	ApproveFactHinter = ApproveFact{BaseHinter: hint.NewBaseHinter(ApproveFactHint)}
+	ApproveType       = hint.Type("mitum-currency-approve-operation")
+	ApproveHint       = hint.NewHint(ApproveType, "v0.0.1")
+	ApproveHinter     = Approve{BaseOperation: operationHinter(ApproveHint)}
+)
+
+// ApproveFact sets the allowance of spender over the balance of sender. The
+// previous allowance is replaced; zero amount revokes the allowance.
+type ApproveFact struct {
+	hint.BaseHinter
+	h       valuehash.Hash
+	token   []byte
+	sender  base.Address
+	spender base.Address
+	amount  Amount
+}
+
+func NewApproveFact(token []byte, sender, spender base.Address, amount Amount) ApproveFact {
+	fact := ApproveFact{
+		BaseHinter: hint.NewBaseHinter(ApproveFactHint),
+		token:      token,
+		sender:     sender,
+		spender:    spender,
+		amount:     amount,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact ApproveFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact ApproveFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ApproveFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.spender.Bytes(),
+		fact.amount.Bytes(),
+	)
+}
+
+func (fact ApproveFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := isvalid.Check(nil, false,
+		fact.sender,
+		fact.spender,
+		fact.amount,
+	); err != nil {
+		return err
+	}
+
+	if !fact.amount.Big().OverNil() {
+		return isvalid.InvalidError.Errorf("amount should be over nil")
+	}
+
+	if fact.sender.Equal(fact.spender) {
+		return isvalid.InvalidError.Errorf("spender is same with sender, %q", fact.sender)
+	}
+
+	return nil
+}
+
+func (fact ApproveFact) Token() []byte {
+	return fact.token
+}
+
+func (fact ApproveFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ApproveFact) Spender() base.Address {
+	return fact.spender
+}
+
+func (fact ApproveFact) Amount() Amount {
+	return fact.amount
+}
+
+func (fact ApproveFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.spender}, nil
+}
+
+type Approve struct {
+	BaseOperation
+}
+
+func NewApprove(fact ApproveFact, fs []base.FactSign, memo string) (Approve, error) {
+	bo, err := NewBaseOperationFromFact(ApproveHint, fact, fs, memo)
+	if err != nil {
+		return Approve{}, err
+	}
+
+	return Approve{BaseOperation: bo}, nil
+}
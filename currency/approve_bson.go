@@ -0,0 +1,49 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact ApproveFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":    fact.h,
+				"token":   fact.token,
+				"sender":  fact.sender,
+				"spender": fact.spender,
+				"amount":  fact.amount,
+			}))
+}
+
+type ApproveFactBSONUnpacker struct {
+	H  valuehash.Bytes     `bson:"hash"`
+	TK []byte              `bson:"token"`
+	SD base.AddressDecoder `bson:"sender"`
+	SP base.AddressDecoder `bson:"spender"`
+	AM Amount              `bson:"amount"`
+}
+
+func (fact *ApproveFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ufact ApproveFactBSONUnpacker
+	if err := bson.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, ufact.SP, ufact.AM)
+}
+
+func (op *Approve) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *ApproveFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bsender,
+	bspender base.AddressDecoder,
+	am Amount,
+) error {
+	sender, err := bsender.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	spender, err := bspender.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.sender = sender
+	fact.spender = spender
+	fact.amount = am
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type ApproveFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	SD base.Address   `json:"sender"`
+	SP base.Address   `json:"spender"`
+	AM Amount         `json:"amount"`
+}
+
+func (fact ApproveFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(ApproveFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		SP:         fact.spender,
+		AM:         fact.amount,
+	})
+}
+
+type ApproveFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	SP base.AddressDecoder `json:"spender"`
+	AM Amount              `json:"amount"`
+}
+
+func (fact *ApproveFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact ApproveFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, ufact.SP, ufact.AM)
+}
+
+func (op *Approve) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package currency
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var approveProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ApproveProcessor)
+	},
+}
+
+func (Approve) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type ApproveProcessor struct {
+	cp *CurrencyPool
+	Approve
+	as  state.State
+	si  AccountIndexState
+	sb  AmountState
+	fee feePayment
+}
+
+func NewApproveProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(Approve)
+		if !ok {
+			return nil, errors.Errorf("not Approve, %T", op)
+		}
+
+		opp := approveProcessorPool.Get().(*ApproveProcessor)
+
+		opp.cp = cp
+		opp.Approve = i
+		opp.as = nil
+		opp.si = AccountIndexState{}
+		opp.sb = AmountState{}
+		opp.fee = feePayment{}
+
+		return opp, nil
+	}
+}
+
+func (opp *ApproveProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(ApproveFact)
+
+	if err := checkExistsState(StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkActiveAccountState(fact.sender, getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, errors.Wrap(err, "invalid signing")
+	}
+
+	if _, err := existsState(StateKeyAccount(fact.spender), "spender", getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkNotClosedState(fact.spender, getState); err != nil {
+		return nil, err
+	}
+
+	cid := fact.amount.Currency()
+	policy, found := opp.cp.Policy(cid)
+	if !found {
+		return nil, operation.NewBaseReasonError("currency, %q not found of Approve", cid)
+	}
+
+	as, _, err := getState(StateKeyAllowance(fact.sender, fact.spender, cid))
+	if err != nil {
+		return nil, err
+	}
+
+	si, err := loadAccountIndexState(fact.sender, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := existsState(StateKeyBalance(fact.sender, cid), "balance of sender", getState)
+	if err != nil {
+		return nil, err
+	}
+	sb := NewAmountState(st, cid)
+
+	fee, err := loadFeePayment(opp.cp, policy, fact.sender, cid, ZeroBig, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	switch b, e := StateBalanceValue(sb); {
+	case e != nil:
+		return nil, operation.NewBaseReasonErrorFromError(e)
+	case b.Big().Compare(fee.required(ZeroBig)) < 0:
+		return nil, operation.NewBaseReasonError("insufficient balance with fee")
+	}
+
+	opp.as = as
+	opp.si = si
+	opp.sb = sb
+	opp.fee = fee
+
+	return opp, nil
+}
+
+func (opp *ApproveProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(ApproveFact)
+
+	as, err := SetStateAllowanceValue(opp.as, NewAllowance(fact.sender, fact.spender, fact.amount))
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	// NOTE the allowances in account index are revoked by AccountMerge
+	si := opp.si.Add(as.Key())
+	if !fact.amount.Big().OverZero() {
+		si = opp.si.Remove(as.Key())
+	}
+
+	return setState(fact.Hash(), append([]state.State{as, si}, opp.fee.pay(opp.sb, ZeroBig)...)...)
+}
+
+func (opp *ApproveProcessor) Close() error {
+	opp.cp = nil
+	opp.Approve = Approve{}
+	opp.as = nil
+	opp.si = AccountIndexState{}
+	opp.sb = AmountState{}
+	opp.fee = feePayment{}
+
+	approveProcessorPool.Put(opp)
+
+	return nil
+}
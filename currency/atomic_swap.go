@@ -0,0 +1,139 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	AtomicSwapFactType   = hint.Type("mitum-currency-atomic-swap-operation-fact")
+	AtomicSwapFactHint   = hint.NewHint(AtomicSwapFactType, "v0.0.1")
+	AtomicSwapFactHinter = AtomicSwapFact{BaseHinter: hint.NewBaseHinter(AtomicSwapFactHint)}
+	AtomicSwapType       = hint.Type("mitum-currency-atomic-swap-operation")
+	AtomicSwapHint       = hint.NewHint(AtomicSwapType, "v0.0.1")
+	AtomicSwapHinter     = AtomicSwap{BaseOperation: operationHinter(AtomicSwapHint)}
+)
+
+// AtomicSwapFact exchanges the currencies between sender and counterparty;
+// sender pays amount to counterparty and counterparty pays counterAmount to
+// sender. Both legs are applied or neither.
+type AtomicSwapFact struct {
+	hint.BaseHinter
+	h             valuehash.Hash
+	token         []byte
+	sender        base.Address
+	amount        Amount
+	counterparty  base.Address
+	counterAmount Amount
+}
+
+func NewAtomicSwapFact(
+	token []byte,
+	sender base.Address,
+	amount Amount,
+	counterparty base.Address,
+	counterAmount Amount,
+) AtomicSwapFact {
+	fact := AtomicSwapFact{
+		BaseHinter:    hint.NewBaseHinter(AtomicSwapFactHint),
+		token:         token,
+		sender:        sender,
+		amount:        amount,
+		counterparty:  counterparty,
+		counterAmount: counterAmount,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact AtomicSwapFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact AtomicSwapFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AtomicSwapFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.amount.Bytes(),
+		fact.counterparty.Bytes(),
+		fact.counterAmount.Bytes(),
+	)
+}
+
+func (fact AtomicSwapFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := isvalid.Check(nil, false,
+		fact.sender,
+		fact.amount,
+		fact.counterparty,
+		fact.counterAmount,
+	); err != nil {
+		return err
+	}
+
+	if !fact.amount.Big().OverZero() || !fact.counterAmount.Big().OverZero() {
+		return isvalid.InvalidError.Errorf("amount should be over zero")
+	}
+
+	if fact.sender.Equal(fact.counterparty) {
+		return isvalid.InvalidError.Errorf("counterparty is same with sender, %q", fact.sender)
+	}
+
+	if fact.amount.Currency() == fact.counterAmount.Currency() {
+		return isvalid.InvalidError.Errorf("same currency, %q can not be swapped", fact.amount.Currency())
+	}
+
+	return nil
+}
+
+func (fact AtomicSwapFact) Token() []byte {
+	return fact.token
+}
+
+func (fact AtomicSwapFact) Sender() base.Address {
+	return fact.sender
+}
+
+// Amount is paid by sender to counterparty.
+func (fact AtomicSwapFact) Amount() Amount {
+	return fact.amount
+}
+
+func (fact AtomicSwapFact) Counterparty() base.Address {
+	return fact.counterparty
+}
+
+// CounterAmount is paid by counterparty to sender.
+func (fact AtomicSwapFact) CounterAmount() Amount {
+	return fact.counterAmount
+}
+
+func (fact AtomicSwapFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.counterparty}, nil
+}
+
+// AtomicSwap should be signed by the keys of sender and counterparty; the
+// signs should pass the thresholds of both accounts.
+type AtomicSwap struct {
+	BaseOperation
+}
+
+func NewAtomicSwap(fact AtomicSwapFact, fs []base.FactSign, memo string) (AtomicSwap, error) {
+	bo, err := NewBaseOperationFromFact(AtomicSwapHint, fact, fs, memo)
+	if err != nil {
+		return AtomicSwap{}, err
+	}
+
+	return AtomicSwap{BaseOperation: bo}, nil
+}
@@ -0,0 +1,51 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact AtomicSwapFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":           fact.h,
+				"token":          fact.token,
+				"sender":         fact.sender,
+				"amount":         fact.amount,
+				"counterparty":   fact.counterparty,
+				"counter_amount": fact.counterAmount,
+			}))
+}
+
+type AtomicSwapFactBSONUnpacker struct {
+	H  valuehash.Bytes     `bson:"hash"`
+	TK []byte              `bson:"token"`
+	SD base.AddressDecoder `bson:"sender"`
+	AM Amount              `bson:"amount"`
+	CP base.AddressDecoder `bson:"counterparty"`
+	CA Amount              `bson:"counter_amount"`
+}
+
+func (fact *AtomicSwapFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ufact AtomicSwapFactBSONUnpacker
+	if err := bson.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, ufact.AM, ufact.CP, ufact.CA)
+}
+
+func (op *AtomicSwap) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *AtomicSwapFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bsender base.AddressDecoder,
+	am Amount,
+	bcounterparty base.AddressDecoder,
+	cam Amount,
+) error {
+	sender, err := bsender.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	counterparty, err := bcounterparty.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.sender = sender
+	fact.amount = am
+	fact.counterparty = counterparty
+	fact.counterAmount = cam
+
+	return nil
+}
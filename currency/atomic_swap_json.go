@@ -0,0 +1,58 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type AtomicSwapFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	SD base.Address   `json:"sender"`
+	AM Amount         `json:"amount"`
+	CP base.Address   `json:"counterparty"`
+	CA Amount         `json:"counter_amount"`
+}
+
+func (fact AtomicSwapFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(AtomicSwapFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		AM:         fact.amount,
+		CP:         fact.counterparty,
+		CA:         fact.counterAmount,
+	})
+}
+
+type AtomicSwapFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	AM Amount              `json:"amount"`
+	CP base.AddressDecoder `json:"counterparty"`
+	CA Amount              `json:"counter_amount"`
+}
+
+func (fact *AtomicSwapFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact AtomicSwapFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, ufact.AM, ufact.CP, ufact.CA)
+}
+
+func (op *AtomicSwap) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
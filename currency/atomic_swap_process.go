@@ -0,0 +1,176 @@
+package currency
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var atomicSwapProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AtomicSwapProcessor)
+	},
+}
+
+func (AtomicSwap) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+// AtomicSwapProcessor charges the fee of each leg to the payer of the leg by
+// the CurrencyPolicy of the currency of the leg. Both legs are checked in
+// PreProcess, so both legs are applied or neither.
+type AtomicSwapProcessor struct {
+	cp *CurrencyPool
+	AtomicSwap
+	sb  AmountState // NOTE balance of sender by amount
+	cb  AmountState // NOTE balance of counterparty by counter amount
+	sr  AmountState // NOTE balance of sender by counter amount
+	cr  AmountState // NOTE balance of counterparty by amount
+	fee feePayment
+	cfe feePayment
+}
+
+func NewAtomicSwapProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(AtomicSwap)
+		if !ok {
+			return nil, errors.Errorf("not AtomicSwap, %T", op)
+		}
+
+		opp := atomicSwapProcessorPool.Get().(*AtomicSwapProcessor)
+
+		opp.cp = cp
+		opp.AtomicSwap = i
+		opp.sb = AmountState{}
+		opp.cb = AmountState{}
+		opp.sr = AmountState{}
+		opp.cr = AmountState{}
+		opp.fee = feePayment{}
+		opp.cfe = feePayment{}
+
+		return opp, nil
+	}
+}
+
+func (opp *AtomicSwapProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(AtomicSwapFact)
+
+	for _, a := range []base.Address{fact.sender, fact.counterparty} {
+		if err := checkExistsState(StateKeyAccount(a), getState); err != nil {
+			return nil, err
+		}
+
+		if err := checkActiveAccountState(a, getState); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := checkFactSignsByStates(
+		[]base.Address{fact.sender, fact.counterparty}, opp.Signs(), getState,
+	); err != nil {
+		return nil, errors.Wrap(err, "invalid signing")
+	}
+
+	sb, fee, err := opp.prepareLeg(fact.sender, fact.amount, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	cb, cfe, err := opp.prepareLeg(fact.counterparty, fact.counterAmount, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	st, _, err := getState(StateKeyBalance(fact.sender, fact.counterAmount.Currency()))
+	if err != nil {
+		return nil, err
+	}
+	opp.sr = NewAmountState(st, fact.counterAmount.Currency())
+
+	st, _, err = getState(StateKeyBalance(fact.counterparty, fact.amount.Currency()))
+	if err != nil {
+		return nil, err
+	}
+	opp.cr = NewAmountState(st, fact.amount.Currency())
+
+	opp.sb = sb
+	opp.cb = cb
+	opp.fee = fee
+	opp.cfe = cfe
+
+	return opp, nil
+}
+
+func (opp *AtomicSwapProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(AtomicSwapFact)
+
+	sts := opp.fee.pay(opp.sb, fact.amount.Big())
+	sts = append(sts, opp.cfe.pay(opp.cb, fact.counterAmount.Big())...)
+
+	return setState(
+		fact.Hash(),
+		append(sts, opp.sr.Add(fact.counterAmount.Big()), opp.cr.Add(fact.amount.Big()))...,
+	)
+}
+
+func (opp *AtomicSwapProcessor) Close() error {
+	opp.cp = nil
+	opp.AtomicSwap = AtomicSwap{}
+	opp.sb = AmountState{}
+	opp.cb = AmountState{}
+	opp.sr = AmountState{}
+	opp.cr = AmountState{}
+	opp.fee = feePayment{}
+	opp.cfe = feePayment{}
+
+	atomicSwapProcessorPool.Put(opp)
+
+	return nil
+}
+
+// prepareLeg checks the balance of payer for the amount and the fee of the leg.
+func (opp *AtomicSwapProcessor) prepareLeg(
+	payer base.Address,
+	am Amount,
+	getState func(string) (state.State, bool, error),
+) (AmountState, feePayment, error) {
+	cid := am.Currency()
+	policy, found := opp.cp.Policy(cid)
+	if !found {
+		return AmountState{}, feePayment{}, operation.NewBaseReasonError("currency, %q not found of AtomicSwap", cid)
+	}
+
+	fee, err := loadFeePayment(opp.cp, policy, payer, cid, am.Big(), getState)
+	if err != nil {
+		return AmountState{}, feePayment{}, err
+	}
+
+	st, err := existsState(StateKeyBalance(payer, cid), "balance of "+payer.String(), getState)
+	if err != nil {
+		return AmountState{}, feePayment{}, err
+	}
+	ab := NewAmountState(st, cid)
+
+	switch b, e := StateBalanceValue(ab); {
+	case e != nil:
+		return AmountState{}, feePayment{}, operation.NewBaseReasonErrorFromError(e)
+	case b.Big().Compare(fee.required(am.Big())) < 0:
+		return AmountState{}, feePayment{}, operation.NewBaseReasonError("insufficient balance of %q with fee", payer)
+	}
+
+	return ab, fee, nil
+}
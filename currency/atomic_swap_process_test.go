@@ -0,0 +1,248 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testAtomicSwapOperation struct {
+	baseTestOperationProcessor
+	ocid CurrencyID
+}
+
+func (t *testAtomicSwapOperation) SetupSuite() {
+	t.baseTestOperationProcessor.SetupSuite()
+
+	t.ocid = CurrencyID("FINDME")
+}
+
+func (t *testAtomicSwapOperation) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr := NewOperationProcessor(cp)
+
+	_, err := copr.SetProcessor(AtomicSwapHinter, NewAtomicSwapProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testAtomicSwapOperation) newOperation(
+	sender base.Address, am Amount,
+	counterparty base.Address, cam Amount,
+	pks []key.Privatekey,
+) AtomicSwap {
+	fact := NewAtomicSwapFact(util.UUID().Bytes(), sender, am, counterparty, cam)
+
+	fs := make([]base.FactSign, len(pks))
+	for i := range pks {
+		sig, err := base.NewFactSignature(pks[i], fact, nil)
+		t.NoError(err)
+
+		fs[i] = base.NewBaseFactSign(pks[i].Publickey(), sig)
+	}
+
+	op, err := NewAtomicSwap(fact, fs, "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testAtomicSwapOperation) currencyPool(sfee, cfee Big, feeReceiver base.Address) *CurrencyPool {
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(feeReceiver, sfee))))
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.ocid, NewBig(99), NewTestAddress(), NewFixedFeeer(feeReceiver, cfee))))
+
+	return cp
+}
+
+func (t *testAtomicSwapOperation) TestSwap() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ca, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(44), t.ocid)})
+	fa, st2 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1, st2)
+
+	opr := t.processor(t.currencyPool(NewBig(1), NewBig(2), fa.Address), pool)
+
+	op := t.newOperation(
+		sa.Address, NewAmount(NewBig(10), t.cid),
+		ca.Address, NewAmount(NewBig(20), t.ocid),
+		append(sa.Privs(), ca.Privs()...),
+	)
+
+	t.NoError(opr.Process(op))
+
+	balances := map[string]Big{}
+	for _, st := range pool.Updates() {
+		if !IsStateBalanceKey(st.Key()) {
+			continue
+		}
+
+		am, err := StateBalanceValue(st.GetState())
+		t.NoError(err)
+
+		balances[st.Key()] = am.Big()
+	}
+
+	t.Equal(4, len(balances))
+	t.True(NewBig(33 - 10 - 1).Equal(balances[StateKeyBalance(sa.Address, t.cid)]))
+	t.True(NewBig(20).Equal(balances[StateKeyBalance(sa.Address, t.ocid)]))
+	t.True(NewBig(44 - 20 - 2).Equal(balances[StateKeyBalance(ca.Address, t.ocid)]))
+	t.True(NewBig(10).Equal(balances[StateKeyBalance(ca.Address, t.cid)]))
+}
+
+func (t *testAtomicSwapOperation) TestNotSignedByCounterparty() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ca, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(44), t.ocid)})
+
+	pool, _ := t.statepool(st0, st1)
+
+	opr := t.processor(t.currencyPool(ZeroBig, ZeroBig, sa.Address), pool)
+
+	op := t.newOperation(
+		sa.Address, NewAmount(NewBig(10), t.cid),
+		ca.Address, NewAmount(NewBig(20), t.ocid),
+		sa.Privs(),
+	)
+
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "not passed threshold")
+	t.Empty(pool.Updates())
+}
+
+func (t *testAtomicSwapOperation) TestUnknownSigner() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ca, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(44), t.ocid)})
+
+	pool, _ := t.statepool(st0, st1)
+
+	opr := t.processor(t.currencyPool(ZeroBig, ZeroBig, sa.Address), pool)
+
+	op := t.newOperation(
+		sa.Address, NewAmount(NewBig(10), t.cid),
+		ca.Address, NewAmount(NewBig(20), t.ocid),
+		[]key.Privatekey{sa.Priv, ca.Priv, key.NewBasePrivatekey()},
+	)
+
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "unknown key found")
+}
+
+func (t *testAtomicSwapOperation) TestInsufficientCounterparty() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ca, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(21), t.ocid)})
+
+	pool, _ := t.statepool(st0, st1)
+
+	opr := t.processor(t.currencyPool(NewBig(1), NewBig(2), sa.Address), pool)
+
+	op := t.newOperation(
+		sa.Address, NewAmount(NewBig(10), t.cid),
+		ca.Address, NewAmount(NewBig(20), t.ocid),
+		append(sa.Privs(), ca.Privs()...),
+	)
+
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "insufficient balance")
+
+	// NOTE neither leg is applied
+	t.Empty(pool.Updates())
+}
+
+func (t *testAtomicSwapOperation) TestFrozenCounterparty() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ca, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(44), t.ocid)})
+	st1 = t.freezeAccountState(ca.Address, st1)
+
+	pool, _ := t.statepool(st0, st1)
+
+	opr := t.processor(t.currencyPool(ZeroBig, ZeroBig, sa.Address), pool)
+
+	op := t.newOperation(
+		sa.Address, NewAmount(NewBig(10), t.cid),
+		ca.Address, NewAmount(NewBig(20), t.ocid),
+		append(sa.Privs(), ca.Privs()...),
+	)
+
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "frozen")
+}
+
+func (t *testAtomicSwapOperation) TestCounterpartyAlreadyInProposal() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ca, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(44), t.ocid)})
+	ra, st2 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1, st2)
+
+	cp := t.currencyPool(ZeroBig, ZeroBig, sa.Address)
+	copr := NewOperationProcessor(cp)
+	_, err := copr.SetProcessor(TransfersHinter, NewTransfersProcessor(cp))
+	t.NoError(err)
+	_, err = copr.SetProcessor(AtomicSwapHinter, NewAtomicSwapProcessor(cp))
+	t.NoError(err)
+	opr := copr.New(pool)
+
+	// NOTE counterparty sends in same proposal
+	tfact := NewTransfersFact(util.UUID().Bytes(), ca.Address, []TransfersItem{
+		NewTransfersItemSingleAmount(ra.Address, NewAmount(NewBig(1), t.ocid)),
+	})
+	sig, err := base.NewFactSignature(ca.Priv, tfact, nil)
+	t.NoError(err)
+	tf, err := NewTransfers(tfact, []base.FactSign{base.NewBaseFactSign(ca.Priv.Publickey(), sig)}, "")
+	t.NoError(err)
+
+	t.NoError(opr.Process(tf))
+
+	op := t.newOperation(
+		sa.Address, NewAmount(NewBig(10), t.cid),
+		ca.Address, NewAmount(NewBig(20), t.ocid),
+		append(sa.Privs(), ca.Privs()...),
+	)
+
+	err = opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "violates only one sender")
+
+	// NOTE sender is not marked by the failed swap
+	sfact := NewTransfersFact(util.UUID().Bytes(), sa.Address, []TransfersItem{
+		NewTransfersItemSingleAmount(ra.Address, NewAmount(NewBig(1), t.cid)),
+	})
+	sig, err = base.NewFactSignature(sa.Priv, sfact, nil)
+	t.NoError(err)
+	sf, err := NewTransfers(sfact, []base.FactSign{base.NewBaseFactSign(sa.Priv.Publickey(), sig)}, "")
+	t.NoError(err)
+
+	t.NoError(opr.Process(sf))
+}
+
+func TestAtomicSwapOperation(t *testing.T) {
+	suite.Run(t, new(testAtomicSwapOperation))
+}
@@ -0,0 +1,109 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+type testAtomicSwap struct {
+	baseTest
+}
+
+func (t *testAtomicSwap) TestNew() {
+	fact := NewAtomicSwapFact(util.UUID().Bytes(),
+		NewTestAddress(), NewAmount(NewBig(10), t.cid), NewTestAddress(), NewAmount(NewBig(3), CurrencyID("FINDME")))
+	t.NoError(fact.IsValid(nil))
+
+	as, err := fact.Addresses()
+	t.NoError(err)
+	t.Equal(2, len(as))
+}
+
+func (t *testAtomicSwap) TestSameAddresses() {
+	sender := NewTestAddress()
+
+	fact := NewAtomicSwapFact(util.UUID().Bytes(),
+		sender, NewAmount(NewBig(10), t.cid), sender, NewAmount(NewBig(3), CurrencyID("FINDME")))
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "counterparty is same with sender")
+}
+
+func (t *testAtomicSwap) TestSameCurrency() {
+	fact := NewAtomicSwapFact(util.UUID().Bytes(),
+		NewTestAddress(), NewAmount(NewBig(10), t.cid), NewTestAddress(), NewAmount(NewBig(3), t.cid))
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "same currency")
+}
+
+func (t *testAtomicSwap) TestZeroAmount() {
+	fact := NewAtomicSwapFact(util.UUID().Bytes(),
+		NewTestAddress(), NewAmount(NewBig(10), t.cid), NewTestAddress(), NewAmount(ZeroBig, CurrencyID("FINDME")))
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "over zero")
+}
+
+func TestAtomicSwap(t *testing.T) {
+	suite.Run(t, new(testAtomicSwap))
+}
+
+func testAtomicSwapEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		spk := key.NewBasePrivatekey()
+		cpk := key.NewBasePrivatekey()
+
+		fact := NewAtomicSwapFact(util.UUID().Bytes(),
+			NewTestAddress(), NewAmount(NewBig(10), CurrencyID("SHOWME")),
+			NewTestAddress(), NewAmount(NewBig(3), CurrencyID("FINDME")),
+		)
+
+		fs := make([]base.FactSign, 2)
+		for i, pk := range []key.Privatekey{spk, cpk} {
+			sig, err := base.NewFactSignature(pk, fact, nil)
+			t.NoError(err)
+
+			fs[i] = base.NewBaseFactSign(pk.Publickey(), sig)
+		}
+
+		op, err := NewAtomicSwap(fact, fs, "")
+		t.NoError(err)
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		fact := a.(AtomicSwap).Fact().(AtomicSwapFact)
+		ufact := b.(AtomicSwap).Fact().(AtomicSwapFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.amount.Equal(ufact.amount))
+		t.True(fact.counterparty.Equal(ufact.counterparty))
+		t.True(fact.counterAmount.Equal(ufact.counterAmount))
+	}
+
+	return t
+}
+
+func TestAtomicSwapEncodeJSON(t *testing.T) {
+	suite.Run(t, testAtomicSwapEncode(jsonenc.NewEncoder()))
+}
+
+func TestAtomicSwapEncodeBSON(t *testing.T) {
+	suite.Run(t, testAtomicSwapEncode(bsonenc.NewEncoder()))
+}
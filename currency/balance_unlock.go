@@ -0,0 +1,96 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	BalanceUnlockFactType   = hint.Type("mitum-currency-balance-unlock-operation-fact")
+	BalanceUnlockFactHint   = hint.NewHint(BalanceUnlockFactType, "v0.0.1")
+	BalanceUnlockFactHinter = BalanceUnlockFact{BaseHinter: hint.NewBaseHinter(BalanceUnlockFactHint)}
+	BalanceUnlockType       = hint.Type("mitum-currency-balance-unlock-operation")
+	BalanceUnlockHint       = hint.NewHint(BalanceUnlockType, "v0.0.1")
+	BalanceUnlockHinter     = BalanceUnlock{BaseOperation: operationHinter(BalanceUnlockHint)}
+)
+
+// BalanceUnlockFact moves the locked balance of sender, which reached the
+// unlock height, into the spendable balance.
+type BalanceUnlockFact struct {
+	hint.BaseHinter
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	currency CurrencyID
+}
+
+func NewBalanceUnlockFact(token []byte, sender base.Address, currency CurrencyID) BalanceUnlockFact {
+	fact := BalanceUnlockFact{
+		BaseHinter: hint.NewBaseHinter(BalanceUnlockFactHint),
+		token:      token,
+		sender:     sender,
+		currency:   currency,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact BalanceUnlockFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact BalanceUnlockFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact BalanceUnlockFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact BalanceUnlockFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return isvalid.Check(nil, false,
+		fact.sender,
+		fact.currency,
+	)
+}
+
+func (fact BalanceUnlockFact) Token() []byte {
+	return fact.token
+}
+
+func (fact BalanceUnlockFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact BalanceUnlockFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact BalanceUnlockFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type BalanceUnlock struct {
+	BaseOperation
+}
+
+func NewBalanceUnlock(fact BalanceUnlockFact, fs []base.FactSign, memo string) (BalanceUnlock, error) {
+	bo, err := NewBaseOperationFromFact(BalanceUnlockHint, fact, fs, memo)
+	if err != nil {
+		return BalanceUnlock{}, err
+	}
+
+	return BalanceUnlock{BaseOperation: bo}, nil
+}
@@ -0,0 +1,27 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *BalanceUnlockFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bsender base.AddressDecoder,
+	cr string,
+) error {
+	sender, err := bsender.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.sender = sender
+	fact.currency = CurrencyID(cr)
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type BalanceUnlockFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	SD base.Address   `json:"sender"`
+	CR CurrencyID     `json:"currency"`
+}
+
+func (fact BalanceUnlockFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(BalanceUnlockFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		CR:         fact.currency,
+	})
+}
+
+type BalanceUnlockFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	CR string              `json:"currency"`
+}
+
+func (fact *BalanceUnlockFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact BalanceUnlockFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, ufact.CR)
+}
+
+func (op *BalanceUnlock) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package currency
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var balanceUnlockProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(BalanceUnlockProcessor)
+	},
+}
+
+func (BalanceUnlock) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type BalanceUnlockProcessor struct {
+	cp *CurrencyPool
+	BalanceUnlock
+	height   base.Height
+	lb       LockedAmountState
+	sb       AmountState
+	unlocked Big
+	fee      feePayment
+}
+
+func NewBalanceUnlockProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(BalanceUnlock)
+		if !ok {
+			return nil, errors.Errorf("not BalanceUnlock, %T", op)
+		}
+
+		opp := balanceUnlockProcessorPool.Get().(*BalanceUnlockProcessor)
+
+		opp.cp = cp
+		opp.BalanceUnlock = i
+		opp.height = base.NilHeight
+		opp.lb = LockedAmountState{}
+		opp.sb = AmountState{}
+		opp.unlocked = ZeroBig
+		opp.fee = feePayment{}
+
+		return opp, nil
+	}
+}
+
+func (opp *BalanceUnlockProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(BalanceUnlockFact)
+
+	if err := checkExistsState(StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkActiveAccountState(fact.sender, getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, errors.Wrap(err, "invalid signing")
+	}
+
+	policy, found := opp.cp.Policy(fact.currency)
+	if !found {
+		return nil, operation.NewBaseReasonError("currency, %q not found of BalanceUnlock", fact.currency)
+	}
+
+	st, err := existsState(StateKeyLockedBalance(fact.sender, fact.currency), "locked balance", getState)
+	if err != nil {
+		return nil, err
+	}
+
+	lb, unlocked, err := NewLockedAmountState(st, fact.currency).Unlock(opp.height)
+	switch {
+	case err != nil:
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	case !unlocked.OverZero():
+		return nil, operation.NewBaseReasonError("nothing to unlock at height, %v", opp.height)
+	}
+
+	st, _, err = getState(StateKeyBalance(fact.sender, fact.currency))
+	if err != nil {
+		return nil, err
+	}
+	sb := NewAmountState(st, fact.currency)
+
+	fee, err := loadFeePayment(opp.cp, policy, fact.sender, fact.currency, ZeroBig, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := unlocked
+	if b, e := StateBalanceValue(sb); e == nil {
+		balance = balance.Add(b.Big())
+	}
+
+	if balance.Compare(fee.required(ZeroBig)) < 0 {
+		return nil, operation.NewBaseReasonError("insufficient balance with fee")
+	}
+
+	opp.lb = lb
+	opp.sb = sb
+	opp.unlocked = unlocked
+	opp.fee = fee
+
+	return opp, nil
+}
+
+func (opp *BalanceUnlockProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(BalanceUnlockFact)
+
+	return setState(fact.Hash(), append([]state.State{opp.lb}, opp.fee.pay(opp.sb.Add(opp.unlocked), ZeroBig)...)...)
+}
+
+func (opp *BalanceUnlockProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *BalanceUnlockProcessor) Close() error {
+	opp.cp = nil
+	opp.BalanceUnlock = BalanceUnlock{}
+	opp.height = base.NilHeight
+	opp.lb = LockedAmountState{}
+	opp.sb = AmountState{}
+	opp.unlocked = ZeroBig
+	opp.fee = feePayment{}
+
+	balanceUnlockProcessorPool.Put(opp)
+
+	return nil
+}
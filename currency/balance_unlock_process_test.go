@@ -0,0 +1,194 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testBalanceUnlockOperation struct {
+	baseTestOperationProcessor
+}
+
+func (t *testBalanceUnlockOperation) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr, err := NewOperationProcessor(cp).
+		SetProcessor(BalanceUnlockHinter, NewBalanceUnlockProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testBalanceUnlockOperation) newOperation(sender base.Address, pks []key.Privatekey, cid CurrencyID) BalanceUnlock {
+	fact := NewBalanceUnlockFact(util.UUID().Bytes(), sender, cid)
+
+	var fs []base.FactSign
+	for _, pk := range pks {
+		sig, err := base.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, base.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewBalanceUnlock(fact, fs, "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testBalanceUnlockOperation) TestNew() {
+	am := NewAmount(NewBig(3), t.cid)
+	sa, sts := t.newAccount(true, []Amount{am})
+
+	la := NewLockedAmount(t.cid, []AmountLock{
+		NewAmountLock(NewBig(10), base.GenesisHeight),
+		NewAmountLock(NewBig(20), base.Height(30)),
+	})
+
+	fa, fsts := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(sts, fsts, []state.State{t.newStateLockedAmount(sa.Address, la)})
+
+	fee := NewBig(1)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, fee))))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newOperation(sa.Address, sa.Privs(), t.cid)))
+	t.NoError(opr.Close())
+
+	var sst, lst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyLockedBalance(sa.Address, t.cid):
+			lst = st.GetState()
+		}
+	}
+
+	sb, err := StateBalanceValue(sst)
+	t.NoError(err)
+	t.True(am.Big().Add(NewBig(10)).Sub(fee).Equal(sb.Big()))
+	t.True(sst.(AmountState).Fee().Equal(fee))
+
+	lb, err := StateLockedBalanceValue(lst)
+	t.NoError(err)
+	t.Equal(1, len(lb.Locks()))
+	t.Equal(base.Height(30), lb.Locks()[0].Height())
+	t.True(NewBig(20).Equal(lb.Total()))
+}
+
+func (t *testBalanceUnlockOperation) TestFeeCurrency() {
+	fcid := CurrencyID("FEE")
+
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid), NewAmount(NewBig(10), fcid)})
+
+	la := NewLockedAmount(t.cid, []AmountLock{NewAmountLock(NewBig(10), base.GenesisHeight)})
+
+	pool, _ := t.statepool(sts, []state.State{t.newStateLockedAmount(sa.Address, la)})
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(sa.Address, NewBig(4))).SetFeeCurrency(fcid, 0)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignStateWithPolicy(t.cid, NewBig(99), NewTestAddress(), po)))
+	t.NoError(cp.Set(t.newCurrencyDesignState(fcid, NewBig(99), NewTestAddress(), NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newOperation(sa.Address, sa.Privs(), t.cid)))
+
+	var sst, fst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyBalance(sa.Address, fcid):
+			fst = st.GetState()
+		}
+	}
+
+	sb, err := StateBalanceValue(sst)
+	t.NoError(err)
+	t.Equal(NewBig(13), sb.Big())
+	t.True(sst.(AmountState).Fee().IsZero())
+
+	fb, err := StateBalanceValue(fst)
+	t.NoError(err)
+	t.Equal(NewBig(6), fb.Big())
+	t.Equal(NewBig(4), fst.(AmountState).Fee())
+}
+
+func (t *testBalanceUnlockOperation) TestNothingToUnlock() {
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+
+	la := NewLockedAmount(t.cid, []AmountLock{NewAmountLock(NewBig(20), base.Height(30))})
+
+	pool, _ := t.statepool(sts, []state.State{t.newStateLockedAmount(sa.Address, la)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newOperation(sa.Address, sa.Privs(), t.cid))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "nothing to unlock")
+}
+
+func (t *testBalanceUnlockOperation) TestLockedBalanceNotExist() {
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid)})
+
+	pool, _ := t.statepool(sts)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newOperation(sa.Address, sa.Privs(), t.cid))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "locked balance")
+}
+
+func (t *testBalanceUnlockOperation) TestInsufficientBalanceWithFee() {
+	sa, sts := t.newAccount(true, nil)
+
+	la := NewLockedAmount(t.cid, []AmountLock{NewAmountLock(NewBig(2), base.GenesisHeight)})
+
+	pool, _ := t.statepool(sts, []state.State{t.newStateLockedAmount(sa.Address, la)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, NewBig(3)))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newOperation(sa.Address, sa.Privs(), t.cid))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "insufficient balance with fee")
+}
+
+func TestBalanceUnlockOperation(t *testing.T) {
+	suite.Run(t, new(testBalanceUnlockOperation))
+}
@@ -0,0 +1,84 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type testBalanceUnlock struct {
+	baseTest
+}
+
+func (t *testBalanceUnlock) TestNew() {
+	spk := key.NewBasePrivatekey()
+
+	fact := NewBalanceUnlockFact(util.UUID().Bytes(), NewTestAddress(), t.cid)
+	sig, err := base.NewFactSignature(spk, fact, nil)
+	t.NoError(err)
+	fs := []base.FactSign{base.NewBaseFactSign(spk.Publickey(), sig)}
+
+	op, err := NewBalanceUnlock(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	t.Implements((*base.Fact)(nil), op.Fact())
+	t.Implements((*operation.Operation)(nil), op)
+}
+
+func TestBalanceUnlock(t *testing.T) {
+	suite.Run(t, new(testBalanceUnlock))
+}
+
+func testBalanceUnlockEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		spk := key.NewBasePrivatekey()
+
+		fact := NewBalanceUnlockFact(util.UUID().Bytes(), NewTestAddress(), CurrencyID("SEEME"))
+		sig, err := base.NewFactSignature(spk, fact, nil)
+		t.NoError(err)
+		fs := []base.FactSign{base.NewBaseFactSign(spk.Publickey(), sig)}
+
+		op, err := NewBalanceUnlock(fact, fs, "")
+		t.NoError(err)
+
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ca := a.(BalanceUnlock)
+		cb := b.(BalanceUnlock)
+
+		t.Equal(ca.Memo, cb.Memo)
+
+		fact := ca.Fact().(BalanceUnlockFact)
+		ufact := cb.Fact().(BalanceUnlockFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.Equal(fact.currency, ufact.currency)
+	}
+
+	return t
+}
+
+func TestBalanceUnlockEncodeJSON(t *testing.T) {
+	suite.Run(t, testBalanceUnlockEncode(jsonenc.NewEncoder()))
+}
+
+func TestBalanceUnlockEncodeBSON(t *testing.T) {
+	suite.Run(t, testBalanceUnlockEncode(bsonenc.NewEncoder()))
+}
@@ -2,6 +2,7 @@ package currency
 
 import (
 	"math/big"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -40,6 +41,31 @@ func MustBigFromString(s string) Big {
 	panic(errors.Errorf("not proper Big string, %q", s))
 }
 
+// NewBigFromDecimalString converts the decimal string, like "12.5" to Big with
+// the given decimals; "12.5" with 3 decimals becomes 12500. The fractional
+// part longer than decimals can not be converted exactly, so it returns error.
+func NewBigFromDecimalString(s string, decimals uint) (Big, error) {
+	var sign string
+	n := s
+	if strings.HasPrefix(n, "-") {
+		sign, n = "-", n[1:]
+	}
+
+	i, f := n, ""
+	if j := strings.Index(n, "."); j >= 0 {
+		i, f = n[:j], n[j+1:]
+	}
+
+	switch {
+	case len(i) < 1 || !isDigits(i) || !isDigits(f):
+		return Big{}, errors.Errorf("not proper decimal string, %q", s)
+	case uint(len(f)) > decimals:
+		return Big{}, errors.Errorf("too many decimal places, %q; decimals is %d", s, decimals)
+	}
+
+	return NewBigFromString(sign + i + f + strings.Repeat("0", int(decimals)-len(f)))
+}
+
 func NewBigFromInterface(a interface{}) (Big, error) {
 	switch t := a.(type) {
 	case int:
@@ -76,6 +102,31 @@ func (a Big) String() string {
 	return a.Int.String()
 }
 
+// DecimalString formats Big with the given decimals; 12500 with 3 decimals
+// becomes "12.5".
+func (a Big) DecimalString(decimals uint) string {
+	if a.Int == nil || decimals < 1 {
+		return a.String()
+	}
+
+	var sign string
+	s := a.Int.String()
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+
+	if l := int(decimals) + 1 - len(s); l > 0 {
+		s = strings.Repeat("0", l) + s
+	}
+
+	i, f := s[:len(s)-int(decimals)], strings.TrimRight(s[len(s)-int(decimals):], "0")
+	if len(f) < 1 {
+		return sign + i
+	}
+
+	return sign + i + "." + f
+}
+
 func (a Big) IsZero() bool {
 	if a.Int == nil {
 		return true
@@ -154,3 +205,13 @@ func (a Big) Div(b Big) Big {
 func (a Big) Neg() Big {
 	return NewBigFromBigInt((new(big.Int)).Neg(a.Int))
 }
+
+func isDigits(s string) bool {
+	for i := range s {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -73,6 +73,78 @@ func (t *testBig) TestFromString() {
 	}
 }
 
+func (t *testBig) TestFromDecimalString() {
+	cases := []struct {
+		name     string
+		s        string
+		decimals uint
+		big      string
+		err      string
+	}{
+		{name: "no decimals", s: "10", decimals: 0, big: "10"},
+		{name: "integer", s: "10", decimals: 3, big: "10000"},
+		{name: "fraction", s: "12.5", decimals: 3, big: "12500"},
+		{name: "full fraction", s: "0.001", decimals: 3, big: "1"},
+		{name: "negative", s: "-1.25", decimals: 2, big: "-125"},
+		{name: "trailing dot", s: "12.", decimals: 2, big: "1200"},
+		{name: "too many decimal places", s: "0.0001", decimals: 3, err: "too many decimal places"},
+		{name: "empty integer", s: ".5", decimals: 3, err: "not proper decimal string"},
+		{name: "alphabet", s: "1.a", decimals: 3, err: "not proper decimal string"},
+		{name: "2 dots", s: "1.2.3", decimals: 3, err: "not proper decimal string"},
+		{name: "plus sign", s: "+1", decimals: 3, err: "not proper decimal string"},
+	}
+
+	for i, c := range cases {
+		i := i
+		c := c
+		t.Run(
+			c.name,
+			func() {
+				big, err := NewBigFromDecimalString(c.s, c.decimals)
+				if len(c.err) > 0 {
+					t.Contains(err.Error(), c.err, "%d: %v; %v != %v", i, c.name, c.err, err)
+				} else if err != nil {
+					t.NoError(err, "%d: %v; %v != %v", i, c.name, c.err, err)
+				} else {
+					t.Equal(c.big, big.String(), "%d: %v; %v != %v", i, c.name, c.big, big.String())
+				}
+			},
+		)
+	}
+}
+
+func (t *testBig) TestDecimalString() {
+	cases := []struct {
+		name     string
+		big      Big
+		decimals uint
+		s        string
+	}{
+		{name: "no decimals", big: NewBig(12500), decimals: 0, s: "12500"},
+		{name: "fraction", big: NewBig(12500), decimals: 3, s: "12.5"},
+		{name: "integer", big: NewBig(12000), decimals: 3, s: "12"},
+		{name: "under 1", big: NewBig(1), decimals: 3, s: "0.001"},
+		{name: "zero", big: ZeroBig, decimals: 3, s: "0"},
+		{name: "negative", big: NewBig(-125), decimals: 3, s: "-0.125"},
+	}
+
+	for i, c := range cases {
+		i := i
+		c := c
+		t.Run(
+			c.name,
+			func() {
+				s := c.big.DecimalString(c.decimals)
+				t.Equal(c.s, s, "%d: %v; %v != %v", i, c.name, c.s, s)
+
+				b, err := NewBigFromDecimalString(s, c.decimals)
+				t.NoError(err)
+				t.True(c.big.Equal(b))
+			},
+		)
+	}
+}
+
 func (t *testBig) TestAdd() {
 	cases := []struct {
 		name   string
@@ -0,0 +1,104 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	CancelScheduleFactType   = hint.Type("mitum-currency-cancel-schedule-operation-fact")
+	CancelScheduleFactHint   = hint.NewHint(CancelScheduleFactType, "v0.0.1")
+	CancelScheduleFactHinter = CancelScheduleFact{BaseHinter: hint.NewBaseHinter(CancelScheduleFactHint)}
+	CancelScheduleType       = hint.Type("mitum-currency-cancel-schedule-operation")
+	CancelScheduleHint       = hint.NewHint(CancelScheduleType, "v0.0.1")
+	CancelScheduleHinter     = CancelSchedule{BaseOperation: operationHinter(CancelScheduleHint)}
+)
+
+// CancelScheduleFact cancels the active payment schedule and returns the
+// amount of the remaining runs to the sender of schedule. The fee of the
+// remaining runs is not returned.
+type CancelScheduleFact struct {
+	hint.BaseHinter
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	schedule valuehash.Hash
+}
+
+func NewCancelScheduleFact(token []byte, sender base.Address, schedule valuehash.Hash) CancelScheduleFact {
+	fact := CancelScheduleFact{
+		BaseHinter: hint.NewBaseHinter(CancelScheduleFactHint),
+		token:      token,
+		sender:     sender,
+		schedule:   schedule,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact CancelScheduleFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact CancelScheduleFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CancelScheduleFact) Bytes() []byte {
+	var bs, ss []byte
+	if fact.sender != nil {
+		bs = fact.sender.Bytes()
+	}
+
+	if fact.schedule != nil {
+		ss = fact.schedule.Bytes()
+	}
+
+	return util.ConcatBytesSlice(fact.token, bs, ss)
+}
+
+func (fact CancelScheduleFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender, fact.schedule); err != nil {
+		return isvalid.InvalidError.Errorf("invalid CancelScheduleFact: %w", err)
+	}
+
+	return nil
+}
+
+func (fact CancelScheduleFact) Token() []byte {
+	return fact.token
+}
+
+func (fact CancelScheduleFact) Sender() base.Address {
+	return fact.sender
+}
+
+// Schedule is the id of payment schedule, the fact hash of SchedulePayment.
+func (fact CancelScheduleFact) Schedule() valuehash.Hash {
+	return fact.schedule
+}
+
+func (fact CancelScheduleFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type CancelSchedule struct {
+	BaseOperation
+}
+
+func NewCancelSchedule(fact CancelScheduleFact, fs []base.FactSign, memo string) (CancelSchedule, error) {
+	bo, err := NewBaseOperationFromFact(CancelScheduleHint, fact, fs, memo)
+	if err != nil {
+		return CancelSchedule{}, err
+	}
+
+	return CancelSchedule{BaseOperation: bo}, nil
+}
@@ -0,0 +1,52 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type CancelScheduleFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	SD base.Address   `json:"sender"`
+	SC valuehash.Hash `json:"schedule"`
+}
+
+func (fact CancelScheduleFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(CancelScheduleFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		SC:         fact.schedule,
+	})
+}
+
+type CancelScheduleFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	SC valuehash.Bytes     `json:"schedule"`
+}
+
+func (fact *CancelScheduleFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact CancelScheduleFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, ufact.SC)
+}
+
+func (op *CancelSchedule) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
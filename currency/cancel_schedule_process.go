@@ -0,0 +1,123 @@
+package currency
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var cancelScheduleProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CancelScheduleProcessor)
+	},
+}
+
+func (CancelSchedule) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type CancelScheduleProcessor struct {
+	CancelSchedule
+	sc state.State
+	si AccountIndexState
+	sb AmountState
+}
+
+func NewCancelScheduleProcessor() GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(CancelSchedule)
+		if !ok {
+			return nil, errors.Errorf("not CancelSchedule, %T", op)
+		}
+
+		opp := cancelScheduleProcessorPool.Get().(*CancelScheduleProcessor)
+
+		opp.CancelSchedule = i
+		opp.sc = nil
+		opp.si = AccountIndexState{}
+		opp.sb = AmountState{}
+
+		return opp, nil
+	}
+}
+
+func (opp *CancelScheduleProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(CancelScheduleFact)
+
+	if err := checkExistsState(StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, errors.Wrap(err, "invalid signing")
+	}
+
+	st, err := existsState(StateKeySchedule(fact.schedule), "payment schedule", getState)
+	if err != nil {
+		return nil, err
+	}
+
+	sc, err := StateScheduleValue(st)
+	if err != nil {
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	}
+
+	switch {
+	case !sc.IsActive():
+		return nil, operation.NewBaseReasonError("payment schedule, %q already %s", fact.schedule, sc.Status())
+	case !fact.sender.Equal(sc.Sender()):
+		return nil, operation.NewBaseReasonError("sender, %q should be sender of payment schedule", fact.sender)
+	}
+
+	nst, err := SetStateScheduleValue(st, sc.SetStatus(ScheduleStatusCancelled))
+	if err != nil {
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	}
+
+	cid := sc.Amount().Currency()
+	bst, _, err := getState(StateKeyBalance(fact.sender, cid))
+	if err != nil {
+		return nil, err
+	}
+
+	si, err := loadAccountIndexState(fact.sender, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	opp.sc = nst
+	opp.si = si.Remove(nst.Key())
+	opp.sb = NewAmountState(bst, cid).Add(sc.Reserved())
+
+	return opp, nil
+}
+
+func (opp *CancelScheduleProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(CancelScheduleFact)
+
+	return setState(fact.Hash(), opp.sc, opp.si, opp.sb)
+}
+
+func (opp *CancelScheduleProcessor) Close() error {
+	opp.CancelSchedule = CancelSchedule{}
+	opp.sc = nil
+	opp.si = AccountIndexState{}
+	opp.sb = AmountState{}
+
+	cancelScheduleProcessorPool.Put(opp)
+
+	return nil
+}
@@ -70,6 +70,10 @@ func (opp *CreateAccountsItemProcessor) PreProcess(
 	}
 	opp.ns = st
 
+	if err := checkKeysByAccountPolicy(opp.item.Keys(), getState); err != nil {
+		return err
+	}
+
 	nb := map[CurrencyID]AmountState{}
 	for i := range opp.item.Amounts() {
 		am := opp.item.Amounts()[i]
@@ -158,6 +162,10 @@ func (opp *CreateAccountsProcessor) PreProcess(
 		return nil, err
 	}
 
+	if err := checkActiveAccountState(fact.sender, getState); err != nil {
+		return nil, err
+	}
+
 	if required, err := opp.calculateItemsFee(); err != nil {
 		return nil, operation.NewBaseReasonError("failed to calculate fee: %w", err)
 	} else if sb, err := CheckEnoughBalance(fact.sender, required, getState); err != nil {
@@ -234,10 +242,21 @@ func (opp *CreateAccountsProcessor) calculateItemsFee() (map[CurrencyID][2]Big,
 		items[i] = fact.items[i]
 	}
 
-	return CalculateItemsFee(opp.cp, items)
+	return CalculateSenderItemsFee(opp.cp, fact.sender, items)
 }
 
 func CalculateItemsFee(cp *CurrencyPool, items []AmountsItem) (map[CurrencyID][2]Big, error) {
+	return CalculateSenderItemsFee(cp, nil, items)
+}
+
+// CalculateSenderItemsFee calculates the required amounts and fee of items
+// for sender; the sender in the fee exemptions of currency policy does not pay
+// fee.
+func CalculateSenderItemsFee(
+	cp *CurrencyPool,
+	sender base.Address,
+	items []AmountsItem,
+) (map[CurrencyID][2]Big, error) {
 	required := map[CurrencyID][2]Big{}
 
 	for i := range items {
@@ -257,17 +276,35 @@ func CalculateItemsFee(cp *CurrencyPool, items []AmountsItem) (map[CurrencyID][2
 				continue
 			}
 
-			feeer, found := cp.Feeer(am.Currency())
+			policy, found := cp.Policy(am.Currency())
 			if !found {
 				return nil, errors.Errorf("unknown currency id found, %q", am.Currency())
 			}
-			switch k, err := feeer.Fee(am.Big()); {
-			case err != nil:
+
+			if policy.IsFeeExempted(sender) {
+				required[am.Currency()] = [2]Big{rq[0].Add(am.Big()), rq[1]}
+
+				continue
+			}
+
+			k, err := policy.Feeer().Fee(am.Big())
+			if err != nil {
 				return nil, err
+			}
+
+			fcid, k := policy.PayFee(am.Currency(), k)
+			switch {
 			case !k.OverZero():
 				required[am.Currency()] = [2]Big{rq[0].Add(am.Big()), rq[1]}
-			default:
+			case fcid == am.Currency():
 				required[am.Currency()] = [2]Big{rq[0].Add(am.Big()).Add(k), rq[1].Add(k)}
+			default:
+				if !cp.Exists(fcid) {
+					return nil, errors.Errorf("unknown fee currency id found, %q", fcid)
+				}
+
+				required[am.Currency()] = [2]Big{rq[0].Add(am.Big()), rq[1]}
+				required = addRequiredFee(required, fcid, k)
 			}
 		}
 	}
@@ -275,6 +312,17 @@ func CalculateItemsFee(cp *CurrencyPool, items []AmountsItem) (map[CurrencyID][2
 	return required, nil
 }
 
+func addRequiredFee(required map[CurrencyID][2]Big, cid CurrencyID, fee Big) map[CurrencyID][2]Big {
+	rq := [2]Big{ZeroBig, ZeroBig}
+	if k, found := required[cid]; found {
+		rq = k
+	}
+
+	required[cid] = [2]Big{rq[0].Add(fee), rq[1].Add(fee)}
+
+	return required
+}
+
 func CheckEnoughBalance(
 	holder base.Address,
 	required map[CurrencyID][2]Big,
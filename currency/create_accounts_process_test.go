@@ -335,6 +335,69 @@ func (t *testCreateAccountsOperation) TestInSufficientBalanceWithFee() {
 	t.Contains(err.Error(), "insufficient balance")
 }
 
+func (t *testCreateAccountsOperation) TestFeeExempted() {
+	cid := CurrencyID("SHOWME")
+
+	balance := []Amount{NewAmount(NewBig(33), cid)}
+
+	sa, st := t.newAccount(true, balance)
+	na, _ := t.newAccount(false, nil)
+
+	pool, _ := t.statepool(st)
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(sa.Address, NewBig(4))).SetFeeExemptions([]base.Address{sa.Address})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignStateWithPolicy(cid, NewBig(99), sa.Address, po)))
+
+	opr := t.processor(cp, pool)
+
+	ams := []Amount{NewAmount(balance[0].Big(), cid)}
+
+	items := []CreateAccountsItem{NewCreateAccountsItemMultiAmounts(na.Keys(), ams)}
+	ca := t.newOperation(sa.Address, items, sa.Privs())
+
+	t.NoError(opr.Process(ca))
+
+	var nst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyBalance(sa.Address, cid) {
+			nst = st.GetState()
+		}
+	}
+
+	nb, err := StateBalanceValue(nst)
+	t.NoError(err)
+	t.True(nb.Big().IsZero())
+	t.True(nst.(AmountState).Fee().IsZero())
+}
+
+func (t *testCreateAccountsOperation) TestFrozenSender() {
+	cid := CurrencyID("SHOWME")
+
+	balance := []Amount{NewAmount(NewBig(33), cid)}
+
+	sa, st := t.newAccount(true, balance)
+	na, _ := t.newAccount(false, nil)
+
+	pool, _ := t.statepool(t.freezeAccountState(sa.Address, st))
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(cid, NewBig(99), sa.Address, feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []CreateAccountsItem{NewCreateAccountsItemMultiAmounts(na.Keys(), []Amount{NewAmount(NewBig(1), cid)})}
+	ca := t.newOperation(sa.Address, items, sa.Privs())
+
+	err := opr.Process(ca)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "frozen")
+}
+
 func (t *testCreateAccountsOperation) TestUnknownCurrencyID() {
 	cid := CurrencyID("SHOWME")
 
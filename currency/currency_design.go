@@ -14,12 +14,16 @@ var (
 	CurrencyDesignHinter = CurrencyDesign{BaseHinter: hint.NewBaseHinter(CurrencyDesignHint)}
 )
 
+var MaxCurrencyDecimals uint = 36
+
 type CurrencyDesign struct {
 	hint.BaseHinter
 	Amount
 	genesisAccount base.Address
 	policy         CurrencyPolicy
 	aggregate      Big
+	burned         Big
+	decimals       uint
 }
 
 func NewCurrencyDesign(amount Amount, genesisAccount base.Address, po CurrencyPolicy) CurrencyDesign {
@@ -29,6 +33,7 @@ func NewCurrencyDesign(amount Amount, genesisAccount base.Address, po CurrencyPo
 		genesisAccount: genesisAccount,
 		policy:         po,
 		aggregate:      amount.Big(),
+		burned:         ZeroBig,
 	}
 }
 
@@ -37,6 +42,7 @@ func (de CurrencyDesign) IsValid([]byte) error {
 		de.BaseHinter,
 		de.Amount,
 		de.aggregate,
+		de.burned,
 	); err != nil {
 		return isvalid.InvalidError.Errorf("invalid currency balance: %w", err)
 	}
@@ -46,6 +52,10 @@ func (de CurrencyDesign) IsValid([]byte) error {
 		return isvalid.InvalidError.Errorf("currency balance should be over zero")
 	case !de.aggregate.OverZero():
 		return isvalid.InvalidError.Errorf("aggregate should be over zero")
+	case !de.burned.OverNil():
+		return isvalid.InvalidError.Errorf("burned should be over nil")
+	case de.decimals > MaxCurrencyDecimals:
+		return isvalid.InvalidError.Errorf("decimals over max, %d > %d", de.decimals, MaxCurrencyDecimals)
 	}
 
 	if de.genesisAccount != nil {
@@ -58,6 +68,10 @@ func (de CurrencyDesign) IsValid([]byte) error {
 		return isvalid.InvalidError.Errorf("invalid CurrencyPolicy: %w", err)
 	}
 
+	if ms, found := de.policy.MaxSupply(); found && de.aggregate.Compare(ms) > 0 {
+		return isvalid.InvalidError.Errorf("aggregate, %v over max supply, %v", de.aggregate, ms)
+	}
+
 	return nil
 }
 
@@ -67,11 +81,23 @@ func (de CurrencyDesign) Bytes() []byte {
 		gb = de.genesisAccount.Bytes()
 	}
 
+	var bb []byte
+	if de.burned.OverZero() {
+		bb = de.burned.Bytes()
+	}
+
+	var db []byte
+	if de.decimals > 0 {
+		db = util.UintToBytes(de.decimals)
+	}
+
 	return util.ConcatBytesSlice(
 		de.Amount.Bytes(),
 		gb,
 		de.policy.Bytes(),
 		de.aggregate.Bytes(),
+		bb,
+		db,
 	)
 }
 
@@ -89,6 +115,24 @@ func (de CurrencyDesign) SetPolicy(po CurrencyPolicy) CurrencyDesign {
 	return de
 }
 
+// Decimals is the number of decimal places of currency amount; 12500 with 3
+// decimals means 12.5. Decimals is set when currency is registered and can not
+// be updated by CurrencyPolicyUpdater.
+func (de CurrencyDesign) Decimals() uint {
+	return de.decimals
+}
+
+func (de CurrencyDesign) SetDecimals(d uint) CurrencyDesign {
+	de.decimals = d
+
+	return de
+}
+
+// FormatBig formats the given amount of currency with decimals.
+func (de CurrencyDesign) FormatBig(b Big) string {
+	return b.DecimalString(de.decimals)
+}
+
 func (de CurrencyDesign) Aggregate() Big {
 	return de.aggregate
 }
@@ -102,3 +146,25 @@ func (de CurrencyDesign) AddAggregate(b Big) (CurrencyDesign, error) {
 
 	return de, nil
 }
+
+// Burned returns the total amount of burned fee.
+func (de CurrencyDesign) Burned() Big {
+	return de.burned
+}
+
+// Burn removes the given amount from the aggregate; the aggregate is the
+// circulating supply of currency.
+func (de CurrencyDesign) Burn(b Big) (CurrencyDesign, error) {
+	if !b.OverZero() {
+		return de, errors.Errorf("burning amount not over zero")
+	}
+
+	if de.aggregate.Compare(b) <= 0 {
+		return de, errors.Errorf("burning amount, %v over aggregate, %v", b, de.aggregate)
+	}
+
+	de.aggregate = de.aggregate.Sub(b)
+	de.burned = de.burned.Add(b)
+
+	return de, nil
+}
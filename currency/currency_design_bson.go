@@ -15,6 +15,8 @@ func (de CurrencyDesign) MarshalBSON() ([]byte, error) {
 			"genesis_account": de.genesisAccount,
 			"policy":          de.policy,
 			"aggregate":       de.aggregate,
+			"burned":          de.burned,
+			"decimals":        de.decimals,
 		}),
 	)
 }
@@ -24,6 +26,8 @@ type CurrencyDesignBSONUnpacker struct {
 	GA base.AddressDecoder `bson:"genesis_account"`
 	PO bson.Raw            `bson:"policy"`
 	AG Big                 `bson:"aggregate"`
+	BU Big                 `bson:"burned"`
+	DE uint                `bson:"decimals"`
 }
 
 func (de *CurrencyDesign) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -32,5 +36,5 @@ func (de *CurrencyDesign) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		return err
 	}
 
-	return de.unpack(enc, ude.AM, ude.GA, ude.PO, ude.AG)
+	return de.unpack(enc, ude.AM, ude.GA, ude.PO, ude.AG, ude.BU, ude.DE)
 }
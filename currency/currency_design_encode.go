@@ -5,7 +5,15 @@ import (
 	"github.com/spikeekips/mitum/util/encoder"
 )
 
-func (de *CurrencyDesign) unpack(enc encoder.Encoder, am Amount, ga base.AddressDecoder, bpo []byte, ag Big) error {
+func (de *CurrencyDesign) unpack(
+	enc encoder.Encoder,
+	am Amount,
+	ga base.AddressDecoder,
+	bpo []byte,
+	ag,
+	bu Big,
+	d uint,
+) error {
 	de.Amount = am
 
 	a, err := ga.Encode(enc)
@@ -20,5 +28,11 @@ func (de *CurrencyDesign) unpack(enc encoder.Encoder, am Amount, ga base.Address
 
 	de.aggregate = ag
 
+	if bu.Int == nil {
+		bu = ZeroBig
+	}
+	de.burned = bu
+	de.decimals = d
+
 	return nil
 }
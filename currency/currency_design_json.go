@@ -13,6 +13,8 @@ type CurrencyDesignJSONPacker struct {
 	GA base.Address   `json:"genesis_account"`
 	PO CurrencyPolicy `json:"policy"`
 	AG Big            `json:"aggregate"`
+	BU Big            `json:"burned"`
+	DE uint           `json:"decimals"`
 }
 
 func (de CurrencyDesign) MarshalJSON() ([]byte, error) {
@@ -22,6 +24,8 @@ func (de CurrencyDesign) MarshalJSON() ([]byte, error) {
 		GA:         de.genesisAccount,
 		PO:         de.policy,
 		AG:         de.aggregate,
+		BU:         de.burned,
+		DE:         de.decimals,
 	})
 }
 
@@ -30,6 +34,8 @@ type CurrencyDesignJSONUnpacker struct {
 	GA base.AddressDecoder `json:"genesis_account"`
 	PO json.RawMessage     `json:"policy"`
 	AG Big                 `json:"aggregate"`
+	BU Big                 `json:"burned"`
+	DE uint                `json:"decimals"`
 }
 
 func (de *CurrencyDesign) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -38,5 +44,5 @@ func (de *CurrencyDesign) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 		return err
 	}
 
-	return de.unpack(enc, ude.AM, ude.GA, ude.PO, ude.AG)
+	return de.unpack(enc, ude.AM, ude.GA, ude.PO, ude.AG, ude.BU, ude.DE)
 }
@@ -0,0 +1,161 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	currencyDesignStateType = hint.Type("mitum-currency-currency-design-state")
+	currencyDesignStateHint = hint.NewHint(currencyDesignStateType, "v0.0.1")
+)
+
+// CurrencyDesignState updates CurrencyDesign by the changes, not by the new
+// value; the OperationProcessors of one block are processed concurrently, so
+// like AmountState, the changes of the fee burning, inflation and policy are
+// merged into the latest CurrencyDesign of block.
+type CurrencyDesignState struct {
+	state.State
+	cid    CurrencyID
+	add    Big
+	burned Big
+	policy *CurrencyPolicy
+}
+
+func NewCurrencyDesignState(st state.State, cid CurrencyID) CurrencyDesignState {
+	if sst, ok := st.(CurrencyDesignState); ok {
+		return sst
+	}
+
+	return CurrencyDesignState{
+		State:  st,
+		cid:    cid,
+		add:    ZeroBig,
+		burned: ZeroBig,
+	}
+}
+
+func (CurrencyDesignState) Hint() hint.Hint {
+	return currencyDesignStateHint
+}
+
+func (st CurrencyDesignState) IsValid(b []byte) error {
+	if err := isvalid.Check(b, false, st.State); err != nil {
+		return err
+	}
+
+	if !st.add.OverNil() {
+		return isvalid.InvalidError.Errorf("invalid aggregate; under zero, %v", st.add)
+	}
+
+	if !st.burned.OverNil() {
+		return isvalid.InvalidError.Errorf("invalid burned; under zero, %v", st.burned)
+	}
+
+	return nil
+}
+
+func (st CurrencyDesignState) Merge(b state.State) (state.State, error) {
+	de, err := StateCurrencyDesignValue(b)
+	if err != nil {
+		return nil, err
+	}
+
+	if st.policy != nil {
+		de = de.SetPolicy(*st.policy)
+	}
+
+	if st.add.OverZero() {
+		if de, err = de.AddAggregate(st.add); err != nil {
+			return nil, err
+		}
+	}
+
+	if st.burned.OverZero() {
+		if de, err = de.Burn(st.burned); err != nil {
+			return nil, err
+		}
+	}
+
+	return SetStateCurrencyDesignValue(st, de)
+}
+
+func (st CurrencyDesignState) Currency() CurrencyID {
+	return st.cid
+}
+
+// AddAggregate increases the aggregate of CurrencyDesign.
+func (st CurrencyDesignState) AddAggregate(a Big) CurrencyDesignState {
+	st.add = st.add.Add(a)
+
+	return st
+}
+
+// Burn removes the given amount from the aggregate and adds it to the burned.
+func (st CurrencyDesignState) Burn(a Big) CurrencyDesignState {
+	st.burned = st.burned.Add(a)
+
+	return st
+}
+
+// SetPolicy replaces the policy of CurrencyDesign.
+func (st CurrencyDesignState) SetPolicy(po CurrencyPolicy) CurrencyDesignState {
+	st.policy = &po
+
+	return st
+}
+
+func (st CurrencyDesignState) SetValue(v state.Value) (state.State, error) {
+	s, err := st.State.SetValue(v)
+	if err != nil {
+		return nil, err
+	}
+	st.State = s
+
+	return st, nil
+}
+
+func (st CurrencyDesignState) SetHash(h valuehash.Hash) (state.State, error) {
+	s, err := st.State.SetHash(h)
+	if err != nil {
+		return nil, err
+	}
+	st.State = s
+
+	return st, nil
+}
+
+func (st CurrencyDesignState) SetHeight(h base.Height) state.State {
+	st.State = st.State.SetHeight(h)
+
+	return st
+}
+
+func (st CurrencyDesignState) SetPreviousHeight(h base.Height) (state.State, error) {
+	s, err := st.State.SetPreviousHeight(h)
+	if err != nil {
+		return nil, err
+	}
+	st.State = s
+
+	return st, nil
+}
+
+func (st CurrencyDesignState) SetOperation(ops []valuehash.Hash) state.State {
+	st.State = st.State.SetOperation(ops)
+
+	return st
+}
+
+func (st CurrencyDesignState) Clear() state.State {
+	st.State = st.State.Clear()
+
+	st.add = ZeroBig
+	st.burned = ZeroBig
+	st.policy = nil
+
+	return st
+}
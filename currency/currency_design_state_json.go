@@ -0,0 +1,7 @@
+package currency
+
+import jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+
+func (st CurrencyDesignState) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(st.State)
+}
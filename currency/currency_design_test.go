@@ -39,6 +39,28 @@ func (t *testCurrencyDesign) TestUnderZeroAmount() {
 	t.Contains(err.Error(), "should be over zero")
 }
 
+func (t *testCurrencyDesign) TestOverMaxSupply() {
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer()).SetMaxSupply(NewBig(32))
+	gc := NewCurrencyDesign(MustNewAmount(NewBig(33), CurrencyID("ABC")), NewTestAddress(), po)
+	err := gc.IsValid(nil)
+	t.Contains(err.Error(), "over max supply")
+}
+
+func (t *testCurrencyDesign) TestDecimals() {
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer())
+	gc := NewCurrencyDesign(MustNewAmount(NewBig(12500), CurrencyID("ABC")), NewTestAddress(), po)
+	t.Equal(uint(0), gc.Decimals())
+	t.Equal("12500", gc.FormatBig(gc.Big()))
+
+	gc = gc.SetDecimals(3)
+	t.NoError(gc.IsValid(nil))
+	t.Equal("12.5", gc.FormatBig(gc.Big()))
+
+	gc = gc.SetDecimals(MaxCurrencyDecimals + 1)
+	err := gc.IsValid(nil)
+	t.Contains(err.Error(), "decimals over max")
+}
+
 func (t *testCurrencyDesign) TestAddAggregate() {
 	de := NewCurrencyDesign(
 		NewAmount(NewBig(33), CurrencyID("SHOWME")),
@@ -63,6 +85,31 @@ func (t *testCurrencyDesign) TestAddAggregate() {
 	t.True(de.aggregate.Equal(NewBig(44)))
 }
 
+func (t *testCurrencyDesign) TestBurn() {
+	de := NewCurrencyDesign(
+		NewAmount(NewBig(33), CurrencyID("SHOWME")),
+		NewTestAddress(),
+		NewCurrencyPolicy(ZeroBig, NewNilFeeer()),
+	)
+	t.NoError(de.IsValid(nil))
+	t.True(de.Burned().IsZero())
+
+	var err error
+	de, err = de.Burn(NewBig(3))
+	t.NoError(err)
+	t.NoError(de.IsValid(nil))
+
+	t.True(de.Aggregate().Equal(NewBig(30)))
+	t.True(de.Burned().Equal(NewBig(3)))
+	t.True(de.Big().Equal(NewBig(33)))
+
+	_, err = de.Burn(ZeroBig)
+	t.Contains(err.Error(), "burning amount not over zero")
+
+	_, err = de.Burn(NewBig(30))
+	t.Contains(err.Error(), "over aggregate")
+}
+
 func TestCurrencyDesign(t *testing.T) {
 	suite.Run(t, new(testCurrencyDesign))
 }
@@ -81,6 +128,10 @@ func testCurrencyDesignEncode(enc encoder.Encoder) suite.TestingSuite {
 			),
 		)
 		de.BaseHinter = hint.NewBaseHinter(hint.NewHint(CurrencyDesignType, "v0.0.9"))
+		de = de.SetDecimals(8)
+
+		de, err := de.Burn(NewBig(3))
+		t.NoError(err)
 		t.NoError(de.IsValid(nil))
 
 		return de
@@ -1,6 +1,11 @@
 package currency
 
 import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
 	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/isvalid"
@@ -12,10 +17,50 @@ var (
 	CurrencyPolicyHinter = CurrencyPolicy{BaseHinter: hint.NewBaseHinter(CurrencyPolicyHint)}
 )
 
+// FeeReceiver receives the share of collected fee by it's weight.
+type FeeReceiver struct {
+	address base.Address
+	weight  uint
+}
+
+func NewFeeReceiver(address base.Address, weight uint) FeeReceiver {
+	return FeeReceiver{address: address, weight: weight}
+}
+
+func (fr FeeReceiver) Bytes() []byte {
+	return util.ConcatBytesSlice(fr.address.Bytes(), util.UintToBytes(fr.weight))
+}
+
+func (fr FeeReceiver) IsValid([]byte) error {
+	if err := isvalid.Check(nil, false, fr.address); err != nil {
+		return isvalid.InvalidError.Errorf("invalid fee receiver address: %w", err)
+	}
+
+	if fr.weight < 1 {
+		return isvalid.InvalidError.Errorf("fee receiver weight should be over zero")
+	}
+
+	return nil
+}
+
+func (fr FeeReceiver) Address() base.Address {
+	return fr.address
+}
+
+func (fr FeeReceiver) Weight() uint {
+	return fr.weight
+}
+
 type CurrencyPolicy struct {
 	hint.BaseHinter
 	newAccountMinBalance Big
 	feeer                Feeer
+	feeReceivers         []FeeReceiver
+	feeBurnRatio         float64 // 0 >=, or <= 1.0
+	maxSupply            Big     // NOTE nil means unlimited
+	feeCurrency          CurrencyID
+	feeRate              float64 // NOTE 0 means no conversion
+	feeExemptions        []base.Address
 }
 
 func NewCurrencyPolicy(newAccountMinBalance Big, feeer Feeer) CurrencyPolicy {
@@ -26,7 +71,31 @@ func NewCurrencyPolicy(newAccountMinBalance Big, feeer Feeer) CurrencyPolicy {
 }
 
 func (po CurrencyPolicy) Bytes() []byte {
-	return util.ConcatBytesSlice(po.newAccountMinBalance.Bytes(), po.feeer.Bytes())
+	bs := make([][]byte, len(po.feeReceivers)+2)
+	bs[0] = po.newAccountMinBalance.Bytes()
+	bs[1] = po.feeer.Bytes()
+
+	for i := range po.feeReceivers {
+		bs[i+2] = po.feeReceivers[i].Bytes()
+	}
+
+	if po.feeBurnRatio > 0 {
+		bs = append(bs, util.Float64ToBytes(po.feeBurnRatio))
+	}
+
+	if po.hasMaxSupply() {
+		bs = append(bs, po.maxSupply.Bytes())
+	}
+
+	if len(po.feeCurrency) > 0 {
+		bs = append(bs, po.feeCurrency.Bytes(), util.Float64ToBytes(po.feeRate))
+	}
+
+	for i := range po.feeExemptions {
+		bs = append(bs, po.feeExemptions[i].Bytes())
+	}
+
+	return util.ConcatBytesSlice(bs...)
 }
 
 func (po CurrencyPolicy) IsValid([]byte) error {
@@ -38,6 +107,50 @@ func (po CurrencyPolicy) IsValid([]byte) error {
 		return isvalid.InvalidError.Errorf("invalid currency policy: %w", err)
 	}
 
+	founds := map[string]struct{}{}
+	for i := range po.feeReceivers {
+		fr := po.feeReceivers[i]
+		if err := fr.IsValid(nil); err != nil {
+			return isvalid.InvalidError.Errorf("invalid currency policy: %w", err)
+		}
+
+		if _, found := founds[fr.address.String()]; found {
+			return isvalid.InvalidError.Errorf("duplicated fee receiver, %q", fr.address)
+		}
+		founds[fr.address.String()] = struct{}{}
+	}
+
+	if po.feeBurnRatio < 0 || po.feeBurnRatio > 1 {
+		return isvalid.InvalidError.Errorf("invalid fee burn ratio, %v; it should be 0 >=, <= 1", po.feeBurnRatio)
+	}
+
+	if po.hasMaxSupply() && !po.maxSupply.OverZero() {
+		return isvalid.InvalidError.Errorf("max supply should be over zero")
+	}
+
+	if len(po.feeCurrency) > 0 {
+		if err := po.feeCurrency.IsValid(nil); err != nil {
+			return isvalid.InvalidError.Errorf("invalid fee currency: %w", err)
+		}
+	}
+
+	if po.feeRate < 0 {
+		return isvalid.InvalidError.Errorf("fee rate should not be under zero, %v", po.feeRate)
+	}
+
+	exempted := map[string]struct{}{}
+	for i := range po.feeExemptions {
+		a := po.feeExemptions[i]
+		if err := isvalid.Check(nil, false, a); err != nil {
+			return isvalid.InvalidError.Errorf("invalid fee exemption address: %w", err)
+		}
+
+		if _, found := exempted[a.String()]; found {
+			return isvalid.InvalidError.Errorf("duplicated fee exemption address, %q", a)
+		}
+		exempted[a.String()] = struct{}{}
+	}
+
 	return nil
 }
 
@@ -48,3 +161,282 @@ func (po CurrencyPolicy) NewAccountMinBalance() Big {
 func (po CurrencyPolicy) Feeer() Feeer {
 	return po.feeer
 }
+
+// FeeReceivers returns the receivers of collected fee. Without the weighted
+// receivers, the receiver of Feeer receives all the fee.
+func (po CurrencyPolicy) FeeReceivers() []FeeReceiver {
+	if len(po.feeReceivers) > 0 {
+		return po.feeReceivers
+	}
+
+	if po.feeer == nil || po.feeer.Receiver() == nil {
+		return nil
+	}
+
+	return []FeeReceiver{NewFeeReceiver(po.feeer.Receiver(), 1)}
+}
+
+func (po CurrencyPolicy) SetFeeReceivers(frs []FeeReceiver) CurrencyPolicy {
+	po.feeReceivers = frs
+
+	return po
+}
+
+func (po CurrencyPolicy) FeeBurnRatio() float64 {
+	return po.feeBurnRatio
+}
+
+func (po CurrencyPolicy) SetFeeBurnRatio(ratio float64) CurrencyPolicy {
+	po.feeBurnRatio = ratio
+
+	return po
+}
+
+// BurnFee returns the amount of fee, which will be burned and the rest of fee.
+func (po CurrencyPolicy) BurnFee(fee Big) (Big, Big) {
+	switch {
+	case po.feeBurnRatio <= 0:
+		return ZeroBig, fee
+	case po.feeBurnRatio >= 1:
+		return fee, ZeroBig
+	default:
+		burned := fee.MulFloat64(po.feeBurnRatio)
+
+		return burned, fee.Sub(burned)
+	}
+}
+
+// MaxSupply returns the maximum aggregate of currency. If not set, the
+// aggregate is unlimited.
+func (po CurrencyPolicy) MaxSupply() (Big, bool) {
+	return po.maxSupply, po.hasMaxSupply()
+}
+
+func (po CurrencyPolicy) SetMaxSupply(b Big) CurrencyPolicy {
+	po.maxSupply = b
+
+	return po
+}
+
+func (po CurrencyPolicy) hasMaxSupply() bool {
+	return po.maxSupply.Int != nil
+}
+
+func (po CurrencyPolicy) checkMaxSupply(aggregate Big) error {
+	if !po.hasMaxSupply() {
+		return nil
+	}
+
+	if aggregate.Compare(po.maxSupply) > 0 {
+		return operation.NewBaseReasonError("aggregate, %v over max supply, %v", aggregate, po.maxSupply)
+	}
+
+	return nil
+}
+
+// FeeCurrency returns the currency, in which fee is paid. Without fee
+// currency, fee is paid in the currency of amount.
+func (po CurrencyPolicy) FeeCurrency() CurrencyID {
+	return po.feeCurrency
+}
+
+// FeeRate is the conversion rate from the fee of amount to the fee currency.
+func (po CurrencyPolicy) FeeRate() float64 {
+	return po.feeRate
+}
+
+// SetFeeCurrency sets the fee currency and the conversion rate. With 0 rate,
+// the fee by Feeer is paid as it is in the fee currency, so FixedFeeer means
+// the fixed fee in the fee currency.
+func (po CurrencyPolicy) SetFeeCurrency(cid CurrencyID, rate float64) CurrencyPolicy {
+	po.feeCurrency = cid
+	po.feeRate = rate
+
+	return po
+}
+
+// PayFee returns the currency and the amount of fee, which will be paid for
+// the fee of amount in cid. The collected fee is processed by the policy of
+// the fee currency.
+func (po CurrencyPolicy) PayFee(cid CurrencyID, fee Big) (CurrencyID, Big) {
+	if len(po.feeCurrency) < 1 {
+		return cid, fee
+	}
+
+	if po.feeRate > 0 {
+		return po.feeCurrency, fee.MulFloat64(po.feeRate)
+	}
+
+	return po.feeCurrency, fee
+}
+
+// FeeExemptions returns the addresses, which do not pay fee.
+func (po CurrencyPolicy) FeeExemptions() []base.Address {
+	return po.feeExemptions
+}
+
+func (po CurrencyPolicy) SetFeeExemptions(as []base.Address) CurrencyPolicy {
+	po.feeExemptions = as
+
+	return po
+}
+
+func (po CurrencyPolicy) IsFeeExempted(a base.Address) bool {
+	if a == nil {
+		return false
+	}
+
+	for i := range po.feeExemptions {
+		if po.feeExemptions[i].Equal(a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SplitFee splits fee by the weight of fee receivers. Each share is rounded
+// down and the remainder is given by one unit to the receivers in order.
+func (po CurrencyPolicy) SplitFee(fee Big) ([]FeeReceiver, []Big) {
+	frs := po.FeeReceivers()
+	if len(frs) < 1 {
+		return nil, nil
+	}
+
+	var total uint
+	for i := range frs {
+		total += frs[i].weight
+	}
+
+	shares := make([]Big, len(frs))
+	remain := fee
+	for i := range frs {
+		shares[i] = fee.MulInt64(int64(frs[i].weight)).Div(NewBig(int64(total)))
+		remain = remain.Sub(shares[i])
+	}
+
+	one := NewBig(1)
+	for i := 0; remain.OverZero(); i++ {
+		shares[i] = shares[i].Add(one)
+		remain = remain.Sub(one)
+	}
+
+	return frs, shares
+}
+
+func checkFeeReceiversExist(po CurrencyPolicy, getState func(string) (state.State, bool, error)) error {
+	for i := range po.feeReceivers {
+		if err := checkExistsState(StateKeyAccount(po.feeReceivers[i].address), getState); err != nil {
+			return errors.Wrap(err, "fee receiver account not found")
+		}
+	}
+
+	return nil
+}
+
+func checkFeeCurrency(cid CurrencyID, po CurrencyPolicy, getState func(string) (state.State, bool, error)) error {
+	switch fcid := po.feeCurrency; {
+	case len(fcid) < 1:
+		return nil
+	case fcid == cid:
+		return operation.NewBaseReasonError("fee currency, %q same with currency", fcid)
+	default:
+		if err := checkExistsState(StateKeyCurrencyDesign(fcid), getState); err != nil {
+			return errors.Wrap(err, "fee currency not found")
+		}
+
+		return nil
+	}
+}
+
+// feePayment is the fee of operation paid by payer. When the policy charges
+// fee in another currency, fb is the balance of payer in the fee currency;
+// otherwise fb is empty and the fee is paid with the amount of operation.
+type feePayment struct {
+	fee Big
+	fb  AmountState
+}
+
+// loadFeePayment calculates the fee of amount in cid for payer by policy. The
+// balance of fee currency is checked here; the balance in cid should be checked
+// by the caller with required().
+func loadFeePayment(
+	cp *CurrencyPool,
+	policy CurrencyPolicy,
+	payer base.Address,
+	cid CurrencyID,
+	amount Big,
+	getState func(string) (state.State, bool, error),
+) (feePayment, error) {
+	fp := feePayment{fee: ZeroBig}
+	if policy.IsFeeExempted(payer) {
+		return fp, nil
+	}
+
+	k, err := policy.Feeer().Fee(amount)
+	if err != nil {
+		return fp, operation.NewBaseReasonErrorFromError(err)
+	}
+
+	return newFeePayment(cp, policy, payer, cid, k, getState)
+}
+
+// newFeePayment pays the fee in cid by the fee currency of policy.
+func newFeePayment(
+	cp *CurrencyPool,
+	policy CurrencyPolicy,
+	payer base.Address,
+	cid CurrencyID,
+	fee Big,
+	getState func(string) (state.State, bool, error),
+) (feePayment, error) {
+	fcid, k := policy.PayFee(cid, fee)
+	fp := feePayment{fee: k}
+	if fcid == cid || !k.OverZero() {
+		return fp, nil
+	}
+
+	if !cp.Exists(fcid) {
+		return fp, operation.NewBaseReasonError("unknown fee currency id found, %q", fcid)
+	}
+
+	st, err := existsState(StateKeyBalance(payer, fcid), "balance of fee currency", getState)
+	if err != nil {
+		return fp, err
+	}
+	fp.fb = NewAmountState(st, fcid)
+
+	switch b, err := StateBalanceValue(fp.fb); {
+	case err != nil:
+		return fp, operation.NewBaseReasonErrorFromError(err)
+	case b.Big().Compare(k) < 0:
+		return fp, operation.NewBaseReasonError("insufficient balance of fee currency, %q", fcid)
+	default:
+		return fp, nil
+	}
+}
+
+// required returns the amount, which is paid from the balance in the currency
+// of operation.
+func (fp feePayment) required(amount Big) Big {
+	if fp.fb.State == nil {
+		return amount.Add(fp.fee)
+	}
+
+	return amount
+}
+
+// pay returns the balance states after amount and fee are paid from sb. When
+// the fee is paid in the fee currency, sb is returned only if it is changed.
+func (fp feePayment) pay(sb AmountState, amount Big) []state.State {
+	if fp.fb.State == nil {
+		return []state.State{sb.Sub(amount.Add(fp.fee)).AddFee(fp.fee)}
+	}
+
+	sts := []state.State{fp.fb.Sub(fp.fee).AddFee(fp.fee)}
+	if sb = sb.Sub(amount); !sb.add.IsZero() {
+		sts = append(sts, sb)
+	}
+
+	return sts
+}
@@ -3,22 +3,56 @@ package currency
 import (
 	"go.mongodb.org/mongo-driver/bson"
 
+	"github.com/spikeekips/mitum/base"
 	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
 )
 
+func (fr FeeReceiver) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"address": fr.address,
+		"weight":  fr.weight,
+	})
+}
+
 func (po CurrencyPolicy) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(bsonenc.MergeBSONM(
-		bsonenc.NewHintedDoc(po.Hint()),
-		bson.M{
-			"new_account_min_balance": po.newAccountMinBalance,
-			"feeer":                   po.feeer,
-		}),
-	)
+	m := bson.M{
+		"new_account_min_balance": po.newAccountMinBalance,
+		"feeer":                   po.feeer,
+	}
+
+	if len(po.feeReceivers) > 0 {
+		m["fee_receivers"] = po.feeReceivers
+	}
+
+	if po.feeBurnRatio > 0 {
+		m["fee_burn_ratio"] = po.feeBurnRatio
+	}
+
+	if po.hasMaxSupply() {
+		m["max_supply"] = po.maxSupply
+	}
+
+	if len(po.feeCurrency) > 0 {
+		m["fee_currency"] = po.feeCurrency
+		m["fee_rate"] = po.feeRate
+	}
+
+	if len(po.feeExemptions) > 0 {
+		m["fee_exemptions"] = po.feeExemptions
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(po.Hint()), m))
 }
 
 type CurrencyPolicyBSONUnpacker struct {
-	MN Big      `bson:"new_account_min_balance"`
-	FE bson.Raw `bson:"feeer"`
+	MN Big                   `bson:"new_account_min_balance"`
+	FE bson.Raw              `bson:"feeer"`
+	FR []FeeReceiverUnpacker `bson:"fee_receivers"`
+	FB float64               `bson:"fee_burn_ratio"`
+	MS Big                   `bson:"max_supply"`
+	FC string                `bson:"fee_currency"`
+	FT float64               `bson:"fee_rate"`
+	FX []base.AddressDecoder `bson:"fee_exemptions"`
 }
 
 func (po *CurrencyPolicy) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -27,5 +61,5 @@ func (po *CurrencyPolicy) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		return err
 	}
 
-	return po.unpack(enc, upo.MN, upo.FE)
+	return po.unpack(enc, upo.MN, upo.FE, upo.FR, upo.FB, upo.MS, upo.FC, upo.FT, upo.FX)
 }
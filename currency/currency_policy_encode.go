@@ -1,15 +1,63 @@
 package currency
 
 import (
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/util/encoder"
 )
 
-func (po *CurrencyPolicy) unpack(enc encoder.Encoder, mn Big, bfe []byte) error {
+type FeeReceiverUnpacker struct {
+	AD base.AddressDecoder `json:"address" bson:"address"`
+	WE uint                `json:"weight" bson:"weight"`
+}
+
+func (po *CurrencyPolicy) unpack(
+	enc encoder.Encoder,
+	mn Big,
+	bfe []byte,
+	ufrs []FeeReceiverUnpacker,
+	fb float64,
+	ms Big,
+	fc string,
+	ft float64,
+	ufx []base.AddressDecoder,
+) error {
 	if err := encoder.Decode(bfe, enc, &po.feeer); err != nil {
 		return err
 	}
 
 	po.newAccountMinBalance = mn
+	po.feeBurnRatio = fb
+	po.maxSupply = ms
+	po.feeCurrency = CurrencyID(fc)
+	po.feeRate = ft
+
+	if len(ufrs) > 0 {
+		frs := make([]FeeReceiver, len(ufrs))
+		for i := range ufrs {
+			a, err := ufrs[i].AD.Encode(enc)
+			if err != nil {
+				return err
+			}
+
+			frs[i] = NewFeeReceiver(a, ufrs[i].WE)
+		}
+
+		po.feeReceivers = frs
+	}
+
+	if len(ufx) > 0 {
+		fx := make([]base.Address, len(ufx))
+		for i := range ufx {
+			a, err := ufx[i].Encode(enc)
+			if err != nil {
+				return err
+			}
+
+			fx[i] = a
+		}
+
+		po.feeExemptions = fx
+	}
 
 	return nil
 }
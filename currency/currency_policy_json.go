@@ -3,26 +3,62 @@ package currency
 import (
 	"encoding/json"
 
+	"github.com/spikeekips/mitum/base"
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
 )
 
+type FeeReceiverJSONPacker struct {
+	AD base.Address `json:"address"`
+	WE uint         `json:"weight"`
+}
+
+func (fr FeeReceiver) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(FeeReceiverJSONPacker{
+		AD: fr.address,
+		WE: fr.weight,
+	})
+}
+
 type CurrencyPolicyJSONPacker struct {
 	jsonenc.HintedHead
-	MN Big   `json:"new_account_min_balance"`
-	FE Feeer `json:"feeer"`
+	MN Big            `json:"new_account_min_balance"`
+	FE Feeer          `json:"feeer"`
+	FR []FeeReceiver  `json:"fee_receivers,omitempty"`
+	FB float64        `json:"fee_burn_ratio,omitempty"`
+	MS *Big           `json:"max_supply,omitempty"`
+	FC CurrencyID     `json:"fee_currency,omitempty"`
+	FT float64        `json:"fee_rate,omitempty"`
+	FX []base.Address `json:"fee_exemptions,omitempty"`
 }
 
 func (po CurrencyPolicy) MarshalJSON() ([]byte, error) {
+	var ms *Big
+	if po.hasMaxSupply() {
+		ms = &po.maxSupply
+	}
+
 	return jsonenc.Marshal(CurrencyPolicyJSONPacker{
 		HintedHead: jsonenc.NewHintedHead(po.Hint()),
 		MN:         po.newAccountMinBalance,
 		FE:         po.feeer,
+		FR:         po.feeReceivers,
+		FB:         po.feeBurnRatio,
+		MS:         ms,
+		FC:         po.feeCurrency,
+		FT:         po.feeRate,
+		FX:         po.feeExemptions,
 	})
 }
 
 type CurrencyPolicyJSONUnpacker struct {
-	MN Big             `json:"new_account_min_balance"`
-	FE json.RawMessage `json:"feeer"`
+	MN Big                   `json:"new_account_min_balance"`
+	FE json.RawMessage       `json:"feeer"`
+	FR []FeeReceiverUnpacker `json:"fee_receivers"`
+	FB float64               `json:"fee_burn_ratio"`
+	MS Big                   `json:"max_supply"`
+	FC string                `json:"fee_currency"`
+	FT float64               `json:"fee_rate"`
+	FX []base.AddressDecoder `json:"fee_exemptions"`
 }
 
 func (po *CurrencyPolicy) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -31,5 +67,5 @@ func (po *CurrencyPolicy) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 		return err
 	}
 
-	return po.unpack(enc, upo.MN, upo.FE)
+	return po.unpack(enc, upo.MN, upo.FE, upo.FR, upo.FB, upo.MS, upo.FC, upo.FT, upo.FX)
 }
@@ -3,6 +3,7 @@ package currency
 import (
 	"testing"
 
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/encoder"
 	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
@@ -26,6 +27,137 @@ func (t *testCurrencyPolicy) TestInValidNewAccountMinBalance() {
 	t.Contains(err.Error(), "NewAccountMinBalance under zero")
 }
 
+func (t *testCurrencyPolicy) TestInvalidFeeReceivers() {
+	a := MustAddress(util.UUID().String())
+
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer()).SetFeeReceivers([]FeeReceiver{NewFeeReceiver(a, 0)})
+	err := po.IsValid(nil)
+	t.Contains(err.Error(), "fee receiver weight should be over zero")
+
+	po = NewCurrencyPolicy(ZeroBig, NewNilFeeer()).SetFeeReceivers([]FeeReceiver{
+		NewFeeReceiver(a, 1),
+		NewFeeReceiver(a, 2),
+	})
+	err = po.IsValid(nil)
+	t.Contains(err.Error(), "duplicated fee receiver")
+}
+
+func (t *testCurrencyPolicy) TestSplitFee() {
+	feeerReceiver := MustAddress(util.UUID().String())
+	feeer := NewFixedFeeer(feeerReceiver, NewBig(7))
+
+	po := NewCurrencyPolicy(ZeroBig, feeer)
+	t.NoError(po.IsValid(nil))
+
+	frs, shares := po.SplitFee(NewBig(7))
+	t.Equal(1, len(frs))
+	t.True(feeerReceiver.Equal(frs[0].Address()))
+	t.Equal(NewBig(7), shares[0])
+
+	ra := MustAddress(util.UUID().String())
+	rb := MustAddress(util.UUID().String())
+	rc := MustAddress(util.UUID().String())
+
+	po = po.SetFeeReceivers([]FeeReceiver{
+		NewFeeReceiver(ra, 2),
+		NewFeeReceiver(rb, 1),
+		NewFeeReceiver(rc, 1),
+	})
+	t.NoError(po.IsValid(nil))
+
+	frs, shares = po.SplitFee(NewBig(7))
+	t.Equal(3, len(frs))
+	t.True(ra.Equal(frs[0].Address()))
+	t.Equal([]string{"4", "2", "1"}, []string{shares[0].String(), shares[1].String(), shares[2].String()})
+
+	frs, shares = po.SplitFee(NewBig(8))
+	t.Equal(3, len(frs))
+	t.Equal([]string{"4", "2", "2"}, []string{shares[0].String(), shares[1].String(), shares[2].String()})
+
+	frs, _ = NewCurrencyPolicy(ZeroBig, NewNilFeeer()).SplitFee(NewBig(8))
+	t.Empty(frs)
+}
+
+func (t *testCurrencyPolicy) TestBurnFee() {
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer()).SetFeeBurnRatio(1.1)
+	err := po.IsValid(nil)
+	t.Contains(err.Error(), "invalid fee burn ratio")
+
+	burned, rest := NewCurrencyPolicy(ZeroBig, NewNilFeeer()).BurnFee(NewBig(10))
+	t.Equal(ZeroBig, burned)
+	t.Equal(NewBig(10), rest)
+
+	po = NewCurrencyPolicy(ZeroBig, NewNilFeeer()).SetFeeBurnRatio(0.25)
+	t.NoError(po.IsValid(nil))
+
+	burned, rest = po.BurnFee(NewBig(10))
+	t.Equal(NewBig(2), burned)
+	t.Equal(NewBig(8), rest)
+
+	burned, rest = po.SetFeeBurnRatio(1).BurnFee(NewBig(10))
+	t.Equal(NewBig(10), burned)
+	t.Equal(ZeroBig, rest)
+}
+
+func (t *testCurrencyPolicy) TestInvalidMaxSupply() {
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer())
+	_, found := po.MaxSupply()
+	t.False(found)
+
+	po = po.SetMaxSupply(ZeroBig)
+	err := po.IsValid(nil)
+	t.Contains(err.Error(), "max supply should be over zero")
+
+	po = po.SetMaxSupply(NewBig(10))
+	t.NoError(po.IsValid(nil))
+
+	ms, found := po.MaxSupply()
+	t.True(found)
+	t.True(ms.Equal(NewBig(10)))
+}
+
+func (t *testCurrencyPolicy) TestPayFee() {
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer())
+
+	cid, fee := po.PayFee(CurrencyID("ABC"), NewBig(10))
+	t.Equal(CurrencyID("ABC"), cid)
+	t.Equal(NewBig(10), fee)
+
+	po = po.SetFeeCurrency(CurrencyID("FEE"), 0)
+	t.NoError(po.IsValid(nil))
+
+	cid, fee = po.PayFee(CurrencyID("ABC"), NewBig(10))
+	t.Equal(CurrencyID("FEE"), cid)
+	t.Equal(NewBig(10), fee)
+
+	po = po.SetFeeCurrency(CurrencyID("FEE"), 2.5)
+	t.NoError(po.IsValid(nil))
+
+	cid, fee = po.PayFee(CurrencyID("ABC"), NewBig(10))
+	t.Equal(CurrencyID("FEE"), cid)
+	t.Equal(NewBig(25), fee)
+
+	err := po.SetFeeCurrency(CurrencyID("FEE"), -1).IsValid(nil)
+	t.Contains(err.Error(), "fee rate should not be under zero")
+
+	err = po.SetFeeCurrency(CurrencyID("fee"), 0).IsValid(nil)
+	t.Contains(err.Error(), "invalid fee currency")
+}
+
+func (t *testCurrencyPolicy) TestFeeExemptions() {
+	a := MustAddress(util.UUID().String())
+	b := MustAddress(util.UUID().String())
+
+	po := NewCurrencyPolicy(ZeroBig, NewNilFeeer()).SetFeeExemptions([]base.Address{a})
+	t.NoError(po.IsValid(nil))
+	t.True(po.IsFeeExempted(a))
+	t.False(po.IsFeeExempted(b))
+	t.False(po.IsFeeExempted(nil))
+
+	err := po.SetFeeExemptions([]base.Address{a, a}).IsValid(nil)
+	t.Contains(err.Error(), "duplicated fee exemption address")
+}
+
 func TestCurrencyPolicy(t *testing.T) {
 	suite.Run(t, new(testCurrencyPolicy))
 }
@@ -35,7 +167,15 @@ func testCurrencyPolicyEncode(enc encoder.Encoder) suite.TestingSuite {
 
 	t.enc = enc
 	t.newObject = func() interface{} {
-		po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(MustAddress(util.UUID().String()), NewBig(33)))
+		po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(MustAddress(util.UUID().String()), NewBig(33))).
+			SetFeeReceivers([]FeeReceiver{
+				NewFeeReceiver(MustAddress(util.UUID().String()), 3),
+				NewFeeReceiver(MustAddress(util.UUID().String()), 1),
+			}).
+			SetFeeBurnRatio(0.25).
+			SetMaxSupply(NewBig(999)).
+			SetFeeCurrency(CurrencyID("FEE"), 0.1).
+			SetFeeExemptions([]base.Address{MustAddress(util.UUID().String())})
 		po.BaseHinter = hint.NewBaseHinter(hint.NewHint(CurrencyPolicyType, "v0.0.9"))
 
 		return po
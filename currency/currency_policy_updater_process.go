@@ -76,6 +76,10 @@ func (opp *CurrencyPolicyUpdaterProcessor) PreProcess(
 		}
 		opp.st = i
 		opp.de, _ = opp.cp.Get(fact.Currency())
+
+		if err := fact.Policy().checkMaxSupply(opp.de.Aggregate()); err != nil {
+			return nil, err
+		}
 	}
 
 	if receiver := fact.Policy().Feeer().Receiver(); receiver != nil {
@@ -84,6 +88,14 @@ func (opp *CurrencyPolicyUpdaterProcessor) PreProcess(
 		}
 	}
 
+	if err := checkFeeReceiversExist(fact.Policy(), getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkFeeCurrency(fact.Currency(), fact.Policy(), getState); err != nil {
+		return nil, err
+	}
+
 	return opp, nil
 }
 
@@ -93,11 +105,7 @@ func (opp *CurrencyPolicyUpdaterProcessor) Process(
 ) error {
 	fact := opp.Fact().(CurrencyPolicyUpdaterFact)
 
-	i, err := SetStateCurrencyDesignValue(opp.st, opp.de.SetPolicy(fact.Policy()))
-	if err != nil {
-		return err
-	}
-	return setState(fact.Hash(), i)
+	return setState(fact.Hash(), NewCurrencyDesignState(opp.st, fact.Currency()).SetPolicy(fact.Policy()))
 }
 
 func (opp *CurrencyPolicyUpdaterProcessor) Close() error {
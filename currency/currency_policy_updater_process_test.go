@@ -74,7 +74,7 @@ func (t *testCurrencyPolicyUpdaterOperations) TestNew() {
 	ga, s := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
 	sts = append(sts, s...)
 
-	de := t.currencyDesign(NewBig(33), t.cid, ga.Address)
+	de := t.currencyDesign(NewBig(33), t.cid, ga.Address).SetDecimals(2)
 
 	{
 		st, err := state.NewStateV0(StateKeyCurrencyDesign(de.Currency()), nil, base.Height(33))
@@ -108,6 +108,7 @@ func (t *testCurrencyPolicyUpdaterOperations) TestNew() {
 
 	t.True(de.Amount.Equal(ude.Amount))
 	t.NotEqual(de.Policy(), ude.Policy())
+	t.Equal(de.Decimals(), ude.Decimals())
 }
 
 func (t *testCurrencyPolicyUpdaterOperations) TestEmptyPubs() {
@@ -219,6 +220,87 @@ func (t *testCurrencyPolicyUpdaterOperations) TestUnknownCurrency() {
 	t.Contains(err.Error(), "unknown currency")
 }
 
+func (t *testCurrencyPolicyUpdaterOperations) TestUnderAggregateMaxSupply() {
+	var sts []state.State
+
+	privs, copr := t.processor(3)
+
+	ga, s := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	sts = append(sts, s...)
+
+	de := t.currencyDesign(NewBig(33), t.cid, ga.Address)
+
+	{
+		st, err := state.NewStateV0(StateKeyCurrencyDesign(de.Currency()), nil, base.Height(33))
+		t.NoError(err)
+
+		nst, err := SetStateCurrencyDesignValue(st, de)
+		t.NoError(err)
+		sts = append(sts, nst)
+
+		t.NoError(copr.cp.Set(nst))
+	}
+
+	pool, _ := t.statepool(sts)
+
+	opr := copr.New(pool)
+
+	po := NewCurrencyPolicy(NewBig(1), NewFixedFeeer(ga.Address, NewBig(44))).SetMaxSupply(NewBig(32))
+	op := t.newOperation(privs, t.cid, po)
+
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "over max supply")
+
+	po = po.SetMaxSupply(NewBig(33))
+	op = t.newOperation(privs, t.cid, po)
+	t.NoError(opr.Process(op))
+}
+
+func (t *testCurrencyPolicyUpdaterOperations) TestUnknownFeeCurrency() {
+	var sts []state.State
+
+	privs, copr := t.processor(3)
+
+	ga, s := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	sts = append(sts, s...)
+
+	de := t.currencyDesign(NewBig(33), t.cid, ga.Address)
+
+	{
+		st, err := state.NewStateV0(StateKeyCurrencyDesign(de.Currency()), nil, base.Height(33))
+		t.NoError(err)
+
+		nst, err := SetStateCurrencyDesignValue(st, de)
+		t.NoError(err)
+		sts = append(sts, nst)
+
+		t.NoError(copr.cp.Set(nst))
+	}
+
+	pool, _ := t.statepool(sts)
+
+	opr := copr.New(pool)
+
+	po := NewCurrencyPolicy(NewBig(1), NewFixedFeeer(ga.Address, NewBig(44))).SetFeeCurrency(CurrencyID("FINEME"), 0)
+	op := t.newOperation(privs, t.cid, po)
+
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "fee currency not found")
+
+	po = po.SetFeeCurrency(t.cid, 0)
+	op = t.newOperation(privs, t.cid, po)
+
+	err = opr.Process(op)
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "same with currency")
+}
+
 func (t *testCurrencyPolicyUpdaterOperations) TestUnknownReceiver() {
 	var sts []state.State
 
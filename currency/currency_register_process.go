@@ -83,6 +83,14 @@ func (opp *CurrencyRegisterProcessor) PreProcess(
 		}
 	}
 
+	if err := checkFeeReceiversExist(item.Policy(), getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkFeeCurrency(item.Currency(), item.Policy(), getState); err != nil {
+		return nil, err
+	}
+
 	switch st, found, err := getState(StateKeyCurrencyDesign(item.Currency())); {
 	case err != nil:
 		return nil, err
@@ -0,0 +1,146 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	EscrowType   = hint.Type("mitum-currency-escrow")
+	EscrowHint   = hint.NewHint(EscrowType, "v0.0.1")
+	EscrowHinter = Escrow{BaseHinter: hint.NewBaseHinter(EscrowHint)}
+)
+
+type EscrowStatus string
+
+const (
+	EscrowStatusOpen     EscrowStatus = "open"
+	EscrowStatusReleased EscrowStatus = "released"
+	EscrowStatusRefunded EscrowStatus = "refunded"
+)
+
+func (es EscrowStatus) Bytes() []byte {
+	return []byte(es)
+}
+
+func (es EscrowStatus) IsValid([]byte) error {
+	switch es {
+	case EscrowStatusOpen, EscrowStatusReleased, EscrowStatusRefunded:
+		return nil
+	default:
+		return isvalid.InvalidError.Errorf("unknown escrow status, %q", es)
+	}
+}
+
+// Escrow is the state value of the amount, which is locked by EscrowCreate. The
+// id of Escrow is the fact hash of EscrowCreate.
+type Escrow struct {
+	hint.BaseHinter
+	id          valuehash.Hash
+	sender      base.Address
+	beneficiary base.Address
+	arbiter     base.Address
+	amount      Amount
+	expiry      base.Height
+	status      EscrowStatus
+}
+
+func NewEscrow(
+	id valuehash.Hash,
+	sender, beneficiary, arbiter base.Address,
+	amount Amount,
+	expiry base.Height,
+) Escrow {
+	return Escrow{
+		BaseHinter:  hint.NewBaseHinter(EscrowHint),
+		id:          id,
+		sender:      sender,
+		beneficiary: beneficiary,
+		arbiter:     arbiter,
+		amount:      amount,
+		expiry:      expiry,
+		status:      EscrowStatusOpen,
+	}
+}
+
+func (es Escrow) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		es.id.Bytes(),
+		es.sender.Bytes(),
+		es.beneficiary.Bytes(),
+		es.arbiter.Bytes(),
+		es.amount.Bytes(),
+		es.expiry.Bytes(),
+		es.status.Bytes(),
+	)
+}
+
+func (es Escrow) Hash() valuehash.Hash {
+	return es.GenerateHash()
+}
+
+func (es Escrow) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(es.Bytes())
+}
+
+func (es Escrow) IsValid([]byte) error {
+	if err := isvalid.Check(nil, false,
+		es.BaseHinter,
+		es.id,
+		es.sender,
+		es.beneficiary,
+		es.arbiter,
+		es.amount,
+		es.status,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid Escrow: %w", err)
+	}
+
+	return nil
+}
+
+func (es Escrow) ID() valuehash.Hash {
+	return es.id
+}
+
+func (es Escrow) Sender() base.Address {
+	return es.sender
+}
+
+func (es Escrow) Beneficiary() base.Address {
+	return es.beneficiary
+}
+
+func (es Escrow) Arbiter() base.Address {
+	return es.arbiter
+}
+
+func (es Escrow) Amount() Amount {
+	return es.amount
+}
+
+// Expiry is the height, after when the escrow can be refunded.
+func (es Escrow) Expiry() base.Height {
+	return es.expiry
+}
+
+func (es Escrow) Status() EscrowStatus {
+	return es.status
+}
+
+func (es Escrow) IsOpen() bool {
+	return es.status == EscrowStatusOpen
+}
+
+func (es Escrow) SetStatus(status EscrowStatus) Escrow {
+	es.status = status
+
+	return es
+}
+
+func (es Escrow) Addresses() []base.Address {
+	return []base.Address{es.sender, es.beneficiary, es.arbiter}
+}
@@ -0,0 +1,43 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (es Escrow) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(es.Hint()),
+		bson.M{
+			"id":          es.id,
+			"sender":      es.sender,
+			"beneficiary": es.beneficiary,
+			"arbiter":     es.arbiter,
+			"amount":      es.amount,
+			"expiry":      es.expiry,
+			"status":      es.status,
+		},
+	))
+}
+
+type EscrowBSONUnpacker struct {
+	ID valuehash.Bytes     `bson:"id"`
+	SD base.AddressDecoder `bson:"sender"`
+	BE base.AddressDecoder `bson:"beneficiary"`
+	AB base.AddressDecoder `bson:"arbiter"`
+	AM Amount              `bson:"amount"`
+	EX base.Height         `bson:"expiry"`
+	ST string              `bson:"status"`
+}
+
+func (es *Escrow) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ues EscrowBSONUnpacker
+	if err := enc.Unmarshal(b, &ues); err != nil {
+		return err
+	}
+
+	return es.unpack(enc, ues.ID, ues.SD, ues.BE, ues.AB, ues.AM, ues.EX, ues.ST)
+}
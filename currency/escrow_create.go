@@ -0,0 +1,145 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	EscrowCreateFactType   = hint.Type("mitum-currency-escrow-create-operation-fact")
+	EscrowCreateFactHint   = hint.NewHint(EscrowCreateFactType, "v0.0.1")
+	EscrowCreateFactHinter = EscrowCreateFact{BaseHinter: hint.NewBaseHinter(EscrowCreateFactHint)}
+	EscrowCreateType       = hint.Type("mitum-currency-escrow-create-operation")
+	EscrowCreateHint       = hint.NewHint(EscrowCreateType, "v0.0.1")
+	EscrowCreateHinter     = EscrowCreate{BaseOperation: operationHinter(EscrowCreateHint)}
+)
+
+// EscrowCreateFact locks the amount of sender until it is released to
+// beneficiary or refunded to sender.
+type EscrowCreateFact struct {
+	hint.BaseHinter
+	h           valuehash.Hash
+	token       []byte
+	sender      base.Address
+	beneficiary base.Address
+	arbiter     base.Address
+	amount      Amount
+	expiry      base.Height
+}
+
+func NewEscrowCreateFact(
+	token []byte,
+	sender, beneficiary, arbiter base.Address,
+	amount Amount,
+	expiry base.Height,
+) EscrowCreateFact {
+	fact := EscrowCreateFact{
+		BaseHinter:  hint.NewBaseHinter(EscrowCreateFactHint),
+		token:       token,
+		sender:      sender,
+		beneficiary: beneficiary,
+		arbiter:     arbiter,
+		amount:      amount,
+		expiry:      expiry,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact EscrowCreateFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact EscrowCreateFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact EscrowCreateFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.beneficiary.Bytes(),
+		fact.arbiter.Bytes(),
+		fact.amount.Bytes(),
+		fact.expiry.Bytes(),
+	)
+}
+
+func (fact EscrowCreateFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := isvalid.Check(nil, false,
+		fact.sender,
+		fact.beneficiary,
+		fact.arbiter,
+		fact.amount,
+	); err != nil {
+		return err
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return isvalid.InvalidError.Errorf("amount should be over zero")
+	}
+
+	switch {
+	case fact.sender.Equal(fact.beneficiary):
+		return isvalid.InvalidError.Errorf("beneficiary is same with sender, %q", fact.sender)
+	case fact.arbiter.Equal(fact.sender):
+		return isvalid.InvalidError.Errorf("arbiter is same with sender, %q", fact.sender)
+	case fact.arbiter.Equal(fact.beneficiary):
+		return isvalid.InvalidError.Errorf("arbiter is same with beneficiary, %q", fact.beneficiary)
+	}
+
+	if fact.expiry <= base.GenesisHeight {
+		return isvalid.InvalidError.Errorf("expiry height should be over genesis height")
+	}
+
+	return nil
+}
+
+func (fact EscrowCreateFact) Token() []byte {
+	return fact.token
+}
+
+func (fact EscrowCreateFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact EscrowCreateFact) Beneficiary() base.Address {
+	return fact.beneficiary
+}
+
+func (fact EscrowCreateFact) Arbiter() base.Address {
+	return fact.arbiter
+}
+
+func (fact EscrowCreateFact) Amount() Amount {
+	return fact.amount
+}
+
+func (fact EscrowCreateFact) Expiry() base.Height {
+	return fact.expiry
+}
+
+func (fact EscrowCreateFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.beneficiary, fact.arbiter}, nil
+}
+
+type EscrowCreate struct {
+	BaseOperation
+}
+
+func NewEscrowCreate(fact EscrowCreateFact, fs []base.FactSign, memo string) (EscrowCreate, error) {
+	bo, err := NewBaseOperationFromFact(EscrowCreateHint, fact, fs, memo)
+	if err != nil {
+		return EscrowCreate{}, err
+	}
+
+	return EscrowCreate{BaseOperation: bo}, nil
+}
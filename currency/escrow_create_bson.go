@@ -0,0 +1,53 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact EscrowCreateFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":        fact.h,
+				"token":       fact.token,
+				"sender":      fact.sender,
+				"beneficiary": fact.beneficiary,
+				"arbiter":     fact.arbiter,
+				"amount":      fact.amount,
+				"expiry":      fact.expiry,
+			}))
+}
+
+type EscrowCreateFactBSONUnpacker struct {
+	H  valuehash.Bytes     `bson:"hash"`
+	TK []byte              `bson:"token"`
+	SD base.AddressDecoder `bson:"sender"`
+	BE base.AddressDecoder `bson:"beneficiary"`
+	AB base.AddressDecoder `bson:"arbiter"`
+	AM Amount              `bson:"amount"`
+	EX base.Height         `bson:"expiry"`
+}
+
+func (fact *EscrowCreateFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ufact EscrowCreateFactBSONUnpacker
+	if err := bson.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, ufact.BE, ufact.AB, ufact.AM, ufact.EX)
+}
+
+func (op *EscrowCreate) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *EscrowCreateFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bsender,
+	bbeneficiary,
+	barbiter base.AddressDecoder,
+	am Amount,
+	expiry base.Height,
+) error {
+	sender, err := bsender.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	beneficiary, err := bbeneficiary.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	arbiter, err := barbiter.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.sender = sender
+	fact.beneficiary = beneficiary
+	fact.arbiter = arbiter
+	fact.amount = am
+	fact.expiry = expiry
+
+	return nil
+}
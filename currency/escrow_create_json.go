@@ -0,0 +1,61 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type EscrowCreateFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	SD base.Address   `json:"sender"`
+	BE base.Address   `json:"beneficiary"`
+	AB base.Address   `json:"arbiter"`
+	AM Amount         `json:"amount"`
+	EX base.Height    `json:"expiry"`
+}
+
+func (fact EscrowCreateFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(EscrowCreateFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		BE:         fact.beneficiary,
+		AB:         fact.arbiter,
+		AM:         fact.amount,
+		EX:         fact.expiry,
+	})
+}
+
+type EscrowCreateFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	BE base.AddressDecoder `json:"beneficiary"`
+	AB base.AddressDecoder `json:"arbiter"`
+	AM Amount              `json:"amount"`
+	EX base.Height         `json:"expiry"`
+}
+
+func (fact *EscrowCreateFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact EscrowCreateFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, ufact.BE, ufact.AB, ufact.AM, ufact.EX)
+}
+
+func (op *EscrowCreate) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package currency
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var escrowCreateProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(EscrowCreateProcessor)
+	},
+}
+
+func (EscrowCreate) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type EscrowCreateProcessor struct {
+	cp *CurrencyPool
+	EscrowCreate
+	height base.Height
+	es     state.State
+	si     AccountIndexState
+	sb     AmountState
+	fee    feePayment
+}
+
+func NewEscrowCreateProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(EscrowCreate)
+		if !ok {
+			return nil, errors.Errorf("not EscrowCreate, %T", op)
+		}
+
+		opp := escrowCreateProcessorPool.Get().(*EscrowCreateProcessor)
+
+		opp.cp = cp
+		opp.EscrowCreate = i
+		opp.height = base.NilHeight
+		opp.es = nil
+		opp.si = AccountIndexState{}
+		opp.sb = AmountState{}
+		opp.fee = feePayment{}
+
+		return opp, nil
+	}
+}
+
+func (opp *EscrowCreateProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(EscrowCreateFact)
+
+	if err := checkExistsState(StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkActiveAccountState(fact.sender, getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, errors.Wrap(err, "invalid signing")
+	}
+
+	if _, err := existsState(StateKeyAccount(fact.beneficiary), "beneficiary", getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkNotClosedState(fact.beneficiary, getState); err != nil {
+		return nil, err
+	}
+
+	if _, err := existsState(StateKeyAccount(fact.arbiter), "arbiter", getState); err != nil {
+		return nil, err
+	}
+
+	if fact.expiry <= opp.height {
+		return nil, operation.NewBaseReasonError("expiry height, %v should be over current height, %v", fact.expiry, opp.height)
+	}
+
+	cid := fact.amount.Currency()
+	policy, found := opp.cp.Policy(cid)
+	if !found {
+		return nil, operation.NewBaseReasonError("currency, %q not found of EscrowCreate", cid)
+	}
+
+	es, err := notExistsState(StateKeyEscrow(fact.Hash()), "escrow", getState)
+	if err != nil {
+		return nil, err
+	}
+
+	si, err := loadAccountIndexState(fact.sender, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := existsState(StateKeyBalance(fact.sender, cid), "balance of sender", getState)
+	if err != nil {
+		return nil, err
+	}
+	sb := NewAmountState(st, cid)
+
+	fee, err := loadFeePayment(opp.cp, policy, fact.sender, cid, fact.amount.Big(), getState)
+	if err != nil {
+		return nil, err
+	}
+
+	switch b, e := StateBalanceValue(sb); {
+	case e != nil:
+		return nil, operation.NewBaseReasonErrorFromError(e)
+	case b.Big().Compare(fee.required(fact.amount.Big())) < 0:
+		return nil, operation.NewBaseReasonError("insufficient balance with fee")
+	}
+
+	opp.es = es
+	opp.si = si
+	opp.sb = sb
+	opp.fee = fee
+
+	return opp, nil
+}
+
+func (opp *EscrowCreateProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(EscrowCreateFact)
+
+	es, err := SetStateEscrowValue(opp.es, NewEscrow(
+		fact.Hash(),
+		fact.sender,
+		fact.beneficiary,
+		fact.arbiter,
+		fact.amount,
+		fact.expiry,
+	))
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	sts := []state.State{es, opp.si.Add(es.Key())}
+
+	return setState(fact.Hash(), append(sts, opp.fee.pay(opp.sb, fact.amount.Big())...)...)
+}
+
+func (opp *EscrowCreateProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *EscrowCreateProcessor) Close() error {
+	opp.cp = nil
+	opp.EscrowCreate = EscrowCreate{}
+	opp.height = base.NilHeight
+	opp.es = nil
+	opp.si = AccountIndexState{}
+	opp.sb = AmountState{}
+	opp.fee = feePayment{}
+
+	escrowCreateProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (es *Escrow) unpack(
+	enc encoder.Encoder,
+	id valuehash.Hash,
+	bsender,
+	bbeneficiary,
+	barbiter base.AddressDecoder,
+	am Amount,
+	expiry base.Height,
+	status string,
+) error {
+	sender, err := bsender.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	beneficiary, err := bbeneficiary.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	arbiter, err := barbiter.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	es.id = id
+	es.sender = sender
+	es.beneficiary = beneficiary
+	es.arbiter = arbiter
+	es.amount = am
+	es.expiry = expiry
+	es.status = EscrowStatus(status)
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type EscrowJSONPacker struct {
+	jsonenc.HintedHead
+	ID valuehash.Hash `json:"id"`
+	SD base.Address   `json:"sender"`
+	BE base.Address   `json:"beneficiary"`
+	AB base.Address   `json:"arbiter"`
+	AM Amount         `json:"amount"`
+	EX base.Height    `json:"expiry"`
+	ST EscrowStatus   `json:"status"`
+}
+
+func (es Escrow) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(EscrowJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(es.Hint()),
+		ID:         es.id,
+		SD:         es.sender,
+		BE:         es.beneficiary,
+		AB:         es.arbiter,
+		AM:         es.amount,
+		EX:         es.expiry,
+		ST:         es.status,
+	})
+}
+
+type EscrowJSONUnpacker struct {
+	ID valuehash.Bytes     `json:"id"`
+	SD base.AddressDecoder `json:"sender"`
+	BE base.AddressDecoder `json:"beneficiary"`
+	AB base.AddressDecoder `json:"arbiter"`
+	AM Amount              `json:"amount"`
+	EX base.Height         `json:"expiry"`
+	ST string              `json:"status"`
+}
+
+func (es *Escrow) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ues EscrowJSONUnpacker
+	if err := enc.Unmarshal(b, &ues); err != nil {
+		return err
+	}
+
+	return es.unpack(enc, ues.ID, ues.SD, ues.BE, ues.AB, ues.AM, ues.EX, ues.ST)
+}
@@ -0,0 +1,437 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testEscrowOperations struct {
+	baseTestOperationProcessor
+}
+
+func (t *testEscrowOperations) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr := NewOperationProcessor(cp)
+
+	_, err := copr.SetProcessor(EscrowCreateHinter, NewEscrowCreateProcessor(cp))
+	t.NoError(err)
+	_, err = copr.SetProcessor(EscrowReleaseHinter, NewEscrowReleaseProcessor())
+	t.NoError(err)
+	_, err = copr.SetProcessor(EscrowRefundHinter, NewEscrowRefundProcessor())
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testEscrowOperations) signs(fact base.Fact, pks []key.Privatekey) []base.FactSign {
+	fs := make([]base.FactSign, len(pks))
+	for i := range pks {
+		sig, err := base.NewFactSignature(pks[i], fact, nil)
+		t.NoError(err)
+
+		fs[i] = base.NewBaseFactSign(pks[i].Publickey(), sig)
+	}
+
+	return fs
+}
+
+func (t *testEscrowOperations) newCreate(
+	sender, beneficiary, arbiter base.Address,
+	am Amount,
+	expiry base.Height,
+	pks []key.Privatekey,
+) EscrowCreate {
+	fact := NewEscrowCreateFact(util.UUID().Bytes(), sender, beneficiary, arbiter, am, expiry)
+
+	op, err := NewEscrowCreate(fact, t.signs(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testEscrowOperations) newRelease(sender base.Address, id valuehash.Hash, pks []key.Privatekey) EscrowRelease {
+	fact := NewEscrowReleaseFact(util.UUID().Bytes(), sender, id)
+
+	op, err := NewEscrowRelease(fact, t.signs(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testEscrowOperations) newRefund(sender base.Address, id valuehash.Hash, pks []key.Privatekey) EscrowRefund {
+	fact := NewEscrowRefundFact(util.UUID().Bytes(), sender, id)
+
+	op, err := NewEscrowRefund(fact, t.signs(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testEscrowOperations) newStateEscrow(es Escrow) state.State {
+	st, err := state.NewStateV0(StateKeyEscrow(es.ID()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateEscrowValue(st, es)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *testEscrowOperations) TestCreate() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ba, st1 := t.newAccount(true, nil)
+	aa, st2 := t.newAccount(true, nil)
+	fa, st3 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1, st2, st3)
+
+	fee := NewBig(3)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, fee))))
+
+	opr := t.processor(cp, pool)
+
+	am := NewAmount(NewBig(10), t.cid)
+	op := t.newCreate(sa.Address, ba.Address, aa.Address, am, base.Height(10), sa.Privs())
+
+	t.NoError(opr.Process(op))
+	t.NoError(opr.Close())
+
+	var sst, est, ist state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyEscrow(op.Fact().Hash()):
+			est = st.GetState()
+		case StateKeyAccountIndex(sa.Address):
+			ist = st.GetState()
+		}
+	}
+
+	sb, err := StateBalanceValue(sst)
+	t.NoError(err)
+	t.True(NewBig(33).Sub(am.Big()).Sub(fee).Equal(sb.Big()))
+
+	es, err := StateEscrowValue(est)
+	t.NoError(err)
+	t.NoError(es.IsValid(nil))
+	t.True(es.ID().Equal(op.Fact().Hash()))
+	t.True(es.Sender().Equal(sa.Address))
+	t.True(es.Beneficiary().Equal(ba.Address))
+	t.True(es.Arbiter().Equal(aa.Address))
+	t.True(es.Amount().Equal(am))
+	t.Equal(base.Height(10), es.Expiry())
+	t.True(es.IsOpen())
+
+	ai, err := StateAccountIndexValue(ist)
+	t.NoError(err)
+	t.Equal([]string{StateKeyEscrow(op.Fact().Hash())}, ai.Keys())
+}
+
+func (t *testEscrowOperations) TestCreateInsufficientBalance() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ba, st1 := t.newAccount(true, nil)
+	aa, st2 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1, st2)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, NewBig(1)))))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCreate(sa.Address, ba.Address, aa.Address, NewAmount(NewBig(10), t.cid), base.Height(10), sa.Privs())
+
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "insufficient balance with fee")
+}
+
+func (t *testEscrowOperations) TestCreateArbiterNotExist() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ba, st1 := t.newAccount(true, nil)
+	aa, _ := t.newAccount(false, nil)
+
+	pool, _ := t.statepool(st0, st1)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newCreate(sa.Address, ba.Address, aa.Address, NewAmount(NewBig(10), t.cid), base.Height(10), sa.Privs())
+
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "arbiter does not exist")
+}
+
+func (t *testEscrowOperations) TestRelease() {
+	sa, st0 := t.newAccount(true, nil)
+	ba, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+	aa, st2 := t.newAccount(true, nil)
+
+	am := NewAmount(NewBig(10), t.cid)
+	es := NewEscrow(valuehash.RandomSHA256(), sa.Address, ba.Address, aa.Address, am, base.Height(10))
+
+	other := StateKeyEscrow(valuehash.RandomSHA256())
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{
+		t.newStateEscrow(es),
+		t.newStateAccountIndex(sa.Address, StateKeyEscrow(es.ID()), other),
+	})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, NewBig(1)))))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newRelease(aa.Address, es.ID(), aa.Privs())))
+	t.NoError(opr.Close())
+
+	var bst, est, ist state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(ba.Address, t.cid):
+			bst = st.GetState()
+		case StateKeyEscrow(es.ID()):
+			est = st.GetState()
+		case StateKeyAccountIndex(sa.Address):
+			ist = st.GetState()
+		}
+	}
+
+	bb, err := StateBalanceValue(bst)
+	t.NoError(err)
+	t.True(NewBig(11).Equal(bb.Big()))
+
+	ues, err := StateEscrowValue(est)
+	t.NoError(err)
+	t.Equal(EscrowStatusReleased, ues.Status())
+
+	ai, err := StateAccountIndexValue(ist)
+	t.NoError(err)
+	t.Equal([]string{other}, ai.Keys())
+}
+
+func (t *testEscrowOperations) TestReleaseByUnknown() {
+	sa, st0 := t.newAccount(true, nil)
+	ba, st1 := t.newAccount(true, nil)
+	aa, st2 := t.newAccount(true, nil)
+
+	es := NewEscrow(valuehash.RandomSHA256(), sa.Address, ba.Address, aa.Address, NewAmount(NewBig(10), t.cid), base.Height(10))
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{t.newStateEscrow(es)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newRelease(ba.Address, es.ID(), ba.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "should be sender or arbiter of escrow")
+}
+
+func (t *testEscrowOperations) TestReleaseAlreadySettled() {
+	sa, st0 := t.newAccount(true, nil)
+	ba, st1 := t.newAccount(true, nil)
+	aa, st2 := t.newAccount(true, nil)
+
+	es := NewEscrow(valuehash.RandomSHA256(), sa.Address, ba.Address, aa.Address, NewAmount(NewBig(10), t.cid), base.Height(10)).
+		SetStatus(EscrowStatusRefunded)
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{t.newStateEscrow(es)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newRelease(sa.Address, es.ID(), sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "already refunded")
+}
+
+func (t *testEscrowOperations) TestSettleTwiceInSameProposal() {
+	sa, st0 := t.newAccount(true, nil)
+	ba, st1 := t.newAccount(true, nil)
+	aa, st2 := t.newAccount(true, nil)
+
+	es := NewEscrow(valuehash.RandomSHA256(), sa.Address, ba.Address, aa.Address, NewAmount(NewBig(10), t.cid), base.Height(10))
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{t.newStateEscrow(es)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newRelease(sa.Address, es.ID(), sa.Privs())))
+
+	err := opr.Process(t.newRelease(aa.Address, es.ID(), aa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "duplicated escrow")
+}
+
+func (t *testEscrowOperations) TestRefund() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+	ba, st1 := t.newAccount(true, nil)
+	aa, st2 := t.newAccount(true, nil)
+
+	es := NewEscrow(valuehash.RandomSHA256(), sa.Address, ba.Address, aa.Address, NewAmount(NewBig(10), t.cid), base.Height(10))
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{t.newStateEscrow(es)})
+
+	op := t.newRefund(sa.Address, es.ID(), sa.Privs())
+
+	opp, err := NewEscrowRefundProcessor()(op)
+	t.NoError(err)
+
+	// NOTE before expiry
+	opp.(*EscrowRefundProcessor).setHeight(base.Height(9))
+
+	_, err = opp.(*EscrowRefundProcessor).PreProcess(pool.Get, pool.Set)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "not yet expired")
+
+	// NOTE at expiry
+	opp.(*EscrowRefundProcessor).setHeight(base.Height(10))
+
+	_, err = opp.(*EscrowRefundProcessor).PreProcess(pool.Get, pool.Set)
+	t.NoError(err)
+	t.NoError(opp.(*EscrowRefundProcessor).Process(pool.Get, pool.Set))
+
+	var sst, est state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyEscrow(es.ID()):
+			est = st.GetState()
+		}
+	}
+
+	sb, err := StateBalanceValue(sst)
+	t.NoError(err)
+	t.True(NewBig(11).Equal(sb.Big()))
+
+	ues, err := StateEscrowValue(est)
+	t.NoError(err)
+	t.Equal(EscrowStatusRefunded, ues.Status())
+}
+
+func (t *testEscrowOperations) TestReleaseAfterExpiry() {
+	sa, st0 := t.newAccount(true, nil)
+	ba, st1 := t.newAccount(true, nil)
+	aa, st2 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1, st2)
+
+	es := NewEscrow(valuehash.RandomSHA256(), sa.Address, ba.Address, aa.Address, NewAmount(NewBig(10), t.cid), pool.Height())
+
+	pool, _ = t.statepool(st0, st1, st2, []state.State{t.newStateEscrow(es)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newRelease(aa.Address, es.ID(), aa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "already expired")
+}
+
+func (t *testEscrowOperations) TestReleaseToFrozenBeneficiary() {
+	sa, st0 := t.newAccount(true, nil)
+	ba, st1 := t.newAccount(true, nil)
+	aa, st2 := t.newAccount(true, nil)
+
+	es := NewEscrow(valuehash.RandomSHA256(), sa.Address, ba.Address, aa.Address, NewAmount(NewBig(10), t.cid), base.Height(10))
+
+	pool, _ := t.statepool(st0, t.freezeAccountState(ba.Address, st1), st2, []state.State{t.newStateEscrow(es)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newRelease(aa.Address, es.ID(), aa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "frozen")
+}
+
+func (t *testEscrowOperations) TestReleaseAndRefundInSameBlock() {
+	sa, st0 := t.newAccount(true, nil)
+	ba, st1 := t.newAccount(true, nil)
+	aa, st2 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1, st2)
+
+	es := NewEscrow(valuehash.RandomSHA256(), sa.Address, ba.Address, aa.Address, NewAmount(NewBig(10), t.cid), pool.Height())
+
+	pool, _ = t.statepool(st0, st1, st2, []state.State{t.newStateEscrow(es)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	// NOTE release and refund are processed by the different operation
+	// processors of same block
+	copr := t.processor(cp, nil)
+	ropr := copr.New(pool)
+	fopr := copr.New(pool)
+
+	t.NoError(fopr.Process(t.newRefund(sa.Address, es.ID(), sa.Privs())))
+
+	err := ropr.Process(t.newRelease(aa.Address, es.ID(), aa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "already expired")
+
+	t.NoError(ropr.Close())
+	t.NoError(fopr.Close())
+
+	for _, st := range pool.Updates() {
+		t.NotEqual(StateKeyBalance(ba.Address, t.cid), st.Key())
+	}
+}
+
+func TestEscrowOperations(t *testing.T) {
+	suite.Run(t, new(testEscrowOperations))
+}
@@ -0,0 +1,196 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	EscrowReleaseFactType   = hint.Type("mitum-currency-escrow-release-operation-fact")
+	EscrowReleaseFactHint   = hint.NewHint(EscrowReleaseFactType, "v0.0.1")
+	EscrowReleaseFactHinter = EscrowReleaseFact{BaseHinter: hint.NewBaseHinter(EscrowReleaseFactHint)}
+	EscrowReleaseType       = hint.Type("mitum-currency-escrow-release-operation")
+	EscrowReleaseHint       = hint.NewHint(EscrowReleaseType, "v0.0.1")
+	EscrowReleaseHinter     = EscrowRelease{BaseOperation: operationHinter(EscrowReleaseHint)}
+	EscrowRefundFactType    = hint.Type("mitum-currency-escrow-refund-operation-fact")
+	EscrowRefundFactHint    = hint.NewHint(EscrowRefundFactType, "v0.0.1")
+	EscrowRefundFactHinter  = EscrowRefundFact{BaseHinter: hint.NewBaseHinter(EscrowRefundFactHint)}
+	EscrowRefundType        = hint.Type("mitum-currency-escrow-refund-operation")
+	EscrowRefundHint        = hint.NewHint(EscrowRefundType, "v0.0.1")
+	EscrowRefundHinter      = EscrowRefund{BaseOperation: operationHinter(EscrowRefundHint)}
+)
+
+// EscrowReleaseFact releases the escrowed amount to the beneficiary. The sender
+// should be the sender or the arbiter of escrow. Settling escrow does not charge
+// fee; the fee is already charged by EscrowCreate.
+type EscrowReleaseFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	escrow valuehash.Hash
+}
+
+func NewEscrowReleaseFact(token []byte, sender base.Address, escrow valuehash.Hash) EscrowReleaseFact {
+	fact := EscrowReleaseFact{
+		BaseHinter: hint.NewBaseHinter(EscrowReleaseFactHint),
+		token:      token,
+		sender:     sender,
+		escrow:     escrow,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact EscrowReleaseFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact EscrowReleaseFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact EscrowReleaseFact) Bytes() []byte {
+	return escrowSettleFactBytes(fact.token, fact.sender, fact.escrow, EscrowStatusReleased)
+}
+
+func (fact EscrowReleaseFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender, fact.escrow); err != nil {
+		return isvalid.InvalidError.Errorf("invalid EscrowReleaseFact: %w", err)
+	}
+
+	return nil
+}
+
+func (fact EscrowReleaseFact) Token() []byte {
+	return fact.token
+}
+
+func (fact EscrowReleaseFact) Sender() base.Address {
+	return fact.sender
+}
+
+// Escrow is the id of escrow, the fact hash of EscrowCreate.
+func (fact EscrowReleaseFact) Escrow() valuehash.Hash {
+	return fact.escrow
+}
+
+// Addresses returns only sender; the beneficiary is not known without the
+// escrow state, so digest adds it.
+func (fact EscrowReleaseFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type EscrowRelease struct {
+	BaseOperation
+}
+
+func NewEscrowRelease(fact EscrowReleaseFact, fs []base.FactSign, memo string) (EscrowRelease, error) {
+	bo, err := NewBaseOperationFromFact(EscrowReleaseHint, fact, fs, memo)
+	if err != nil {
+		return EscrowRelease{}, err
+	}
+
+	return EscrowRelease{BaseOperation: bo}, nil
+}
+
+// EscrowRefundFact refunds the escrowed amount to the sender of escrow after
+// the expiry height. The sender should be the sender or the arbiter of escrow.
+type EscrowRefundFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	escrow valuehash.Hash
+}
+
+func NewEscrowRefundFact(token []byte, sender base.Address, escrow valuehash.Hash) EscrowRefundFact {
+	fact := EscrowRefundFact{
+		BaseHinter: hint.NewBaseHinter(EscrowRefundFactHint),
+		token:      token,
+		sender:     sender,
+		escrow:     escrow,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact EscrowRefundFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact EscrowRefundFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact EscrowRefundFact) Bytes() []byte {
+	return escrowSettleFactBytes(fact.token, fact.sender, fact.escrow, EscrowStatusRefunded)
+}
+
+func (fact EscrowRefundFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender, fact.escrow); err != nil {
+		return isvalid.InvalidError.Errorf("invalid EscrowRefundFact: %w", err)
+	}
+
+	return nil
+}
+
+func (fact EscrowRefundFact) Token() []byte {
+	return fact.token
+}
+
+func (fact EscrowRefundFact) Sender() base.Address {
+	return fact.sender
+}
+
+// Escrow is the id of escrow, the fact hash of EscrowCreate.
+func (fact EscrowRefundFact) Escrow() valuehash.Hash {
+	return fact.escrow
+}
+
+// Addresses returns only sender; the sender of escrow is not known without the
+// escrow state, so digest adds it.
+func (fact EscrowRefundFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type EscrowRefund struct {
+	BaseOperation
+}
+
+func NewEscrowRefund(fact EscrowRefundFact, fs []base.FactSign, memo string) (EscrowRefund, error) {
+	bo, err := NewBaseOperationFromFact(EscrowRefundHint, fact, fs, memo)
+	if err != nil {
+		return EscrowRefund{}, err
+	}
+
+	return EscrowRefund{BaseOperation: bo}, nil
+}
+
+// escrowSettleFactBytes appends the settled status, so EscrowReleaseFact and
+// EscrowRefundFact with same token and escrow have different hash.
+func escrowSettleFactBytes(token []byte, sender base.Address, escrow valuehash.Hash, status EscrowStatus) []byte {
+	var bs, es []byte
+	if sender != nil {
+		bs = sender.Bytes()
+	}
+
+	if escrow != nil {
+		es = escrow.Bytes()
+	}
+
+	return util.ConcatBytesSlice(token, bs, es, status.Bytes())
+}
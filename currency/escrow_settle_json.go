@@ -0,0 +1,102 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type EscrowSettleFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	SD base.Address   `json:"sender"`
+	ES valuehash.Hash `json:"escrow"`
+}
+
+type EscrowSettleFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	ES valuehash.Bytes     `json:"escrow"`
+}
+
+func (fact EscrowReleaseFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(EscrowSettleFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		ES:         fact.escrow,
+	})
+}
+
+func (fact *EscrowReleaseFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uf EscrowSettleFactJSONUnpacker
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return err
+	}
+
+	sender, err := uf.SD.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	fact.h = uf.H
+	fact.token = uf.TK
+	fact.sender = sender
+	fact.escrow = uf.ES
+
+	return nil
+}
+
+func (op *EscrowRelease) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
+
+func (fact EscrowRefundFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(EscrowSettleFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		ES:         fact.escrow,
+	})
+}
+
+func (fact *EscrowRefundFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uf EscrowSettleFactJSONUnpacker
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return err
+	}
+
+	sender, err := uf.SD.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	fact.h = uf.H
+	fact.token = uf.TK
+	fact.sender = sender
+	fact.escrow = uf.ES
+
+	return nil
+}
+
+func (op *EscrowRefund) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,240 @@
+package currency
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var escrowReleaseProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(EscrowReleaseProcessor)
+	},
+}
+
+var escrowRefundProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(EscrowRefundProcessor)
+	},
+}
+
+func (EscrowRelease) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+func (EscrowRefund) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type EscrowReleaseProcessor struct {
+	EscrowRelease
+	height base.Height
+	sts    []state.State
+}
+
+func NewEscrowReleaseProcessor() GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(EscrowRelease)
+		if !ok {
+			return nil, errors.Errorf("not EscrowRelease, %T", op)
+		}
+
+		opp := escrowReleaseProcessorPool.Get().(*EscrowReleaseProcessor)
+
+		opp.EscrowRelease = i
+		opp.height = base.NilHeight
+		opp.sts = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *EscrowReleaseProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(EscrowReleaseFact)
+
+	sts, err := preProcessEscrowSettle(
+		fact.sender, fact.escrow, opp.Signs(), EscrowStatusReleased, opp.height, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	opp.sts = sts
+
+	return opp, nil
+}
+
+func (opp *EscrowReleaseProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(EscrowReleaseFact)
+
+	return setState(fact.Hash(), opp.sts...)
+}
+
+func (opp *EscrowReleaseProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *EscrowReleaseProcessor) Close() error {
+	opp.EscrowRelease = EscrowRelease{}
+	opp.height = base.NilHeight
+	opp.sts = nil
+
+	escrowReleaseProcessorPool.Put(opp)
+
+	return nil
+}
+
+type EscrowRefundProcessor struct {
+	EscrowRefund
+	height base.Height
+	sts    []state.State
+}
+
+func NewEscrowRefundProcessor() GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(EscrowRefund)
+		if !ok {
+			return nil, errors.Errorf("not EscrowRefund, %T", op)
+		}
+
+		opp := escrowRefundProcessorPool.Get().(*EscrowRefundProcessor)
+
+		opp.EscrowRefund = i
+		opp.height = base.NilHeight
+		opp.sts = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *EscrowRefundProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(EscrowRefundFact)
+
+	sts, err := preProcessEscrowSettle(
+		fact.sender, fact.escrow, opp.Signs(), EscrowStatusRefunded, opp.height, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	opp.sts = sts
+
+	return opp, nil
+}
+
+func (opp *EscrowRefundProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(EscrowRefundFact)
+
+	return setState(fact.Hash(), opp.sts...)
+}
+
+func (opp *EscrowRefundProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *EscrowRefundProcessor) Close() error {
+	opp.EscrowRefund = EscrowRefund{}
+	opp.height = base.NilHeight
+	opp.sts = nil
+
+	escrowRefundProcessorPool.Put(opp)
+
+	return nil
+}
+
+// preProcessEscrowSettle checks the escrow can be settled by sender and
+// returns the updated escrow state, the account index state of the sender of
+// escrow and the balance state of the receiver; beneficiary for release and
+// sender of escrow for refund. Release is allowed
+// only before expiry and refund only after it, so both can not be accepted for
+// the same escrow.
+func preProcessEscrowSettle(
+	sender base.Address,
+	id valuehash.Hash,
+	fs []base.FactSign,
+	status EscrowStatus,
+	height base.Height,
+	getState func(string) (state.State, bool, error),
+) ([]state.State, error) {
+	if err := checkExistsState(StateKeyAccount(sender), getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkFactSignsByState(sender, fs, getState); err != nil {
+		return nil, errors.Wrap(err, "invalid signing")
+	}
+
+	st, err := existsState(StateKeyEscrow(id), "escrow", getState)
+	if err != nil {
+		return nil, err
+	}
+
+	es, err := StateEscrowValue(st)
+	if err != nil {
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	}
+
+	switch {
+	case !es.IsOpen():
+		return nil, operation.NewBaseReasonError("escrow, %q already %s", id, es.Status())
+	case !sender.Equal(es.Sender()) && !sender.Equal(es.Arbiter()):
+		return nil, operation.NewBaseReasonError("sender, %q should be sender or arbiter of escrow", sender)
+	}
+
+	receiver := es.Beneficiary()
+	switch {
+	case status != EscrowStatusRefunded:
+		if height >= es.Expiry() {
+			return nil, operation.NewBaseReasonError(
+				"escrow, %q already expired; expiry height, %v <= current height, %v", id, es.Expiry(), height)
+		}
+	case height < es.Expiry():
+		return nil, operation.NewBaseReasonError(
+			"escrow, %q not yet expired; expiry height, %v > current height, %v", id, es.Expiry(), height)
+	default:
+		receiver = es.Sender()
+	}
+
+	if err := checkActiveAccountState(receiver, getState); err != nil {
+		return nil, err
+	}
+
+	nst, err := SetStateEscrowValue(st, es.SetStatus(status))
+	if err != nil {
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	}
+
+	si, err := loadAccountIndexState(es.Sender(), getState)
+	if err != nil {
+		return nil, err
+	}
+
+	cid := es.Amount().Currency()
+	rst, _, err := getState(StateKeyBalance(receiver, cid))
+	if err != nil {
+		return nil, err
+	}
+
+	return []state.State{nst, si.Remove(nst.Key()), NewAmountState(rst, cid).Add(es.Amount().Big())}, nil
+}
@@ -0,0 +1,247 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testEscrow struct {
+	baseTest
+}
+
+func (t *testEscrow) TestNewCreate() {
+	fact := NewEscrowCreateFact(util.UUID().Bytes(),
+		NewTestAddress(), NewTestAddress(), NewTestAddress(), NewAmount(NewBig(10), t.cid), base.Height(10))
+	t.NoError(fact.IsValid(nil))
+
+	as, err := fact.Addresses()
+	t.NoError(err)
+	t.Equal(3, len(as))
+}
+
+func (t *testEscrow) TestCreateSameAddresses() {
+	sender := NewTestAddress()
+
+	fact := NewEscrowCreateFact(util.UUID().Bytes(),
+		sender, sender, NewTestAddress(), NewAmount(NewBig(10), t.cid), base.Height(10))
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "beneficiary is same with sender")
+
+	fact = NewEscrowCreateFact(util.UUID().Bytes(),
+		sender, NewTestAddress(), sender, NewAmount(NewBig(10), t.cid), base.Height(10))
+	err = fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "arbiter is same with sender")
+}
+
+func (t *testEscrow) TestCreateZeroAmount() {
+	fact := NewEscrowCreateFact(util.UUID().Bytes(),
+		NewTestAddress(), NewTestAddress(), NewTestAddress(), NewAmount(ZeroBig, t.cid), base.Height(10))
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "over zero")
+}
+
+func (t *testEscrow) TestCreateWrongExpiry() {
+	fact := NewEscrowCreateFact(util.UUID().Bytes(),
+		NewTestAddress(), NewTestAddress(), NewTestAddress(), NewAmount(NewBig(10), t.cid), base.GenesisHeight)
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "expiry height")
+}
+
+func (t *testEscrow) TestSettleHash() {
+	token := util.UUID().Bytes()
+	sender := NewTestAddress()
+	id := valuehash.RandomSHA256()
+
+	release := NewEscrowReleaseFact(token, sender, id)
+	refund := NewEscrowRefundFact(token, sender, id)
+	t.NoError(release.IsValid(nil))
+	t.NoError(refund.IsValid(nil))
+
+	t.False(release.Hash().Equal(refund.Hash()))
+}
+
+func (t *testEscrow) TestStatus() {
+	es := NewEscrow(valuehash.RandomSHA256(),
+		NewTestAddress(), NewTestAddress(), NewTestAddress(), NewAmount(NewBig(10), t.cid), base.Height(10))
+	t.NoError(es.IsValid(nil))
+	t.True(es.IsOpen())
+
+	nes := es.SetStatus(EscrowStatusReleased)
+	t.False(nes.IsOpen())
+	t.True(es.IsOpen())
+	t.False(es.Hash().Equal(nes.Hash()))
+
+	err := es.SetStatus(EscrowStatus("unknown")).IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "unknown escrow status")
+}
+
+func TestEscrow(t *testing.T) {
+	suite.Run(t, new(testEscrow))
+}
+
+func testEscrowEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		return NewEscrow(valuehash.RandomSHA256(),
+			NewTestAddress(), NewTestAddress(), NewTestAddress(),
+			NewAmount(NewBig(10), CurrencyID("SHOWME")), base.Height(10),
+		).SetStatus(EscrowStatusRefunded)
+	}
+
+	t.compare = func(a, b interface{}) {
+		ea := a.(Escrow)
+		eb := b.(Escrow)
+
+		t.True(ea.Hint().Equal(eb.Hint()))
+		t.True(ea.ID().Equal(eb.ID()))
+		t.True(ea.Sender().Equal(eb.Sender()))
+		t.True(ea.Beneficiary().Equal(eb.Beneficiary()))
+		t.True(ea.Arbiter().Equal(eb.Arbiter()))
+		t.True(ea.Amount().Equal(eb.Amount()))
+		t.Equal(ea.Expiry(), eb.Expiry())
+		t.Equal(ea.Status(), eb.Status())
+		t.True(ea.Hash().Equal(eb.Hash()))
+	}
+
+	return t
+}
+
+func TestEscrowEncodeJSON(t *testing.T) {
+	suite.Run(t, testEscrowEncode(jsonenc.NewEncoder()))
+}
+
+func TestEscrowEncodeBSON(t *testing.T) {
+	suite.Run(t, testEscrowEncode(bsonenc.NewEncoder()))
+}
+
+func testEscrowCreateEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.NewBasePrivatekey()
+
+		fact := NewEscrowCreateFact(util.UUID().Bytes(),
+			NewTestAddress(), NewTestAddress(), NewTestAddress(),
+			NewAmount(NewBig(10), CurrencyID("SHOWME")), base.Height(10))
+		sig, err := base.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		op, err := NewEscrowCreate(fact, []base.FactSign{base.NewBaseFactSign(pk.Publickey(), sig)}, "")
+		t.NoError(err)
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		fact := a.(EscrowCreate).Fact().(EscrowCreateFact)
+		ufact := b.(EscrowCreate).Fact().(EscrowCreateFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.beneficiary.Equal(ufact.beneficiary))
+		t.True(fact.arbiter.Equal(ufact.arbiter))
+		t.True(fact.amount.Equal(ufact.amount))
+		t.Equal(fact.expiry, ufact.expiry)
+	}
+
+	return t
+}
+
+func TestEscrowCreateEncodeJSON(t *testing.T) {
+	suite.Run(t, testEscrowCreateEncode(jsonenc.NewEncoder()))
+}
+
+func TestEscrowCreateEncodeBSON(t *testing.T) {
+	suite.Run(t, testEscrowCreateEncode(bsonenc.NewEncoder()))
+}
+
+func testEscrowSettleEncode(enc encoder.Encoder, refund bool) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.NewBasePrivatekey()
+
+		token := util.UUID().Bytes()
+		sender := NewTestAddress()
+		id := valuehash.RandomSHA256()
+
+		var fact base.Fact = NewEscrowReleaseFact(token, sender, id)
+		if refund {
+			fact = NewEscrowRefundFact(token, sender, id)
+		}
+
+		sig, err := base.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+		fs := []base.FactSign{base.NewBaseFactSign(pk.Publickey(), sig)}
+
+		if refund {
+			op, err := NewEscrowRefund(fact.(EscrowRefundFact), fs, "")
+			t.NoError(err)
+			t.NoError(op.IsValid(nil))
+
+			return op
+		}
+
+		op, err := NewEscrowRelease(fact.(EscrowReleaseFact), fs, "")
+		t.NoError(err)
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		if refund {
+			fact := a.(EscrowRefund).Fact().(EscrowRefundFact)
+			ufact := b.(EscrowRefund).Fact().(EscrowRefundFact)
+
+			t.True(fact.sender.Equal(ufact.sender))
+			t.True(fact.escrow.Equal(ufact.escrow))
+
+			return
+		}
+
+		fact := a.(EscrowRelease).Fact().(EscrowReleaseFact)
+		ufact := b.(EscrowRelease).Fact().(EscrowReleaseFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.escrow.Equal(ufact.escrow))
+	}
+
+	return t
+}
+
+func TestEscrowReleaseEncodeJSON(t *testing.T) {
+	suite.Run(t, testEscrowSettleEncode(jsonenc.NewEncoder(), false))
+}
+
+func TestEscrowReleaseEncodeBSON(t *testing.T) {
+	suite.Run(t, testEscrowSettleEncode(bsonenc.NewEncoder(), false))
+}
+
+func TestEscrowRefundEncodeJSON(t *testing.T) {
+	suite.Run(t, testEscrowSettleEncode(jsonenc.NewEncoder(), true))
+}
+
+func TestEscrowRefundEncodeBSON(t *testing.T) {
+	suite.Run(t, testEscrowSettleEncode(bsonenc.NewEncoder(), true))
+}
@@ -174,28 +174,44 @@ func (opp *FeeOperationProcessor) Process(
 ) error {
 	fact := opp.Fact().(FeeOperationFact)
 
-	sts := make([]state.State, len(fact.amounts))
+	var sts []state.State // nolint:prealloc
 	for i := range fact.amounts {
 		am := fact.amounts[i]
-		var feeer Feeer
-		j, found := opp.cp.Feeer(am.Currency())
+		policy, found := opp.cp.Policy(am.Currency())
 		if !found {
 			return errors.Errorf("unknown currency id, %q found for FeeOperation", am.Currency())
 		}
-		feeer = j
 
-		if feeer.Receiver() == nil {
+		burned, rest := policy.BurnFee(am.Big())
+		if burned.OverZero() {
+			st, err := existsState(StateKeyCurrencyDesign(am.Currency()), "currency design", getState)
+			if err != nil {
+				return err
+			}
+			sts = append(sts, NewCurrencyDesignState(st, am.Currency()).Burn(burned))
+		}
+
+		if !rest.OverZero() {
 			continue
 		}
 
-		if err := checkExistsState(StateKeyAccount(feeer.Receiver()), getState); err != nil {
-			return err
-		} else if st, _, err := getState(StateKeyBalance(feeer.Receiver(), am.Currency())); err != nil {
-			return err
-		} else {
-			rb := NewAmountState(st, am.Currency())
+		frs, shares := policy.SplitFee(rest)
+		for j := range frs {
+			if !shares[j].OverZero() {
+				continue
+			}
+
+			receiver := frs[j].Address()
+
+			if err := checkExistsState(StateKeyAccount(receiver), getState); err != nil {
+				return err
+			} else if st, _, err := getState(StateKeyBalance(receiver, am.Currency())); err != nil {
+				return err
+			} else {
+				rb := NewAmountState(st, am.Currency())
 
-			sts[i] = rb.Add(am.Big())
+				sts = append(sts, rb.Add(shares[j]))
+			}
 		}
 	}
 
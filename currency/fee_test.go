@@ -7,10 +7,12 @@ import (
 
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
 	"github.com/spikeekips/mitum/util/encoder"
 	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
 	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
 	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
 )
 
 type testFeeOperation struct {
@@ -40,6 +42,92 @@ func TestFeeOperation(t *testing.T) {
 	suite.Run(t, new(testFeeOperation))
 }
 
+type testFeeOperationProcessor struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testFeeOperationProcessor) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testFeeOperationProcessor) TestBurnMerged() {
+	fa, st0 := t.newAccount(true, []Amount{NewAmount(ZeroBig, t.cid)})
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(fa.Address, NewBig(1))).SetFeeBurnRatio(0.4)
+	dst := t.newCurrencyDesignStateWithPolicy(t.cid, NewBig(99), NewTestAddress(), po)
+
+	pool, _ := t.statepool(st0, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	// NOTE FeeOperation is created by the OperationProcessor of each
+	// operation type
+	for _, fee := range []int64{10, 20} {
+		op := NewFeeOperation(NewFeeOperationFact(base.Height(3), map[CurrencyID]Big{t.cid: NewBig(fee)}))
+		t.NoError(NewFeeOperationProcessor(cp, op).Process(pool.Get, pool.Set))
+	}
+
+	// NOTE inflation in same block
+	t.NoError(pool.Set(valuehash.RandomSHA256(), NewCurrencyDesignState(dst, t.cid).AddAggregate(NewBig(100))))
+
+	var fst, ust state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(fa.Address, t.cid):
+			fst = st.GetState()
+		case StateKeyCurrencyDesign(t.cid):
+			ust = st.GetState()
+		}
+	}
+
+	fb, err := StateBalanceValue(fst)
+	t.NoError(err)
+	t.Equal(NewBig(6+12), fb.Big())
+
+	ude, err := StateCurrencyDesignValue(ust)
+	t.NoError(err)
+	t.True(ude.Burned().Equal(NewBig(4 + 8)))
+	t.True(ude.Aggregate().Equal(NewBig(99 - 12 + 100)))
+	t.Equal(po.Bytes(), ude.Policy().Bytes())
+}
+
+func (t *testFeeOperationProcessor) TestBurnWithPolicyUpdate() {
+	fa, st0 := t.newAccount(true, []Amount{NewAmount(ZeroBig, t.cid)})
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(fa.Address, NewBig(1))).SetFeeBurnRatio(1)
+	dst := t.newCurrencyDesignStateWithPolicy(t.cid, NewBig(99), NewTestAddress(), po)
+
+	pool, _ := t.statepool(st0, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	npo := NewCurrencyPolicy(NewBig(3), NewFixedFeeer(fa.Address, NewBig(2)))
+	t.NoError(pool.Set(valuehash.RandomSHA256(), NewCurrencyDesignState(dst, t.cid).SetPolicy(npo)))
+
+	op := NewFeeOperation(NewFeeOperationFact(base.Height(3), map[CurrencyID]Big{t.cid: NewBig(10)}))
+	t.NoError(NewFeeOperationProcessor(cp, op).Process(pool.Get, pool.Set))
+
+	var ust state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyCurrencyDesign(t.cid) {
+			ust = st.GetState()
+		}
+	}
+
+	ude, err := StateCurrencyDesignValue(ust)
+	t.NoError(err)
+	t.True(ude.Burned().Equal(NewBig(10)))
+	t.True(ude.Aggregate().Equal(NewBig(89)))
+	t.Equal(npo.Bytes(), ude.Policy().Bytes())
+}
+
+func TestFeeOperationProcessor(t *testing.T) {
+	suite.Run(t, new(testFeeOperationProcessor))
+}
+
 func testFeeOperationEncode(enc encoder.Encoder) suite.TestingSuite {
 	t := new(baseTestOperationEncode)
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 
+	"github.com/pkg/errors"
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/util"
 	"github.com/spikeekips/mitum/util/hint"
@@ -11,21 +12,25 @@ import (
 )
 
 const (
-	FeeerNil   = "nil"
-	FeeerFixed = "fixed"
-	FeeerRatio = "ratio"
+	FeeerNil    = "nil"
+	FeeerFixed  = "fixed"
+	FeeerRatio  = "ratio"
+	FeeerTiered = "tiered"
 )
 
 var (
-	NilFeeerType     = hint.Type("mitum-currency-nil-feeer")
-	NilFeeerHint     = hint.NewHint(NilFeeerType, "v0.0.1")
-	NilFeeerHinter   = NilFeeer{BaseHinter: hint.NewBaseHinter(NilFeeerHint)}
-	FixedFeeerType   = hint.Type("mitum-currency-fixed-feeer")
-	FixedFeeerHint   = hint.NewHint(FixedFeeerType, "v0.0.1")
-	FixedFeeerHinter = FixedFeeer{BaseHinter: hint.NewBaseHinter(FixedFeeerHint)}
-	RatioFeeerType   = hint.Type("mitum-currency-ratio-feeer")
-	RatioFeeerHint   = hint.NewHint(RatioFeeerType, "v0.0.1")
-	RatioFeeerHinter = RatioFeeer{BaseHinter: hint.NewBaseHinter(RatioFeeerHint)}
+	NilFeeerType      = hint.Type("mitum-currency-nil-feeer")
+	NilFeeerHint      = hint.NewHint(NilFeeerType, "v0.0.1")
+	NilFeeerHinter    = NilFeeer{BaseHinter: hint.NewBaseHinter(NilFeeerHint)}
+	FixedFeeerType    = hint.Type("mitum-currency-fixed-feeer")
+	FixedFeeerHint    = hint.NewHint(FixedFeeerType, "v0.0.1")
+	FixedFeeerHinter  = FixedFeeer{BaseHinter: hint.NewBaseHinter(FixedFeeerHint)}
+	RatioFeeerType    = hint.Type("mitum-currency-ratio-feeer")
+	RatioFeeerHint    = hint.NewHint(RatioFeeerType, "v0.0.1")
+	RatioFeeerHinter  = RatioFeeer{BaseHinter: hint.NewBaseHinter(RatioFeeerHint)}
+	TieredFeeerType   = hint.Type("mitum-currency-tiered-feeer")
+	TieredFeeerHint   = hint.NewHint(TieredFeeerType, "v0.0.1")
+	TieredFeeerHinter = TieredFeeer{BaseHinter: hint.NewBaseHinter(TieredFeeerHint)}
 )
 
 var UnlimitedMaxFeeAmount = NewBig(-1)
@@ -224,6 +229,179 @@ func (fa RatioFeeer) isOne() bool {
 	return fa.ratio == 1
 }
 
+// FeeBracket is the fee rule of TieredFeeer for the amounts under upper. The
+// fee of bracket is amount + (operation amount * ratio); the upper of the last
+// bracket should be UnlimitedMaxFeeAmount.
+type FeeBracket struct {
+	upper  Big
+	amount Big
+	ratio  float64 // 0 >=, or <= 1.0
+}
+
+func NewFeeBracket(upper, amount Big, ratio float64) FeeBracket {
+	return FeeBracket{
+		upper:  upper,
+		amount: amount,
+		ratio:  ratio,
+	}
+}
+
+func (fb FeeBracket) Bytes() []byte {
+	var rb bytes.Buffer
+	_ = binary.Write(&rb, binary.BigEndian, fb.ratio)
+
+	return util.ConcatBytesSlice(fb.upper.Bytes(), fb.amount.Bytes(), rb.Bytes())
+}
+
+func (fb FeeBracket) Upper() Big {
+	return fb.upper
+}
+
+func (fb FeeBracket) Amount() Big {
+	return fb.amount
+}
+
+func (fb FeeBracket) Ratio() float64 {
+	return fb.ratio
+}
+
+func (fb FeeBracket) IsValid([]byte) error {
+	if !fb.isUnlimited() && !fb.upper.OverZero() {
+		return isvalid.InvalidError.Errorf("fee bracket upper should be over zero")
+	}
+
+	if !fb.amount.OverNil() {
+		return isvalid.InvalidError.Errorf("fee bracket amount under zero")
+	}
+
+	if fb.ratio < 0 || fb.ratio > 1 {
+		return isvalid.InvalidError.Errorf("invalid fee bracket ratio, %v; it should be 0 >=, <= 1", fb.ratio)
+	}
+
+	return nil
+}
+
+func (fb FeeBracket) contains(a Big) bool {
+	return fb.isUnlimited() || a.Compare(fb.upper) < 0
+}
+
+func (fb FeeBracket) fee(a Big) Big {
+	if fb.ratio == 0 || a.IsZero() {
+		return fb.amount
+	}
+
+	return fb.amount.Add(a.MulFloat64(fb.ratio))
+}
+
+func (fb FeeBracket) isUnlimited() bool {
+	return fb.upper.Equal(UnlimitedMaxFeeAmount)
+}
+
+// TieredFeeer charges fee by the bracket, which the operation amount belongs
+// to. The brackets are ordered by upper.
+type TieredFeeer struct {
+	hint.BaseHinter
+	receiver base.Address
+	brackets []FeeBracket
+}
+
+func NewTieredFeeer(receiver base.Address, brackets []FeeBracket) TieredFeeer {
+	return TieredFeeer{
+		BaseHinter: hint.NewBaseHinter(TieredFeeerHint),
+		receiver:   receiver,
+		brackets:   brackets,
+	}
+}
+
+func (TieredFeeer) Type() string {
+	return FeeerTiered
+}
+
+func (fa TieredFeeer) Bytes() []byte {
+	bs := make([][]byte, len(fa.brackets)+1)
+	bs[0] = fa.receiver.Bytes()
+
+	for i := range fa.brackets {
+		bs[i+1] = fa.brackets[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (fa TieredFeeer) Receiver() base.Address {
+	return fa.receiver
+}
+
+func (fa TieredFeeer) Brackets() []FeeBracket {
+	return fa.brackets
+}
+
+// Min returns the lowest fee across brackets; the fee of bracket is the lowest
+// at the upper of the previous bracket, and the fee does not have to increase
+// with the brackets.
+func (fa TieredFeeer) Min() Big {
+	if len(fa.brackets) < 1 {
+		return ZeroBig
+	}
+
+	min := fa.brackets[0].fee(ZeroBig)
+	for i := range fa.brackets[1:] {
+		if f := fa.brackets[i+1].fee(fa.brackets[i].upper); f.Compare(min) < 0 {
+			min = f
+		}
+	}
+
+	return min
+}
+
+func (fa TieredFeeer) Fee(a Big) (Big, error) {
+	for i := range fa.brackets {
+		if fb := fa.brackets[i]; fb.contains(a) {
+			return fb.fee(a), nil
+		}
+	}
+
+	return ZeroBig, errors.Errorf("no fee bracket found for amount, %v", a)
+}
+
+func (fa TieredFeeer) IsValid([]byte) error {
+	if err := fa.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := isvalid.Check(nil, false, fa.receiver); err != nil {
+		return isvalid.InvalidError.Errorf("invalid receiver for tiered feeer: %w", err)
+	}
+
+	if len(fa.brackets) < 1 {
+		return isvalid.InvalidError.Errorf("empty brackets for tiered feeer")
+	}
+
+	for i := range fa.brackets {
+		fb := fa.brackets[i]
+		if err := fb.IsValid(nil); err != nil {
+			return isvalid.InvalidError.Errorf("invalid bracket of tiered feeer: %w", err)
+		}
+
+		if i == len(fa.brackets)-1 {
+			if !fb.isUnlimited() {
+				return isvalid.InvalidError.Errorf("upper of last bracket should be unlimited")
+			}
+
+			break
+		}
+
+		switch next := fa.brackets[i+1]; {
+		case fb.isUnlimited():
+			return isvalid.InvalidError.Errorf("only last bracket can be unlimited")
+		case !next.isUnlimited() && fb.upper.Compare(next.upper) >= 0:
+			return isvalid.InvalidError.Errorf("brackets should be ordered by upper, %v >= %v", fb.upper, next.upper)
+		}
+	}
+
+	return nil
+}
+
 func NewFeeToken(feeer Feeer, height base.Height) []byte {
 	return util.ConcatBytesSlice(feeer.Bytes(), height.Bytes())
 }
@@ -76,3 +76,53 @@ func (fa *RatioFeeer) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 
 	return fa.unpack(enc, ufa.HT, ufa.RC, ufa.RA, ufa.MI, ufa.MA)
 }
+
+type FeeBracketBSONPacker struct {
+	UP Big     `bson:"upper"`
+	AM Big     `bson:"amount"`
+	RA float64 `bson:"ratio"`
+}
+
+func (fb FeeBracket) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(FeeBracketBSONPacker{
+		UP: fb.upper,
+		AM: fb.amount,
+		RA: fb.ratio,
+	})
+}
+
+func (fb *FeeBracket) UnmarshalBSON(b []byte) error {
+	var ufb FeeBracketBSONPacker
+	if err := bsonenc.Unmarshal(b, &ufb); err != nil {
+		return err
+	}
+
+	*fb = NewFeeBracket(ufb.UP, ufb.AM, ufb.RA)
+
+	return nil
+}
+
+func (fa TieredFeeer) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(fa.Hint()),
+		bson.M{
+			"receiver": fa.receiver,
+			"brackets": fa.brackets,
+		}),
+	)
+}
+
+type TieredFeeerBSONUnpacker struct {
+	HT hint.Hint           `bson:"_hint"`
+	RC base.AddressDecoder `bson:"receiver"`
+	BR []FeeBracket        `bson:"brackets"`
+}
+
+func (fa *TieredFeeer) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ufa TieredFeeerBSONUnpacker
+	if err := enc.Unmarshal(b, &ufa); err != nil {
+		return err
+	}
+
+	return fa.unpack(enc, ufa.HT, ufa.RC, ufa.BR)
+}
@@ -41,3 +41,22 @@ func (fa *RatioFeeer) unpack(
 
 	return nil
 }
+
+func (fa *TieredFeeer) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	brc base.AddressDecoder,
+	brackets []FeeBracket,
+) error {
+	fa.BaseHinter = hint.NewBaseHinter(ht)
+
+	i, err := brc.Encode(enc)
+	if err != nil {
+		return err
+	}
+	fa.receiver = i
+
+	fa.brackets = brackets
+
+	return nil
+}
@@ -86,3 +86,57 @@ func (fa *RatioFeeer) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 
 	return fa.unpack(enc, ufa.HT, ufa.RC, ufa.RA, ufa.MI, ufa.MA)
 }
+
+type FeeBracketJSONPacker struct {
+	UP Big     `json:"upper"`
+	AM Big     `json:"amount"`
+	RA float64 `json:"ratio"`
+}
+
+func (fb FeeBracket) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(FeeBracketJSONPacker{
+		UP: fb.upper,
+		AM: fb.amount,
+		RA: fb.ratio,
+	})
+}
+
+func (fb *FeeBracket) UnmarshalJSON(b []byte) error {
+	var ufb FeeBracketJSONPacker
+	if err := jsonenc.Unmarshal(b, &ufb); err != nil {
+		return err
+	}
+
+	*fb = NewFeeBracket(ufb.UP, ufb.AM, ufb.RA)
+
+	return nil
+}
+
+type TieredFeeerJSONPacker struct {
+	jsonenc.HintedHead
+	RC base.Address `json:"receiver"`
+	BR []FeeBracket `json:"brackets"`
+}
+
+func (fa TieredFeeer) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(TieredFeeerJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fa.Hint()),
+		RC:         fa.receiver,
+		BR:         fa.brackets,
+	})
+}
+
+type TieredFeeerJSONUnpacker struct {
+	HT hint.Hint           `json:"_hint"`
+	RC base.AddressDecoder `json:"receiver"`
+	BR []FeeBracket        `json:"brackets"`
+}
+
+func (fa *TieredFeeer) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufa TieredFeeerJSONUnpacker
+	if err := enc.Unmarshal(b, &ufa); err != nil {
+		return err
+	}
+
+	return fa.unpack(enc, ufa.HT, ufa.RC, ufa.BR)
+}
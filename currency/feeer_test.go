@@ -187,6 +187,160 @@ func (t *testFeeer) TestRatioFeeer() {
 	}
 }
 
+func (t *testFeeer) TestTieredFeeer() {
+	brackets := []FeeBracket{
+		NewFeeBracket(NewBig(1000), NewBig(1), 0),
+		NewFeeBracket(NewBig(1000000), ZeroBig, 0.005),
+		NewFeeBracket(UnlimitedMaxFeeAmount, NewBig(5000), 0),
+	}
+
+	cases := []struct {
+		name   string
+		big    string
+		result string
+	}{
+		{name: "zero", big: "0", result: "1"},
+		{name: "under first upper", big: "999", result: "1"},
+		{name: "same with first upper", big: "1000", result: "5"},
+		{name: "in second", big: "200000", result: "1000"},
+		{name: "same with second upper", big: "1000000", result: "5000"},
+		{name: "over second upper", big: "900000000", result: "5000"},
+	}
+
+	receiver := MustAddress(util.UUID().String())
+	fa := NewTieredFeeer(receiver, brackets)
+	t.NoError(fa.IsValid(nil))
+	t.Equal(NewBig(1), fa.Min())
+
+	for i, c := range cases {
+		i := i
+		c := c
+		t.Run(
+			c.name,
+			func() {
+				big, err := NewBigFromString(c.big)
+				t.NoError(err)
+
+				result, err := fa.Fee(big)
+				t.NoError(err, "%d: %v", i, c.name)
+				t.Equal(c.result, result.String(), "%d: %v; %v != %v", i, c.name, c.result, result.String())
+			},
+		)
+	}
+}
+
+func (t *testFeeer) TestTieredFeeerMin() {
+	receiver := MustAddress(util.UUID().String())
+
+	cases := []struct {
+		name     string
+		brackets []FeeBracket
+		min      Big
+	}{
+		{
+			name:     "single",
+			brackets: []FeeBracket{NewFeeBracket(UnlimitedMaxFeeAmount, NewBig(3), 0.1)},
+			min:      NewBig(3),
+		},
+		{
+			name: "decreasing amount",
+			brackets: []FeeBracket{
+				NewFeeBracket(NewBig(1000), NewBig(10), 0),
+				NewFeeBracket(NewBig(1000000), NewBig(2), 0),
+				NewFeeBracket(UnlimitedMaxFeeAmount, NewBig(5), 0),
+			},
+			min: NewBig(2),
+		},
+		{
+			name: "ratio from previous upper",
+			brackets: []FeeBracket{
+				NewFeeBracket(NewBig(1000), NewBig(10), 0),
+				NewFeeBracket(UnlimitedMaxFeeAmount, ZeroBig, 0.005),
+			},
+			min: NewBig(5),
+		},
+	}
+
+	for i, c := range cases {
+		i := i
+		c := c
+		t.Run(
+			c.name,
+			func() {
+				fa := NewTieredFeeer(receiver, c.brackets)
+				t.NoError(fa.IsValid(nil))
+				t.Equal(c.min.String(), fa.Min().String(), "%d: %v", i, c.name)
+			},
+		)
+	}
+}
+
+func (t *testFeeer) TestTieredFeeerInvalid() {
+	receiver := MustAddress(util.UUID().String())
+
+	cases := []struct {
+		name     string
+		brackets []FeeBracket
+		err      string
+	}{
+		{
+			name: "empty",
+			err:  "empty brackets",
+		},
+		{
+			name: "last not unlimited",
+			brackets: []FeeBracket{
+				NewFeeBracket(NewBig(1000), NewBig(1), 0),
+			},
+			err: "upper of last bracket should be unlimited",
+		},
+		{
+			name: "unlimited in middle",
+			brackets: []FeeBracket{
+				NewFeeBracket(UnlimitedMaxFeeAmount, NewBig(1), 0),
+				NewFeeBracket(UnlimitedMaxFeeAmount, NewBig(1), 0),
+			},
+			err: "only last bracket can be unlimited",
+		},
+		{
+			name: "not ordered",
+			brackets: []FeeBracket{
+				NewFeeBracket(NewBig(1000), NewBig(1), 0),
+				NewFeeBracket(NewBig(1000), NewBig(1), 0),
+				NewFeeBracket(UnlimitedMaxFeeAmount, NewBig(1), 0),
+			},
+			err: "brackets should be ordered by upper",
+		},
+		{
+			name: "invalid ratio",
+			brackets: []FeeBracket{
+				NewFeeBracket(UnlimitedMaxFeeAmount, NewBig(1), 1.1),
+			},
+			err: "invalid fee bracket ratio",
+		},
+		{
+			name: "amount under zero",
+			brackets: []FeeBracket{
+				NewFeeBracket(UnlimitedMaxFeeAmount, NewBig(-2), 0),
+			},
+			err: "fee bracket amount under zero",
+		},
+	}
+
+	for i, c := range cases {
+		i := i
+		c := c
+		t.Run(
+			c.name,
+			func() {
+				err := NewTieredFeeer(receiver, c.brackets).IsValid(nil)
+				t.Error(err, "%d: %v", i, c.name)
+				t.Contains(err.Error(), c.err, "%d: %v", i, c.name)
+			},
+		)
+	}
+}
+
 func TestFeeer(t *testing.T) {
 	suite.Run(t, new(testFeeer))
 }
@@ -253,6 +407,31 @@ func testRatioFeeerEncode(enc encoder.Encoder) suite.TestingSuite {
 	return t
 }
 
+func testTieredFeeerEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		return NewTieredFeeer(
+			MustAddress(util.UUID().String()),
+			[]FeeBracket{
+				NewFeeBracket(NewBig(1000), NewBig(1), 0),
+				NewFeeBracket(NewBig(1000000), ZeroBig, 0.005),
+				NewFeeBracket(UnlimitedMaxFeeAmount, NewBig(5000), 0),
+			},
+		)
+	}
+
+	t.compare = func(a, b interface{}) {
+		ca := a.(TieredFeeer)
+		cb := b.(TieredFeeer)
+
+		t.Equal(ca, cb)
+	}
+
+	return t
+}
+
 func TestNilFeeerEncodeJSON(t *testing.T) {
 	suite.Run(t, testNilFeeerEncode(jsonenc.NewEncoder()))
 }
@@ -265,6 +444,10 @@ func TestRatioFeeerEncodeJSON(t *testing.T) {
 	suite.Run(t, testRatioFeeerEncode(jsonenc.NewEncoder()))
 }
 
+func TestTieredFeeerEncodeJSON(t *testing.T) {
+	suite.Run(t, testTieredFeeerEncode(jsonenc.NewEncoder()))
+}
+
 func TestNilFeeerEncodeBSON(t *testing.T) {
 	suite.Run(t, testNilFeeerEncode(bsonenc.NewEncoder()))
 }
@@ -276,3 +459,7 @@ func TestFixedFeeerEncodeBSON(t *testing.T) {
 func TestRatioFeeerEncodeBSON(t *testing.T) {
 	suite.Run(t, testRatioFeeerEncode(bsonenc.NewEncoder()))
 }
+
+func TestTieredFeeerEncodeBSON(t *testing.T) {
+	suite.Run(t, testTieredFeeerEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,200 @@
+package currency
+
+import (
+	"crypto/sha256"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	HTLCType   = hint.Type("mitum-currency-htlc")
+	HTLCHint   = hint.NewHint(HTLCType, "v0.0.1")
+	HTLCHinter = HTLC{BaseHinter: hint.NewBaseHinter(HTLCHint)}
+)
+
+var MaxHTLCPreimageSize = 256
+
+type HTLCStatus string
+
+const (
+	HTLCStatusLocked   HTLCStatus = "locked"
+	HTLCStatusClaimed  HTLCStatus = "claimed"
+	HTLCStatusRefunded HTLCStatus = "refunded"
+)
+
+func (hs HTLCStatus) Bytes() []byte {
+	return []byte(hs)
+}
+
+func (hs HTLCStatus) IsValid([]byte) error {
+	switch hs {
+	case HTLCStatusLocked, HTLCStatusClaimed, HTLCStatusRefunded:
+		return nil
+	default:
+		return isvalid.InvalidError.Errorf("unknown htlc status, %q", hs)
+	}
+}
+
+// HTLCHashlock returns the hashlock of preimage, sha256(preimage).
+func HTLCHashlock(preimage []byte) []byte {
+	h := sha256.Sum256(preimage)
+
+	return h[:]
+}
+
+func isValidHTLCHashlock(hashlock []byte) error {
+	if len(hashlock) != sha256.Size {
+		return isvalid.InvalidError.Errorf("wrong length of hashlock, %d != %d", len(hashlock), sha256.Size)
+	}
+
+	return nil
+}
+
+func isValidHTLCPreimage(preimage []byte) error {
+	if n := len(preimage); n < 1 || n > MaxHTLCPreimageSize {
+		return isvalid.InvalidError.Errorf("wrong length of preimage, %d; should be between 1 and %d", n, MaxHTLCPreimageSize)
+	}
+
+	return nil
+}
+
+// HTLC is the state value of the amount, which is locked by HTLCLock. The id
+// of HTLC is the fact hash of HTLCLock. The preimage is set when the HTLC is
+// claimed.
+type HTLC struct {
+	hint.BaseHinter
+	id       valuehash.Hash
+	sender   base.Address
+	receiver base.Address
+	amount   Amount
+	hashlock []byte
+	expiry   base.Height
+	status   HTLCStatus
+	preimage []byte
+}
+
+func NewHTLC(
+	id valuehash.Hash,
+	sender, receiver base.Address,
+	amount Amount,
+	hashlock []byte,
+	expiry base.Height,
+) HTLC {
+	return HTLC{
+		BaseHinter: hint.NewBaseHinter(HTLCHint),
+		id:         id,
+		sender:     sender,
+		receiver:   receiver,
+		amount:     amount,
+		hashlock:   hashlock,
+		expiry:     expiry,
+		status:     HTLCStatusLocked,
+	}
+}
+
+func (hl HTLC) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		hl.id.Bytes(),
+		hl.sender.Bytes(),
+		hl.receiver.Bytes(),
+		hl.amount.Bytes(),
+		hl.hashlock,
+		hl.expiry.Bytes(),
+		hl.status.Bytes(),
+		hl.preimage,
+	)
+}
+
+func (hl HTLC) Hash() valuehash.Hash {
+	return hl.GenerateHash()
+}
+
+func (hl HTLC) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(hl.Bytes())
+}
+
+func (hl HTLC) IsValid([]byte) error {
+	if err := isvalid.Check(nil, false,
+		hl.BaseHinter,
+		hl.id,
+		hl.sender,
+		hl.receiver,
+		hl.amount,
+		hl.status,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid HTLC: %w", err)
+	}
+
+	if err := isValidHTLCHashlock(hl.hashlock); err != nil {
+		return isvalid.InvalidError.Errorf("invalid HTLC: %w", err)
+	}
+
+	if hl.status == HTLCStatusClaimed {
+		if err := isValidHTLCPreimage(hl.preimage); err != nil {
+			return isvalid.InvalidError.Errorf("invalid HTLC: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (hl HTLC) ID() valuehash.Hash {
+	return hl.id
+}
+
+func (hl HTLC) Sender() base.Address {
+	return hl.sender
+}
+
+func (hl HTLC) Receiver() base.Address {
+	return hl.receiver
+}
+
+func (hl HTLC) Amount() Amount {
+	return hl.amount
+}
+
+// Hashlock is sha256 of the preimage.
+func (hl HTLC) Hashlock() []byte {
+	return hl.hashlock
+}
+
+// Expiry is the height, after when the HTLC can not be claimed and can be
+// refunded.
+func (hl HTLC) Expiry() base.Height {
+	return hl.expiry
+}
+
+func (hl HTLC) Status() HTLCStatus {
+	return hl.status
+}
+
+func (hl HTLC) IsLocked() bool {
+	return hl.status == HTLCStatusLocked
+}
+
+// Preimage is revealed by HTLCClaim; before claimed, it is empty.
+func (hl HTLC) Preimage() []byte {
+	return hl.preimage
+}
+
+func (hl HTLC) SetStatus(status HTLCStatus) HTLC {
+	hl.status = status
+
+	return hl
+}
+
+func (hl HTLC) Claim(preimage []byte) HTLC {
+	hl.status = HTLCStatusClaimed
+	hl.preimage = preimage
+
+	return hl
+}
+
+func (hl HTLC) Addresses() []base.Address {
+	return []base.Address{hl.sender, hl.receiver}
+}
@@ -0,0 +1,45 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (hl HTLC) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(hl.Hint()),
+		bson.M{
+			"id":       hl.id,
+			"sender":   hl.sender,
+			"receiver": hl.receiver,
+			"amount":   hl.amount,
+			"hashlock": hl.hashlock,
+			"expiry":   hl.expiry,
+			"status":   hl.status,
+			"preimage": hl.preimage,
+		},
+	))
+}
+
+type HTLCBSONUnpacker struct {
+	ID valuehash.Bytes     `bson:"id"`
+	SD base.AddressDecoder `bson:"sender"`
+	RC base.AddressDecoder `bson:"receiver"`
+	AM Amount              `bson:"amount"`
+	HL []byte              `bson:"hashlock"`
+	EX base.Height         `bson:"expiry"`
+	ST string              `bson:"status"`
+	PI []byte              `bson:"preimage"`
+}
+
+func (hl *HTLC) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uhl HTLCBSONUnpacker
+	if err := enc.Unmarshal(b, &uhl); err != nil {
+		return err
+	}
+
+	return hl.unpack(enc, uhl.ID, uhl.SD, uhl.RC, uhl.AM, uhl.HL, uhl.EX, uhl.ST, uhl.PI)
+}
@@ -0,0 +1,43 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (hl *HTLC) unpack(
+	enc encoder.Encoder,
+	id valuehash.Hash,
+	bsender,
+	breceiver base.AddressDecoder,
+	am Amount,
+	hashlock []byte,
+	expiry base.Height,
+	status string,
+	preimage []byte,
+) error {
+	sender, err := bsender.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	receiver, err := breceiver.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	hl.id = id
+	hl.sender = sender
+	hl.receiver = receiver
+	hl.amount = am
+	hl.hashlock = hashlock
+	hl.expiry = expiry
+	hl.status = HTLCStatus(status)
+
+	if len(preimage) > 0 {
+		hl.preimage = preimage
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type HTLCJSONPacker struct {
+	jsonenc.HintedHead
+	ID valuehash.Hash `json:"id"`
+	SD base.Address   `json:"sender"`
+	RC base.Address   `json:"receiver"`
+	AM Amount         `json:"amount"`
+	HL []byte         `json:"hashlock"`
+	EX base.Height    `json:"expiry"`
+	ST HTLCStatus     `json:"status"`
+	PI []byte         `json:"preimage,omitempty"`
+}
+
+func (hl HTLC) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(HTLCJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(hl.Hint()),
+		ID:         hl.id,
+		SD:         hl.sender,
+		RC:         hl.receiver,
+		AM:         hl.amount,
+		HL:         hl.hashlock,
+		EX:         hl.expiry,
+		ST:         hl.status,
+		PI:         hl.preimage,
+	})
+}
+
+type HTLCJSONUnpacker struct {
+	ID valuehash.Bytes     `json:"id"`
+	SD base.AddressDecoder `json:"sender"`
+	RC base.AddressDecoder `json:"receiver"`
+	AM Amount              `json:"amount"`
+	HL []byte              `json:"hashlock"`
+	EX base.Height         `json:"expiry"`
+	ST string              `json:"status"`
+	PI []byte              `json:"preimage,omitempty"`
+}
+
+func (hl *HTLC) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uhl HTLCJSONUnpacker
+	if err := enc.Unmarshal(b, &uhl); err != nil {
+		return err
+	}
+
+	return hl.unpack(enc, uhl.ID, uhl.SD, uhl.RC, uhl.AM, uhl.HL, uhl.EX, uhl.ST, uhl.PI)
+}
@@ -0,0 +1,144 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	HTLCLockFactType   = hint.Type("mitum-currency-htlc-lock-operation-fact")
+	HTLCLockFactHint   = hint.NewHint(HTLCLockFactType, "v0.0.1")
+	HTLCLockFactHinter = HTLCLockFact{BaseHinter: hint.NewBaseHinter(HTLCLockFactHint)}
+	HTLCLockType       = hint.Type("mitum-currency-htlc-lock-operation")
+	HTLCLockHint       = hint.NewHint(HTLCLockType, "v0.0.1")
+	HTLCLockHinter     = HTLCLock{BaseOperation: operationHinter(HTLCLockHint)}
+)
+
+// HTLCLockFact locks the amount of sender to receiver under the hashlock,
+// sha256(preimage) until the expiry height.
+type HTLCLockFact struct {
+	hint.BaseHinter
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	receiver base.Address
+	amount   Amount
+	hashlock []byte
+	expiry   base.Height
+}
+
+func NewHTLCLockFact(
+	token []byte,
+	sender, receiver base.Address,
+	amount Amount,
+	hashlock []byte,
+	expiry base.Height,
+) HTLCLockFact {
+	fact := HTLCLockFact{
+		BaseHinter: hint.NewBaseHinter(HTLCLockFactHint),
+		token:      token,
+		sender:     sender,
+		receiver:   receiver,
+		amount:     amount,
+		hashlock:   hashlock,
+		expiry:     expiry,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact HTLCLockFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact HTLCLockFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact HTLCLockFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.receiver.Bytes(),
+		fact.amount.Bytes(),
+		fact.hashlock,
+		fact.expiry.Bytes(),
+	)
+}
+
+func (fact HTLCLockFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := isvalid.Check(nil, false,
+		fact.sender,
+		fact.receiver,
+		fact.amount,
+	); err != nil {
+		return err
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return isvalid.InvalidError.Errorf("amount should be over zero")
+	}
+
+	if fact.sender.Equal(fact.receiver) {
+		return isvalid.InvalidError.Errorf("receiver is same with sender, %q", fact.sender)
+	}
+
+	if err := isValidHTLCHashlock(fact.hashlock); err != nil {
+		return err
+	}
+
+	if fact.expiry <= base.GenesisHeight {
+		return isvalid.InvalidError.Errorf("expiry height should be over genesis height")
+	}
+
+	return nil
+}
+
+func (fact HTLCLockFact) Token() []byte {
+	return fact.token
+}
+
+func (fact HTLCLockFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact HTLCLockFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact HTLCLockFact) Amount() Amount {
+	return fact.amount
+}
+
+func (fact HTLCLockFact) Hashlock() []byte {
+	return fact.hashlock
+}
+
+func (fact HTLCLockFact) Expiry() base.Height {
+	return fact.expiry
+}
+
+func (fact HTLCLockFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.receiver}, nil
+}
+
+type HTLCLock struct {
+	BaseOperation
+}
+
+func NewHTLCLock(fact HTLCLockFact, fs []base.FactSign, memo string) (HTLCLock, error) {
+	bo, err := NewBaseOperationFromFact(HTLCLockHint, fact, fs, memo)
+	if err != nil {
+		return HTLCLock{}, err
+	}
+
+	return HTLCLock{BaseOperation: bo}, nil
+}
@@ -0,0 +1,53 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact HTLCLockFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":     fact.h,
+				"token":    fact.token,
+				"sender":   fact.sender,
+				"receiver": fact.receiver,
+				"amount":   fact.amount,
+				"hashlock": fact.hashlock,
+				"expiry":   fact.expiry,
+			}))
+}
+
+type HTLCLockFactBSONUnpacker struct {
+	H  valuehash.Bytes     `bson:"hash"`
+	TK []byte              `bson:"token"`
+	SD base.AddressDecoder `bson:"sender"`
+	RC base.AddressDecoder `bson:"receiver"`
+	AM Amount              `bson:"amount"`
+	HL []byte              `bson:"hashlock"`
+	EX base.Height         `bson:"expiry"`
+}
+
+func (fact *HTLCLockFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ufact HTLCLockFactBSONUnpacker
+	if err := bson.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, ufact.RC, ufact.AM, ufact.HL, ufact.EX)
+}
+
+func (op *HTLCLock) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
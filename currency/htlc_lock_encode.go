@@ -0,0 +1,38 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *HTLCLockFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bsender,
+	breceiver base.AddressDecoder,
+	am Amount,
+	hashlock []byte,
+	expiry base.Height,
+) error {
+	sender, err := bsender.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	receiver, err := breceiver.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.sender = sender
+	fact.receiver = receiver
+	fact.amount = am
+	fact.hashlock = hashlock
+	fact.expiry = expiry
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type HTLCLockFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	SD base.Address   `json:"sender"`
+	RC base.Address   `json:"receiver"`
+	AM Amount         `json:"amount"`
+	HL []byte         `json:"hashlock"`
+	EX base.Height    `json:"expiry"`
+}
+
+func (fact HTLCLockFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(HTLCLockFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		RC:         fact.receiver,
+		AM:         fact.amount,
+		HL:         fact.hashlock,
+		EX:         fact.expiry,
+	})
+}
+
+type HTLCLockFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	RC base.AddressDecoder `json:"receiver"`
+	AM Amount              `json:"amount"`
+	HL []byte              `json:"hashlock"`
+	EX base.Height         `json:"expiry"`
+}
+
+func (fact *HTLCLockFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact HTLCLockFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, ufact.RC, ufact.AM, ufact.HL, ufact.EX)
+}
+
+func (op *HTLCLock) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
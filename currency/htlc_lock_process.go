@@ -0,0 +1,169 @@
+package currency
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var htlcLockProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(HTLCLockProcessor)
+	},
+}
+
+func (HTLCLock) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type HTLCLockProcessor struct {
+	cp *CurrencyPool
+	HTLCLock
+	height base.Height
+	hl     state.State
+	si     AccountIndexState
+	sb     AmountState
+	fee    feePayment
+}
+
+func NewHTLCLockProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(HTLCLock)
+		if !ok {
+			return nil, errors.Errorf("not HTLCLock, %T", op)
+		}
+
+		opp := htlcLockProcessorPool.Get().(*HTLCLockProcessor)
+
+		opp.cp = cp
+		opp.HTLCLock = i
+		opp.height = base.NilHeight
+		opp.hl = nil
+		opp.si = AccountIndexState{}
+		opp.sb = AmountState{}
+		opp.fee = feePayment{}
+
+		return opp, nil
+	}
+}
+
+func (opp *HTLCLockProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(HTLCLockFact)
+
+	if err := checkExistsState(StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkActiveAccountState(fact.sender, getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, errors.Wrap(err, "invalid signing")
+	}
+
+	if _, err := existsState(StateKeyAccount(fact.receiver), "receiver", getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkNotClosedState(fact.receiver, getState); err != nil {
+		return nil, err
+	}
+
+	if fact.expiry <= opp.height {
+		return nil, operation.NewBaseReasonError("expiry height, %v should be over current height, %v", fact.expiry, opp.height)
+	}
+
+	cid := fact.amount.Currency()
+	policy, found := opp.cp.Policy(cid)
+	if !found {
+		return nil, operation.NewBaseReasonError("currency, %q not found of HTLCLock", cid)
+	}
+
+	hl, err := notExistsState(StateKeyHTLC(fact.Hash()), "htlc", getState)
+	if err != nil {
+		return nil, err
+	}
+
+	si, err := loadAccountIndexState(fact.sender, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := existsState(StateKeyBalance(fact.sender, cid), "balance of sender", getState)
+	if err != nil {
+		return nil, err
+	}
+	sb := NewAmountState(st, cid)
+
+	fee, err := loadFeePayment(opp.cp, policy, fact.sender, cid, fact.amount.Big(), getState)
+	if err != nil {
+		return nil, err
+	}
+
+	switch b, e := StateBalanceValue(sb); {
+	case e != nil:
+		return nil, operation.NewBaseReasonErrorFromError(e)
+	case b.Big().Compare(fee.required(fact.amount.Big())) < 0:
+		return nil, operation.NewBaseReasonError("insufficient balance with fee")
+	}
+
+	opp.hl = hl
+	opp.si = si
+	opp.sb = sb
+	opp.fee = fee
+
+	return opp, nil
+}
+
+func (opp *HTLCLockProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(HTLCLockFact)
+
+	hl, err := SetStateHTLCValue(opp.hl, NewHTLC(
+		fact.Hash(),
+		fact.sender,
+		fact.receiver,
+		fact.amount,
+		fact.hashlock,
+		fact.expiry,
+	))
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	sts := []state.State{hl, opp.si.Add(hl.Key())}
+
+	return setState(fact.Hash(), append(sts, opp.fee.pay(opp.sb, fact.amount.Big())...)...)
+}
+
+func (opp *HTLCLockProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *HTLCLockProcessor) Close() error {
+	opp.cp = nil
+	opp.HTLCLock = HTLCLock{}
+	opp.height = base.NilHeight
+	opp.hl = nil
+	opp.si = AccountIndexState{}
+	opp.sb = AmountState{}
+	opp.fee = feePayment{}
+
+	htlcLockProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,442 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testHTLCOperations struct {
+	baseTestOperationProcessor
+}
+
+func (t *testHTLCOperations) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr := NewOperationProcessor(cp)
+
+	_, err := copr.SetProcessor(HTLCLockHinter, NewHTLCLockProcessor(cp))
+	t.NoError(err)
+	_, err = copr.SetProcessor(HTLCClaimHinter, NewHTLCClaimProcessor())
+	t.NoError(err)
+	_, err = copr.SetProcessor(HTLCRefundHinter, NewHTLCRefundProcessor())
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testHTLCOperations) signs(fact base.Fact, pks []key.Privatekey) []base.FactSign {
+	fs := make([]base.FactSign, len(pks))
+	for i := range pks {
+		sig, err := base.NewFactSignature(pks[i], fact, nil)
+		t.NoError(err)
+
+		fs[i] = base.NewBaseFactSign(pks[i].Publickey(), sig)
+	}
+
+	return fs
+}
+
+func (t *testHTLCOperations) newLock(
+	sender, receiver base.Address,
+	am Amount,
+	hashlock []byte,
+	expiry base.Height,
+	pks []key.Privatekey,
+) HTLCLock {
+	fact := NewHTLCLockFact(util.UUID().Bytes(), sender, receiver, am, hashlock, expiry)
+
+	op, err := NewHTLCLock(fact, t.signs(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testHTLCOperations) newClaim(
+	sender base.Address, id valuehash.Hash, preimage []byte, pks []key.Privatekey,
+) HTLCClaim {
+	fact := NewHTLCClaimFact(util.UUID().Bytes(), sender, id, preimage)
+
+	op, err := NewHTLCClaim(fact, t.signs(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testHTLCOperations) newRefund(sender base.Address, id valuehash.Hash, pks []key.Privatekey) HTLCRefund {
+	fact := NewHTLCRefundFact(util.UUID().Bytes(), sender, id)
+
+	op, err := NewHTLCRefund(fact, t.signs(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testHTLCOperations) newStateHTLC(hl HTLC) state.State {
+	st, err := state.NewStateV0(StateKeyHTLC(hl.ID()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateHTLCValue(st, hl)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *testHTLCOperations) TestLock() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+	fa, st2 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1, st2)
+
+	fee := NewBig(3)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, fee))))
+
+	opr := t.processor(cp, pool)
+
+	am := NewAmount(NewBig(10), t.cid)
+	hashlock := HTLCHashlock([]byte("showme"))
+	op := t.newLock(sa.Address, ra.Address, am, hashlock, base.Height(10), sa.Privs())
+
+	t.NoError(opr.Process(op))
+	t.NoError(opr.Close())
+
+	var sst, hst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyHTLC(op.Fact().Hash()):
+			hst = st.GetState()
+		}
+	}
+
+	sb, err := StateBalanceValue(sst)
+	t.NoError(err)
+	t.True(NewBig(33).Sub(am.Big()).Sub(fee).Equal(sb.Big()))
+
+	hl, err := StateHTLCValue(hst)
+	t.NoError(err)
+	t.NoError(hl.IsValid(nil))
+	t.True(hl.ID().Equal(op.Fact().Hash()))
+	t.True(hl.Sender().Equal(sa.Address))
+	t.True(hl.Receiver().Equal(ra.Address))
+	t.True(hl.Amount().Equal(am))
+	t.Equal(hashlock, hl.Hashlock())
+	t.Equal(base.Height(10), hl.Expiry())
+	t.True(hl.IsLocked())
+}
+
+func (t *testHTLCOperations) TestLockInsufficientBalance() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, NewBig(1)))))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newLock(sa.Address, ra.Address, NewAmount(NewBig(10), t.cid),
+		HTLCHashlock([]byte("showme")), base.Height(10), sa.Privs())
+
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "insufficient balance with fee")
+}
+
+func (t *testHTLCOperations) TestClaim() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	preimage := []byte("showme")
+	hl := NewHTLC(valuehash.RandomSHA256(), sa.Address, ra.Address,
+		NewAmount(NewBig(10), t.cid), HTLCHashlock(preimage), base.Height(10))
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newStateHTLC(hl)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, NewBig(1)))))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newClaim(ra.Address, hl.ID(), preimage, ra.Privs())))
+	t.NoError(opr.Close())
+
+	var rst, hst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(ra.Address, t.cid):
+			rst = st.GetState()
+		case StateKeyHTLC(hl.ID()):
+			hst = st.GetState()
+		}
+	}
+
+	rb, err := StateBalanceValue(rst)
+	t.NoError(err)
+	t.True(NewBig(11).Equal(rb.Big()))
+
+	uhl, err := StateHTLCValue(hst)
+	t.NoError(err)
+	t.Equal(HTLCStatusClaimed, uhl.Status())
+	t.Equal(preimage, uhl.Preimage())
+}
+
+func (t *testHTLCOperations) TestClaimWrongPreimage() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	hl := NewHTLC(valuehash.RandomSHA256(), sa.Address, ra.Address,
+		NewAmount(NewBig(10), t.cid), HTLCHashlock([]byte("showme")), base.Height(10))
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newStateHTLC(hl)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newClaim(ra.Address, hl.ID(), []byte("findme"), ra.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "preimage does not match")
+}
+
+func (t *testHTLCOperations) TestClaimBySender() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	preimage := []byte("showme")
+	hl := NewHTLC(valuehash.RandomSHA256(), sa.Address, ra.Address,
+		NewAmount(NewBig(10), t.cid), HTLCHashlock(preimage), base.Height(10))
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newStateHTLC(hl)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newClaim(sa.Address, hl.ID(), preimage, sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "should be receiver of htlc")
+}
+
+func (t *testHTLCOperations) TestClaimExpired() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	preimage := []byte("showme")
+	hl := NewHTLC(valuehash.RandomSHA256(), sa.Address, ra.Address,
+		NewAmount(NewBig(10), t.cid), HTLCHashlock(preimage), base.Height(10))
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newStateHTLC(hl)})
+
+	op := t.newClaim(ra.Address, hl.ID(), preimage, ra.Privs())
+
+	opp, err := NewHTLCClaimProcessor()(op)
+	t.NoError(err)
+
+	opp.(*HTLCClaimProcessor).setHeight(base.Height(10))
+
+	_, err = opp.(*HTLCClaimProcessor).PreProcess(pool.Get, pool.Set)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "already expired")
+}
+
+func (t *testHTLCOperations) TestClaimAlreadySettled() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	preimage := []byte("showme")
+	hl := NewHTLC(valuehash.RandomSHA256(), sa.Address, ra.Address,
+		NewAmount(NewBig(10), t.cid), HTLCHashlock(preimage), base.Height(10)).
+		SetStatus(HTLCStatusRefunded)
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newStateHTLC(hl)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newClaim(ra.Address, hl.ID(), preimage, ra.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "already refunded")
+}
+
+func (t *testHTLCOperations) TestSettleTwiceInSameProposal() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	preimage := []byte("showme")
+	hl := NewHTLC(valuehash.RandomSHA256(), sa.Address, ra.Address,
+		NewAmount(NewBig(10), t.cid), HTLCHashlock(preimage), base.Height(10))
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newStateHTLC(hl)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newClaim(ra.Address, hl.ID(), preimage, ra.Privs())))
+
+	err := opr.Process(t.newClaim(ra.Address, hl.ID(), preimage, ra.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "duplicated htlc")
+}
+
+func (t *testHTLCOperations) TestRefund() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	hl := NewHTLC(valuehash.RandomSHA256(), sa.Address, ra.Address,
+		NewAmount(NewBig(10), t.cid), HTLCHashlock([]byte("showme")), base.Height(10))
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newStateHTLC(hl)})
+
+	op := t.newRefund(sa.Address, hl.ID(), sa.Privs())
+
+	opp, err := NewHTLCRefundProcessor()(op)
+	t.NoError(err)
+
+	// NOTE before expiry
+	opp.(*HTLCRefundProcessor).setHeight(base.Height(9))
+
+	_, err = opp.(*HTLCRefundProcessor).PreProcess(pool.Get, pool.Set)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "not yet expired")
+
+	// NOTE at expiry
+	opp.(*HTLCRefundProcessor).setHeight(base.Height(10))
+
+	_, err = opp.(*HTLCRefundProcessor).PreProcess(pool.Get, pool.Set)
+	t.NoError(err)
+	t.NoError(opp.(*HTLCRefundProcessor).Process(pool.Get, pool.Set))
+
+	var sst, hst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyHTLC(hl.ID()):
+			hst = st.GetState()
+		}
+	}
+
+	sb, err := StateBalanceValue(sst)
+	t.NoError(err)
+	t.True(NewBig(11).Equal(sb.Big()))
+
+	uhl, err := StateHTLCValue(hst)
+	t.NoError(err)
+	t.Equal(HTLCStatusRefunded, uhl.Status())
+	t.Nil(uhl.Preimage())
+}
+
+func (t *testHTLCOperations) TestRefundByReceiver() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	hl := NewHTLC(valuehash.RandomSHA256(), sa.Address, ra.Address,
+		NewAmount(NewBig(10), t.cid), HTLCHashlock([]byte("showme")), base.Height(10))
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newStateHTLC(hl)})
+
+	op := t.newRefund(ra.Address, hl.ID(), ra.Privs())
+
+	opp, err := NewHTLCRefundProcessor()(op)
+	t.NoError(err)
+
+	opp.(*HTLCRefundProcessor).setHeight(base.Height(10))
+
+	_, err = opp.(*HTLCRefundProcessor).PreProcess(pool.Get, pool.Set)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "should be sender of htlc")
+}
+
+func (t *testHTLCOperations) TestRefundToFrozenSender() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	hl := NewHTLC(valuehash.RandomSHA256(), sa.Address, ra.Address,
+		NewAmount(NewBig(10), t.cid), HTLCHashlock([]byte("showme")), base.Height(10))
+
+	pool, _ := t.statepool(t.freezeAccountState(sa.Address, st0), st1, []state.State{t.newStateHTLC(hl)})
+
+	op := t.newRefund(sa.Address, hl.ID(), sa.Privs())
+
+	opp, err := NewHTLCRefundProcessor()(op)
+	t.NoError(err)
+
+	opp.(*HTLCRefundProcessor).setHeight(base.Height(10))
+
+	_, err = opp.(*HTLCRefundProcessor).PreProcess(pool.Get, pool.Set)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "frozen")
+}
+
+func (t *testHTLCOperations) TestClaimToFrozenReceiver() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	preimage := []byte("showme")
+	hl := NewHTLC(valuehash.RandomSHA256(), sa.Address, ra.Address,
+		NewAmount(NewBig(10), t.cid), HTLCHashlock(preimage), base.Height(10))
+
+	pool, _ := t.statepool(st0, t.freezeAccountState(ra.Address, st1), []state.State{t.newStateHTLC(hl)})
+
+	op := t.newClaim(ra.Address, hl.ID(), preimage, ra.Privs())
+
+	opp, err := NewHTLCClaimProcessor()(op)
+	t.NoError(err)
+
+	opp.(*HTLCClaimProcessor).setHeight(base.Height(9))
+
+	_, err = opp.(*HTLCClaimProcessor).PreProcess(pool.Get, pool.Set)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "frozen")
+}
+
+func TestHTLCOperations(t *testing.T) {
+	suite.Run(t, new(testHTLCOperations))
+}
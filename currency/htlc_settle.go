@@ -0,0 +1,202 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	HTLCClaimFactType    = hint.Type("mitum-currency-htlc-claim-operation-fact")
+	HTLCClaimFactHint    = hint.NewHint(HTLCClaimFactType, "v0.0.1")
+	HTLCClaimFactHinter  = HTLCClaimFact{BaseHinter: hint.NewBaseHinter(HTLCClaimFactHint)}
+	HTLCClaimType        = hint.Type("mitum-currency-htlc-claim-operation")
+	HTLCClaimHint        = hint.NewHint(HTLCClaimType, "v0.0.1")
+	HTLCClaimHinter      = HTLCClaim{BaseOperation: operationHinter(HTLCClaimHint)}
+	HTLCRefundFactType   = hint.Type("mitum-currency-htlc-refund-operation-fact")
+	HTLCRefundFactHint   = hint.NewHint(HTLCRefundFactType, "v0.0.1")
+	HTLCRefundFactHinter = HTLCRefundFact{BaseHinter: hint.NewBaseHinter(HTLCRefundFactHint)}
+	HTLCRefundType       = hint.Type("mitum-currency-htlc-refund-operation")
+	HTLCRefundHint       = hint.NewHint(HTLCRefundType, "v0.0.1")
+	HTLCRefundHinter     = HTLCRefund{BaseOperation: operationHinter(HTLCRefundHint)}
+)
+
+// HTLCClaimFact claims the locked amount to the receiver of HTLC by revealing
+// the preimage of the hashlock before the expiry height. The sender should be
+// the receiver of HTLC. Settling HTLC does not charge fee; the fee is already
+// charged by HTLCLock.
+type HTLCClaimFact struct {
+	hint.BaseHinter
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	htlc     valuehash.Hash
+	preimage []byte
+}
+
+func NewHTLCClaimFact(token []byte, sender base.Address, htlc valuehash.Hash, preimage []byte) HTLCClaimFact {
+	fact := HTLCClaimFact{
+		BaseHinter: hint.NewBaseHinter(HTLCClaimFactHint),
+		token:      token,
+		sender:     sender,
+		htlc:       htlc,
+		preimage:   preimage,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact HTLCClaimFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact HTLCClaimFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact HTLCClaimFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		htlcSettleFactBytes(fact.token, fact.sender, fact.htlc, HTLCStatusClaimed),
+		fact.preimage,
+	)
+}
+
+func (fact HTLCClaimFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender, fact.htlc); err != nil {
+		return isvalid.InvalidError.Errorf("invalid HTLCClaimFact: %w", err)
+	}
+
+	return isValidHTLCPreimage(fact.preimage)
+}
+
+func (fact HTLCClaimFact) Token() []byte {
+	return fact.token
+}
+
+func (fact HTLCClaimFact) Sender() base.Address {
+	return fact.sender
+}
+
+// HTLC is the id of HTLC, the fact hash of HTLCLock.
+func (fact HTLCClaimFact) HTLC() valuehash.Hash {
+	return fact.htlc
+}
+
+func (fact HTLCClaimFact) Preimage() []byte {
+	return fact.preimage
+}
+
+func (fact HTLCClaimFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type HTLCClaim struct {
+	BaseOperation
+}
+
+func NewHTLCClaim(fact HTLCClaimFact, fs []base.FactSign, memo string) (HTLCClaim, error) {
+	bo, err := NewBaseOperationFromFact(HTLCClaimHint, fact, fs, memo)
+	if err != nil {
+		return HTLCClaim{}, err
+	}
+
+	return HTLCClaim{BaseOperation: bo}, nil
+}
+
+// HTLCRefundFact refunds the locked amount to the sender of HTLC after the
+// expiry height. The sender should be the sender of HTLC.
+type HTLCRefundFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	sender base.Address
+	htlc   valuehash.Hash
+}
+
+func NewHTLCRefundFact(token []byte, sender base.Address, htlc valuehash.Hash) HTLCRefundFact {
+	fact := HTLCRefundFact{
+		BaseHinter: hint.NewBaseHinter(HTLCRefundFactHint),
+		token:      token,
+		sender:     sender,
+		htlc:       htlc,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact HTLCRefundFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact HTLCRefundFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact HTLCRefundFact) Bytes() []byte {
+	return htlcSettleFactBytes(fact.token, fact.sender, fact.htlc, HTLCStatusRefunded)
+}
+
+func (fact HTLCRefundFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := isvalid.Check(nil, false, fact.sender, fact.htlc); err != nil {
+		return isvalid.InvalidError.Errorf("invalid HTLCRefundFact: %w", err)
+	}
+
+	return nil
+}
+
+func (fact HTLCRefundFact) Token() []byte {
+	return fact.token
+}
+
+func (fact HTLCRefundFact) Sender() base.Address {
+	return fact.sender
+}
+
+// HTLC is the id of HTLC, the fact hash of HTLCLock.
+func (fact HTLCRefundFact) HTLC() valuehash.Hash {
+	return fact.htlc
+}
+
+func (fact HTLCRefundFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type HTLCRefund struct {
+	BaseOperation
+}
+
+func NewHTLCRefund(fact HTLCRefundFact, fs []base.FactSign, memo string) (HTLCRefund, error) {
+	bo, err := NewBaseOperationFromFact(HTLCRefundHint, fact, fs, memo)
+	if err != nil {
+		return HTLCRefund{}, err
+	}
+
+	return HTLCRefund{BaseOperation: bo}, nil
+}
+
+// htlcSettleFactBytes appends the settled status, so HTLCClaimFact and
+// HTLCRefundFact with same token and htlc have different hash.
+func htlcSettleFactBytes(token []byte, sender base.Address, htlc valuehash.Hash, status HTLCStatus) []byte {
+	var bs, hs []byte
+	if sender != nil {
+		bs = sender.Bytes()
+	}
+
+	if htlc != nil {
+		hs = htlc.Bytes()
+	}
+
+	return util.ConcatBytesSlice(token, bs, hs, status.Bytes())
+}
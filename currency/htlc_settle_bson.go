@@ -0,0 +1,108 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type HTLCClaimFactBSONUnpacker struct {
+	H  valuehash.Bytes     `bson:"hash"`
+	TK []byte              `bson:"token"`
+	SD base.AddressDecoder `bson:"sender"`
+	HL valuehash.Bytes     `bson:"htlc"`
+	PI []byte              `bson:"preimage"`
+}
+
+func (fact HTLCClaimFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":     fact.h,
+				"token":    fact.token,
+				"sender":   fact.sender,
+				"htlc":     fact.htlc,
+				"preimage": fact.preimage,
+			}))
+}
+
+func (fact *HTLCClaimFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uf HTLCClaimFactBSONUnpacker
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return err
+	}
+
+	sender, err := uf.SD.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	fact.h = uf.H
+	fact.token = uf.TK
+	fact.sender = sender
+	fact.htlc = uf.HL
+	fact.preimage = uf.PI
+
+	return nil
+}
+
+func (op *HTLCClaim) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
+
+type HTLCRefundFactBSONUnpacker struct {
+	H  valuehash.Bytes     `bson:"hash"`
+	TK []byte              `bson:"token"`
+	SD base.AddressDecoder `bson:"sender"`
+	HL valuehash.Bytes     `bson:"htlc"`
+}
+
+func (fact HTLCRefundFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":   fact.h,
+				"token":  fact.token,
+				"sender": fact.sender,
+				"htlc":   fact.htlc,
+			}))
+}
+
+func (fact *HTLCRefundFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uf HTLCRefundFactBSONUnpacker
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return err
+	}
+
+	sender, err := uf.SD.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	fact.h = uf.H
+	fact.token = uf.TK
+	fact.sender = sender
+	fact.htlc = uf.HL
+
+	return nil
+}
+
+func (op *HTLCRefund) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type HTLCClaimFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	SD base.Address   `json:"sender"`
+	HL valuehash.Hash `json:"htlc"`
+	PI []byte         `json:"preimage"`
+}
+
+type HTLCClaimFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	HL valuehash.Bytes     `json:"htlc"`
+	PI []byte              `json:"preimage"`
+}
+
+func (fact HTLCClaimFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(HTLCClaimFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		HL:         fact.htlc,
+		PI:         fact.preimage,
+	})
+}
+
+func (fact *HTLCClaimFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uf HTLCClaimFactJSONUnpacker
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return err
+	}
+
+	sender, err := uf.SD.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	fact.h = uf.H
+	fact.token = uf.TK
+	fact.sender = sender
+	fact.htlc = uf.HL
+	fact.preimage = uf.PI
+
+	return nil
+}
+
+func (op *HTLCClaim) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
+
+type HTLCRefundFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	SD base.Address   `json:"sender"`
+	HL valuehash.Hash `json:"htlc"`
+}
+
+type HTLCRefundFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	HL valuehash.Bytes     `json:"htlc"`
+}
+
+func (fact HTLCRefundFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(HTLCRefundFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		HL:         fact.htlc,
+	})
+}
+
+func (fact *HTLCRefundFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uf HTLCRefundFactJSONUnpacker
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return err
+	}
+
+	sender, err := uf.SD.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	fact.h = uf.H
+	fact.token = uf.TK
+	fact.sender = sender
+	fact.htlc = uf.HL
+
+	return nil
+}
+
+func (op *HTLCRefund) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,255 @@
+package currency
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var htlcClaimProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(HTLCClaimProcessor)
+	},
+}
+
+var htlcRefundProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(HTLCRefundProcessor)
+	},
+}
+
+func (HTLCClaim) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+func (HTLCRefund) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type HTLCClaimProcessor struct {
+	HTLCClaim
+	height base.Height
+	sts    []state.State
+}
+
+func NewHTLCClaimProcessor() GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(HTLCClaim)
+		if !ok {
+			return nil, errors.Errorf("not HTLCClaim, %T", op)
+		}
+
+		opp := htlcClaimProcessorPool.Get().(*HTLCClaimProcessor)
+
+		opp.HTLCClaim = i
+		opp.height = base.NilHeight
+		opp.sts = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *HTLCClaimProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(HTLCClaimFact)
+
+	st, hl, err := preProcessHTLCSettle(fact.sender, fact.htlc, opp.Signs(), getState)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case !fact.sender.Equal(hl.Receiver()):
+		return nil, operation.NewBaseReasonError("sender, %q should be receiver of htlc", fact.sender)
+	case opp.height >= hl.Expiry():
+		return nil, operation.NewBaseReasonError(
+			"htlc, %q already expired; expiry height, %v <= current height, %v", fact.htlc, hl.Expiry(), opp.height)
+	case !bytes.Equal(HTLCHashlock(fact.preimage), hl.Hashlock()):
+		return nil, operation.NewBaseReasonError("preimage does not match with hashlock of htlc")
+	}
+
+	sts, err := settleHTLC(st, hl.Claim(fact.preimage), hl.Receiver(), getState)
+	if err != nil {
+		return nil, err
+	}
+
+	opp.sts = sts
+
+	return opp, nil
+}
+
+func (opp *HTLCClaimProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(HTLCClaimFact)
+
+	return setState(fact.Hash(), opp.sts...)
+}
+
+func (opp *HTLCClaimProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *HTLCClaimProcessor) Close() error {
+	opp.HTLCClaim = HTLCClaim{}
+	opp.height = base.NilHeight
+	opp.sts = nil
+
+	htlcClaimProcessorPool.Put(opp)
+
+	return nil
+}
+
+type HTLCRefundProcessor struct {
+	HTLCRefund
+	height base.Height
+	sts    []state.State
+}
+
+func NewHTLCRefundProcessor() GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(HTLCRefund)
+		if !ok {
+			return nil, errors.Errorf("not HTLCRefund, %T", op)
+		}
+
+		opp := htlcRefundProcessorPool.Get().(*HTLCRefundProcessor)
+
+		opp.HTLCRefund = i
+		opp.height = base.NilHeight
+		opp.sts = nil
+
+		return opp, nil
+	}
+}
+
+func (opp *HTLCRefundProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(HTLCRefundFact)
+
+	st, hl, err := preProcessHTLCSettle(fact.sender, fact.htlc, opp.Signs(), getState)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case !fact.sender.Equal(hl.Sender()):
+		return nil, operation.NewBaseReasonError("sender, %q should be sender of htlc", fact.sender)
+	case opp.height < hl.Expiry():
+		return nil, operation.NewBaseReasonError(
+			"htlc, %q not yet expired; expiry height, %v > current height, %v", fact.htlc, hl.Expiry(), opp.height)
+	}
+
+	sts, err := settleHTLC(st, hl.SetStatus(HTLCStatusRefunded), hl.Sender(), getState)
+	if err != nil {
+		return nil, err
+	}
+
+	opp.sts = sts
+
+	return opp, nil
+}
+
+func (opp *HTLCRefundProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(HTLCRefundFact)
+
+	return setState(fact.Hash(), opp.sts...)
+}
+
+func (opp *HTLCRefundProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *HTLCRefundProcessor) Close() error {
+	opp.HTLCRefund = HTLCRefund{}
+	opp.height = base.NilHeight
+	opp.sts = nil
+
+	htlcRefundProcessorPool.Put(opp)
+
+	return nil
+}
+
+// preProcessHTLCSettle checks the signs of sender and returns the locked HTLC.
+func preProcessHTLCSettle(
+	sender base.Address,
+	id valuehash.Hash,
+	fs []base.FactSign,
+	getState func(string) (state.State, bool, error),
+) (state.State, HTLC, error) {
+	if err := checkExistsState(StateKeyAccount(sender), getState); err != nil {
+		return nil, HTLC{}, err
+	}
+
+	if err := checkFactSignsByState(sender, fs, getState); err != nil {
+		return nil, HTLC{}, errors.Wrap(err, "invalid signing")
+	}
+
+	st, err := existsState(StateKeyHTLC(id), "htlc", getState)
+	if err != nil {
+		return nil, HTLC{}, err
+	}
+
+	hl, err := StateHTLCValue(st)
+	if err != nil {
+		return nil, HTLC{}, operation.NewBaseReasonErrorFromError(err)
+	}
+
+	if !hl.IsLocked() {
+		return nil, HTLC{}, operation.NewBaseReasonError("htlc, %q already %s", id, hl.Status())
+	}
+
+	return st, hl, nil
+}
+
+// settleHTLC returns the settled HTLC state, the account index state of the
+// sender of HTLC and the balance state of the receiver of the locked amount;
+// like escrow, the receiver should not be frozen or closed.
+func settleHTLC(
+	st state.State,
+	hl HTLC,
+	receiver base.Address,
+	getState func(string) (state.State, bool, error),
+) ([]state.State, error) {
+	if err := checkActiveAccountState(receiver, getState); err != nil {
+		return nil, err
+	}
+
+	nst, err := SetStateHTLCValue(st, hl)
+	if err != nil {
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	}
+
+	si, err := loadAccountIndexState(hl.Sender(), getState)
+	if err != nil {
+		return nil, err
+	}
+
+	cid := hl.Amount().Currency()
+	rst, _, err := getState(StateKeyBalance(receiver, cid))
+	if err != nil {
+		return nil, err
+	}
+
+	return []state.State{nst, si.Remove(nst.Key()), NewAmountState(rst, cid).Add(hl.Amount().Big())}, nil
+}
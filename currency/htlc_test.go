@@ -0,0 +1,268 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testHTLC struct {
+	baseTest
+}
+
+func (t *testHTLC) TestNewLock() {
+	fact := NewHTLCLockFact(util.UUID().Bytes(),
+		NewTestAddress(), NewTestAddress(), NewAmount(NewBig(10), t.cid), HTLCHashlock([]byte("showme")), base.Height(10))
+	t.NoError(fact.IsValid(nil))
+
+	as, err := fact.Addresses()
+	t.NoError(err)
+	t.Equal(2, len(as))
+}
+
+func (t *testHTLC) TestLockSameAddresses() {
+	sender := NewTestAddress()
+
+	fact := NewHTLCLockFact(util.UUID().Bytes(),
+		sender, sender, NewAmount(NewBig(10), t.cid), HTLCHashlock([]byte("showme")), base.Height(10))
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "receiver is same with sender")
+}
+
+func (t *testHTLC) TestLockWrongHashlock() {
+	fact := NewHTLCLockFact(util.UUID().Bytes(),
+		NewTestAddress(), NewTestAddress(), NewAmount(NewBig(10), t.cid), []byte("showme"), base.Height(10))
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "wrong length of hashlock")
+}
+
+func (t *testHTLC) TestLockWrongExpiry() {
+	fact := NewHTLCLockFact(util.UUID().Bytes(),
+		NewTestAddress(), NewTestAddress(), NewAmount(NewBig(10), t.cid), HTLCHashlock([]byte("showme")), base.GenesisHeight)
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "expiry height")
+}
+
+func (t *testHTLC) TestClaimWrongPreimage() {
+	fact := NewHTLCClaimFact(util.UUID().Bytes(), NewTestAddress(), valuehash.RandomSHA256(), nil)
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "wrong length of preimage")
+
+	fact = NewHTLCClaimFact(util.UUID().Bytes(), NewTestAddress(), valuehash.RandomSHA256(),
+		make([]byte, MaxHTLCPreimageSize+1))
+	err = fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "wrong length of preimage")
+}
+
+func (t *testHTLC) TestSettleHash() {
+	token := util.UUID().Bytes()
+	sender := NewTestAddress()
+	id := valuehash.RandomSHA256()
+
+	claim := NewHTLCClaimFact(token, sender, id, []byte("showme"))
+	refund := NewHTLCRefundFact(token, sender, id)
+	t.NoError(claim.IsValid(nil))
+	t.NoError(refund.IsValid(nil))
+
+	t.False(claim.Hash().Equal(refund.Hash()))
+}
+
+func (t *testHTLC) TestClaim() {
+	preimage := []byte("showme")
+
+	hl := NewHTLC(valuehash.RandomSHA256(),
+		NewTestAddress(), NewTestAddress(), NewAmount(NewBig(10), t.cid), HTLCHashlock(preimage), base.Height(10))
+	t.NoError(hl.IsValid(nil))
+	t.True(hl.IsLocked())
+	t.Nil(hl.Preimage())
+
+	nhl := hl.Claim(preimage)
+	t.NoError(nhl.IsValid(nil))
+	t.False(nhl.IsLocked())
+	t.Equal(HTLCStatusClaimed, nhl.Status())
+	t.Equal(preimage, nhl.Preimage())
+	t.True(hl.IsLocked())
+	t.False(hl.Hash().Equal(nhl.Hash()))
+
+	err := hl.SetStatus(HTLCStatusClaimed).IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "wrong length of preimage")
+
+	err = hl.SetStatus(HTLCStatus("unknown")).IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "unknown htlc status")
+}
+
+func TestHTLC(t *testing.T) {
+	suite.Run(t, new(testHTLC))
+}
+
+func testHTLCEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	preimage := []byte(util.UUID().String())
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		return NewHTLC(valuehash.RandomSHA256(),
+			NewTestAddress(), NewTestAddress(),
+			NewAmount(NewBig(10), CurrencyID("SHOWME")), HTLCHashlock(preimage), base.Height(10),
+		).Claim(preimage)
+	}
+
+	t.compare = func(a, b interface{}) {
+		ha := a.(HTLC)
+		hb := b.(HTLC)
+
+		t.True(ha.Hint().Equal(hb.Hint()))
+		t.True(ha.ID().Equal(hb.ID()))
+		t.True(ha.Sender().Equal(hb.Sender()))
+		t.True(ha.Receiver().Equal(hb.Receiver()))
+		t.True(ha.Amount().Equal(hb.Amount()))
+		t.Equal(ha.Hashlock(), hb.Hashlock())
+		t.Equal(ha.Expiry(), hb.Expiry())
+		t.Equal(ha.Status(), hb.Status())
+		t.Equal(ha.Preimage(), hb.Preimage())
+		t.True(ha.Hash().Equal(hb.Hash()))
+	}
+
+	return t
+}
+
+func TestHTLCEncodeJSON(t *testing.T) {
+	suite.Run(t, testHTLCEncode(jsonenc.NewEncoder()))
+}
+
+func TestHTLCEncodeBSON(t *testing.T) {
+	suite.Run(t, testHTLCEncode(bsonenc.NewEncoder()))
+}
+
+func testHTLCLockEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.NewBasePrivatekey()
+
+		fact := NewHTLCLockFact(util.UUID().Bytes(),
+			NewTestAddress(), NewTestAddress(),
+			NewAmount(NewBig(10), CurrencyID("SHOWME")), HTLCHashlock([]byte("showme")), base.Height(10))
+		sig, err := base.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		op, err := NewHTLCLock(fact, []base.FactSign{base.NewBaseFactSign(pk.Publickey(), sig)}, "")
+		t.NoError(err)
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		fact := a.(HTLCLock).Fact().(HTLCLockFact)
+		ufact := b.(HTLCLock).Fact().(HTLCLockFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.receiver.Equal(ufact.receiver))
+		t.True(fact.amount.Equal(ufact.amount))
+		t.Equal(fact.hashlock, ufact.hashlock)
+		t.Equal(fact.expiry, ufact.expiry)
+	}
+
+	return t
+}
+
+func TestHTLCLockEncodeJSON(t *testing.T) {
+	suite.Run(t, testHTLCLockEncode(jsonenc.NewEncoder()))
+}
+
+func TestHTLCLockEncodeBSON(t *testing.T) {
+	suite.Run(t, testHTLCLockEncode(bsonenc.NewEncoder()))
+}
+
+func testHTLCSettleEncode(enc encoder.Encoder, refund bool) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.NewBasePrivatekey()
+
+		token := util.UUID().Bytes()
+		sender := NewTestAddress()
+		id := valuehash.RandomSHA256()
+
+		var fact base.Fact = NewHTLCClaimFact(token, sender, id, []byte("showme"))
+		if refund {
+			fact = NewHTLCRefundFact(token, sender, id)
+		}
+
+		sig, err := base.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+		fs := []base.FactSign{base.NewBaseFactSign(pk.Publickey(), sig)}
+
+		if refund {
+			op, err := NewHTLCRefund(fact.(HTLCRefundFact), fs, "")
+			t.NoError(err)
+			t.NoError(op.IsValid(nil))
+
+			return op
+		}
+
+		op, err := NewHTLCClaim(fact.(HTLCClaimFact), fs, "")
+		t.NoError(err)
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		if refund {
+			fact := a.(HTLCRefund).Fact().(HTLCRefundFact)
+			ufact := b.(HTLCRefund).Fact().(HTLCRefundFact)
+
+			t.True(fact.sender.Equal(ufact.sender))
+			t.True(fact.htlc.Equal(ufact.htlc))
+
+			return
+		}
+
+		fact := a.(HTLCClaim).Fact().(HTLCClaimFact)
+		ufact := b.(HTLCClaim).Fact().(HTLCClaimFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.htlc.Equal(ufact.htlc))
+		t.Equal(fact.preimage, ufact.preimage)
+	}
+
+	return t
+}
+
+func TestHTLCClaimEncodeJSON(t *testing.T) {
+	suite.Run(t, testHTLCSettleEncode(jsonenc.NewEncoder(), false))
+}
+
+func TestHTLCClaimEncodeBSON(t *testing.T) {
+	suite.Run(t, testHTLCSettleEncode(bsonenc.NewEncoder(), false))
+}
+
+func TestHTLCRefundEncodeJSON(t *testing.T) {
+	suite.Run(t, testHTLCSettleEncode(jsonenc.NewEncoder(), true))
+}
+
+func TestHTLCRefundEncodeBSON(t *testing.T) {
+	suite.Run(t, testHTLCSettleEncode(bsonenc.NewEncoder(), true))
+}
@@ -23,7 +23,13 @@ var (
 	AccountKeysHinter = BaseAccountKeys{BaseHinter: hint.NewBaseHinter(AccountKeysHint)}
 )
 
-var MaxAccountKeyInKeys = 10
+// MaxAccountKeyInKeys and MaxAccountKeyWeight are the hard limits of keys. The
+// active limits are managed by AccountPolicy and they can not be over the hard
+// limits.
+var (
+	MaxAccountKeyInKeys      = 100
+	MaxAccountKeyWeight uint = 100
+)
 
 type AccountKey interface {
 	hint.Hinter
@@ -58,8 +64,8 @@ func NewBaseAccountKey(k key.Publickey, w uint) (BaseAccountKey, error) {
 }
 
 func (ky BaseAccountKey) IsValid([]byte) error {
-	if ky.w < 1 || ky.w > 100 {
-		return isvalid.InvalidError.Errorf("invalid key weight, 1 <= weight <= 100")
+	if ky.w < 1 || ky.w > MaxAccountKeyWeight {
+		return isvalid.InvalidError.Errorf("invalid key weight, 1 <= weight <= %d", MaxAccountKeyWeight)
 	}
 
 	return isvalid.Check(nil, false, ky.k)
@@ -27,7 +27,7 @@ type KeyUpdaterProcessor struct {
 	KeyUpdater
 	sa  state.State
 	sb  AmountState
-	fee Big
+	fee feePayment
 }
 
 func NewKeyUpdaterProcessor(cp *CurrencyPool) GetNewProcessor {
@@ -43,7 +43,7 @@ func NewKeyUpdaterProcessor(cp *CurrencyPool) GetNewProcessor {
 		opp.KeyUpdater = i
 		opp.sa = nil
 		opp.sb = AmountState{}
-		opp.fee = ZeroBig
+		opp.fee = feePayment{}
 
 		return opp, nil
 	}
@@ -61,12 +61,20 @@ func (opp *KeyUpdaterProcessor) PreProcess(
 	}
 	opp.sa = st
 
-	if ks, e := StateKeysValue(opp.sa); err != nil {
+	if ac, e := LoadStateAccountValue(opp.sa); e != nil {
 		return nil, operation.NewBaseReasonErrorFromError(e)
-	} else if ks.Equal(fact.Keys()) {
+	} else if ac.IsFrozen() {
+		return nil, operation.NewBaseReasonError("account, %q frozen", fact.target)
+	} else if ac.IsClosed() {
+		return nil, operation.NewBaseReasonError("account, %q closed", fact.target)
+	} else if ac.Keys().Equal(fact.Keys()) {
 		return nil, operation.NewBaseReasonError("same Keys with the existing")
 	}
 
+	if err := checkKeysByAccountPolicy(fact.Keys(), getState); err != nil {
+		return nil, err
+	}
+
 	st, err = existsState(StateKeyBalance(fact.target, fact.currency), "balance of target", getState)
 	if err != nil {
 		return nil, err
@@ -77,19 +85,20 @@ func (opp *KeyUpdaterProcessor) PreProcess(
 		return nil, errors.Wrap(err, "invalid signing")
 	}
 
-	feeer, found := opp.cp.Feeer(fact.currency)
+	policy, found := opp.cp.Policy(fact.currency)
 	if !found {
 		return nil, operation.NewBaseReasonError("currency, %q not found of KeyUpdater", fact.currency)
 	}
 
-	fee, err := feeer.Fee(ZeroBig)
+	fee, err := loadFeePayment(opp.cp, policy, fact.target, fact.currency, ZeroBig, getState)
 	if err != nil {
-		return nil, operation.NewBaseReasonErrorFromError(err)
+		return nil, err
 	}
+
 	switch b, err := StateBalanceValue(opp.sb); {
 	case err != nil:
 		return nil, operation.NewBaseReasonErrorFromError(err)
-	case b.Big().Compare(fee) < 0:
+	case b.Big().Compare(fee.required(ZeroBig)) < 0:
 		return nil, operation.NewBaseReasonError("insufficient balance with fee")
 	default:
 		opp.fee = fee
@@ -104,12 +113,12 @@ func (opp *KeyUpdaterProcessor) Process(
 ) error {
 	fact := opp.Fact().(KeyUpdaterFact)
 
-	opp.sb = opp.sb.Sub(opp.fee).AddFee(opp.fee)
 	st, err := SetStateKeysValue(opp.sa, fact.keys)
 	if err != nil {
 		return operation.NewBaseReasonErrorFromError(err)
 	}
-	return setState(fact.Hash(), st, opp.sb)
+
+	return setState(fact.Hash(), append([]state.State{st}, opp.fee.pay(opp.sb, ZeroBig)...)...)
 }
 
 func (opp *KeyUpdaterProcessor) Close() error {
@@ -117,7 +126,7 @@ func (opp *KeyUpdaterProcessor) Close() error {
 	opp.KeyUpdater = KeyUpdater{}
 	opp.sa = nil
 	opp.sb = AmountState{}
-	opp.fee = ZeroBig
+	opp.fee = feePayment{}
 
 	keyUpdaterProcessorPool.Put(opp)
 
@@ -104,6 +104,170 @@ func (t *testKeyUpdaterOperation) TestNew() {
 	t.NoError(opr.Close())
 }
 
+func (t *testKeyUpdaterOperation) TestFeeExempted() {
+	am := NewAmount(NewBig(3), t.cid)
+	sa, st := t.newAccount(true, []Amount{am})
+
+	pool, _ := t.statepool(st)
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(sa.Address, NewBig(1))).SetFeeExemptions([]base.Address{sa.Address})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignStateWithPolicy(t.cid, NewBig(99), NewTestAddress(), po)))
+
+	opr := t.processor(cp, pool)
+
+	npk := key.NewBasePrivatekey()
+	nkey, err := NewBaseAccountKey(npk.Publickey(), 100)
+	t.NoError(err)
+	nkeys, err := NewBaseAccountKeys([]AccountKey{nkey}, 100)
+	t.NoError(err)
+
+	op := t.newOperation(sa.Address, nkeys, sa.Privs(), t.cid)
+
+	t.NoError(opr.Process(op))
+
+	var nst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyBalance(sa.Address, am.Currency()) {
+			nst = st.GetState()
+		}
+	}
+
+	nb, err := StateBalanceValue(nst)
+	t.NoError(err)
+	t.True(am.Big().Equal(nb.Big()))
+	t.True(nst.(AmountState).Fee().IsZero())
+}
+
+func (t *testKeyUpdaterOperation) TestFeeCurrency() {
+	fcid := CurrencyID("FEE")
+
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(3), t.cid), NewAmount(NewBig(10), fcid)})
+
+	pool, _ := t.statepool(st)
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(sa.Address, NewBig(4))).SetFeeCurrency(fcid, 0.5)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignStateWithPolicy(t.cid, NewBig(99), NewTestAddress(), po)))
+	t.NoError(cp.Set(t.newCurrencyDesignState(fcid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, NewBig(1)))))
+
+	opr := t.processor(cp, pool)
+
+	npk := key.NewBasePrivatekey()
+	nkey, err := NewBaseAccountKey(npk.Publickey(), 100)
+	t.NoError(err)
+	nkeys, err := NewBaseAccountKeys([]AccountKey{nkey}, 100)
+	t.NoError(err)
+
+	op := t.newOperation(sa.Address, nkeys, sa.Privs(), t.cid)
+
+	t.NoError(opr.Process(op))
+
+	var fst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			t.Fail("balance of currency should not be updated")
+		case StateKeyBalance(sa.Address, fcid):
+			fst = st.GetState()
+		}
+	}
+
+	fam, err := StateBalanceValue(fst)
+	t.NoError(err)
+	t.Equal(NewBig(8), fam.Big())
+	t.Equal(NewBig(2), fst.(AmountState).Fee())
+}
+
+func (t *testKeyUpdaterOperation) TestFeeCurrencyInsufficientBalance() {
+	fcid := CurrencyID("FEE")
+
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(30), t.cid), NewAmount(NewBig(1), fcid)})
+
+	pool, _ := t.statepool(st)
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(sa.Address, NewBig(4))).SetFeeCurrency(fcid, 0)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignStateWithPolicy(t.cid, NewBig(99), NewTestAddress(), po)))
+	t.NoError(cp.Set(t.newCurrencyDesignState(fcid, NewBig(99), NewTestAddress(), NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	npk := key.NewBasePrivatekey()
+	nkey, err := NewBaseAccountKey(npk.Publickey(), 100)
+	t.NoError(err)
+	nkeys, err := NewBaseAccountKeys([]AccountKey{nkey}, 100)
+	t.NoError(err)
+
+	op := t.newOperation(sa.Address, nkeys, sa.Privs(), t.cid)
+
+	err = opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "insufficient balance of fee currency")
+}
+
+func (t *testKeyUpdaterOperation) TestFeeCurrencyWithoutBalance() {
+	fcid := CurrencyID("FEE")
+
+	sa, st := t.newAccount(true, []Amount{NewAmount(NewBig(30), t.cid)})
+
+	pool, _ := t.statepool(st)
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(sa.Address, NewBig(4))).SetFeeCurrency(fcid, 0)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignStateWithPolicy(t.cid, NewBig(99), NewTestAddress(), po)))
+	t.NoError(cp.Set(t.newCurrencyDesignState(fcid, NewBig(99), NewTestAddress(), NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	npk := key.NewBasePrivatekey()
+	nkey, err := NewBaseAccountKey(npk.Publickey(), 100)
+	t.NoError(err)
+	nkeys, err := NewBaseAccountKeys([]AccountKey{nkey}, 100)
+	t.NoError(err)
+
+	op := t.newOperation(sa.Address, nkeys, sa.Privs(), t.cid)
+
+	err = opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "balance of fee currency does not exist")
+}
+
+func (t *testKeyUpdaterOperation) TestFrozenTarget() {
+	am := NewAmount(NewBig(3), t.cid)
+	sa, st := t.newAccount(true, []Amount{am})
+
+	pool, _ := t.statepool(t.freezeAccountState(sa.Address, st))
+	feeer := NewFixedFeeer(sa.Address, NewBig(1))
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	npk := key.NewBasePrivatekey()
+	nkey, err := NewBaseAccountKey(npk.Publickey(), 100)
+	t.NoError(err)
+	nkeys, err := NewBaseAccountKeys([]AccountKey{nkey}, 100)
+	t.NoError(err)
+
+	op := t.newOperation(sa.Address, nkeys, sa.Privs(), t.cid)
+
+	err = opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "frozen")
+}
+
 func (t *testKeyUpdaterOperation) TestUnknownCurrency() {
 	am := NewAmount(NewBig(3), CurrencyID("FINDME"))
 	sa, st := t.newAccount(true, []Amount{am})
@@ -0,0 +1,178 @@
+package currency
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	LockedAmountType   = hint.Type("mitum-currency-locked-amount")
+	LockedAmountHint   = hint.NewHint(LockedAmountType, "v0.0.1")
+	LockedAmountHinter = LockedAmount{BaseHinter: hint.NewBaseHinter(LockedAmountHint)}
+)
+
+// AmountLock is the amount, which can not be spent until the unlock height.
+type AmountLock struct {
+	big    Big
+	height base.Height
+}
+
+func NewAmountLock(big Big, height base.Height) AmountLock {
+	return AmountLock{big: big, height: height}
+}
+
+func (lo AmountLock) Bytes() []byte {
+	return util.ConcatBytesSlice(lo.big.Bytes(), lo.height.Bytes())
+}
+
+func (lo AmountLock) IsValid([]byte) error {
+	if !lo.big.OverZero() {
+		return isvalid.InvalidError.Errorf("locked amount should be over zero")
+	}
+
+	if lo.height < base.GenesisHeight {
+		return isvalid.InvalidError.Errorf("invalid unlock height, %v", lo.height)
+	}
+
+	return nil
+}
+
+func (lo AmountLock) Big() Big {
+	return lo.big
+}
+
+// Height is the unlock height; the locked amount can be unlocked at the
+// height or after.
+func (lo AmountLock) Height() base.Height {
+	return lo.height
+}
+
+func (lo AmountLock) String() string {
+	return fmt.Sprintf("%s@%d", lo.big.String(), lo.height)
+}
+
+// LockedAmount is the state value of the locked balance of account. The locks
+// are sorted by the unlock height.
+type LockedAmount struct {
+	hint.BaseHinter
+	cid   CurrencyID
+	locks []AmountLock
+}
+
+func NewLockedAmount(cid CurrencyID, locks []AmountLock) LockedAmount {
+	ls := make([]AmountLock, len(locks))
+	copy(ls, locks)
+
+	sort.SliceStable(ls, func(i, j int) bool {
+		return ls[i].height < ls[j].height
+	})
+
+	return LockedAmount{BaseHinter: hint.NewBaseHinter(LockedAmountHint), cid: cid, locks: ls}
+}
+
+func NewZeroLockedAmount(cid CurrencyID) LockedAmount {
+	return NewLockedAmount(cid, nil)
+}
+
+func (la LockedAmount) Bytes() []byte {
+	bs := make([][]byte, len(la.locks)+1)
+	bs[0] = la.cid.Bytes()
+
+	for i := range la.locks {
+		bs[i+1] = la.locks[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (la LockedAmount) Hash() valuehash.Hash {
+	return la.GenerateHash()
+}
+
+func (la LockedAmount) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(la.Bytes())
+}
+
+func (la LockedAmount) IsValid([]byte) error {
+	if err := isvalid.Check(nil, false, la.BaseHinter, la.cid); err != nil {
+		return isvalid.InvalidError.Errorf("invalid LockedAmount: %w", err)
+	}
+
+	founds := map[base.Height]struct{}{}
+	for i := range la.locks {
+		lo := la.locks[i]
+		if err := lo.IsValid(nil); err != nil {
+			return isvalid.InvalidError.Errorf("invalid LockedAmount: %w", err)
+		}
+
+		if _, found := founds[lo.height]; found {
+			return isvalid.InvalidError.Errorf("duplicated unlock height found, %v", lo.height)
+		}
+		founds[lo.height] = struct{}{}
+	}
+
+	return nil
+}
+
+func (la LockedAmount) Currency() CurrencyID {
+	return la.cid
+}
+
+func (la LockedAmount) Locks() []AmountLock {
+	return la.locks
+}
+
+// Total returns the sum of all the locked amounts.
+func (la LockedAmount) Total() Big {
+	total := ZeroBig
+	for i := range la.locks {
+		total = total.Add(la.locks[i].big)
+	}
+
+	return total
+}
+
+// Lock adds new lock; the lock of same unlock height is merged.
+func (la LockedAmount) Lock(big Big, height base.Height) LockedAmount {
+	locks := make([]AmountLock, len(la.locks), len(la.locks)+1)
+	copy(locks, la.locks)
+
+	for i := range locks {
+		if locks[i].height == height {
+			locks[i].big = locks[i].big.Add(big)
+
+			return NewLockedAmount(la.cid, locks)
+		}
+	}
+
+	return NewLockedAmount(la.cid, append(locks, NewAmountLock(big, height)))
+}
+
+// Unlock removes the locks, which can be unlocked at the given height and
+// returns the sum of the unlocked amounts.
+func (la LockedAmount) Unlock(height base.Height) (LockedAmount, Big) {
+	unlocked := ZeroBig
+
+	var locks []AmountLock
+	for i := range la.locks {
+		if la.locks[i].height <= height {
+			unlocked = unlocked.Add(la.locks[i].big)
+
+			continue
+		}
+
+		locks = append(locks, la.locks[i])
+	}
+
+	return NewLockedAmount(la.cid, locks), unlocked
+}
+
+func (la LockedAmount) String() string {
+	return fmt.Sprintf("%s(%s, locked)", la.Total().String(), la.cid)
+}
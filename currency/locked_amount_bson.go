@@ -0,0 +1,61 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type AmountLockBSONPacker struct {
+	BG Big         `bson:"amount"`
+	HT base.Height `bson:"unlock_height"`
+}
+
+func (lo AmountLock) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(AmountLockBSONPacker{
+		BG: lo.big,
+		HT: lo.height,
+	})
+}
+
+func (lo *AmountLock) UnmarshalBSON(b []byte) error {
+	var ulo AmountLockBSONPacker
+	if err := bsonenc.Unmarshal(b, &ulo); err != nil {
+		return err
+	}
+
+	lo.big = ulo.BG
+	lo.height = ulo.HT
+
+	return nil
+}
+
+func (la LockedAmount) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(la.Hint()),
+		bson.M{
+			"currency": la.cid,
+			"locks":    la.locks,
+		}),
+	)
+}
+
+type LockedAmountBSONUnpacker struct {
+	HT hint.Hint    `bson:"_hint"`
+	CR string       `bson:"currency"`
+	LS []AmountLock `bson:"locks"`
+}
+
+func (la *LockedAmount) UnmarshalBSON(b []byte) error {
+	var ula LockedAmountBSONUnpacker
+	if err := bsonenc.Unmarshal(b, &ula); err != nil {
+		return err
+	}
+
+	la.BaseHinter = hint.NewBaseHinter(ula.HT)
+	la.cid = CurrencyID(ula.CR)
+	la.locks = ula.LS
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
+)
+
+type AmountLockJSONPacker struct {
+	BG Big         `json:"amount"`
+	HT base.Height `json:"unlock_height"`
+}
+
+func (lo AmountLock) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(AmountLockJSONPacker{
+		BG: lo.big,
+		HT: lo.height,
+	})
+}
+
+func (lo *AmountLock) UnmarshalJSON(b []byte) error {
+	var ulo AmountLockJSONPacker
+	if err := jsonenc.Unmarshal(b, &ulo); err != nil {
+		return err
+	}
+
+	lo.big = ulo.BG
+	lo.height = ulo.HT
+
+	return nil
+}
+
+type LockedAmountJSONPacker struct {
+	jsonenc.HintedHead
+	CR CurrencyID   `json:"currency"`
+	LS []AmountLock `json:"locks"`
+}
+
+func (la LockedAmount) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(LockedAmountJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(la.Hint()),
+		CR:         la.cid,
+		LS:         la.locks,
+	})
+}
+
+type LockedAmountJSONUnpacker struct {
+	HT hint.Hint    `json:"_hint"`
+	CR string       `json:"currency"`
+	LS []AmountLock `json:"locks"`
+}
+
+func (la *LockedAmount) UnmarshalJSON(b []byte) error {
+	var ula LockedAmountJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &ula); err != nil {
+		return err
+	}
+
+	la.BaseHinter = hint.NewBaseHinter(ula.HT)
+	la.cid = CurrencyID(ula.CR)
+	la.locks = ula.LS
+
+	return nil
+}
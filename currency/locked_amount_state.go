@@ -0,0 +1,147 @@
+package currency
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	lockedAmountStateType = hint.Type("mitum-currency-locked-amount-state")
+	lockedAmountStateHint = hint.NewHint(lockedAmountStateType, "v0.0.1")
+)
+
+// LockedAmountState keeps the changes of locked balance like AmountState, so
+// the locked balance can be updated by multiple operations in one block.
+type LockedAmountState struct {
+	state.State
+	cid      CurrencyID
+	locks    []AmountLock
+	unlocked base.Height
+}
+
+func NewLockedAmountState(st state.State, cid CurrencyID) LockedAmountState {
+	if sst, ok := st.(LockedAmountState); ok {
+		return sst
+	}
+
+	return LockedAmountState{
+		State:    st,
+		cid:      cid,
+		unlocked: base.NilHeight,
+	}
+}
+
+func (LockedAmountState) Hint() hint.Hint {
+	return lockedAmountStateHint
+}
+
+func (st LockedAmountState) Merge(b state.State) (state.State, error) {
+	var la LockedAmount
+	if i, err := StateLockedBalanceValue(b); err != nil {
+		if !errors.Is(err, util.NotFoundError) {
+			return nil, err
+		}
+		la = NewZeroLockedAmount(st.cid)
+	} else {
+		la = i
+	}
+
+	if st.unlocked > base.NilHeight {
+		la, _ = la.Unlock(st.unlocked)
+	}
+
+	for i := range st.locks {
+		la = la.Lock(st.locks[i].big, st.locks[i].height)
+	}
+
+	return SetStateLockedBalanceValue(st, la)
+}
+
+func (st LockedAmountState) Currency() CurrencyID {
+	return st.cid
+}
+
+func (st LockedAmountState) Lock(big Big, height base.Height) LockedAmountState {
+	locks := make([]AmountLock, len(st.locks)+1)
+	copy(locks, st.locks)
+	locks[len(st.locks)] = NewAmountLock(big, height)
+
+	st.locks = locks
+
+	return st
+}
+
+// Unlock marks the locks until the given height to be unlocked. The unlocked
+// amount is calculated from the current value.
+func (st LockedAmountState) Unlock(height base.Height) (LockedAmountState, Big, error) {
+	la, err := StateLockedBalanceValue(st)
+	if err != nil {
+		if !errors.Is(err, util.NotFoundError) {
+			return st, ZeroBig, err
+		}
+
+		return st, ZeroBig, nil
+	}
+
+	_, unlocked := la.Unlock(height)
+
+	st.unlocked = height
+
+	return st, unlocked, nil
+}
+
+func (st LockedAmountState) SetValue(v state.Value) (state.State, error) {
+	s, err := st.State.SetValue(v)
+	if err != nil {
+		return nil, err
+	}
+	st.State = s
+
+	return st, nil
+}
+
+func (st LockedAmountState) SetHash(h valuehash.Hash) (state.State, error) {
+	s, err := st.State.SetHash(h)
+	if err != nil {
+		return nil, err
+	}
+	st.State = s
+
+	return st, nil
+}
+
+func (st LockedAmountState) SetHeight(h base.Height) state.State {
+	st.State = st.State.SetHeight(h)
+
+	return st
+}
+
+func (st LockedAmountState) SetPreviousHeight(h base.Height) (state.State, error) {
+	s, err := st.State.SetPreviousHeight(h)
+	if err != nil {
+		return nil, err
+	}
+	st.State = s
+
+	return st, nil
+}
+
+func (st LockedAmountState) SetOperation(ops []valuehash.Hash) state.State {
+	st.State = st.State.SetOperation(ops)
+
+	return st
+}
+
+func (st LockedAmountState) Clear() state.State {
+	st.State = st.State.Clear()
+
+	st.locks = nil
+	st.unlocked = base.NilHeight
+
+	return st
+}
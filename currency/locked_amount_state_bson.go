@@ -0,0 +1,9 @@
+package currency
+
+import (
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (st LockedAmountState) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(st.State)
+}
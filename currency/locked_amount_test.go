@@ -0,0 +1,111 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/stretchr/testify/suite"
+)
+
+type testLockedAmount struct {
+	suite.Suite
+}
+
+func (t *testLockedAmount) TestLock() {
+	la := NewZeroLockedAmount(CurrencyID("SHOWME"))
+	t.NoError(la.IsValid(nil))
+	t.True(la.Total().IsZero())
+
+	la = la.Lock(NewBig(10), base.Height(30)).
+		Lock(NewBig(20), base.Height(10)).
+		Lock(NewBig(5), base.Height(30))
+	t.NoError(la.IsValid(nil))
+
+	t.Equal(2, len(la.Locks()))
+	t.Equal(base.Height(10), la.Locks()[0].Height())
+	t.True(NewBig(20).Equal(la.Locks()[0].Big()))
+	t.Equal(base.Height(30), la.Locks()[1].Height())
+	t.True(NewBig(15).Equal(la.Locks()[1].Big()))
+	t.True(NewBig(35).Equal(la.Total()))
+}
+
+func (t *testLockedAmount) TestUnlock() {
+	la := NewLockedAmount(CurrencyID("SHOWME"), []AmountLock{
+		NewAmountLock(NewBig(10), base.Height(30)),
+		NewAmountLock(NewBig(20), base.Height(10)),
+		NewAmountLock(NewBig(30), base.Height(20)),
+	})
+
+	nla, unlocked := la.Unlock(base.Height(9))
+	t.True(unlocked.IsZero())
+	t.True(la.Total().Equal(nla.Total()))
+
+	nla, unlocked = la.Unlock(base.Height(20))
+	t.True(NewBig(50).Equal(unlocked))
+	t.Equal(1, len(nla.Locks()))
+	t.True(NewBig(10).Equal(nla.Total()))
+}
+
+func (t *testLockedAmount) TestDuplicatedHeight() {
+	la := NewLockedAmount(CurrencyID("SHOWME"), []AmountLock{
+		NewAmountLock(NewBig(10), base.Height(30)),
+		NewAmountLock(NewBig(20), base.Height(30)),
+	})
+
+	err := la.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "duplicated unlock height")
+}
+
+func (t *testLockedAmount) TestZeroLock() {
+	la := NewLockedAmount(CurrencyID("SHOWME"), []AmountLock{NewAmountLock(ZeroBig, base.Height(30))})
+
+	err := la.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "over zero")
+}
+
+func TestLockedAmount(t *testing.T) {
+	suite.Run(t, new(testLockedAmount))
+}
+
+func testLockedAmountEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		return NewLockedAmount(CurrencyID("SHOWME"), []AmountLock{
+			NewAmountLock(NewBig(10), base.Height(30)),
+			NewAmountLock(NewBig(20), base.Height(10)),
+		})
+	}
+
+	t.compare = func(a, b interface{}) {
+		la := a.(LockedAmount)
+		lb := b.(LockedAmount)
+
+		t.True(la.Hint().Equal(lb.Hint()))
+		t.Equal(la.Currency(), lb.Currency())
+		t.Equal(len(la.Locks()), len(lb.Locks()))
+		for i := range la.Locks() {
+			t.True(la.Locks()[i].Big().Equal(lb.Locks()[i].Big()))
+			t.Equal(la.Locks()[i].Height(), lb.Locks()[i].Height())
+		}
+		t.True(la.Hash().Equal(lb.Hash()))
+	}
+
+	return t
+}
+
+func TestLockedAmountEncodeJSON(t *testing.T) {
+	suite.Run(t, testLockedAmountEncode(jsonenc.NewEncoder()))
+}
+
+func TestLockedAmountEncodeBSON(t *testing.T) {
+	suite.Run(t, testLockedAmountEncode(bsonenc.NewEncoder()))
+}
@@ -1,8 +1,18 @@
 package currency
 
-import "github.com/spikeekips/mitum/util/isvalid"
+import (
+	"github.com/pkg/errors"
 
-var MaxMemoSize = 100 // TODO should be managed by policy
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+// MaxMemoSize is the hard limit of memo size. The active limit is managed by
+// MemoPolicy and it can not be over MaxMemoSize.
+var MaxMemoSize = 1000
 
 func IsValidMemo(s string) error {
 	if len(s) > MaxMemoSize {
@@ -12,6 +22,90 @@ func IsValidMemo(s string) error {
 	return nil
 }
 
+// memoer is the operation, which has memo; BaseOperation implements memoer.
+type memoer interface {
+	memo() string
+}
+
+func (op BaseOperation) memo() string {
+	return op.Memo
+}
+
+// checkMemoByPolicy checks the memo of operation by the active MemoPolicy in
+// state.
+func checkMemoByPolicy(op state.Processor, getState func(key string) (state.State, bool, error)) error {
+	i, ok := op.(memoer)
+	if !ok {
+		return nil
+	}
+
+	po, err := loadMemoPolicy(getState)
+	if err != nil {
+		return err
+	}
+
+	var receivers []base.Address
+	if len(i.memo()) < 1 && len(po.RequiredReceivers()) > 0 {
+		receivers = memoReceivers(op, getState)
+	}
+
+	if err := po.IsValidMemo(i.memo(), receivers); err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	return nil
+}
+
+func loadMemoPolicy(getState func(key string) (state.State, bool, error)) (MemoPolicy, error) {
+	switch st, found, err := getState(StateKeyMemoPolicy); {
+	case err != nil:
+		return MemoPolicy{}, err
+	case !found:
+		return DefaultMemoPolicy, nil
+	default:
+		po, err := StateMemoPolicyValue(st)
+		if err != nil {
+			if errors.Is(err, util.NotFoundError) {
+				return DefaultMemoPolicy, nil
+			}
+
+			return MemoPolicy{}, err
+		}
+
+		return po, nil
+	}
+}
+
+// memoReceivers returns the accounts, which receive amounts by the operation.
+// Alias is resolved to the account address.
+func memoReceivers(op state.Processor, getState func(key string) (state.State, bool, error)) []base.Address {
+	var as []base.Address
+	switch t := op.(type) {
+	case Transfers:
+		items := t.Fact().(TransfersFact).Items()
+		for i := range items {
+			as = append(as, items[i].Receiver())
+		}
+	case TransferFrom:
+		as = []base.Address{t.Fact().(TransferFromFact).Receiver()}
+	case AccountMerge:
+		as = []base.Address{t.Fact().(AccountMergeFact).Target()}
+	default:
+		return nil
+	}
+
+	for i := range as {
+		a, err := resolveAddress(as[i], getState)
+		if err != nil {
+			// NOTE unknown alias is checked by processor
+			continue
+		}
+		as[i] = a
+	}
+
+	return as
+}
+
 type MemoBSONUnpacker struct {
 	Memo string `bson:"memo"`
 }
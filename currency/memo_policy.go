@@ -0,0 +1,175 @@
+package currency
+
+import (
+	"unicode/utf8"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	MemoPolicyType   = hint.Type("mitum-currency-memo-policy")
+	MemoPolicyHint   = hint.NewHint(MemoPolicyType, "v0.0.1")
+	MemoPolicyHinter = MemoPolicy{BaseHinter: hint.NewBaseHinter(MemoPolicyHint)}
+)
+
+// DefaultMemoPolicy is used when no MemoPolicy is stored in state.
+var DefaultMemoPolicy = NewMemoPolicy(100, MemoCharsetUTF8, nil)
+
+type MemoCharset string
+
+const (
+	MemoCharsetUTF8    MemoCharset = "utf8"    // NOTE any valid utf-8 string
+	MemoCharsetASCII   MemoCharset = "ascii"   // NOTE printable ascii characters
+	MemoCharsetNumeric MemoCharset = "numeric" // NOTE only digits, 0-9
+)
+
+func (mc MemoCharset) Bytes() []byte {
+	return []byte(mc)
+}
+
+func (mc MemoCharset) String() string {
+	return string(mc)
+}
+
+func (mc MemoCharset) IsValid([]byte) error {
+	switch mc {
+	case MemoCharsetUTF8, MemoCharsetASCII, MemoCharsetNumeric:
+		return nil
+	default:
+		return isvalid.InvalidError.Errorf("unknown memo charset, %q", mc)
+	}
+}
+
+func (mc MemoCharset) check(s string) error {
+	switch mc {
+	case MemoCharsetUTF8:
+		if !utf8.ValidString(s) {
+			return isvalid.InvalidError.Errorf("memo is not valid utf8 string")
+		}
+	case MemoCharsetASCII:
+		for i := 0; i < len(s); i++ {
+			if s[i] < 0x20 || s[i] > 0x7e {
+				return isvalid.InvalidError.Errorf("memo has not printable ascii character")
+			}
+		}
+	case MemoCharsetNumeric:
+		for i := 0; i < len(s); i++ {
+			if s[i] < '0' || s[i] > '9' {
+				return isvalid.InvalidError.Errorf("memo has not numeric character")
+			}
+		}
+	default:
+		return isvalid.InvalidError.Errorf("unknown memo charset, %q", mc)
+	}
+
+	return nil
+}
+
+// MemoPolicy is the network-wide rules of operation memo. MemoPolicy is
+// updated by MemoPolicyUpdater.
+type MemoPolicy struct {
+	hint.BaseHinter
+	maxSize           uint
+	charset           MemoCharset
+	requiredReceivers []base.Address // NOTE receivers, which require memo
+}
+
+func NewMemoPolicy(maxSize uint, charset MemoCharset, requiredReceivers []base.Address) MemoPolicy {
+	return MemoPolicy{
+		BaseHinter:        hint.NewBaseHinter(MemoPolicyHint),
+		maxSize:           maxSize,
+		charset:           charset,
+		requiredReceivers: requiredReceivers,
+	}
+}
+
+func (po MemoPolicy) Bytes() []byte {
+	bs := make([][]byte, len(po.requiredReceivers)+2)
+	bs[0] = util.UintToBytes(po.maxSize)
+	bs[1] = po.charset.Bytes()
+
+	for i := range po.requiredReceivers {
+		bs[i+2] = po.requiredReceivers[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (po MemoPolicy) Hash() valuehash.Hash {
+	return po.GenerateHash()
+}
+
+func (po MemoPolicy) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(po.Bytes())
+}
+
+func (po MemoPolicy) IsValid([]byte) error {
+	if err := isvalid.Check(nil, false, po.BaseHinter, po.charset); err != nil {
+		return isvalid.InvalidError.Errorf("invalid memo policy: %w", err)
+	}
+
+	if po.maxSize > uint(MaxMemoSize) {
+		return isvalid.InvalidError.Errorf("memo policy max size over limit, %d > %d", po.maxSize, MaxMemoSize)
+	}
+
+	founds := map[string]struct{}{}
+	for i := range po.requiredReceivers {
+		a := po.requiredReceivers[i]
+		if err := a.IsValid(nil); err != nil {
+			return isvalid.InvalidError.Errorf("invalid memo required receiver: %w", err)
+		}
+
+		if _, found := founds[a.String()]; found {
+			return isvalid.InvalidError.Errorf("duplicated memo required receiver, %q", a)
+		}
+		founds[a.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (po MemoPolicy) MaxSize() uint {
+	return po.maxSize
+}
+
+func (po MemoPolicy) Charset() MemoCharset {
+	return po.charset
+}
+
+func (po MemoPolicy) RequiredReceivers() []base.Address {
+	return po.requiredReceivers
+}
+
+func (po MemoPolicy) IsRequiredReceiver(a base.Address) bool {
+	for i := range po.requiredReceivers {
+		if po.requiredReceivers[i].Equal(a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsValidMemo checks memo by policy; receivers are the accounts, which receive
+// amounts by the operation.
+func (po MemoPolicy) IsValidMemo(s string, receivers []base.Address) error {
+	if uint(len(s)) > po.maxSize {
+		return isvalid.InvalidError.Errorf("memo over max size, %d > %d", len(s), po.maxSize)
+	}
+
+	if len(s) < 1 {
+		for i := range receivers {
+			if po.IsRequiredReceiver(receivers[i]) {
+				return isvalid.InvalidError.Errorf("memo is required for receiver, %q", receivers[i])
+			}
+		}
+
+		return nil
+	}
+
+	return po.charset.check(s)
+}
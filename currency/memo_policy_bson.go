@@ -0,0 +1,36 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+func (po MemoPolicy) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"max_size": po.maxSize,
+		"charset":  po.charset,
+	}
+
+	if len(po.requiredReceivers) > 0 {
+		m["required_receivers"] = po.requiredReceivers
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(bsonenc.NewHintedDoc(po.Hint()), m))
+}
+
+type MemoPolicyBSONUnpacker struct {
+	MS uint                  `bson:"max_size"`
+	CS string                `bson:"charset"`
+	RR []base.AddressDecoder `bson:"required_receivers"`
+}
+
+func (po *MemoPolicy) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var upo MemoPolicyBSONUnpacker
+	if err := enc.Unmarshal(b, &upo); err != nil {
+		return err
+	}
+
+	return po.unpack(enc, upo.MS, upo.CS, upo.RR)
+}
@@ -0,0 +1,32 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (po *MemoPolicy) unpack(
+	enc encoder.Encoder,
+	ms uint,
+	cs string,
+	urr []base.AddressDecoder,
+) error {
+	po.maxSize = ms
+	po.charset = MemoCharset(cs)
+
+	if len(urr) > 0 {
+		rr := make([]base.Address, len(urr))
+		for i := range urr {
+			a, err := urr[i].Encode(enc)
+			if err != nil {
+				return err
+			}
+
+			rr[i] = a
+		}
+
+		po.requiredReceivers = rr
+	}
+
+	return nil
+}
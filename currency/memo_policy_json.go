@@ -0,0 +1,37 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type MemoPolicyJSONPacker struct {
+	jsonenc.HintedHead
+	MS uint           `json:"max_size"`
+	CS MemoCharset    `json:"charset"`
+	RR []base.Address `json:"required_receivers,omitempty"`
+}
+
+func (po MemoPolicy) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(MemoPolicyJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(po.Hint()),
+		MS:         po.maxSize,
+		CS:         po.charset,
+		RR:         po.requiredReceivers,
+	})
+}
+
+type MemoPolicyJSONUnpacker struct {
+	MS uint                  `json:"max_size"`
+	CS string                `json:"charset"`
+	RR []base.AddressDecoder `json:"required_receivers"`
+}
+
+func (po *MemoPolicy) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var upo MemoPolicyJSONUnpacker
+	if err := enc.Unmarshal(b, &upo); err != nil {
+		return err
+	}
+
+	return po.unpack(enc, upo.MS, upo.CS, upo.RR)
+}
@@ -0,0 +1,165 @@
+package currency
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+type testMemoPolicy struct {
+	suite.Suite
+}
+
+func (t *testMemoPolicy) TestNew() {
+	po := NewMemoPolicy(30, MemoCharsetASCII, []base.Address{NewTestAddress()})
+	t.NoError(po.IsValid(nil))
+
+	t.NoError(DefaultMemoPolicy.IsValid(nil))
+}
+
+func (t *testMemoPolicy) TestUnknownCharset() {
+	po := NewMemoPolicy(30, MemoCharset("euc-kr"), nil)
+
+	err := po.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "unknown memo charset")
+}
+
+func (t *testMemoPolicy) TestOverMaxMemoSize() {
+	po := NewMemoPolicy(uint(MaxMemoSize)+1, MemoCharsetUTF8, nil)
+
+	err := po.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "max size over limit")
+}
+
+func (t *testMemoPolicy) TestDuplicatedRequiredReceivers() {
+	a := NewTestAddress()
+	po := NewMemoPolicy(30, MemoCharsetUTF8, []base.Address{a, a})
+
+	err := po.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "duplicated memo required receiver")
+}
+
+func (t *testMemoPolicy) TestIsValidMemo() {
+	ra := NewTestAddress()
+
+	cases := []struct {
+		name      string
+		po        MemoPolicy
+		memo      string
+		receivers []base.Address
+		err       string
+	}{
+		{name: "empty memo", po: NewMemoPolicy(3, MemoCharsetNumeric, nil), memo: ""},
+		{name: "max size", po: NewMemoPolicy(3, MemoCharsetUTF8, nil), memo: "abc"},
+		{name: "over max size", po: NewMemoPolicy(3, MemoCharsetUTF8, nil), memo: "abcd", err: "memo over max size"},
+		{name: "utf8", po: NewMemoPolicy(30, MemoCharsetUTF8, nil), memo: "보여줘"},
+		{name: "invalid utf8", po: NewMemoPolicy(30, MemoCharsetUTF8, nil), memo: "\xff", err: "not valid utf8"},
+		{name: "ascii", po: NewMemoPolicy(30, MemoCharsetASCII, nil), memo: "show me"},
+		{name: "not ascii", po: NewMemoPolicy(30, MemoCharsetASCII, nil), memo: "보여줘", err: "not printable ascii"},
+		{name: "control in ascii", po: NewMemoPolicy(30, MemoCharsetASCII, nil), memo: "a\nb", err: "not printable ascii"},
+		{name: "numeric", po: NewMemoPolicy(30, MemoCharsetNumeric, nil), memo: "0123"},
+		{name: "not numeric", po: NewMemoPolicy(30, MemoCharsetNumeric, nil), memo: "12a", err: "not numeric"},
+		{
+			name: "required receiver", po: NewMemoPolicy(30, MemoCharsetUTF8, []base.Address{ra}),
+			memo: "", receivers: []base.Address{NewTestAddress(), ra}, err: "memo is required",
+		},
+		{
+			name: "required receiver with memo", po: NewMemoPolicy(30, MemoCharsetUTF8, []base.Address{ra}),
+			memo: "1", receivers: []base.Address{ra},
+		},
+		{
+			name: "not required receiver", po: NewMemoPolicy(30, MemoCharsetUTF8, []base.Address{ra}),
+			memo: "", receivers: []base.Address{NewTestAddress()},
+		},
+	}
+
+	for i, c := range cases {
+		i := i
+		c := c
+		t.Run(c.name, func() {
+			err := c.po.IsValidMemo(c.memo, c.receivers)
+			if len(c.err) < 1 {
+				t.NoError(err, "%d: %v", i, c.name)
+
+				return
+			}
+
+			t.True(errors.Is(err, isvalid.InvalidError), "%d: %v", i, c.name)
+			t.Contains(err.Error(), c.err, "%d: %v", i, c.name)
+		})
+	}
+}
+
+func (t *testMemoPolicy) TestOperationOverMaxMemoSize() {
+	fact := NewMemoPolicyUpdaterFact(util.UUID().Bytes(), DefaultMemoPolicy)
+
+	op, err := NewMemoPolicyUpdater(fact, nil, strings.Repeat("a", MaxMemoSize+1))
+	t.NoError(err)
+
+	err = op.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "memo over max size")
+}
+
+func TestMemoPolicy(t *testing.T) {
+	suite.Run(t, new(testMemoPolicy))
+}
+
+func testMemoPolicyUpdaterEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		po := NewMemoPolicy(33, MemoCharsetNumeric, []base.Address{NewTestAddress(), NewTestAddress()})
+		fact := NewMemoPolicyUpdaterFact(util.UUID().Bytes(), po)
+
+		op, err := NewMemoPolicyUpdater(fact, newTestRecoveryFactSigns(t, fact), "findme")
+		t.NoError(err)
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		ta := a.(MemoPolicyUpdater)
+		tb := b.(MemoPolicyUpdater)
+
+		t.Equal(ta.Memo, tb.Memo)
+
+		pa := ta.Fact().(MemoPolicyUpdaterFact).Policy()
+		pb := tb.Fact().(MemoPolicyUpdaterFact).Policy()
+
+		t.True(pa.Hint().Equal(pb.Hint()))
+		t.Equal(pa.MaxSize(), pb.MaxSize())
+		t.Equal(pa.Charset(), pb.Charset())
+		t.Equal(len(pa.RequiredReceivers()), len(pb.RequiredReceivers()))
+
+		for i := range pa.RequiredReceivers() {
+			t.True(pa.RequiredReceivers()[i].Equal(pb.RequiredReceivers()[i]))
+		}
+
+		t.Equal(pa.Bytes(), pb.Bytes())
+	}
+
+	return t
+}
+
+func TestMemoPolicyUpdaterEncodeJSON(t *testing.T) {
+	suite.Run(t, testMemoPolicyUpdaterEncode(jsonenc.NewEncoder()))
+}
+
+func TestMemoPolicyUpdaterEncodeBSON(t *testing.T) {
+	suite.Run(t, testMemoPolicyUpdaterEncode(bsonenc.NewEncoder()))
+}
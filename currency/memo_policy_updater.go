@@ -0,0 +1,91 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	MemoPolicyUpdaterFactType   = hint.Type("mitum-currency-memo-policy-updater-operation-fact")
+	MemoPolicyUpdaterFactHint   = hint.NewHint(MemoPolicyUpdaterFactType, "v0.0.1")
+	MemoPolicyUpdaterFactHinter = MemoPolicyUpdaterFact{
+		BaseHinter: hint.NewBaseHinter(MemoPolicyUpdaterFactHint),
+	}
+	MemoPolicyUpdaterType   = hint.Type("mitum-currency-memo-policy-updater-operation")
+	MemoPolicyUpdaterHint   = hint.NewHint(MemoPolicyUpdaterType, "v0.0.1")
+	MemoPolicyUpdaterHinter = MemoPolicyUpdater{BaseOperation: operationHinter(MemoPolicyUpdaterHint)}
+)
+
+type MemoPolicyUpdaterFact struct {
+	hint.BaseHinter
+	h      valuehash.Hash
+	token  []byte
+	policy MemoPolicy
+}
+
+func NewMemoPolicyUpdaterFact(token []byte, policy MemoPolicy) MemoPolicyUpdaterFact {
+	fact := MemoPolicyUpdaterFact{
+		BaseHinter: hint.NewBaseHinter(MemoPolicyUpdaterFactHint),
+		token:      token,
+		policy:     policy,
+	}
+
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact MemoPolicyUpdaterFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact MemoPolicyUpdaterFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.policy.Bytes(),
+	)
+}
+
+func (fact MemoPolicyUpdaterFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := isvalid.Check(nil, false, fact.policy); err != nil {
+		return isvalid.InvalidError.Errorf("invalid fact: %w", err)
+	}
+
+	return nil
+}
+
+func (fact MemoPolicyUpdaterFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact MemoPolicyUpdaterFact) Token() []byte {
+	return fact.token
+}
+
+func (fact MemoPolicyUpdaterFact) Policy() MemoPolicy {
+	return fact.policy
+}
+
+type MemoPolicyUpdater struct {
+	BaseOperation
+}
+
+func NewMemoPolicyUpdater(
+	fact MemoPolicyUpdaterFact,
+	fs []base.FactSign,
+	memo string,
+) (MemoPolicyUpdater, error) {
+	bo, err := NewBaseOperationFromFact(MemoPolicyUpdaterHint, fact, fs, memo)
+	if err != nil {
+		return MemoPolicyUpdater{}, err
+	}
+
+	return MemoPolicyUpdater{BaseOperation: bo}, nil
+}
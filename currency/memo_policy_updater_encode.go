@@ -0,0 +1,18 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *MemoPolicyUpdaterFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bpo []byte,
+) error {
+	fact.h = h
+	fact.token = token
+
+	return encoder.Decode(bpo, enc, &fact.policy)
+}
@@ -0,0 +1,50 @@
+package currency
+
+import (
+	"encoding/json"
+
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type MemoPolicyUpdaterFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	PO MemoPolicy     `json:"policy"`
+}
+
+func (fact MemoPolicyUpdaterFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(MemoPolicyUpdaterFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		PO:         fact.policy,
+	})
+}
+
+type MemoPolicyUpdaterFactJSONUnpacker struct {
+	H  valuehash.Bytes `json:"hash"`
+	TK []byte          `json:"token"`
+	PO json.RawMessage `json:"policy"`
+}
+
+func (fact *MemoPolicyUpdaterFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact MemoPolicyUpdaterFactJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.PO)
+}
+
+func (op *MemoPolicyUpdater) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
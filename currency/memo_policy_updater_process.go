@@ -0,0 +1,95 @@
+package currency
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var memoPolicyUpdaterProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(MemoPolicyUpdaterProcessor)
+	},
+}
+
+func (MemoPolicyUpdater) Process(
+	func(string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	// NOTE Process is nil func
+	return nil
+}
+
+type MemoPolicyUpdaterProcessor struct {
+	MemoPolicyUpdater
+	pubs      []key.Publickey
+	threshold base.Threshold
+	st        state.State
+}
+
+func NewMemoPolicyUpdaterProcessor(pubs []key.Publickey, threshold base.Threshold) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(MemoPolicyUpdater)
+		if !ok {
+			return nil, errors.Errorf("not MemoPolicyUpdater, %T", op)
+		}
+
+		opp := memoPolicyUpdaterProcessorPool.Get().(*MemoPolicyUpdaterProcessor)
+
+		opp.MemoPolicyUpdater = i
+		opp.pubs = pubs
+		opp.threshold = threshold
+
+		return opp, nil
+	}
+}
+
+func (opp *MemoPolicyUpdaterProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	if len(opp.pubs) < 1 {
+		return nil, operation.NewBaseReasonError("empty publickeys for operation signs")
+	} else if err := checkFactSignsByPubs(opp.pubs, opp.threshold, opp.Signs()); err != nil {
+		return nil, err
+	}
+
+	st, _, err := getState(StateKeyMemoPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	opp.st = st
+
+	return opp, nil
+}
+
+func (opp *MemoPolicyUpdaterProcessor) Process(
+	_ func(string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(MemoPolicyUpdaterFact)
+
+	i, err := SetStateMemoPolicyValue(opp.st, fact.Policy())
+	if err != nil {
+		return err
+	}
+	return setState(fact.Hash(), i)
+}
+
+func (opp *MemoPolicyUpdaterProcessor) Close() error {
+	opp.MemoPolicyUpdater = MemoPolicyUpdater{}
+	opp.pubs = nil
+	opp.threshold = base.Threshold{}
+	opp.st = nil
+
+	memoPolicyUpdaterProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,242 @@
+package currency
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testMemoPolicyUpdaterOperations struct {
+	baseTestOperationProcessor
+	cid CurrencyID
+}
+
+func (t *testMemoPolicyUpdaterOperations) SetupSuite() {
+	t.cid = CurrencyID("SHOWME")
+}
+
+func (t *testMemoPolicyUpdaterOperations) newOperation(keys []key.Privatekey, po MemoPolicy) MemoPolicyUpdater {
+	fact := NewMemoPolicyUpdaterFact(util.UUID().Bytes(), po)
+
+	var fs []base.FactSign
+	for _, pk := range keys {
+		sig, err := base.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, base.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewMemoPolicyUpdater(fact, fs, "")
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testMemoPolicyUpdaterOperations) newTransfers(
+	sender base.Address,
+	keys []key.Privatekey,
+	receiver base.Address,
+	memo string,
+) Transfers {
+	items := []TransfersItem{NewTransfersItemSingleAmount(receiver, NewAmount(NewBig(1), t.cid))}
+	fact := NewTransfersFact(util.UUID().Bytes(), sender, items)
+
+	var fs []base.FactSign
+	for _, pk := range keys {
+		sig, err := base.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		fs = append(fs, base.NewBaseFactSign(pk.Publickey(), sig))
+	}
+
+	op, err := NewTransfers(fact, fs, memo)
+	t.NoError(err)
+
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testMemoPolicyUpdaterOperations) processor(cp *CurrencyPool, n int) ([]key.Privatekey, *OperationProcessor) {
+	privs := make([]key.Privatekey, n)
+	for i := 0; i < n; i++ {
+		privs[i] = key.NewBasePrivatekey()
+	}
+
+	pubs := make([]key.Publickey, len(privs))
+	for i := range privs {
+		pubs[i] = privs[i].Publickey()
+	}
+	threshold, err := base.NewThreshold(uint(len(privs)), 100)
+	t.NoError(err)
+
+	opr := NewOperationProcessor(cp)
+	_, err = opr.SetProcessor(MemoPolicyUpdaterHinter, NewMemoPolicyUpdaterProcessor(pubs, threshold))
+	t.NoError(err)
+
+	_, err = opr.SetProcessor(TransfersHinter, NewTransfersProcessor(cp))
+	t.NoError(err)
+
+	return privs, opr
+}
+
+func (t *testMemoPolicyUpdaterOperations) newStateMemoPolicy(po MemoPolicy) state.State {
+	st, err := state.NewStateV0(StateKeyMemoPolicy, nil, base.Height(33))
+	t.NoError(err)
+
+	nst, err := SetStateMemoPolicyValue(st, po)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *testMemoPolicyUpdaterOperations) TestNew() {
+	pool, _ := t.statepool()
+
+	privs, copr := t.processor(nil, 3)
+	opr := copr.New(pool)
+
+	po := NewMemoPolicy(33, MemoCharsetNumeric, []base.Address{NewTestAddress()})
+	t.NoError(opr.Process(t.newOperation(privs, po)))
+
+	var upo MemoPolicy
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyMemoPolicy {
+			i, err := StateMemoPolicyValue(st.GetState())
+			t.NoError(err)
+
+			upo = i
+		}
+	}
+
+	t.Equal(po.Bytes(), upo.Bytes())
+}
+
+func (t *testMemoPolicyUpdaterOperations) TestNotEnoughSigns() {
+	pool, _ := t.statepool()
+
+	privs, copr := t.processor(nil, 3)
+	opr := copr.New(pool)
+
+	err := opr.Process(t.newOperation(privs[:2], DefaultMemoPolicy))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "not enough suffrage signs")
+}
+
+func (t *testMemoPolicyUpdaterOperations) TestDuplicated() {
+	pool, _ := t.statepool()
+
+	privs, copr := t.processor(nil, 3)
+	opr := copr.New(pool)
+
+	t.NoError(opr.Process(t.newOperation(privs, DefaultMemoPolicy)))
+
+	err := opr.Process(t.newOperation(privs, NewMemoPolicy(10, MemoCharsetASCII, nil)))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "duplicated memo policy")
+}
+
+func (t *testMemoPolicyUpdaterOperations) TestDefaultPolicy() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	_, copr := t.processor(cp, 1)
+	opr := copr.New(pool)
+
+	// NOTE without memo policy in state, DefaultMemoPolicy is used
+	memo := strings.Repeat("a", int(DefaultMemoPolicy.MaxSize())+1)
+	err := opr.Process(t.newTransfers(sa.Address, sa.Privs(), ra.Address, memo))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "memo over max size")
+}
+
+func (t *testMemoPolicyUpdaterOperations) TestActivePolicy() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	po := NewMemoPolicy(300, MemoCharsetNumeric, []base.Address{ra.Address})
+	pool, _ := t.statepool(st0, st1, []state.State{t.newStateMemoPolicy(po)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	_, copr := t.processor(cp, 1)
+
+	{ // NOTE over DefaultMemoPolicy, but under active policy
+		opr := copr.New(pool)
+
+		memo := strings.Repeat("1", int(DefaultMemoPolicy.MaxSize())+1)
+		t.NoError(opr.Process(t.newTransfers(sa.Address, sa.Privs(), ra.Address, memo)))
+	}
+
+	{ // NOTE not numeric
+		opr := copr.New(pool)
+
+		err := opr.Process(t.newTransfers(sa.Address, sa.Privs(), ra.Address, "findme"))
+
+		var oper operation.ReasonError
+		t.True(errors.As(err, &oper))
+		t.Contains(err.Error(), "not numeric")
+	}
+
+	{ // NOTE memo is required for receiver
+		opr := copr.New(pool)
+
+		err := opr.Process(t.newTransfers(sa.Address, sa.Privs(), ra.Address, ""))
+
+		var oper operation.ReasonError
+		t.True(errors.As(err, &oper))
+		t.Contains(err.Error(), "memo is required")
+	}
+}
+
+func (t *testMemoPolicyUpdaterOperations) TestRequiredReceiverByAlias() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	al := NewAlias("showme", ra.Address)
+	st, err := state.NewStateV0(StateKeyAlias(al.Name()), nil, base.Height(33))
+	t.NoError(err)
+	ast, err := SetStateAliasValue(st, al)
+	t.NoError(err)
+
+	po := NewMemoPolicy(30, MemoCharsetUTF8, []base.Address{ra.Address})
+	pool, _ := t.statepool(st0, st1, []state.State{ast, t.newStateMemoPolicy(po)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	_, copr := t.processor(cp, 1)
+	opr := copr.New(pool)
+
+	err = opr.Process(t.newTransfers(sa.Address, sa.Privs(), NewAliasAddress(al.Name()), ""))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "memo is required")
+}
+
+func TestMemoPolicyUpdaterOperations(t *testing.T) {
+	suite.Run(t, new(testMemoPolicyUpdaterOperations))
+}
@@ -53,11 +53,75 @@ func (t *baseTestEncode) SetupSuite() {
 	t.encs.TestAddHinter(NilFeeerHinter)
 	t.encs.TestAddHinter(FixedFeeerHinter)
 	t.encs.TestAddHinter(RatioFeeerHinter)
+	t.encs.TestAddHinter(TieredFeeerHinter)
 	t.encs.TestAddHinter(CurrencyPolicyUpdaterFactHinter)
 	t.encs.TestAddHinter(CurrencyPolicyUpdaterHinter)
 	t.encs.TestAddHinter(CurrencyPolicyHinter)
+	t.encs.TestAddHinter(MemoPolicyUpdaterFactHinter)
+	t.encs.TestAddHinter(MemoPolicyUpdaterHinter)
+	t.encs.TestAddHinter(MemoPolicyHinter)
+	t.encs.TestAddHinter(AccountPolicyUpdaterFactHinter)
+	t.encs.TestAddHinter(AccountPolicyUpdaterHinter)
+	t.encs.TestAddHinter(AccountPolicyHinter)
 	t.encs.TestAddHinter(SuffrageInflationFactHinter)
 	t.encs.TestAddHinter(SuffrageInflationHinter)
+	t.encs.TestAddHinter(AccountFreezeFactHinter)
+	t.encs.TestAddHinter(AccountFreezeHinter)
+	t.encs.TestAddHinter(AccountUnfreezeFactHinter)
+	t.encs.TestAddHinter(AccountUnfreezeHinter)
+	t.encs.TestAddHinter(LockedAmountHinter)
+	t.encs.TestAddHinter(TransfersItemLockedAmountsHinter)
+	t.encs.TestAddHinter(BalanceUnlockFactHinter)
+	t.encs.TestAddHinter(BalanceUnlockHinter)
+	t.encs.TestAddHinter(EscrowHinter)
+	t.encs.TestAddHinter(EscrowCreateFactHinter)
+	t.encs.TestAddHinter(EscrowCreateHinter)
+	t.encs.TestAddHinter(EscrowReleaseFactHinter)
+	t.encs.TestAddHinter(EscrowReleaseHinter)
+	t.encs.TestAddHinter(EscrowRefundFactHinter)
+	t.encs.TestAddHinter(EscrowRefundHinter)
+	t.encs.TestAddHinter(HTLCHinter)
+	t.encs.TestAddHinter(HTLCLockFactHinter)
+	t.encs.TestAddHinter(HTLCLockHinter)
+	t.encs.TestAddHinter(HTLCClaimFactHinter)
+	t.encs.TestAddHinter(HTLCClaimHinter)
+	t.encs.TestAddHinter(HTLCRefundFactHinter)
+	t.encs.TestAddHinter(HTLCRefundHinter)
+	t.encs.TestAddHinter(AllowanceHinter)
+	t.encs.TestAddHinter(ApproveFactHinter)
+	t.encs.TestAddHinter(ApproveHinter)
+	t.encs.TestAddHinter(TransferFromFactHinter)
+	t.encs.TestAddHinter(TransferFromHinter)
+	t.encs.TestAddHinter(AccountMergeFactHinter)
+	t.encs.TestAddHinter(AccountMergeHinter)
+	t.encs.TestAddHinter(AccountRecoveryHinter)
+	t.encs.TestAddHinter(RecoveryUpdaterFactHinter)
+	t.encs.TestAddHinter(RecoveryUpdaterHinter)
+	t.encs.TestAddHinter(RecoveryInitiateFactHinter)
+	t.encs.TestAddHinter(RecoveryInitiateHinter)
+	t.encs.TestAddHinter(RecoveryCancelFactHinter)
+	t.encs.TestAddHinter(RecoveryCancelHinter)
+	t.encs.TestAddHinter(RecoveryFinalizeFactHinter)
+	t.encs.TestAddHinter(RecoveryFinalizeHinter)
+	t.encs.TestAddHinter(AliasAddressHinter)
+	t.encs.TestAddHinter(AliasHinter)
+	t.encs.TestAddHinter(RegisterAliasFactHinter)
+	t.encs.TestAddHinter(RegisterAliasHinter)
+	t.encs.TestAddHinter(AccountMetadataHinter)
+	t.encs.TestAddHinter(AccountMetadataUpdaterFactHinter)
+	t.encs.TestAddHinter(AccountMetadataUpdaterHinter)
+	t.encs.TestAddHinter(PaymentScheduleHinter)
+	t.encs.TestAddHinter(ScheduleQueueHinter)
+	t.encs.TestAddHinter(AccountIndexHinter)
+	t.encs.TestAddHinter(SchedulePaymentFactHinter)
+	t.encs.TestAddHinter(SchedulePaymentHinter)
+	t.encs.TestAddHinter(CancelScheduleFactHinter)
+	t.encs.TestAddHinter(CancelScheduleHinter)
+	t.encs.TestAddHinter(ScheduleRunHinter)
+	t.encs.TestAddHinter(ScheduleRunOperationFactHinter)
+	t.encs.TestAddHinter(ScheduleRunOperationHinter)
+	t.encs.TestAddHinter(AtomicSwapFactHinter)
+	t.encs.TestAddHinter(AtomicSwapHinter)
 }
 
 func (t *baseTestEncode) TestEncode() {
@@ -26,25 +26,116 @@ var operationProcessorPool = sync.Pool{
 
 type GetNewProcessor func(state.Processor) (state.Processor, error)
 
+// heightProcessor is the processor, which needs the height of the block in
+// processing.
+type heightProcessor interface {
+	setHeight(base.Height)
+}
+
 type DuplicationType string
 
 const (
-	DuplicationTypeSender   DuplicationType = "sender"
-	DuplicationTypeCurrency DuplicationType = "currency"
+	DuplicationTypeSender        DuplicationType = "sender"
+	DuplicationTypeCurrency      DuplicationType = "currency"
+	DuplicationTypeEscrow        DuplicationType = "escrow"
+	DuplicationTypeMemo          DuplicationType = "memo"
+	DuplicationTypeAccount       DuplicationType = "account"
+	DuplicationTypeReceiver      DuplicationType = "receiver"
+	DuplicationTypeAccountPolicy DuplicationType = "account-policy"
+	DuplicationTypeSchedule      DuplicationType = "schedule"
+	DuplicationTypeHTLC          DuplicationType = "htlc"
+	DuplicationTypeAllowance     DuplicationType = "allowance"
 )
 
+// blockSession is shared by the OperationProcessors of same block. mitum
+// creates OperationProcessor by the hint of operation, so the duplications
+// across the operation types are checked by blockSession. The fee collected by
+// each OperationProcessor is also gathered into blockSession.
+type blockSession struct {
+	sync.Mutex
+	duplicated           map[string]DuplicationType
+	duplicatedNewAddress map[string]struct{}
+	fee                  map[CurrencyID]Big
+	oprs                 int
+}
+
+func (bs *blockSession) addFee(fee map[CurrencyID]Big) {
+	bs.Lock()
+	defer bs.Unlock()
+
+	for cid := range fee {
+		f := ZeroBig
+		if i, found := bs.fee[cid]; found {
+			f = i
+		}
+
+		bs.fee[cid] = f.Add(fee[cid])
+	}
+}
+
+type blockSessions struct {
+	sync.Mutex
+	m map[*storage.Statepool]*blockSession
+}
+
+func newBlockSessions() *blockSessions {
+	return &blockSessions{m: map[*storage.Statepool]*blockSession{}}
+}
+
+// open returns the blockSession of the block of pool; the first
+// OperationProcessor of the block creates it.
+func (bss *blockSessions) open(pool *storage.Statepool) *blockSession {
+	bss.Lock()
+	defer bss.Unlock()
+
+	bs, found := bss.m[pool]
+	if !found {
+		bs = &blockSession{
+			duplicated:           map[string]DuplicationType{},
+			duplicatedNewAddress: map[string]struct{}{},
+			fee:                  map[CurrencyID]Big{},
+		}
+
+		bss.m[pool] = bs
+	}
+
+	bs.oprs++
+
+	return bs
+}
+
+// close returns true when the last OperationProcessor of the block is closed.
+func (bss *blockSessions) close(pool *storage.Statepool) bool {
+	bss.Lock()
+	defer bss.Unlock()
+
+	bs, found := bss.m[pool]
+	if !found {
+		return false
+	}
+
+	bs.oprs--
+	if bs.oprs > 0 {
+		return false
+	}
+
+	delete(bss.m, pool)
+
+	return true
+}
+
 type OperationProcessor struct {
 	id string
 	sync.RWMutex
 	*logging.Logging
-	processorHintSet     *hint.Hintmap
-	cp                   *CurrencyPool
-	pool                 *storage.Statepool
-	fee                  map[CurrencyID]Big
-	amountPool           map[string]AmountState
-	duplicated           map[string]DuplicationType
-	duplicatedNewAddress map[string]struct{}
-	processorClosers     *sync.Map
+	processorHintSet *hint.Hintmap
+	cp               *CurrencyPool
+	pool             *storage.Statepool
+	fee              map[CurrencyID]Big
+	amountPool       map[string]AmountState
+	sessions         *blockSessions
+	session          *blockSession
+	processorClosers *sync.Map
 }
 
 func NewOperationProcessor(cp *CurrencyPool) *OperationProcessor {
@@ -54,6 +145,7 @@ func NewOperationProcessor(cp *CurrencyPool) *OperationProcessor {
 		}),
 		processorHintSet: hint.NewHintmap(),
 		cp:               cp,
+		sessions:         newBlockSessions(),
 	}
 }
 
@@ -68,19 +160,16 @@ func (opr *OperationProcessor) New(pool *storage.Statepool) prprocessor.Operatio
 		_ = nopr.SetLogging(opr.Logging)
 	}
 
-	if nopr.processorHintSet == nil {
-		nopr.processorHintSet = opr.processorHintSet
-	}
-
-	if nopr.cp == nil {
-		nopr.cp = opr.cp
-	}
+	// NOTE OperationProcessor from pool may be created by the other
+	// OperationProcessor
+	nopr.processorHintSet = opr.processorHintSet
+	nopr.cp = opr.cp
 
 	nopr.pool = pool
 	nopr.fee = map[CurrencyID]Big{}
 	nopr.amountPool = map[string]AmountState{}
-	nopr.duplicated = map[string]DuplicationType{}
-	nopr.duplicatedNewAddress = map[string]struct{}{}
+	nopr.sessions = opr.sessions
+	nopr.session = opr.sessions.open(pool)
 	nopr.processorClosers = &sync.Map{}
 
 	nopr.Log().Debug().Str("processor_id", nopr.id).Msg("new operation processors created")
@@ -132,12 +221,20 @@ func (opr *OperationProcessor) PreProcess(op state.Processor) (state.Processor,
 		sp = i
 	}
 
+	if i, ok := sp.(heightProcessor); ok {
+		i.setHeight(opr.pool.Height())
+	}
+
+	if err := checkMemoByPolicy(op, opr.pool.Get); err != nil {
+		return nil, err
+	}
+
 	pop, err := sp.(state.PreProcessor).PreProcess(opr.pool.Get, opr.setState)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := opr.checkDuplication(op); err != nil {
+	if err := opr.checkDuplication(op, pop); err != nil {
 		return nil, operation.NewBaseReasonError("duplication found: %w", err)
 	}
 
@@ -151,9 +248,60 @@ func (opr *OperationProcessor) Process(op state.Processor) error {
 		*KeyUpdaterProcessor,
 		*CurrencyRegisterProcessor,
 		*CurrencyPolicyUpdaterProcessor,
-		*SuffrageInflationProcessor:
+		*SuffrageInflationProcessor,
+		*AccountFreezeProcessor,
+		*AccountUnfreezeProcessor,
+		*BalanceUnlockProcessor,
+		*EscrowCreateProcessor,
+		*EscrowReleaseProcessor,
+		*EscrowRefundProcessor,
+		*HTLCLockProcessor,
+		*HTLCClaimProcessor,
+		*HTLCRefundProcessor,
+		*ApproveProcessor,
+		*TransferFromProcessor,
+		*AccountMergeProcessor,
+		*RecoveryUpdaterProcessor,
+		*RecoveryInitiateProcessor,
+		*RecoveryCancelProcessor,
+		*RecoveryFinalizeProcessor,
+		*RegisterAliasProcessor,
+		*AccountMetadataUpdaterProcessor,
+		*MemoPolicyUpdaterProcessor,
+		*AccountPolicyUpdaterProcessor,
+		*SchedulePaymentProcessor,
+		*CancelScheduleProcessor,
+		*AtomicSwapProcessor:
 		return opr.process(op)
-	case Transfers, CreateAccounts, KeyUpdater, CurrencyRegister, CurrencyPolicyUpdater, SuffrageInflation:
+	case Transfers,
+		CreateAccounts,
+		KeyUpdater,
+		CurrencyRegister,
+		CurrencyPolicyUpdater,
+		SuffrageInflation,
+		AccountFreeze,
+		AccountUnfreeze,
+		BalanceUnlock,
+		EscrowCreate,
+		EscrowRelease,
+		EscrowRefund,
+		HTLCLock,
+		HTLCClaim,
+		HTLCRefund,
+		Approve,
+		TransferFrom,
+		AccountMerge,
+		RecoveryUpdater,
+		RecoveryInitiate,
+		RecoveryCancel,
+		RecoveryFinalize,
+		RegisterAlias,
+		AccountMetadataUpdater,
+		MemoPolicyUpdater,
+		AccountPolicyUpdater,
+		SchedulePayment,
+		CancelSchedule,
+		AtomicSwap:
 		pr, err := opr.PreProcess(op)
 		if err != nil {
 			return err
@@ -174,6 +322,44 @@ func (opr *OperationProcessor) process(op state.Processor) error {
 		sp = t
 	case *KeyUpdaterProcessor:
 		sp = t
+	case *BalanceUnlockProcessor:
+		sp = t
+	case *EscrowCreateProcessor:
+		sp = t
+	case *EscrowReleaseProcessor:
+		sp = t
+	case *EscrowRefundProcessor:
+		sp = t
+	case *HTLCLockProcessor:
+		sp = t
+	case *HTLCClaimProcessor:
+		sp = t
+	case *HTLCRefundProcessor:
+		sp = t
+	case *ApproveProcessor:
+		sp = t
+	case *TransferFromProcessor:
+		sp = t
+	case *AccountMergeProcessor:
+		sp = t
+	case *RecoveryUpdaterProcessor:
+		sp = t
+	case *RecoveryInitiateProcessor:
+		sp = t
+	case *RecoveryCancelProcessor:
+		sp = t
+	case *RecoveryFinalizeProcessor:
+		sp = t
+	case *RegisterAliasProcessor:
+		sp = t
+	case *AccountMetadataUpdaterProcessor:
+		sp = t
+	case *SchedulePaymentProcessor:
+		sp = t
+	case *CancelScheduleProcessor:
+		sp = t
+	case *AtomicSwapProcessor:
+		sp = t
 	default:
 		return op.Process(opr.pool.Get, opr.pool.Set)
 	}
@@ -181,18 +367,28 @@ func (opr *OperationProcessor) process(op state.Processor) error {
 	return sp.Process(opr.pool.Get, opr.setState)
 }
 
-func (opr *OperationProcessor) checkDuplication(op state.Processor) error {
-	opr.Lock()
-	defer opr.Unlock()
+// checkDuplication checks op with the operations processed before in the same
+// block; pop is the preprocessed op, which has the states resolved from op.
+func (opr *OperationProcessor) checkDuplication(op, pop state.Processor) error {
+	opr.session.Lock()
+	defer opr.session.Unlock()
 
 	var did string
 	var didtype DuplicationType
+	var others []string
+	var accounts []base.Address  // NOTE the accounts, whose account state is updated
+	var receivers []base.Address // NOTE the accounts, which receive the amounts
+	var allowance string         // NOTE the allowance state, which is updated
 	var newAddresses []base.Address
 
 	switch t := op.(type) {
 	case Transfers:
 		did = t.Fact().(TransfersFact).Sender().String()
 		didtype = DuplicationTypeSender
+
+		if i, ok := pop.(*TransfersProcessor); ok {
+			receivers = i.receivers()
+		}
 	case CreateAccounts:
 		fact := t.Fact().(CreateAccountsFact)
 		as, err := fact.Targets()
@@ -204,31 +400,159 @@ func (opr *OperationProcessor) checkDuplication(op state.Processor) error {
 		did = fact.Sender().String()
 		didtype = DuplicationTypeSender
 	case KeyUpdater:
-		did = t.Fact().(KeyUpdaterFact).Target().String()
+		target := t.Fact().(KeyUpdaterFact).Target()
+		did = target.String()
+		didtype = DuplicationTypeSender
+		accounts = []base.Address{target}
+	case BalanceUnlock:
+		did = t.Fact().(BalanceUnlockFact).Sender().String()
+		didtype = DuplicationTypeSender
+	case EscrowCreate:
+		did = t.Fact().(EscrowCreateFact).Sender().String()
+		didtype = DuplicationTypeSender
+	case EscrowRelease:
+		did = StateKeyEscrow(t.Fact().(EscrowReleaseFact).Escrow())
+		didtype = DuplicationTypeEscrow
+	case EscrowRefund:
+		did = StateKeyEscrow(t.Fact().(EscrowRefundFact).Escrow())
+		didtype = DuplicationTypeEscrow
+	case HTLCLock:
+		did = t.Fact().(HTLCLockFact).Sender().String()
+		didtype = DuplicationTypeSender
+	case HTLCClaim:
+		did = StateKeyHTLC(t.Fact().(HTLCClaimFact).HTLC())
+		didtype = DuplicationTypeHTLC
+	case HTLCRefund:
+		did = StateKeyHTLC(t.Fact().(HTLCRefundFact).HTLC())
+		didtype = DuplicationTypeHTLC
+	case Approve:
+		fact := t.Fact().(ApproveFact)
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		allowance = StateKeyAllowance(fact.Sender(), fact.Spender(), fact.Amount().Currency())
+	case TransferFrom: // NOTE the balance of owner is spent
+		fact := t.Fact().(TransferFromFact)
+		did = fact.Owner().String()
+		didtype = DuplicationTypeSender
+		allowance = StateKeyAllowance(fact.Owner(), fact.Sender(), fact.Amount().Currency())
+	case AccountMerge:
+		sender := t.Fact().(AccountMergeFact).Sender()
+		did = sender.String()
 		didtype = DuplicationTypeSender
+		accounts = []base.Address{sender}
+	case RecoveryUpdater:
+		target := t.Fact().(RecoveryUpdaterFact).Target()
+		did = target.String()
+		didtype = DuplicationTypeSender
+		accounts = []base.Address{target}
+	case RecoveryInitiate:
+		target := t.Fact().(RecoveryInitiateFact).Target()
+		did = target.String()
+		didtype = DuplicationTypeSender
+		accounts = []base.Address{target}
+	case RecoveryCancel:
+		target := t.Fact().(RecoveryCancelFact).Target()
+		did = target.String()
+		didtype = DuplicationTypeSender
+		accounts = []base.Address{target}
+	case RecoveryFinalize:
+		target := t.Fact().(RecoveryFinalizeFact).Target()
+		did = target.String()
+		didtype = DuplicationTypeSender
+		accounts = []base.Address{target}
+	case RegisterAlias:
+		fact := t.Fact().(RegisterAliasFact)
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		newAddresses = []base.Address{NewAliasAddress(fact.Alias())}
+	case AccountMetadataUpdater:
+		did = t.Fact().(AccountMetadataUpdaterFact).Target().String()
+		didtype = DuplicationTypeSender
+	case SchedulePayment:
+		did = t.Fact().(SchedulePaymentFact).Sender().String()
+		didtype = DuplicationTypeSender
+	case CancelSchedule:
+		did = StateKeySchedule(t.Fact().(CancelScheduleFact).Schedule())
+		didtype = DuplicationTypeSchedule
+	case AtomicSwap: // NOTE the balances of both sender and counterparty are spent
+		fact := t.Fact().(AtomicSwapFact)
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		others = []string{fact.Counterparty().String()}
 	case CurrencyRegister:
 		did = t.Fact().(CurrencyRegisterFact).Currency().Currency().String()
 		didtype = DuplicationTypeCurrency
 	case CurrencyPolicyUpdater:
 		did = t.Fact().(CurrencyPolicyUpdaterFact).Currency().String()
 		didtype = DuplicationTypeCurrency
+	case SuffrageInflation: // NOTE the aggregates of currencies are updated
+		items := t.Fact().(SuffrageInflationFact).Items()
+		for i := range items {
+			others = append(others, items[i].Amount().Currency().String())
+		}
+		didtype = DuplicationTypeCurrency
+	case MemoPolicyUpdater:
+		did = StateKeyMemoPolicy
+		didtype = DuplicationTypeMemo
+	case AccountPolicyUpdater:
+		did = StateKeyAccountPolicy
+		didtype = DuplicationTypeAccountPolicy
+	case AccountFreeze:
+		accounts = t.Fact().(AccountFreezeFact).Targets()
+	case AccountUnfreeze:
+		accounts = t.Fact().(AccountUnfreezeFact).Targets()
 	default:
 		return nil
 	}
 
+	for i := range others {
+		if _, found := opr.session.duplicated[others[i]]; found {
+			return duplicationError(didtype, others[i])
+		}
+	}
+
+	for i := range accounts {
+		if _, found := opr.session.duplicated[StateKeyAccount(accounts[i])]; found {
+			return errors.Errorf("account, %q already updated in proposal", accounts[i])
+		}
+	}
+
+	// NOTE the account, which receives the amounts, can not be updated in the
+	// same block, so the merged account does not receive the amounts.
+	for i := range receivers {
+		if t, found := opr.session.duplicated[StateKeyAccount(receivers[i])]; found && t != DuplicationTypeReceiver {
+			return errors.Errorf("account, %q already updated in proposal", receivers[i])
+		}
+	}
+
+	if len(allowance) > 0 {
+		if _, found := opr.session.duplicated[allowance]; found {
+			return duplicationError(DuplicationTypeAllowance, allowance)
+		}
+	}
+
 	if len(did) > 0 {
-		if _, found := opr.duplicated[did]; found {
-			switch didtype {
-			case DuplicationTypeSender:
-				return errors.Errorf("violates only one sender in proposal")
-			case DuplicationTypeCurrency:
-				return errors.Errorf("duplicated currency id, %q found in proposal", did)
-			default:
-				return errors.Errorf("violates duplication in proposal")
-			}
+		if _, found := opr.session.duplicated[did]; found {
+			return duplicationError(didtype, did)
 		}
 
-		opr.duplicated[did] = didtype
+		opr.session.duplicated[did] = didtype
+	}
+
+	for i := range others {
+		opr.session.duplicated[others[i]] = didtype
+	}
+
+	for i := range accounts {
+		opr.session.duplicated[StateKeyAccount(accounts[i])] = DuplicationTypeAccount
+	}
+
+	for i := range receivers {
+		opr.session.duplicated[StateKeyAccount(receivers[i])] = DuplicationTypeReceiver
+	}
+
+	if len(allowance) > 0 {
+		opr.session.duplicated[allowance] = DuplicationTypeAllowance
 	}
 
 	if len(newAddresses) > 0 {
@@ -240,15 +564,38 @@ func (opr *OperationProcessor) checkDuplication(op state.Processor) error {
 	return nil
 }
 
+func duplicationError(didtype DuplicationType, did string) error {
+	switch didtype {
+	case DuplicationTypeSender:
+		return errors.Errorf("violates only one sender in proposal")
+	case DuplicationTypeCurrency:
+		return errors.Errorf("duplicated currency id, %q found in proposal", did)
+	case DuplicationTypeEscrow:
+		return errors.Errorf("duplicated escrow, %q found in proposal", did)
+	case DuplicationTypeMemo:
+		return errors.Errorf("duplicated memo policy found in proposal")
+	case DuplicationTypeAccountPolicy:
+		return errors.Errorf("duplicated account policy found in proposal")
+	case DuplicationTypeSchedule:
+		return errors.Errorf("duplicated payment schedule, %q found in proposal", did)
+	case DuplicationTypeHTLC:
+		return errors.Errorf("duplicated htlc, %q found in proposal", did)
+	case DuplicationTypeAllowance:
+		return errors.Errorf("duplicated allowance, %q found in proposal", did)
+	default:
+		return errors.Errorf("violates duplication in proposal")
+	}
+}
+
 func (opr *OperationProcessor) checkNewAddressDuplication(as []base.Address) error {
 	for i := range as {
-		if _, found := opr.duplicatedNewAddress[as[i].String()]; found {
+		if _, found := opr.session.duplicatedNewAddress[as[i].String()]; found {
 			return errors.Errorf("new address already processed")
 		}
 	}
 
 	for i := range as {
-		opr.duplicatedNewAddress[as[i].String()] = struct{}{}
+		opr.session.duplicatedNewAddress[as[i].String()] = struct{}{}
 	}
 
 	return nil
@@ -260,8 +607,25 @@ func (opr *OperationProcessor) Close() error {
 
 	defer opr.close()
 
-	if opr.cp != nil && len(opr.fee) > 0 {
-		op := NewFeeOperation(NewFeeOperationFact(opr.pool.Height(), opr.fee))
+	opr.session.addFee(opr.fee)
+
+	if !opr.sessions.close(opr.pool) {
+		return nil
+	}
+
+	return opr.closeBlock()
+}
+
+// closeBlock is called once for the block by the last closed
+// OperationProcessor of the block; the due schedules are run and the fee of the
+// whole block is processed by one FeeOperation.
+func (opr *OperationProcessor) closeBlock() error {
+	if err := runSchedules(opr.pool); err != nil {
+		return err
+	}
+
+	if fee := opr.session.fee; opr.cp != nil && len(fee) > 0 {
+		op := NewFeeOperation(NewFeeOperationFact(opr.pool.Height(), fee))
 
 		pr := NewFeeOperationProcessor(opr.cp, op)
 		if err := pr.Process(opr.pool.Get, opr.pool.Set); err != nil {
@@ -279,6 +643,8 @@ func (opr *OperationProcessor) Cancel() error {
 
 	defer opr.close()
 
+	_ = opr.sessions.close(opr.pool)
+
 	return nil
 }
 
@@ -296,7 +662,30 @@ func (opr *OperationProcessor) getNewProcessor(op state.Processor) (state.Proces
 		KeyUpdater,
 		CurrencyRegister,
 		CurrencyPolicyUpdater,
-		SuffrageInflation:
+		SuffrageInflation,
+		AccountFreeze,
+		AccountUnfreeze,
+		BalanceUnlock,
+		EscrowCreate,
+		EscrowRelease,
+		EscrowRefund,
+		HTLCLock,
+		HTLCClaim,
+		HTLCRefund,
+		Approve,
+		TransferFrom,
+		AccountMerge,
+		RecoveryUpdater,
+		RecoveryInitiate,
+		RecoveryCancel,
+		RecoveryFinalize,
+		RegisterAlias,
+		AccountMetadataUpdater,
+		MemoPolicyUpdater,
+		AccountPolicyUpdater,
+		SchedulePayment,
+		CancelSchedule,
+		AtomicSwap:
 		return nil, false, errors.Errorf("%T needs SetProcessor", t)
 	default:
 		return op, false, nil
@@ -355,8 +744,7 @@ func (opr *OperationProcessor) close() {
 	opr.pool = nil
 	opr.fee = nil
 	opr.amountPool = nil
-	opr.duplicated = nil
-	opr.duplicatedNewAddress = nil
+	opr.session = nil
 	opr.processorClosers = nil
 
 	operationProcessorPool.Put(opr)
@@ -0,0 +1,134 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	AccountRecoveryType   = hint.Type("mitum-currency-account-recovery")
+	AccountRecoveryHint   = hint.NewHint(AccountRecoveryType, "v0.0.1")
+	AccountRecoveryHinter = AccountRecovery{BaseHinter: hint.NewBaseHinter(AccountRecoveryHint)}
+)
+
+var MaxRecoveryDelay uint64 = 1000000
+
+// AccountRecovery is the recovery setting of account. The recovery keys can
+// initiate the recovery with new keys by RecoveryInitiate; after the delay,
+// RecoveryFinalize replaces the keys of account with the new keys. Before the
+// delay passes, the current keys of account can cancel it by RecoveryCancel.
+type AccountRecovery struct {
+	hint.BaseHinter
+	keys    AccountKeys
+	delay   uint64
+	pending AccountKeys
+	height  base.Height
+}
+
+func NewAccountRecovery(keys AccountKeys, delay uint64) AccountRecovery {
+	return AccountRecovery{
+		BaseHinter: hint.NewBaseHinter(AccountRecoveryHint),
+		keys:       keys,
+		delay:      delay,
+		height:     base.NilHeight,
+	}
+}
+
+func (ar AccountRecovery) Bytes() []byte {
+	var kb, pb []byte
+	if ar.keys != nil {
+		kb = ar.keys.Bytes()
+	}
+
+	if ar.pending != nil {
+		pb = ar.pending.Bytes()
+	}
+
+	return util.ConcatBytesSlice(kb, util.Uint64ToBytes(ar.delay), pb, ar.height.Bytes())
+}
+
+func (ar AccountRecovery) IsValid([]byte) error {
+	if err := ar.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if ar.keys == nil {
+		return isvalid.InvalidError.Errorf("empty recovery keys")
+	}
+
+	if err := ar.keys.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := isValidRecoveryDelay(ar.delay); err != nil {
+		return err
+	}
+
+	if ar.pending == nil {
+		return nil
+	}
+
+	if err := ar.pending.IsValid(nil); err != nil {
+		return err
+	}
+
+	if ar.height <= base.GenesisHeight {
+		return isvalid.InvalidError.Errorf("invalid recoverable height, %v", ar.height)
+	}
+
+	return nil
+}
+
+// Keys returns the recovery keys, which can initiate and finalize the
+// recovery.
+func (ar AccountRecovery) Keys() AccountKeys {
+	return ar.keys
+}
+
+// Delay is the number of blocks between initiating and finalizing recovery.
+func (ar AccountRecovery) Delay() uint64 {
+	return ar.delay
+}
+
+// Pending returns the new keys of the initiated recovery; if not initiated,
+// nil.
+func (ar AccountRecovery) Pending() AccountKeys {
+	return ar.pending
+}
+
+// Height is the height, when the initiated recovery can be finalized; if not
+// initiated, base.NilHeight.
+func (ar AccountRecovery) Height() base.Height {
+	return ar.height
+}
+
+func (ar AccountRecovery) IsPending() bool {
+	return ar.pending != nil
+}
+
+// Initiate starts the recovery with new keys at the given height; it can be
+// finalized after the delay.
+func (ar AccountRecovery) Initiate(keys AccountKeys, height base.Height) AccountRecovery {
+	ar.pending = keys
+	ar.height = height + base.Height(ar.delay)
+
+	return ar
+}
+
+// Clear removes the initiated recovery.
+func (ar AccountRecovery) Clear() AccountRecovery {
+	ar.pending = nil
+	ar.height = base.NilHeight
+
+	return ar
+}
+
+func isValidRecoveryDelay(delay uint64) error {
+	if delay < 1 || delay > MaxRecoveryDelay {
+		return isvalid.InvalidError.Errorf("invalid recovery delay, %d; 0 < delay <= %d", delay, MaxRecoveryDelay)
+	}
+
+	return nil
+}
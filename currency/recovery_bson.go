@@ -0,0 +1,38 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/hint"
+)
+
+func (ar AccountRecovery) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(ar.Hint()),
+		bson.M{
+			"keys":    ar.keys,
+			"delay":   ar.delay,
+			"pending": ar.pending,
+			"height":  ar.height,
+		}),
+	)
+}
+
+type AccountRecoveryBSONUnpacker struct {
+	HT hint.Hint   `bson:"_hint"`
+	KS bson.Raw    `bson:"keys"`
+	DL uint64      `bson:"delay"`
+	PD bson.Raw    `bson:"pending"`
+	HE base.Height `bson:"height"`
+}
+
+func (ar *AccountRecovery) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uar AccountRecoveryBSONUnpacker
+	if err := enc.Unmarshal(b, &uar); err != nil {
+		return err
+	}
+
+	return ar.unpack(enc, uar.HT, uar.KS, uar.DL, uar.PD, uar.HE)
+}
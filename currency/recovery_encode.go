@@ -0,0 +1,30 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/hint"
+)
+
+func (ar *AccountRecovery) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	bks []byte,
+	delay uint64,
+	bpd []byte,
+	height base.Height,
+) error {
+	if err := encoder.Decode(bks, enc, &ar.keys); err != nil {
+		return err
+	}
+
+	if err := encoder.Decode(bpd, enc, &ar.pending); err != nil {
+		return err
+	}
+
+	ar.BaseHinter = hint.NewBaseHinter(ht)
+	ar.delay = delay
+	ar.height = height
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	RecoveryInitiateFactType   = hint.Type("mitum-currency-recovery-initiate-operation-fact")
+	RecoveryInitiateFactHint   = hint.NewHint(RecoveryInitiateFactType, "v0.0.1")
+	RecoveryInitiateFactHinter = RecoveryInitiateFact{BaseHinter: hint.NewBaseHinter(RecoveryInitiateFactHint)}
+	RecoveryInitiateType       = hint.Type("mitum-currency-recovery-initiate-operation")
+	RecoveryInitiateHint       = hint.NewHint(RecoveryInitiateType, "v0.0.1")
+	RecoveryInitiateHinter     = RecoveryInitiate{BaseOperation: operationHinter(RecoveryInitiateHint)}
+)
+
+// RecoveryInitiateFact starts the recovery of target account with new keys; it
+// should be signed by the recovery keys of target. The fee is paid by target.
+type RecoveryInitiateFact struct {
+	hint.BaseHinter
+	h        valuehash.Hash
+	token    []byte
+	target   base.Address
+	keys     AccountKeys
+	currency CurrencyID
+}
+
+func NewRecoveryInitiateFact(token []byte, target base.Address, keys AccountKeys, currency CurrencyID) RecoveryInitiateFact {
+	fact := RecoveryInitiateFact{
+		BaseHinter: hint.NewBaseHinter(RecoveryInitiateFactHint),
+		token:      token,
+		target:     target,
+		keys:       keys,
+		currency:   currency,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact RecoveryInitiateFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact RecoveryInitiateFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RecoveryInitiateFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.target.Bytes(),
+		fact.keys.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RecoveryInitiateFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return isvalid.Check(nil, false,
+		fact.target,
+		fact.keys,
+		fact.currency,
+	)
+}
+
+func (fact RecoveryInitiateFact) Token() []byte {
+	return fact.token
+}
+
+func (fact RecoveryInitiateFact) Target() base.Address {
+	return fact.target
+}
+
+// Keys returns the new keys, which will replace the keys of target.
+func (fact RecoveryInitiateFact) Keys() AccountKeys {
+	return fact.keys
+}
+
+func (fact RecoveryInitiateFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact RecoveryInitiateFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.target}, nil
+}
+
+type RecoveryInitiate struct {
+	BaseOperation
+}
+
+func NewRecoveryInitiate(fact RecoveryInitiateFact, fs []base.FactSign, memo string) (RecoveryInitiate, error) {
+	bo, err := NewBaseOperationFromFact(RecoveryInitiateHint, fact, fs, memo)
+	if err != nil {
+		return RecoveryInitiate{}, err
+	}
+
+	return RecoveryInitiate{BaseOperation: bo}, nil
+}
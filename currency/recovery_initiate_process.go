@@ -0,0 +1,135 @@
+package currency
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var recoveryInitiateProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RecoveryInitiateProcessor)
+	},
+}
+
+func (RecoveryInitiate) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type RecoveryInitiateProcessor struct {
+	cp *CurrencyPool
+	RecoveryInitiate
+	height base.Height
+	sa     state.State
+	sb     AmountState
+	fee    feePayment
+}
+
+func NewRecoveryInitiateProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(RecoveryInitiate)
+		if !ok {
+			return nil, errors.Errorf("not RecoveryInitiate, %T", op)
+		}
+
+		opp := recoveryInitiateProcessorPool.Get().(*RecoveryInitiateProcessor)
+
+		opp.cp = cp
+		opp.RecoveryInitiate = i
+		opp.height = base.NilHeight
+		opp.sa = nil
+		opp.sb = AmountState{}
+		opp.fee = feePayment{}
+
+		return opp, nil
+	}
+}
+
+func (opp *RecoveryInitiateProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(RecoveryInitiateFact)
+
+	st, ac, err := loadRecoveryAccountState(fact.target, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case !ac.HasRecovery():
+		return nil, operation.NewBaseReasonError("recovery of account, %q not set", fact.target)
+	case ac.Recovery().IsPending():
+		return nil, operation.NewBaseReasonError("recovery of account, %q already initiated", fact.target)
+	case ac.Keys().Equal(fact.keys):
+		return nil, operation.NewBaseReasonError("same Keys with the existing")
+	}
+
+	if err := checkKeysByAccountPolicy(fact.keys, getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkThreshold(opp.Signs(), ac.Recovery().Keys()); err != nil {
+		return nil, errors.Wrap(operation.NewBaseReasonErrorFromError(err), "invalid signing by recovery keys")
+	}
+
+	sb, fee, err := loadRecoveryFee(opp.cp, fact.target, fact.currency, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	opp.sa = st
+	opp.sb = sb
+	opp.fee = fee
+
+	return opp, nil
+}
+
+func (opp *RecoveryInitiateProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(RecoveryInitiateFact)
+
+	ac, err := LoadStateAccountValue(opp.sa)
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	nac, err := ac.SetRecovery(ac.Recovery().Initiate(fact.keys, opp.height))
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	st, err := SetStateAccountValue(opp.sa, nac)
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	return setState(fact.Hash(), append([]state.State{st}, opp.fee.pay(opp.sb, ZeroBig)...)...)
+}
+
+func (opp *RecoveryInitiateProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *RecoveryInitiateProcessor) Close() error {
+	opp.cp = nil
+	opp.RecoveryInitiate = RecoveryInitiate{}
+	opp.height = base.NilHeight
+	opp.sa = nil
+	opp.sb = AmountState{}
+	opp.fee = feePayment{}
+
+	recoveryInitiateProcessorPool.Put(opp)
+
+	return nil
+}
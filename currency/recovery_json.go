@@ -0,0 +1,44 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
+)
+
+type AccountRecoveryJSONPacker struct {
+	jsonenc.HintedHead
+	KS AccountKeys `json:"keys"`
+	DL uint64      `json:"delay"`
+	PD AccountKeys `json:"pending"`
+	HE base.Height `json:"height"`
+}
+
+func (ar AccountRecovery) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(AccountRecoveryJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(ar.Hint()),
+		KS:         ar.keys,
+		DL:         ar.delay,
+		PD:         ar.pending,
+		HE:         ar.height,
+	})
+}
+
+type AccountRecoveryJSONUnpacker struct {
+	HT hint.Hint       `json:"_hint"`
+	KS json.RawMessage `json:"keys"`
+	DL uint64          `json:"delay"`
+	PD json.RawMessage `json:"pending"`
+	HE base.Height     `json:"height"`
+}
+
+func (ar *AccountRecovery) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uar AccountRecoveryJSONUnpacker
+	if err := enc.Unmarshal(b, &uar); err != nil {
+		return err
+	}
+
+	return ar.unpack(enc, uar.HT, uar.KS, uar.DL, uar.PD, uar.HE)
+}
@@ -0,0 +1,327 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+)
+
+type testRecoveryOperations struct {
+	baseTestOperationProcessor
+}
+
+func (t *testRecoveryOperations) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr := NewOperationProcessor(cp)
+
+	_, err := copr.SetProcessor(RecoveryUpdaterHinter, NewRecoveryUpdaterProcessor(cp))
+	t.NoError(err)
+	_, err = copr.SetProcessor(RecoveryCancelHinter, NewRecoveryCancelProcessor(cp))
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testRecoveryOperations) currencyPool(fa base.Address, fee Big) *CurrencyPool {
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa, fee))))
+
+	return cp
+}
+
+func (t *testRecoveryOperations) signs(fact base.Fact, pks []key.Privatekey) []base.FactSign {
+	fs := make([]base.FactSign, len(pks))
+	for i := range pks {
+		sig, err := base.NewFactSignature(pks[i], fact, nil)
+		t.NoError(err)
+
+		fs[i] = base.NewBaseFactSign(pks[i].Publickey(), sig)
+	}
+
+	return fs
+}
+
+func (t *testRecoveryOperations) newUpdater(target base.Address, keys AccountKeys, delay uint64, pks []key.Privatekey) RecoveryUpdater {
+	fact := NewRecoveryUpdaterFact(util.UUID().Bytes(), target, keys, delay, t.cid)
+
+	op, err := NewRecoveryUpdater(fact, t.signs(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testRecoveryOperations) newInitiate(target base.Address, keys AccountKeys, pks []key.Privatekey) RecoveryInitiate {
+	fact := NewRecoveryInitiateFact(util.UUID().Bytes(), target, keys, t.cid)
+
+	op, err := NewRecoveryInitiate(fact, t.signs(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testRecoveryOperations) newCancel(target base.Address, pks []key.Privatekey) RecoveryCancel {
+	fact := NewRecoveryCancelFact(util.UUID().Bytes(), target, t.cid)
+
+	op, err := NewRecoveryCancel(fact, t.signs(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testRecoveryOperations) newFinalize(target base.Address, pks []key.Privatekey) RecoveryFinalize {
+	fact := NewRecoveryFinalizeFact(util.UUID().Bytes(), target, t.cid)
+
+	op, err := NewRecoveryFinalize(fact, t.signs(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testRecoveryOperations) accountState(pool *storage.Statepool, a base.Address) state.State {
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyAccount(a) {
+			return st.GetState()
+		}
+	}
+
+	st, found, err := pool.Get(StateKeyAccount(a))
+	t.NoError(err)
+	t.True(found)
+
+	return st
+}
+
+func (t *testRecoveryOperations) account(pool *storage.Statepool, a base.Address) Account {
+	ac, err := LoadStateAccountValue(t.accountState(pool, a))
+	t.NoError(err)
+
+	return ac
+}
+
+func (t *testRecoveryOperations) TestUpdate() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra := generateAccount()
+
+	pool, _ := t.statepool(st0)
+
+	opr := t.processor(t.currencyPool(sa.Address, NewBig(3)), pool)
+
+	t.NoError(opr.Process(t.newUpdater(sa.Address, ra.Keys(), 5, sa.Privs())))
+
+	var ast, sst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyAccount(sa.Address):
+			ast = st.GetState()
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		}
+	}
+
+	ac, err := LoadStateAccountValue(ast)
+	t.NoError(err)
+	t.True(ac.HasRecovery())
+	t.True(ac.Recovery().Keys().Equal(ra.Keys()))
+	t.Equal(uint64(5), ac.Recovery().Delay())
+	t.False(ac.Recovery().IsPending())
+	t.True(ac.Keys().Equal(sa.Keys()))
+
+	sb, err := StateBalanceValue(sst)
+	t.NoError(err)
+	t.Equal(NewBig(7), sb.Big())
+	t.Equal(NewBig(3), sst.(AmountState).Fee())
+}
+
+func (t *testRecoveryOperations) TestUpdateBySigningRecoveryKeys() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra := generateAccount()
+
+	pool, _ := t.statepool(t.recoveryAccountState(sa.Address, NewAccountRecovery(ra.Keys(), 5), st0))
+
+	opr := t.processor(t.currencyPool(sa.Address, ZeroBig), pool)
+
+	err := opr.Process(t.newUpdater(sa.Address, nil, 0, ra.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "invalid signing")
+}
+
+func (t *testRecoveryOperations) TestRemove() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra := generateAccount()
+
+	pool, _ := t.statepool(t.recoveryAccountState(sa.Address, NewAccountRecovery(ra.Keys(), 5), st0))
+
+	opr := t.processor(t.currencyPool(sa.Address, ZeroBig), pool)
+
+	t.NoError(opr.Process(t.newUpdater(sa.Address, nil, 0, sa.Privs())))
+
+	t.False(t.account(pool, sa.Address).HasRecovery())
+}
+
+func (t *testRecoveryOperations) TestRecover() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra := generateAccount()
+	na := generateAccount()
+
+	pool, _ := t.statepool(t.recoveryAccountState(sa.Address, NewAccountRecovery(ra.Keys(), 5), st0))
+
+	cp := t.currencyPool(sa.Address, ZeroBig)
+
+	// NOTE initiate at height 10
+	iopp, err := NewRecoveryInitiateProcessor(cp)(t.newInitiate(sa.Address, na.Keys(), ra.Privs()))
+	t.NoError(err)
+	iopp.(*RecoveryInitiateProcessor).setHeight(base.Height(10))
+
+	_, err = iopp.(*RecoveryInitiateProcessor).PreProcess(pool.Get, pool.Set)
+	t.NoError(err)
+	t.NoError(iopp.(*RecoveryInitiateProcessor).Process(pool.Get, pool.Set))
+
+	ac := t.account(pool, sa.Address)
+	t.True(ac.Recovery().IsPending())
+	t.True(ac.Recovery().Pending().Equal(na.Keys()))
+	t.Equal(base.Height(15), ac.Recovery().Height())
+	t.True(ac.Keys().Equal(sa.Keys()))
+
+	pool, _ = t.statepool(st0[1:], []state.State{t.accountState(pool, sa.Address)})
+
+	fopp, err := NewRecoveryFinalizeProcessor(cp)(t.newFinalize(sa.Address, ra.Privs()))
+	t.NoError(err)
+
+	// NOTE before delay passes
+	fopp.(*RecoveryFinalizeProcessor).setHeight(base.Height(14))
+
+	_, err = fopp.(*RecoveryFinalizeProcessor).PreProcess(pool.Get, pool.Set)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "not yet finalizable")
+
+	fopp.(*RecoveryFinalizeProcessor).setHeight(base.Height(15))
+
+	_, err = fopp.(*RecoveryFinalizeProcessor).PreProcess(pool.Get, pool.Set)
+	t.NoError(err)
+	t.NoError(fopp.(*RecoveryFinalizeProcessor).Process(pool.Get, pool.Set))
+
+	ac = t.account(pool, sa.Address)
+	t.True(ac.Keys().Equal(na.Keys()))
+	t.True(ac.HasRecovery())
+	t.True(ac.Recovery().Keys().Equal(ra.Keys()))
+	t.False(ac.Recovery().IsPending())
+	t.True(ac.Hash().Equal(ac.GenerateHash()))
+}
+
+func (t *testRecoveryOperations) TestInitiateByCurrentKeys() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra := generateAccount()
+	na := generateAccount()
+
+	pool, _ := t.statepool(t.recoveryAccountState(sa.Address, NewAccountRecovery(ra.Keys(), 5), st0))
+
+	opp, err := NewRecoveryInitiateProcessor(t.currencyPool(sa.Address, ZeroBig))(
+		t.newInitiate(sa.Address, na.Keys(), sa.Privs()))
+	t.NoError(err)
+
+	_, err = opp.(*RecoveryInitiateProcessor).PreProcess(pool.Get, pool.Set)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "invalid signing by recovery keys")
+}
+
+func (t *testRecoveryOperations) TestInitiateWithoutRecovery() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	na := generateAccount()
+
+	pool, _ := t.statepool(st0)
+
+	opp, err := NewRecoveryInitiateProcessor(t.currencyPool(sa.Address, ZeroBig))(
+		t.newInitiate(sa.Address, na.Keys(), sa.Privs()))
+	t.NoError(err)
+
+	_, err = opp.(*RecoveryInitiateProcessor).PreProcess(pool.Get, pool.Set)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "not set")
+}
+
+func (t *testRecoveryOperations) TestCancel() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra := generateAccount()
+	na := generateAccount()
+
+	ar := NewAccountRecovery(ra.Keys(), 5).Initiate(na.Keys(), base.Height(10))
+	pool, _ := t.statepool(t.recoveryAccountState(sa.Address, ar, st0))
+
+	opr := t.processor(t.currencyPool(sa.Address, ZeroBig), pool)
+
+	// NOTE recovery keys can not cancel
+	err := opr.Process(t.newCancel(sa.Address, ra.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "invalid signing")
+
+	t.NoError(opr.Process(t.newCancel(sa.Address, sa.Privs())))
+
+	ac := t.account(pool, sa.Address)
+	t.True(ac.HasRecovery())
+	t.False(ac.Recovery().IsPending())
+	t.True(ac.Keys().Equal(sa.Keys()))
+}
+
+func (t *testRecoveryOperations) TestUpdateWhilePending() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra := generateAccount()
+	na := generateAccount()
+
+	ar := NewAccountRecovery(ra.Keys(), 5).Initiate(na.Keys(), base.Height(10))
+	pool, _ := t.statepool(t.recoveryAccountState(sa.Address, ar, st0))
+
+	opr := t.processor(t.currencyPool(sa.Address, ZeroBig), pool)
+
+	err := opr.Process(t.newUpdater(sa.Address, nil, 0, sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "already initiated")
+}
+
+func (t *testRecoveryOperations) TestFinalizeNotInitiated() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra := generateAccount()
+
+	pool, _ := t.statepool(t.recoveryAccountState(sa.Address, NewAccountRecovery(ra.Keys(), 5), st0))
+
+	opp, err := NewRecoveryFinalizeProcessor(t.currencyPool(sa.Address, ZeroBig))(t.newFinalize(sa.Address, ra.Privs()))
+	t.NoError(err)
+	opp.(*RecoveryFinalizeProcessor).setHeight(base.Height(100))
+
+	_, err = opp.(*RecoveryFinalizeProcessor).PreProcess(pool.Get, pool.Set)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "not initiated")
+}
+
+func TestRecoveryOperations(t *testing.T) {
+	suite.Run(t, new(testRecoveryOperations))
+}
@@ -0,0 +1,178 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	RecoveryCancelFactType     = hint.Type("mitum-currency-recovery-cancel-operation-fact")
+	RecoveryCancelFactHint     = hint.NewHint(RecoveryCancelFactType, "v0.0.1")
+	RecoveryCancelFactHinter   = RecoveryCancelFact{BaseHinter: hint.NewBaseHinter(RecoveryCancelFactHint)}
+	RecoveryCancelType         = hint.Type("mitum-currency-recovery-cancel-operation")
+	RecoveryCancelHint         = hint.NewHint(RecoveryCancelType, "v0.0.1")
+	RecoveryCancelHinter       = RecoveryCancel{BaseOperation: operationHinter(RecoveryCancelHint)}
+	RecoveryFinalizeFactType   = hint.Type("mitum-currency-recovery-finalize-operation-fact")
+	RecoveryFinalizeFactHint   = hint.NewHint(RecoveryFinalizeFactType, "v0.0.1")
+	RecoveryFinalizeFactHinter = RecoveryFinalizeFact{BaseHinter: hint.NewBaseHinter(RecoveryFinalizeFactHint)}
+	RecoveryFinalizeType       = hint.Type("mitum-currency-recovery-finalize-operation")
+	RecoveryFinalizeHint       = hint.NewHint(RecoveryFinalizeType, "v0.0.1")
+	RecoveryFinalizeHinter     = RecoveryFinalize{BaseOperation: operationHinter(RecoveryFinalizeHint)}
+)
+
+// RecoveryCancelFact cancels the initiated recovery of target account; it
+// should be signed by the current keys of target.
+type RecoveryCancelFact struct {
+	hint.BaseHinter
+	h        valuehash.Hash
+	token    []byte
+	target   base.Address
+	currency CurrencyID
+}
+
+func NewRecoveryCancelFact(token []byte, target base.Address, currency CurrencyID) RecoveryCancelFact {
+	fact := RecoveryCancelFact{
+		BaseHinter: hint.NewBaseHinter(RecoveryCancelFactHint),
+		token:      token,
+		target:     target,
+		currency:   currency,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact RecoveryCancelFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact RecoveryCancelFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RecoveryCancelFact) Bytes() []byte {
+	return recoverySettleFactBytes(fact.token, fact.target, fact.currency, false)
+}
+
+func (fact RecoveryCancelFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return isvalid.Check(nil, false, fact.target, fact.currency)
+}
+
+func (fact RecoveryCancelFact) Token() []byte {
+	return fact.token
+}
+
+func (fact RecoveryCancelFact) Target() base.Address {
+	return fact.target
+}
+
+func (fact RecoveryCancelFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact RecoveryCancelFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.target}, nil
+}
+
+type RecoveryCancel struct {
+	BaseOperation
+}
+
+func NewRecoveryCancel(fact RecoveryCancelFact, fs []base.FactSign, memo string) (RecoveryCancel, error) {
+	bo, err := NewBaseOperationFromFact(RecoveryCancelHint, fact, fs, memo)
+	if err != nil {
+		return RecoveryCancel{}, err
+	}
+
+	return RecoveryCancel{BaseOperation: bo}, nil
+}
+
+// RecoveryFinalizeFact replaces the keys of target account with the keys of the
+// initiated recovery after the delay; it should be signed by the recovery keys
+// of target.
+type RecoveryFinalizeFact struct {
+	hint.BaseHinter
+	h        valuehash.Hash
+	token    []byte
+	target   base.Address
+	currency CurrencyID
+}
+
+func NewRecoveryFinalizeFact(token []byte, target base.Address, currency CurrencyID) RecoveryFinalizeFact {
+	fact := RecoveryFinalizeFact{
+		BaseHinter: hint.NewBaseHinter(RecoveryFinalizeFactHint),
+		token:      token,
+		target:     target,
+		currency:   currency,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact RecoveryFinalizeFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact RecoveryFinalizeFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RecoveryFinalizeFact) Bytes() []byte {
+	return recoverySettleFactBytes(fact.token, fact.target, fact.currency, true)
+}
+
+func (fact RecoveryFinalizeFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return isvalid.Check(nil, false, fact.target, fact.currency)
+}
+
+func (fact RecoveryFinalizeFact) Token() []byte {
+	return fact.token
+}
+
+func (fact RecoveryFinalizeFact) Target() base.Address {
+	return fact.target
+}
+
+func (fact RecoveryFinalizeFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact RecoveryFinalizeFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.target}, nil
+}
+
+type RecoveryFinalize struct {
+	BaseOperation
+}
+
+func NewRecoveryFinalize(fact RecoveryFinalizeFact, fs []base.FactSign, memo string) (RecoveryFinalize, error) {
+	bo, err := NewBaseOperationFromFact(RecoveryFinalizeHint, fact, fs, memo)
+	if err != nil {
+		return RecoveryFinalize{}, err
+	}
+
+	return RecoveryFinalize{BaseOperation: bo}, nil
+}
+
+// recoverySettleFactBytes appends whether finalized, so RecoveryCancelFact and
+// RecoveryFinalizeFact with same token have different hash.
+func recoverySettleFactBytes(token []byte, target base.Address, currency CurrencyID, finalize bool) []byte {
+	var tb []byte
+	if target != nil {
+		tb = target.Bytes()
+	}
+
+	return util.ConcatBytesSlice(token, tb, currency.Bytes(), util.BoolToBytes(finalize))
+}
@@ -0,0 +1,98 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type RecoverySettleFactBSONUnpacker struct {
+	H  valuehash.Bytes     `bson:"hash"`
+	TK []byte              `bson:"token"`
+	TG base.AddressDecoder `bson:"target"`
+	CR string              `bson:"currency"`
+}
+
+func (fact RecoveryCancelFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":     fact.h,
+				"token":    fact.token,
+				"target":   fact.target,
+				"currency": fact.currency,
+			}))
+}
+
+func (fact *RecoveryCancelFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uf RecoverySettleFactBSONUnpacker
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return err
+	}
+
+	target, err := uf.TG.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	fact.h = uf.H
+	fact.token = uf.TK
+	fact.target = target
+	fact.currency = CurrencyID(uf.CR)
+
+	return nil
+}
+
+func (op *RecoveryCancel) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
+
+func (fact RecoveryFinalizeFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":     fact.h,
+				"token":    fact.token,
+				"target":   fact.target,
+				"currency": fact.currency,
+			}))
+}
+
+func (fact *RecoveryFinalizeFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uf RecoverySettleFactBSONUnpacker
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return err
+	}
+
+	target, err := uf.TG.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	fact.h = uf.H
+	fact.token = uf.TK
+	fact.target = target
+	fact.currency = CurrencyID(uf.CR)
+
+	return nil
+}
+
+func (op *RecoveryFinalize) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type RecoverySettleFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	TG base.Address   `json:"target"`
+	CR CurrencyID     `json:"currency"`
+}
+
+type RecoverySettleFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	TG base.AddressDecoder `json:"target"`
+	CR string              `json:"currency"`
+}
+
+func (fact RecoveryCancelFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(RecoverySettleFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		TG:         fact.target,
+		CR:         fact.currency,
+	})
+}
+
+func (fact *RecoveryCancelFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uf RecoverySettleFactJSONUnpacker
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return err
+	}
+
+	target, err := uf.TG.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	fact.h = uf.H
+	fact.token = uf.TK
+	fact.target = target
+	fact.currency = CurrencyID(uf.CR)
+
+	return nil
+}
+
+func (op *RecoveryCancel) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
+
+func (fact RecoveryFinalizeFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(RecoverySettleFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		TG:         fact.target,
+		CR:         fact.currency,
+	})
+}
+
+func (fact *RecoveryFinalizeFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uf RecoverySettleFactJSONUnpacker
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return err
+	}
+
+	target, err := uf.TG.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	fact.h = uf.H
+	fact.token = uf.TK
+	fact.target = target
+	fact.currency = CurrencyID(uf.CR)
+
+	return nil
+}
+
+func (op *RecoveryFinalize) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,244 @@
+package currency
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var recoveryCancelProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RecoveryCancelProcessor)
+	},
+}
+
+var recoveryFinalizeProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RecoveryFinalizeProcessor)
+	},
+}
+
+func (RecoveryCancel) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+func (RecoveryFinalize) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type RecoveryCancelProcessor struct {
+	cp *CurrencyPool
+	RecoveryCancel
+	sa  state.State
+	sb  AmountState
+	fee feePayment
+}
+
+func NewRecoveryCancelProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(RecoveryCancel)
+		if !ok {
+			return nil, errors.Errorf("not RecoveryCancel, %T", op)
+		}
+
+		opp := recoveryCancelProcessorPool.Get().(*RecoveryCancelProcessor)
+
+		opp.cp = cp
+		opp.RecoveryCancel = i
+		opp.sa = nil
+		opp.sb = AmountState{}
+		opp.fee = feePayment{}
+
+		return opp, nil
+	}
+}
+
+func (opp *RecoveryCancelProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(RecoveryCancelFact)
+
+	st, ac, err := loadRecoveryAccountState(fact.target, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ac.Recovery().IsPending() {
+		return nil, operation.NewBaseReasonError("recovery of account, %q not initiated", fact.target)
+	}
+
+	if err := checkFactSignsByState(fact.target, opp.Signs(), getState); err != nil {
+		return nil, errors.Wrap(err, "invalid signing")
+	}
+
+	sb, fee, err := loadRecoveryFee(opp.cp, fact.target, fact.currency, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	opp.sa = st
+	opp.sb = sb
+	opp.fee = fee
+
+	return opp, nil
+}
+
+func (opp *RecoveryCancelProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(RecoveryCancelFact)
+
+	ac, err := LoadStateAccountValue(opp.sa)
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	nac, err := ac.SetRecovery(ac.Recovery().Clear())
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	st, err := SetStateAccountValue(opp.sa, nac)
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	return setState(fact.Hash(), append([]state.State{st}, opp.fee.pay(opp.sb, ZeroBig)...)...)
+}
+
+func (opp *RecoveryCancelProcessor) Close() error {
+	opp.cp = nil
+	opp.RecoveryCancel = RecoveryCancel{}
+	opp.sa = nil
+	opp.sb = AmountState{}
+	opp.fee = feePayment{}
+
+	recoveryCancelProcessorPool.Put(opp)
+
+	return nil
+}
+
+type RecoveryFinalizeProcessor struct {
+	cp *CurrencyPool
+	RecoveryFinalize
+	height base.Height
+	sa     state.State
+	sb     AmountState
+	fee    feePayment
+}
+
+func NewRecoveryFinalizeProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(RecoveryFinalize)
+		if !ok {
+			return nil, errors.Errorf("not RecoveryFinalize, %T", op)
+		}
+
+		opp := recoveryFinalizeProcessorPool.Get().(*RecoveryFinalizeProcessor)
+
+		opp.cp = cp
+		opp.RecoveryFinalize = i
+		opp.height = base.NilHeight
+		opp.sa = nil
+		opp.sb = AmountState{}
+		opp.fee = feePayment{}
+
+		return opp, nil
+	}
+}
+
+func (opp *RecoveryFinalizeProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(RecoveryFinalizeFact)
+
+	st, ac, err := loadRecoveryAccountState(fact.target, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	ar := ac.Recovery()
+	switch {
+	case !ar.IsPending():
+		return nil, operation.NewBaseReasonError("recovery of account, %q not initiated", fact.target)
+	case opp.height < ar.Height():
+		return nil, operation.NewBaseReasonError(
+			"recovery of account, %q not yet finalizable; recoverable height, %v > current height, %v",
+			fact.target, ar.Height(), opp.height)
+	}
+
+	if err := checkThreshold(opp.Signs(), ar.Keys()); err != nil {
+		return nil, errors.Wrap(operation.NewBaseReasonErrorFromError(err), "invalid signing by recovery keys")
+	}
+
+	sb, fee, err := loadRecoveryFee(opp.cp, fact.target, fact.currency, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	opp.sa = st
+	opp.sb = sb
+	opp.fee = fee
+
+	return opp, nil
+}
+
+func (opp *RecoveryFinalizeProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(RecoveryFinalizeFact)
+
+	ac, err := LoadStateAccountValue(opp.sa)
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	nac, err := ac.SetKeys(ac.Recovery().Pending())
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	nac, err = nac.SetRecovery(ac.Recovery().Clear())
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	st, err := SetStateAccountValue(opp.sa, nac)
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	return setState(fact.Hash(), append([]state.State{st}, opp.fee.pay(opp.sb, ZeroBig)...)...)
+}
+
+func (opp *RecoveryFinalizeProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *RecoveryFinalizeProcessor) Close() error {
+	opp.cp = nil
+	opp.RecoveryFinalize = RecoveryFinalize{}
+	opp.height = base.NilHeight
+	opp.sa = nil
+	opp.sb = AmountState{}
+	opp.fee = feePayment{}
+
+	recoveryFinalizeProcessorPool.Put(opp)
+
+	return nil
+}
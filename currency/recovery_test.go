@@ -0,0 +1,250 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+type testRecovery struct {
+	baseTest
+}
+
+func (t *testRecovery) TestNew() {
+	ar := NewAccountRecovery(generateAccount().Keys(), 10)
+	t.NoError(ar.IsValid(nil))
+	t.False(ar.IsPending())
+	t.Equal(base.NilHeight, ar.Height())
+
+	nar := ar.Initiate(generateAccount().Keys(), base.Height(33))
+	t.NoError(nar.IsValid(nil))
+	t.True(nar.IsPending())
+	t.Equal(base.Height(43), nar.Height())
+
+	nar = nar.Clear()
+	t.NoError(nar.IsValid(nil))
+	t.False(nar.IsPending())
+	t.Equal(base.NilHeight, nar.Height())
+}
+
+func (t *testRecovery) TestInvalidDelay() {
+	err := NewAccountRecovery(generateAccount().Keys(), 0).IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "invalid recovery delay")
+
+	err = NewAccountRecovery(generateAccount().Keys(), MaxRecoveryDelay+1).IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "invalid recovery delay")
+}
+
+func (t *testRecovery) TestAccountHash() {
+	ac, err := NewAccountFromKeys(generateAccount().Keys())
+	t.NoError(err)
+	t.False(ac.HasRecovery())
+
+	rac, err := ac.SetRecovery(NewAccountRecovery(generateAccount().Keys(), 10))
+	t.NoError(err)
+	t.True(rac.HasRecovery())
+	t.False(ac.Hash().Equal(rac.Hash()))
+
+	nac, err := rac.SetRecovery(AccountRecovery{})
+	t.NoError(err)
+	t.False(nac.HasRecovery())
+	t.True(ac.Hash().Equal(nac.Hash()))
+}
+
+func (t *testRecovery) TestUpdaterFact() {
+	fact := NewRecoveryUpdaterFact(util.UUID().Bytes(), NewTestAddress(), generateAccount().Keys(), 10, CurrencyID("SHOWME"))
+	t.NoError(fact.IsValid(nil))
+
+	// NOTE remove recovery
+	fact = NewRecoveryUpdaterFact(util.UUID().Bytes(), NewTestAddress(), nil, 0, CurrencyID("SHOWME"))
+	t.NoError(fact.IsValid(nil))
+
+	fact = NewRecoveryUpdaterFact(util.UUID().Bytes(), NewTestAddress(), nil, 10, CurrencyID("SHOWME"))
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "delay should be 0")
+}
+
+func (t *testRecovery) TestSettleFactHash() {
+	token := util.UUID().Bytes()
+	target := NewTestAddress()
+
+	cancel := NewRecoveryCancelFact(token, target, CurrencyID("SHOWME"))
+	t.NoError(cancel.IsValid(nil))
+
+	finalize := NewRecoveryFinalizeFact(token, target, CurrencyID("SHOWME"))
+	t.NoError(finalize.IsValid(nil))
+
+	t.False(cancel.Hash().Equal(finalize.Hash()))
+}
+
+func TestRecovery(t *testing.T) {
+	suite.Run(t, new(testRecovery))
+}
+
+func newTestRecoveryFactSigns(t *baseTestOperationEncode, fact base.Fact) []base.FactSign {
+	pk := key.NewBasePrivatekey()
+
+	sig, err := base.NewFactSignature(pk, fact, nil)
+	t.NoError(err)
+
+	return []base.FactSign{base.NewBaseFactSign(pk.Publickey(), sig)}
+}
+
+func testAccountRecoveryEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		ac, err := NewAccountFromKeys(generateAccount().Keys())
+		t.NoError(err)
+
+		ar := NewAccountRecovery(generateAccount().Keys(), 10).Initiate(generateAccount().Keys(), base.Height(33))
+
+		ac, err = ac.SetRecovery(ar)
+		t.NoError(err)
+
+		return ac
+	}
+
+	t.compare = func(a, b interface{}) {
+		ca := a.(Account)
+		cb := b.(Account)
+
+		t.True(ca.Hash().Equal(cb.Hash()))
+		t.True(cb.HasRecovery())
+
+		ra := ca.Recovery()
+		rb := cb.Recovery()
+
+		t.True(ra.Hint().Equal(rb.Hint()))
+		t.True(ra.Keys().Equal(rb.Keys()))
+		t.Equal(ra.Delay(), rb.Delay())
+		t.True(ra.Pending().Equal(rb.Pending()))
+		t.Equal(ra.Height(), rb.Height())
+		t.True(ca.GenerateHash().Equal(cb.GenerateHash()))
+	}
+
+	return t
+}
+
+func TestAccountRecoveryEncodeJSON(t *testing.T) {
+	suite.Run(t, testAccountRecoveryEncode(jsonenc.NewEncoder()))
+}
+
+func TestAccountRecoveryEncodeBSON(t *testing.T) {
+	suite.Run(t, testAccountRecoveryEncode(bsonenc.NewEncoder()))
+}
+
+func testRecoveryUpdaterEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		fact := NewRecoveryUpdaterFact(util.UUID().Bytes(), NewTestAddress(), generateAccount().Keys(), 10, CurrencyID("SHOWME"))
+
+		op, err := NewRecoveryUpdater(fact, newTestRecoveryFactSigns(t, fact), "")
+		t.NoError(err)
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		fact := a.(RecoveryUpdater).Fact().(RecoveryUpdaterFact)
+		ufact := b.(RecoveryUpdater).Fact().(RecoveryUpdaterFact)
+
+		t.True(fact.target.Equal(ufact.target))
+		t.True(fact.keys.Equal(ufact.keys))
+		t.Equal(fact.delay, ufact.delay)
+		t.Equal(fact.currency, ufact.currency)
+	}
+
+	return t
+}
+
+func TestRecoveryUpdaterEncodeJSON(t *testing.T) {
+	suite.Run(t, testRecoveryUpdaterEncode(jsonenc.NewEncoder()))
+}
+
+func TestRecoveryUpdaterEncodeBSON(t *testing.T) {
+	suite.Run(t, testRecoveryUpdaterEncode(bsonenc.NewEncoder()))
+}
+
+func testRecoveryInitiateEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		fact := NewRecoveryInitiateFact(util.UUID().Bytes(), NewTestAddress(), generateAccount().Keys(), CurrencyID("SHOWME"))
+
+		op, err := NewRecoveryInitiate(fact, newTestRecoveryFactSigns(t, fact), "")
+		t.NoError(err)
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		fact := a.(RecoveryInitiate).Fact().(RecoveryInitiateFact)
+		ufact := b.(RecoveryInitiate).Fact().(RecoveryInitiateFact)
+
+		t.True(fact.target.Equal(ufact.target))
+		t.True(fact.keys.Equal(ufact.keys))
+		t.Equal(fact.currency, ufact.currency)
+	}
+
+	return t
+}
+
+func TestRecoveryInitiateEncodeJSON(t *testing.T) {
+	suite.Run(t, testRecoveryInitiateEncode(jsonenc.NewEncoder()))
+}
+
+func TestRecoveryInitiateEncodeBSON(t *testing.T) {
+	suite.Run(t, testRecoveryInitiateEncode(bsonenc.NewEncoder()))
+}
+
+func testRecoveryFinalizeEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		fact := NewRecoveryFinalizeFact(util.UUID().Bytes(), NewTestAddress(), CurrencyID("SHOWME"))
+
+		op, err := NewRecoveryFinalize(fact, newTestRecoveryFactSigns(t, fact), "")
+		t.NoError(err)
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		fact := a.(RecoveryFinalize).Fact().(RecoveryFinalizeFact)
+		ufact := b.(RecoveryFinalize).Fact().(RecoveryFinalizeFact)
+
+		t.True(fact.target.Equal(ufact.target))
+		t.Equal(fact.currency, ufact.currency)
+	}
+
+	return t
+}
+
+func TestRecoveryFinalizeEncodeJSON(t *testing.T) {
+	suite.Run(t, testRecoveryFinalizeEncode(jsonenc.NewEncoder()))
+}
+
+func TestRecoveryFinalizeEncodeBSON(t *testing.T) {
+	suite.Run(t, testRecoveryFinalizeEncode(bsonenc.NewEncoder()))
+}
@@ -0,0 +1,136 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	RecoveryUpdaterFactType   = hint.Type("mitum-currency-recovery-updater-operation-fact")
+	RecoveryUpdaterFactHint   = hint.NewHint(RecoveryUpdaterFactType, "v0.0.1")
+	RecoveryUpdaterFactHinter = RecoveryUpdaterFact{BaseHinter: hint.NewBaseHinter(RecoveryUpdaterFactHint)}
+	RecoveryUpdaterType       = hint.Type("mitum-currency-recovery-updater-operation")
+	RecoveryUpdaterHint       = hint.NewHint(RecoveryUpdaterType, "v0.0.1")
+	RecoveryUpdaterHinter     = RecoveryUpdater{BaseOperation: operationHinter(RecoveryUpdaterHint)}
+)
+
+// RecoveryUpdaterFact sets the recovery keys and delay of target account; it
+// should be signed by the current keys of target. Empty keys removes the
+// recovery setting.
+type RecoveryUpdaterFact struct {
+	hint.BaseHinter
+	h        valuehash.Hash
+	token    []byte
+	target   base.Address
+	keys     AccountKeys
+	delay    uint64
+	currency CurrencyID
+}
+
+func NewRecoveryUpdaterFact(
+	token []byte,
+	target base.Address,
+	keys AccountKeys,
+	delay uint64,
+	currency CurrencyID,
+) RecoveryUpdaterFact {
+	fact := RecoveryUpdaterFact{
+		BaseHinter: hint.NewBaseHinter(RecoveryUpdaterFactHint),
+		token:      token,
+		target:     target,
+		keys:       keys,
+		delay:      delay,
+		currency:   currency,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact RecoveryUpdaterFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact RecoveryUpdaterFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RecoveryUpdaterFact) Bytes() []byte {
+	var kb []byte
+	if fact.keys != nil {
+		kb = fact.keys.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.target.Bytes(),
+		kb,
+		util.Uint64ToBytes(fact.delay),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RecoveryUpdaterFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := isvalid.Check(nil, false, fact.target, fact.currency); err != nil {
+		return err
+	}
+
+	if fact.keys == nil {
+		if fact.delay != 0 {
+			return isvalid.InvalidError.Errorf("delay should be 0 for removing recovery")
+		}
+
+		return nil
+	}
+
+	if err := fact.keys.IsValid(nil); err != nil {
+		return err
+	}
+
+	return isValidRecoveryDelay(fact.delay)
+}
+
+func (fact RecoveryUpdaterFact) Token() []byte {
+	return fact.token
+}
+
+func (fact RecoveryUpdaterFact) Target() base.Address {
+	return fact.target
+}
+
+// Keys returns the recovery keys; nil for removing recovery.
+func (fact RecoveryUpdaterFact) Keys() AccountKeys {
+	return fact.keys
+}
+
+func (fact RecoveryUpdaterFact) Delay() uint64 {
+	return fact.delay
+}
+
+func (fact RecoveryUpdaterFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact RecoveryUpdaterFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.target}, nil
+}
+
+type RecoveryUpdater struct {
+	BaseOperation
+}
+
+func NewRecoveryUpdater(fact RecoveryUpdaterFact, fs []base.FactSign, memo string) (RecoveryUpdater, error) {
+	bo, err := NewBaseOperationFromFact(RecoveryUpdaterHint, fact, fs, memo)
+	if err != nil {
+		return RecoveryUpdater{}, err
+	}
+
+	return RecoveryUpdater{BaseOperation: bo}, nil
+}
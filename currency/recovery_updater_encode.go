@@ -0,0 +1,34 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *RecoveryUpdaterFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	btarget base.AddressDecoder,
+	bks []byte,
+	delay uint64,
+	cr string,
+) error {
+	target, err := btarget.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	if err := encoder.Decode(bks, enc, &fact.keys); err != nil {
+		return err
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.target = target
+	fact.delay = delay
+	fact.currency = CurrencyID(cr)
+
+	return nil
+}
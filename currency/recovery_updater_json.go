@@ -0,0 +1,60 @@
+package currency // nolint: dupl
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type RecoveryUpdaterFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	TG base.Address   `json:"target"`
+	KS AccountKeys    `json:"keys"`
+	DL uint64         `json:"delay"`
+	CR CurrencyID     `json:"currency"`
+}
+
+func (fact RecoveryUpdaterFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(RecoveryUpdaterFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		TG:         fact.target,
+		KS:         fact.keys,
+		DL:         fact.delay,
+		CR:         fact.currency,
+	})
+}
+
+type RecoveryUpdaterFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	TG base.AddressDecoder `json:"target"`
+	KS json.RawMessage     `json:"keys"`
+	DL uint64              `json:"delay"`
+	CR string              `json:"currency"`
+}
+
+func (fact *RecoveryUpdaterFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact RecoveryUpdaterFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.TG, ufact.KS, ufact.DL, ufact.CR)
+}
+
+func (op *RecoveryUpdater) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package currency
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var recoveryUpdaterProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RecoveryUpdaterProcessor)
+	},
+}
+
+func (RecoveryUpdater) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type RecoveryUpdaterProcessor struct {
+	cp *CurrencyPool
+	RecoveryUpdater
+	sa  state.State
+	sb  AmountState
+	fee feePayment
+}
+
+func NewRecoveryUpdaterProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(RecoveryUpdater)
+		if !ok {
+			return nil, errors.Errorf("not RecoveryUpdater, %T", op)
+		}
+
+		opp := recoveryUpdaterProcessorPool.Get().(*RecoveryUpdaterProcessor)
+
+		opp.cp = cp
+		opp.RecoveryUpdater = i
+		opp.sa = nil
+		opp.sb = AmountState{}
+		opp.fee = feePayment{}
+
+		return opp, nil
+	}
+}
+
+func (opp *RecoveryUpdaterProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(RecoveryUpdaterFact)
+
+	st, ac, err := loadRecoveryAccountState(fact.target, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case ac.Recovery().IsPending():
+		return nil, operation.NewBaseReasonError("recovery of account, %q already initiated", fact.target)
+	case fact.keys == nil && !ac.HasRecovery():
+		return nil, operation.NewBaseReasonError("recovery of account, %q not set", fact.target)
+	case fact.keys != nil && ac.Keys().Equal(fact.keys):
+		return nil, operation.NewBaseReasonError("recovery keys same with the keys of account")
+	}
+
+	if fact.keys != nil {
+		if err := checkKeysByAccountPolicy(fact.keys, getState); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := checkFactSignsByState(fact.target, opp.Signs(), getState); err != nil {
+		return nil, errors.Wrap(err, "invalid signing")
+	}
+
+	sb, fee, err := loadRecoveryFee(opp.cp, fact.target, fact.currency, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	opp.sa = st
+	opp.sb = sb
+	opp.fee = fee
+
+	return opp, nil
+}
+
+func (opp *RecoveryUpdaterProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(RecoveryUpdaterFact)
+
+	ac, err := LoadStateAccountValue(opp.sa)
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	var ar AccountRecovery
+	if fact.keys != nil {
+		ar = NewAccountRecovery(fact.keys, fact.delay)
+	}
+
+	nac, err := ac.SetRecovery(ar)
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	st, err := SetStateAccountValue(opp.sa, nac)
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	return setState(fact.Hash(), append([]state.State{st}, opp.fee.pay(opp.sb, ZeroBig)...)...)
+}
+
+func (opp *RecoveryUpdaterProcessor) Close() error {
+	opp.cp = nil
+	opp.RecoveryUpdater = RecoveryUpdater{}
+	opp.sa = nil
+	opp.sb = AmountState{}
+	opp.fee = feePayment{}
+
+	recoveryUpdaterProcessorPool.Put(opp)
+
+	return nil
+}
+
+// loadRecoveryAccountState returns the account state of target; the frozen or
+// closed account can not update or run the recovery.
+func loadRecoveryAccountState(
+	target base.Address,
+	getState func(string) (state.State, bool, error),
+) (state.State, Account, error) {
+	st, err := existsState(StateKeyAccount(target), "target", getState)
+	if err != nil {
+		return nil, Account{}, err
+	}
+
+	ac, err := LoadStateAccountValue(st)
+	switch {
+	case err != nil:
+		return nil, Account{}, operation.NewBaseReasonErrorFromError(err)
+	case ac.IsFrozen():
+		return nil, Account{}, operation.NewBaseReasonError("account, %q frozen", target)
+	case ac.IsClosed():
+		return nil, Account{}, operation.NewBaseReasonError("account, %q closed", target)
+	}
+
+	return st, ac, nil
+}
+
+// loadRecoveryFee returns the balance state of target and the fee of recovery
+// operations; the fee is paid by target.
+func loadRecoveryFee(
+	cp *CurrencyPool,
+	target base.Address,
+	cid CurrencyID,
+	getState func(string) (state.State, bool, error),
+) (AmountState, feePayment, error) {
+	st, err := existsState(StateKeyBalance(target, cid), "balance of target", getState)
+	if err != nil {
+		return AmountState{}, feePayment{}, err
+	}
+	sb := NewAmountState(st, cid)
+
+	policy, found := cp.Policy(cid)
+	if !found {
+		return AmountState{}, feePayment{}, operation.NewBaseReasonError("currency, %q not found of recovery", cid)
+	}
+
+	fee, err := loadFeePayment(cp, policy, target, cid, ZeroBig, getState)
+	if err != nil {
+		return AmountState{}, feePayment{}, err
+	}
+
+	switch b, err := StateBalanceValue(sb); {
+	case err != nil:
+		return AmountState{}, feePayment{}, operation.NewBaseReasonErrorFromError(err)
+	case b.Big().Compare(fee.required(ZeroBig)) < 0:
+		return AmountState{}, feePayment{}, operation.NewBaseReasonError("insufficient balance with fee")
+	default:
+		return sb, fee, nil
+	}
+}
@@ -0,0 +1,111 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	RegisterAliasFactType   = hint.Type("mitum-currency-register-alias-operation-fact")
+	RegisterAliasFactHint   = hint.NewHint(RegisterAliasFactType, "v0.0.1")
+	RegisterAliasFactHinter = RegisterAliasFact{BaseHinter: hint.NewBaseHinter(RegisterAliasFactHint)}
+	RegisterAliasType       = hint.Type("mitum-currency-register-alias-operation")
+	RegisterAliasHint       = hint.NewHint(RegisterAliasType, "v0.0.1")
+	RegisterAliasHinter     = RegisterAlias{BaseOperation: operationHinter(RegisterAliasHint)}
+)
+
+// RegisterAliasFact claims the unique alias name for sender account. The fee
+// is paid by the policy of currency.
+type RegisterAliasFact struct {
+	hint.BaseHinter
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	alias    string
+	currency CurrencyID
+}
+
+func NewRegisterAliasFact(token []byte, sender base.Address, alias string, currency CurrencyID) RegisterAliasFact {
+	fact := RegisterAliasFact{
+		BaseHinter: hint.NewBaseHinter(RegisterAliasFactHint),
+		token:      token,
+		sender:     sender,
+		alias:      alias,
+		currency:   currency,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact RegisterAliasFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact RegisterAliasFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RegisterAliasFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		[]byte(fact.alias),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RegisterAliasFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := isvalid.Check(nil, false,
+		fact.sender,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if _, ok := fact.sender.(AliasAddress); ok {
+		return isvalid.InvalidError.Errorf("sender can not be alias address, %q", fact.sender)
+	}
+
+	return IsValidAliasName(fact.alias)
+}
+
+func (fact RegisterAliasFact) Token() []byte {
+	return fact.token
+}
+
+func (fact RegisterAliasFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RegisterAliasFact) Alias() string {
+	return fact.alias
+}
+
+func (fact RegisterAliasFact) Currency() CurrencyID {
+	return fact.currency
+}
+
+func (fact RegisterAliasFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type RegisterAlias struct {
+	BaseOperation
+}
+
+func NewRegisterAlias(fact RegisterAliasFact, fs []base.FactSign, memo string) (RegisterAlias, error) {
+	bo, err := NewBaseOperationFromFact(RegisterAliasHint, fact, fs, memo)
+	if err != nil {
+		return RegisterAlias{}, err
+	}
+
+	return RegisterAlias{BaseOperation: bo}, nil
+}
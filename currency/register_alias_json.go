@@ -0,0 +1,55 @@
+package currency // nolint: dupl
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type RegisterAliasFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	SD base.Address   `json:"sender"`
+	AL string         `json:"alias"`
+	CR CurrencyID     `json:"currency"`
+}
+
+func (fact RegisterAliasFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(RegisterAliasFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		AL:         fact.alias,
+		CR:         fact.currency,
+	})
+}
+
+type RegisterAliasFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	AL string              `json:"alias"`
+	CR string              `json:"currency"`
+}
+
+func (fact *RegisterAliasFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact RegisterAliasFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, ufact.AL, ufact.CR)
+}
+
+func (op *RegisterAlias) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
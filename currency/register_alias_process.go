@@ -0,0 +1,128 @@
+package currency
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var registerAliasProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RegisterAliasProcessor)
+	},
+}
+
+func (RegisterAlias) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type RegisterAliasProcessor struct {
+	cp *CurrencyPool
+	RegisterAlias
+	sl  state.State
+	sb  AmountState
+	fee feePayment
+}
+
+func NewRegisterAliasProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(RegisterAlias)
+		if !ok {
+			return nil, errors.Errorf("not RegisterAlias, %T", op)
+		}
+
+		opp := registerAliasProcessorPool.Get().(*RegisterAliasProcessor)
+
+		opp.cp = cp
+		opp.RegisterAlias = i
+		opp.sl = nil
+		opp.sb = AmountState{}
+		opp.fee = feePayment{}
+
+		return opp, nil
+	}
+}
+
+func (opp *RegisterAliasProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(RegisterAliasFact)
+
+	if err := checkExistsState(StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkActiveAccountState(fact.sender, getState); err != nil {
+		return nil, err
+	}
+
+	st, err := notExistsState(StateKeyAlias(fact.alias), "alias", getState)
+	if err != nil {
+		return nil, err
+	}
+	opp.sl = st
+
+	st, err = existsState(StateKeyBalance(fact.sender, fact.currency), "balance of sender", getState)
+	if err != nil {
+		return nil, err
+	}
+	opp.sb = NewAmountState(st, fact.currency)
+
+	if err = checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, errors.Wrap(err, "invalid signing")
+	}
+
+	policy, found := opp.cp.Policy(fact.currency)
+	if !found {
+		return nil, operation.NewBaseReasonError("currency, %q not found of RegisterAlias", fact.currency)
+	}
+
+	fee, err := loadFeePayment(opp.cp, policy, fact.sender, fact.currency, ZeroBig, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	switch b, err := StateBalanceValue(opp.sb); {
+	case err != nil:
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	case b.Big().Compare(fee.required(ZeroBig)) < 0:
+		return nil, operation.NewBaseReasonError("insufficient balance with fee")
+	default:
+		opp.fee = fee
+	}
+
+	return opp, nil
+}
+
+func (opp *RegisterAliasProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(RegisterAliasFact)
+
+	st, err := SetStateAliasValue(opp.sl, NewAlias(fact.alias, fact.sender))
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	return setState(fact.Hash(), append([]state.State{st}, opp.fee.pay(opp.sb, ZeroBig)...)...)
+}
+
+func (opp *RegisterAliasProcessor) Close() error {
+	opp.cp = nil
+	opp.RegisterAlias = RegisterAlias{}
+	opp.sl = nil
+	opp.sb = AmountState{}
+	opp.fee = feePayment{}
+
+	registerAliasProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,334 @@
+package currency
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	PaymentScheduleType   = hint.Type("mitum-currency-payment-schedule")
+	PaymentScheduleHint   = hint.NewHint(PaymentScheduleType, "v0.0.1")
+	PaymentScheduleHinter = PaymentSchedule{BaseHinter: hint.NewBaseHinter(PaymentScheduleHint)}
+	ScheduleQueueType     = hint.Type("mitum-currency-schedule-queue")
+	ScheduleQueueHint     = hint.NewHint(ScheduleQueueType, "v0.0.1")
+	ScheduleQueueHinter   = ScheduleQueue{BaseHinter: hint.NewBaseHinter(ScheduleQueueHint)}
+)
+
+var MaxScheduleCount uint = 1000
+
+type ScheduleStatus string
+
+const (
+	ScheduleStatusActive    ScheduleStatus = "active"
+	ScheduleStatusCancelled ScheduleStatus = "cancelled"
+	ScheduleStatusFinished  ScheduleStatus = "finished"
+)
+
+func (ss ScheduleStatus) Bytes() []byte {
+	return []byte(ss)
+}
+
+func (ss ScheduleStatus) IsValid([]byte) error {
+	switch ss {
+	case ScheduleStatusActive, ScheduleStatusCancelled, ScheduleStatusFinished:
+		return nil
+	default:
+		return isvalid.InvalidError.Errorf("unknown schedule status, %q", ss)
+	}
+}
+
+// PaymentSchedule is the state value of the recurring payment, which is
+// created by SchedulePayment. The id of PaymentSchedule is the fact hash of
+// SchedulePayment. The amount of the remaining runs is reserved from the
+// balance of sender, so the runs do not depend on the balance of sender.
+type PaymentSchedule struct {
+	hint.BaseHinter
+	id        valuehash.Hash
+	sender    base.Address
+	receiver  base.Address
+	amount    Amount
+	interval  uint64
+	remaining uint
+	next      base.Height
+	status    ScheduleStatus
+}
+
+func NewPaymentSchedule(
+	id valuehash.Hash,
+	sender, receiver base.Address,
+	amount Amount,
+	start base.Height,
+	interval uint64,
+	count uint,
+) PaymentSchedule {
+	return PaymentSchedule{
+		BaseHinter: hint.NewBaseHinter(PaymentScheduleHint),
+		id:         id,
+		sender:     sender,
+		receiver:   receiver,
+		amount:     amount,
+		interval:   interval,
+		remaining:  count,
+		next:       start,
+		status:     ScheduleStatusActive,
+	}
+}
+
+func (sc PaymentSchedule) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		sc.id.Bytes(),
+		sc.sender.Bytes(),
+		sc.receiver.Bytes(),
+		sc.amount.Bytes(),
+		util.Uint64ToBytes(sc.interval),
+		util.UintToBytes(sc.remaining),
+		sc.next.Bytes(),
+		sc.status.Bytes(),
+	)
+}
+
+func (sc PaymentSchedule) Hash() valuehash.Hash {
+	return sc.GenerateHash()
+}
+
+func (sc PaymentSchedule) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(sc.Bytes())
+}
+
+func (sc PaymentSchedule) IsValid([]byte) error {
+	if err := isvalid.Check(nil, false,
+		sc.BaseHinter,
+		sc.id,
+		sc.sender,
+		sc.receiver,
+		sc.amount,
+		sc.status,
+	); err != nil {
+		return isvalid.InvalidError.Errorf("invalid PaymentSchedule: %w", err)
+	}
+
+	if sc.interval < 1 {
+		return isvalid.InvalidError.Errorf("invalid PaymentSchedule: zero interval")
+	}
+
+	return nil
+}
+
+func (sc PaymentSchedule) ID() valuehash.Hash {
+	return sc.id
+}
+
+func (sc PaymentSchedule) Sender() base.Address {
+	return sc.sender
+}
+
+func (sc PaymentSchedule) Receiver() base.Address {
+	return sc.receiver
+}
+
+func (sc PaymentSchedule) Amount() Amount {
+	return sc.amount
+}
+
+func (sc PaymentSchedule) Interval() uint64 {
+	return sc.interval
+}
+
+// Remaining is the number of the runs left.
+func (sc PaymentSchedule) Remaining() uint {
+	return sc.remaining
+}
+
+// Next is the height of the next run.
+func (sc PaymentSchedule) Next() base.Height {
+	return sc.next
+}
+
+func (sc PaymentSchedule) Status() ScheduleStatus {
+	return sc.status
+}
+
+func (sc PaymentSchedule) IsActive() bool {
+	return sc.status == ScheduleStatusActive
+}
+
+// Reserved is the amount for the remaining runs.
+func (sc PaymentSchedule) Reserved() Big {
+	return sc.amount.Big().MulInt64(int64(sc.remaining))
+}
+
+func (sc PaymentSchedule) SetStatus(status ScheduleStatus) PaymentSchedule {
+	sc.status = status
+
+	return sc
+}
+
+// Run returns the PaymentSchedule after the next run; if no runs are left, the
+// schedule is finished.
+func (sc PaymentSchedule) Run() PaymentSchedule {
+	if sc.remaining > 0 {
+		sc.remaining--
+	}
+
+	sc.next += base.Height(sc.interval)
+
+	if sc.remaining < 1 {
+		sc.status = ScheduleStatusFinished
+	}
+
+	return sc
+}
+
+func (sc PaymentSchedule) Addresses() []base.Address {
+	return []base.Address{sc.sender, sc.receiver}
+}
+
+// ScheduleQueueItem points the active PaymentSchedule and the height of it's
+// next run.
+type ScheduleQueueItem struct {
+	id     valuehash.Hash
+	height base.Height
+}
+
+func NewScheduleQueueItem(id valuehash.Hash, height base.Height) ScheduleQueueItem {
+	return ScheduleQueueItem{id: id, height: height}
+}
+
+func (it ScheduleQueueItem) Bytes() []byte {
+	return util.ConcatBytesSlice(it.id.Bytes(), it.height.Bytes())
+}
+
+func (it ScheduleQueueItem) IsValid([]byte) error {
+	return isvalid.Check(nil, false, it.id)
+}
+
+func (it ScheduleQueueItem) ID() valuehash.Hash {
+	return it.id
+}
+
+func (it ScheduleQueueItem) Height() base.Height {
+	return it.height
+}
+
+// ScheduleQueue is the state value of the active PaymentSchedules. The items
+// are sorted by the height of next run and id, so the due schedules are always
+// run in the same order.
+type ScheduleQueue struct {
+	hint.BaseHinter
+	items []ScheduleQueueItem
+}
+
+func NewScheduleQueue(items []ScheduleQueueItem) ScheduleQueue {
+	sq := ScheduleQueue{BaseHinter: hint.NewBaseHinter(ScheduleQueueHint)}
+
+	return sq.setItems(items)
+}
+
+func (sq ScheduleQueue) Bytes() []byte {
+	bs := make([][]byte, len(sq.items))
+	for i := range sq.items {
+		bs[i] = sq.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (sq ScheduleQueue) Hash() valuehash.Hash {
+	return sq.GenerateHash()
+}
+
+func (sq ScheduleQueue) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(sq.Bytes())
+}
+
+func (sq ScheduleQueue) IsValid([]byte) error {
+	if err := sq.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for i := range sq.items {
+		it := sq.items[i]
+		if err := it.IsValid(nil); err != nil {
+			return isvalid.InvalidError.Errorf("invalid ScheduleQueue: %w", err)
+		}
+
+		if _, found := founds[it.id.String()]; found {
+			return isvalid.InvalidError.Errorf("duplicated schedule found in ScheduleQueue, %q", it.id)
+		}
+		founds[it.id.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (sq ScheduleQueue) Items() []ScheduleQueueItem {
+	return sq.items
+}
+
+func (sq ScheduleQueue) Exists(id valuehash.Hash) bool {
+	for i := range sq.items {
+		if sq.items[i].id.Equal(id) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Due returns the items, which should be run at the given height.
+func (sq ScheduleQueue) Due(height base.Height) []ScheduleQueueItem {
+	var items []ScheduleQueueItem
+	for i := range sq.items {
+		if sq.items[i].height > height {
+			break
+		}
+
+		items = append(items, sq.items[i])
+	}
+
+	return items
+}
+
+// Set adds or replaces the item of same id.
+func (sq ScheduleQueue) Set(it ScheduleQueueItem) ScheduleQueue {
+	items := make([]ScheduleQueueItem, 0, len(sq.items)+1)
+	for i := range sq.items {
+		if !sq.items[i].id.Equal(it.id) {
+			items = append(items, sq.items[i])
+		}
+	}
+
+	return sq.setItems(append(items, it))
+}
+
+func (sq ScheduleQueue) Remove(id valuehash.Hash) ScheduleQueue {
+	items := make([]ScheduleQueueItem, 0, len(sq.items))
+	for i := range sq.items {
+		if !sq.items[i].id.Equal(id) {
+			items = append(items, sq.items[i])
+		}
+	}
+
+	return sq.setItems(items)
+}
+
+func (sq ScheduleQueue) setItems(items []ScheduleQueueItem) ScheduleQueue {
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].height != items[j].height {
+			return items[i].height < items[j].height
+		}
+
+		return bytes.Compare(items[i].id.Bytes(), items[j].id.Bytes()) < 0
+	})
+
+	sq.items = items
+
+	return sq
+}
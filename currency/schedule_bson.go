@@ -0,0 +1,93 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (sc PaymentSchedule) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(sc.Hint()),
+		bson.M{
+			"id":        sc.id,
+			"sender":    sc.sender,
+			"receiver":  sc.receiver,
+			"amount":    sc.amount,
+			"interval":  sc.interval,
+			"remaining": sc.remaining,
+			"next":      sc.next,
+			"status":    sc.status,
+		},
+	))
+}
+
+type PaymentScheduleBSONUnpacker struct {
+	ID valuehash.Bytes     `bson:"id"`
+	SD base.AddressDecoder `bson:"sender"`
+	RC base.AddressDecoder `bson:"receiver"`
+	AM Amount              `bson:"amount"`
+	IN uint64              `bson:"interval"`
+	RM uint                `bson:"remaining"`
+	NX base.Height         `bson:"next"`
+	ST string              `bson:"status"`
+}
+
+func (sc *PaymentSchedule) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var usc PaymentScheduleBSONUnpacker
+	if err := enc.Unmarshal(b, &usc); err != nil {
+		return err
+	}
+
+	return sc.unpack(enc, usc.ID, usc.SD, usc.RC, usc.AM, usc.IN, usc.RM, usc.NX, usc.ST)
+}
+
+func (it ScheduleQueueItem) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"id":     it.id,
+		"height": it.height,
+	})
+}
+
+type ScheduleQueueItemBSONUnpacker struct {
+	ID valuehash.Bytes `bson:"id"`
+	HT base.Height     `bson:"height"`
+}
+
+func (it *ScheduleQueueItem) UnmarshalBSON(b []byte) error {
+	var uit ScheduleQueueItemBSONUnpacker
+	if err := bson.Unmarshal(b, &uit); err != nil {
+		return err
+	}
+
+	it.id = uit.ID
+	it.height = uit.HT
+
+	return nil
+}
+
+func (sq ScheduleQueue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(sq.Hint()),
+		bson.M{
+			"items": sq.items,
+		},
+	))
+}
+
+type ScheduleQueueBSONUnpacker struct {
+	IT []ScheduleQueueItem `bson:"items"`
+}
+
+func (sq *ScheduleQueue) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var usq ScheduleQueueBSONUnpacker
+	if err := enc.Unmarshal(b, &usq); err != nil {
+		return err
+	}
+
+	sq.items = usq.IT
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (sc *PaymentSchedule) unpack(
+	enc encoder.Encoder,
+	id valuehash.Hash,
+	bsender,
+	breceiver base.AddressDecoder,
+	am Amount,
+	interval uint64,
+	remaining uint,
+	next base.Height,
+	status string,
+) error {
+	sender, err := bsender.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	receiver, err := breceiver.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	sc.id = id
+	sc.sender = sender
+	sc.receiver = receiver
+	sc.amount = am
+	sc.interval = interval
+	sc.remaining = remaining
+	sc.next = next
+	sc.status = ScheduleStatus(status)
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type PaymentScheduleJSONPacker struct {
+	jsonenc.HintedHead
+	ID valuehash.Hash `json:"id"`
+	SD base.Address   `json:"sender"`
+	RC base.Address   `json:"receiver"`
+	AM Amount         `json:"amount"`
+	IN uint64         `json:"interval"`
+	RM uint           `json:"remaining"`
+	NX base.Height    `json:"next"`
+	ST ScheduleStatus `json:"status"`
+}
+
+func (sc PaymentSchedule) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(PaymentScheduleJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(sc.Hint()),
+		ID:         sc.id,
+		SD:         sc.sender,
+		RC:         sc.receiver,
+		AM:         sc.amount,
+		IN:         sc.interval,
+		RM:         sc.remaining,
+		NX:         sc.next,
+		ST:         sc.status,
+	})
+}
+
+type PaymentScheduleJSONUnpacker struct {
+	ID valuehash.Bytes     `json:"id"`
+	SD base.AddressDecoder `json:"sender"`
+	RC base.AddressDecoder `json:"receiver"`
+	AM Amount              `json:"amount"`
+	IN uint64              `json:"interval"`
+	RM uint                `json:"remaining"`
+	NX base.Height         `json:"next"`
+	ST string              `json:"status"`
+}
+
+func (sc *PaymentSchedule) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var usc PaymentScheduleJSONUnpacker
+	if err := enc.Unmarshal(b, &usc); err != nil {
+		return err
+	}
+
+	return sc.unpack(enc, usc.ID, usc.SD, usc.RC, usc.AM, usc.IN, usc.RM, usc.NX, usc.ST)
+}
+
+type ScheduleQueueItemJSONPacker struct {
+	ID valuehash.Hash `json:"id"`
+	HT base.Height    `json:"height"`
+}
+
+func (it ScheduleQueueItem) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(ScheduleQueueItemJSONPacker{
+		ID: it.id,
+		HT: it.height,
+	})
+}
+
+type ScheduleQueueItemJSONUnpacker struct {
+	ID valuehash.Bytes `json:"id"`
+	HT base.Height     `json:"height"`
+}
+
+func (it *ScheduleQueueItem) UnmarshalJSON(b []byte) error {
+	var uit ScheduleQueueItemJSONUnpacker
+	if err := jsonenc.Unmarshal(b, &uit); err != nil {
+		return err
+	}
+
+	it.id = uit.ID
+	it.height = uit.HT
+
+	return nil
+}
+
+type ScheduleQueueJSONPacker struct {
+	jsonenc.HintedHead
+	IT []ScheduleQueueItem `json:"items"`
+}
+
+func (sq ScheduleQueue) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(ScheduleQueueJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(sq.Hint()),
+		IT:         sq.items,
+	})
+}
+
+type ScheduleQueueJSONUnpacker struct {
+	IT []ScheduleQueueItem `json:"items"`
+}
+
+func (sq *ScheduleQueue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var usq ScheduleQueueJSONUnpacker
+	if err := enc.Unmarshal(b, &usq); err != nil {
+		return err
+	}
+
+	sq.items = usq.IT
+
+	return nil
+}
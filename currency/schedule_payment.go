@@ -0,0 +1,158 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	SchedulePaymentFactType   = hint.Type("mitum-currency-schedule-payment-operation-fact")
+	SchedulePaymentFactHint   = hint.NewHint(SchedulePaymentFactType, "v0.0.1")
+	SchedulePaymentFactHinter = SchedulePaymentFact{BaseHinter: hint.NewBaseHinter(SchedulePaymentFactHint)}
+	SchedulePaymentType       = hint.Type("mitum-currency-schedule-payment-operation")
+	SchedulePaymentHint       = hint.NewHint(SchedulePaymentType, "v0.0.1")
+	SchedulePaymentHinter     = SchedulePayment{BaseOperation: operationHinter(SchedulePaymentHint)}
+)
+
+// SchedulePaymentFact pays the amount to receiver at the start height and then
+// every interval blocks, count times. The amount of all the runs is reserved
+// from the balance of sender with the fee of all the runs.
+type SchedulePaymentFact struct {
+	hint.BaseHinter
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	receiver base.Address
+	amount   Amount
+	start    base.Height
+	interval uint64
+	count    uint
+}
+
+func NewSchedulePaymentFact(
+	token []byte,
+	sender, receiver base.Address,
+	amount Amount,
+	start base.Height,
+	interval uint64,
+	count uint,
+) SchedulePaymentFact {
+	fact := SchedulePaymentFact{
+		BaseHinter: hint.NewBaseHinter(SchedulePaymentFactHint),
+		token:      token,
+		sender:     sender,
+		receiver:   receiver,
+		amount:     amount,
+		start:      start,
+		interval:   interval,
+		count:      count,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact SchedulePaymentFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact SchedulePaymentFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact SchedulePaymentFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.receiver.Bytes(),
+		fact.amount.Bytes(),
+		fact.start.Bytes(),
+		util.Uint64ToBytes(fact.interval),
+		util.UintToBytes(fact.count),
+	)
+}
+
+func (fact SchedulePaymentFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := isvalid.Check(nil, false,
+		fact.sender,
+		fact.receiver,
+		fact.amount,
+	); err != nil {
+		return err
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return isvalid.InvalidError.Errorf("amount should be over zero")
+	}
+
+	if fact.sender.Equal(fact.receiver) {
+		return isvalid.InvalidError.Errorf("receiver is same with sender, %q", fact.sender)
+	}
+
+	if fact.start <= base.GenesisHeight {
+		return isvalid.InvalidError.Errorf("start height should be over genesis height")
+	}
+
+	if fact.interval < 1 {
+		return isvalid.InvalidError.Errorf("interval should be over zero")
+	}
+
+	if fact.count < 1 || fact.count > MaxScheduleCount {
+		return isvalid.InvalidError.Errorf("count should be between 1 and %d, %d", MaxScheduleCount, fact.count)
+	}
+
+	return nil
+}
+
+func (fact SchedulePaymentFact) Token() []byte {
+	return fact.token
+}
+
+func (fact SchedulePaymentFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact SchedulePaymentFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact SchedulePaymentFact) Amount() Amount {
+	return fact.amount
+}
+
+// Start is the height of the first run.
+func (fact SchedulePaymentFact) Start() base.Height {
+	return fact.start
+}
+
+func (fact SchedulePaymentFact) Interval() uint64 {
+	return fact.interval
+}
+
+func (fact SchedulePaymentFact) Count() uint {
+	return fact.count
+}
+
+func (fact SchedulePaymentFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.receiver}, nil
+}
+
+type SchedulePayment struct {
+	BaseOperation
+}
+
+func NewSchedulePayment(fact SchedulePaymentFact, fs []base.FactSign, memo string) (SchedulePayment, error) {
+	bo, err := NewBaseOperationFromFact(SchedulePaymentHint, fact, fs, memo)
+	if err != nil {
+		return SchedulePayment{}, err
+	}
+
+	return SchedulePayment{BaseOperation: bo}, nil
+}
@@ -0,0 +1,55 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact SchedulePaymentFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":     fact.h,
+				"token":    fact.token,
+				"sender":   fact.sender,
+				"receiver": fact.receiver,
+				"amount":   fact.amount,
+				"start":    fact.start,
+				"interval": fact.interval,
+				"count":    fact.count,
+			}))
+}
+
+type SchedulePaymentFactBSONUnpacker struct {
+	H  valuehash.Bytes     `bson:"hash"`
+	TK []byte              `bson:"token"`
+	SD base.AddressDecoder `bson:"sender"`
+	RC base.AddressDecoder `bson:"receiver"`
+	AM Amount              `bson:"amount"`
+	SH base.Height         `bson:"start"`
+	IN uint64              `bson:"interval"`
+	CT uint                `bson:"count"`
+}
+
+func (fact *SchedulePaymentFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ufact SchedulePaymentFactBSONUnpacker
+	if err := bson.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, ufact.RC, ufact.AM, ufact.SH, ufact.IN, ufact.CT)
+}
+
+func (op *SchedulePayment) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
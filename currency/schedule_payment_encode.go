@@ -0,0 +1,40 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (fact *SchedulePaymentFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	bsender,
+	breceiver base.AddressDecoder,
+	am Amount,
+	start base.Height,
+	interval uint64,
+	count uint,
+) error {
+	sender, err := bsender.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	receiver, err := breceiver.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.sender = sender
+	fact.receiver = receiver
+	fact.amount = am
+	fact.start = start
+	fact.interval = interval
+	fact.count = count
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type SchedulePaymentFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	SD base.Address   `json:"sender"`
+	RC base.Address   `json:"receiver"`
+	AM Amount         `json:"amount"`
+	SH base.Height    `json:"start"`
+	IN uint64         `json:"interval"`
+	CT uint           `json:"count"`
+}
+
+func (fact SchedulePaymentFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(SchedulePaymentFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		RC:         fact.receiver,
+		AM:         fact.amount,
+		SH:         fact.start,
+		IN:         fact.interval,
+		CT:         fact.count,
+	})
+}
+
+type SchedulePaymentFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	RC base.AddressDecoder `json:"receiver"`
+	AM Amount              `json:"amount"`
+	SH base.Height         `json:"start"`
+	IN uint64              `json:"interval"`
+	CT uint                `json:"count"`
+}
+
+func (fact *SchedulePaymentFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact SchedulePaymentFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, ufact.RC, ufact.AM, ufact.SH, ufact.IN, ufact.CT)
+}
+
+func (op *SchedulePayment) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package currency
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var schedulePaymentProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(SchedulePaymentProcessor)
+	},
+}
+
+func (SchedulePayment) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+type SchedulePaymentProcessor struct {
+	cp *CurrencyPool
+	SchedulePayment
+	height base.Height
+	sc     state.State
+	si     AccountIndexState
+	sb     AmountState
+	fee    feePayment
+}
+
+func NewSchedulePaymentProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(SchedulePayment)
+		if !ok {
+			return nil, errors.Errorf("not SchedulePayment, %T", op)
+		}
+
+		opp := schedulePaymentProcessorPool.Get().(*SchedulePaymentProcessor)
+
+		opp.cp = cp
+		opp.SchedulePayment = i
+		opp.height = base.NilHeight
+		opp.sc = nil
+		opp.si = AccountIndexState{}
+		opp.sb = AmountState{}
+		opp.fee = feePayment{}
+
+		return opp, nil
+	}
+}
+
+func (opp *SchedulePaymentProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(SchedulePaymentFact)
+
+	if err := checkExistsState(StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkActiveAccountState(fact.sender, getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, errors.Wrap(err, "invalid signing")
+	}
+
+	if _, err := existsState(StateKeyAccount(fact.receiver), "receiver", getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkNotClosedState(fact.receiver, getState); err != nil {
+		return nil, err
+	}
+
+	if fact.start <= opp.height {
+		return nil, operation.NewBaseReasonError("start height, %v should be over current height, %v", fact.start, opp.height)
+	}
+
+	cid := fact.amount.Currency()
+	policy, found := opp.cp.Policy(cid)
+	if !found {
+		return nil, operation.NewBaseReasonError("currency, %q not found of SchedulePayment", cid)
+	}
+
+	sc, err := notExistsState(StateKeySchedule(fact.Hash()), "payment schedule", getState)
+	if err != nil {
+		return nil, err
+	}
+
+	si, err := loadAccountIndexState(fact.sender, getState)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := existsState(StateKeyBalance(fact.sender, cid), "balance of sender", getState)
+	if err != nil {
+		return nil, err
+	}
+	sb := NewAmountState(st, cid)
+
+	fee := feePayment{fee: ZeroBig}
+	if !policy.IsFeeExempted(fact.sender) {
+		k, err := policy.Feeer().Fee(fact.amount.Big())
+		if err != nil {
+			return nil, operation.NewBaseReasonErrorFromError(err)
+		}
+
+		if fee, err = newFeePayment(opp.cp, policy, fact.sender, cid, k.MulInt64(int64(fact.count)), getState); err != nil {
+			return nil, err
+		}
+	}
+
+	reserved := fact.amount.Big().MulInt64(int64(fact.count))
+
+	switch b, e := StateBalanceValue(sb); {
+	case e != nil:
+		return nil, operation.NewBaseReasonErrorFromError(e)
+	case b.Big().Compare(fee.required(reserved)) < 0:
+		return nil, operation.NewBaseReasonError("insufficient balance with fee")
+	}
+
+	opp.sc = sc
+	opp.si = si
+	opp.sb = sb
+	opp.fee = fee
+
+	return opp, nil
+}
+
+func (opp *SchedulePaymentProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(SchedulePaymentFact)
+
+	sc, err := SetStateScheduleValue(opp.sc, NewPaymentSchedule(
+		fact.Hash(),
+		fact.sender,
+		fact.receiver,
+		fact.amount,
+		fact.start,
+		fact.interval,
+		fact.count,
+	))
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	reserved := fact.amount.Big().MulInt64(int64(fact.count))
+
+	sts := []state.State{sc, opp.si.Add(sc.Key())}
+
+	return setState(fact.Hash(), append(sts, opp.fee.pay(opp.sb, reserved)...)...)
+}
+
+func (opp *SchedulePaymentProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
+func (opp *SchedulePaymentProcessor) Close() error {
+	opp.cp = nil
+	opp.SchedulePayment = SchedulePayment{}
+	opp.height = base.NilHeight
+	opp.sc = nil
+	opp.si = AccountIndexState{}
+	opp.sb = AmountState{}
+	opp.fee = feePayment{}
+
+	schedulePaymentProcessorPool.Put(opp)
+
+	return nil
+}
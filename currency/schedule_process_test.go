@@ -0,0 +1,471 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testScheduleOperations struct {
+	baseTestOperationProcessor
+}
+
+func (t *testScheduleOperations) processor(cp *CurrencyPool, pool *storage.Statepool) prprocessor.OperationProcessor {
+	copr := NewOperationProcessor(cp)
+
+	_, err := copr.SetProcessor(SchedulePaymentHinter, NewSchedulePaymentProcessor(cp))
+	t.NoError(err)
+	_, err = copr.SetProcessor(CancelScheduleHinter, NewCancelScheduleProcessor())
+	t.NoError(err)
+
+	if pool == nil {
+		return copr
+	}
+
+	return copr.New(pool)
+}
+
+func (t *testScheduleOperations) signs(fact base.Fact, pks []key.Privatekey) []base.FactSign {
+	fs := make([]base.FactSign, len(pks))
+	for i := range pks {
+		sig, err := base.NewFactSignature(pks[i], fact, nil)
+		t.NoError(err)
+
+		fs[i] = base.NewBaseFactSign(pks[i].Publickey(), sig)
+	}
+
+	return fs
+}
+
+func (t *testScheduleOperations) newSchedulePayment(
+	sender, receiver base.Address,
+	am Amount,
+	start base.Height,
+	interval uint64,
+	count uint,
+	pks []key.Privatekey,
+) SchedulePayment {
+	fact := NewSchedulePaymentFact(util.UUID().Bytes(), sender, receiver, am, start, interval, count)
+
+	op, err := NewSchedulePayment(fact, t.signs(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testScheduleOperations) newCancelSchedule(
+	sender base.Address, id valuehash.Hash, pks []key.Privatekey,
+) CancelSchedule {
+	fact := NewCancelScheduleFact(util.UUID().Bytes(), sender, id)
+
+	op, err := NewCancelSchedule(fact, t.signs(fact, pks), "")
+	t.NoError(err)
+	t.NoError(op.IsValid(nil))
+
+	return op
+}
+
+func (t *testScheduleOperations) newStateSchedule(sc PaymentSchedule) state.State {
+	st, err := state.NewStateV0(StateKeySchedule(sc.ID()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateScheduleValue(st, sc)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *testScheduleOperations) newStateScheduleQueue(scs ...PaymentSchedule) state.State {
+	items := make([]ScheduleQueueItem, len(scs))
+	for i := range scs {
+		items[i] = NewScheduleQueueItem(scs[i].ID(), scs[i].Next())
+	}
+
+	st, err := state.NewStateV0(StateKeyScheduleQueue, nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateScheduleQueueValue(st, NewScheduleQueue(items))
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *testScheduleOperations) TestSchedulePayment() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+	fa, st2 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1, st2)
+
+	fee := NewBig(2)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(fa.Address, fee))))
+
+	opr := t.processor(cp, pool)
+
+	am := NewAmount(NewBig(10), t.cid)
+	start := pool.Height() + 10
+	op := t.newSchedulePayment(sa.Address, ra.Address, am, start, 5, 3, sa.Privs())
+
+	t.NoError(opr.Process(op))
+	t.NoError(opr.Close())
+
+	var sst, scst, qst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeySchedule(op.Fact().Hash()):
+			scst = st.GetState()
+		case StateKeyScheduleQueue:
+			qst = st.GetState()
+		}
+	}
+
+	// NOTE amount of 3 runs and the fee of 3 runs are taken
+	sb, err := StateBalanceValue(sst)
+	t.NoError(err)
+	t.True(NewBig(100 - 30 - 6).Equal(sb.Big()))
+
+	sc, err := StateScheduleValue(scst)
+	t.NoError(err)
+	t.NoError(sc.IsValid(nil))
+	t.True(sc.ID().Equal(op.Fact().Hash()))
+	t.True(sc.Receiver().Equal(ra.Address))
+	t.Equal(start, sc.Next())
+	t.Equal(uint(3), sc.Remaining())
+	t.True(sc.IsActive())
+
+	sq, err := StateScheduleQueueValue(qst)
+	t.NoError(err)
+	t.Equal(1, len(sq.Items()))
+	t.True(sq.Exists(sc.ID()))
+
+	for _, op := range pool.AddedOperations() {
+		_, ok := op.(ScheduleRunOperation)
+		t.False(ok)
+	}
+}
+
+func (t *testScheduleOperations) TestSchedulePaymentPastStart() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opp, err := NewSchedulePaymentProcessor(cp)(
+		t.newSchedulePayment(sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), base.Height(10), 5, 3, sa.Privs()))
+	t.NoError(err)
+
+	opp.(*SchedulePaymentProcessor).setHeight(base.Height(10))
+
+	_, err = opp.(*SchedulePaymentProcessor).PreProcess(pool.Get, pool.Set)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "should be over current height")
+}
+
+func (t *testScheduleOperations) TestSchedulePaymentInsufficientBalance() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(32), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, NewBig(1)))))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newSchedulePayment(sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), pool.Height()+10, 5, 3, sa.Privs())
+
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "insufficient balance with fee")
+}
+
+func (t *testScheduleOperations) TestSchedulePaymentClosedReceiver() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+	st1 = t.closeAccountState(ra.Address, st1)
+
+	pool, _ := t.statepool(st0, st1)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	op := t.newSchedulePayment(sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), pool.Height()+10, 5, 3, sa.Privs())
+
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "closed")
+}
+
+func (t *testScheduleOperations) TestCancelSchedule() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	sc := NewPaymentSchedule(valuehash.RandomSHA256(),
+		sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), base.Height(10), 5, 3).Run()
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newStateSchedule(sc), t.newStateScheduleQueue(sc)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	t.NoError(opr.Process(t.newCancelSchedule(sa.Address, sc.ID(), sa.Privs())))
+	t.NoError(opr.Close())
+
+	var sst, scst, qst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeySchedule(sc.ID()):
+			scst = st.GetState()
+		case StateKeyScheduleQueue:
+			qst = st.GetState()
+		}
+	}
+
+	// NOTE the amount of the remaining 2 runs is returned
+	sb, err := StateBalanceValue(sst)
+	t.NoError(err)
+	t.True(NewBig(21).Equal(sb.Big()))
+
+	usc, err := StateScheduleValue(scst)
+	t.NoError(err)
+	t.Equal(ScheduleStatusCancelled, usc.Status())
+
+	sq, err := StateScheduleQueueValue(qst)
+	t.NoError(err)
+	t.False(sq.Exists(sc.ID()))
+}
+
+func (t *testScheduleOperations) TestCancelScheduleByReceiver() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	sc := NewPaymentSchedule(valuehash.RandomSHA256(),
+		sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), base.Height(10), 5, 3)
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newStateSchedule(sc)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newCancelSchedule(ra.Address, sc.ID(), ra.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "should be sender of payment schedule")
+}
+
+func (t *testScheduleOperations) TestCancelScheduleFinished() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+
+	sc := NewPaymentSchedule(valuehash.RandomSHA256(),
+		sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), base.Height(10), 5, 1).Run()
+
+	pool, _ := t.statepool(st0, st1, []state.State{t.newStateSchedule(sc)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	err := opr.Process(t.newCancelSchedule(sa.Address, sc.ID(), sa.Privs()))
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "already finished")
+}
+
+func (t *testScheduleOperations) TestRunDue() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1)
+
+	// NOTE the schedule missed 2 runs; both are run in this block and the last
+	// run remains.
+	sc := NewPaymentSchedule(valuehash.RandomSHA256(),
+		sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), pool.Height()-5, 5, 3)
+
+	pool, _ = t.statepool(st0, st1, []state.State{t.newStateSchedule(sc), t.newStateScheduleQueue(sc)})
+
+	opr := t.processor(nil, pool)
+	t.NoError(opr.Close())
+
+	var rst, scst, qst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(ra.Address, t.cid):
+			rst = st.GetState()
+		case StateKeySchedule(sc.ID()):
+			scst = st.GetState()
+		case StateKeyScheduleQueue:
+			qst = st.GetState()
+		}
+	}
+
+	rb, err := StateBalanceValue(rst)
+	t.NoError(err)
+	t.True(NewBig(21).Equal(rb.Big()))
+
+	usc, err := StateScheduleValue(scst)
+	t.NoError(err)
+	t.True(usc.IsActive())
+	t.Equal(uint(1), usc.Remaining())
+	t.Equal(pool.Height()+5, usc.Next())
+
+	sq, err := StateScheduleQueueValue(qst)
+	t.NoError(err)
+	t.Equal(1, len(sq.Items()))
+	t.Equal(pool.Height()+5, sq.Items()[0].Height())
+
+	ops := pool.AddedOperations()
+	t.Equal(1, len(ops))
+
+	for _, op := range ops {
+		fact, ok := op.Fact().(ScheduleRunOperationFact)
+		t.True(ok)
+		t.Equal(2, len(fact.Runs()))
+		t.Equal(pool.Height()-5, fact.Runs()[0].Height())
+		t.Equal(pool.Height(), fact.Runs()[1].Height())
+	}
+
+	// NOTE the next Close finds nothing to run
+	t.NoError(t.processor(nil, pool).Close())
+	t.Equal(1, len(pool.AddedOperations()))
+}
+
+func (t *testScheduleOperations) TestRunDueOnceInBlock() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	sb, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(100), t.cid)})
+	ra, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1, st2)
+
+	sc := NewPaymentSchedule(valuehash.RandomSHA256(),
+		sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), pool.Height(), 5, 3)
+
+	pool, _ = t.statepool(st0, st1, st2, []state.State{t.newStateSchedule(sc), t.newStateScheduleQueue(sc)})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(ra.Address, NewBig(1)))))
+
+	// NOTE mitum creates the OperationProcessor by the operation hint of block
+	copr := t.processor(cp, nil)
+	oprs := []prprocessor.OperationProcessor{copr.New(pool), copr.New(pool)}
+
+	t.NoError(oprs[0].Process(t.newSchedulePayment(
+		sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), pool.Height()+10, 5, 3, sa.Privs())))
+	t.NoError(oprs[1].Process(t.newSchedulePayment(
+		sb.Address, ra.Address, NewAmount(NewBig(10), t.cid), pool.Height()+10, 5, 3, sb.Privs())))
+
+	// NOTE the schedules are not run until the last OperationProcessor is
+	// closed
+	t.NoError(oprs[0].Close())
+	t.Empty(pool.AddedOperations())
+
+	t.NoError(oprs[1].Close())
+
+	ops := pool.AddedOperations()
+	t.Equal(2, len(ops))
+
+	var runs, fees int
+	for _, op := range ops {
+		switch fact := op.Fact().(type) {
+		case ScheduleRunOperationFact:
+			runs++
+
+			t.Equal(1, len(fact.Runs()))
+		case FeeOperationFact:
+			fees++
+
+			// NOTE the fee of both OperationProcessors is collected by one
+			// FeeOperation
+			t.Equal(1, len(fact.Amounts()))
+			t.Equal(NewBig(6), fact.Amounts()[0].Big())
+		}
+	}
+
+	t.Equal(1, runs)
+	t.Equal(1, fees)
+}
+
+func (t *testScheduleOperations) TestRunDueClosedReceiver() {
+	sa, st0 := t.newAccount(true, nil)
+	ra, st1 := t.newAccount(true, nil)
+	st1 = t.closeAccountState(ra.Address, st1)
+
+	pool, _ := t.statepool(st0, st1)
+
+	sc := NewPaymentSchedule(valuehash.RandomSHA256(),
+		sa.Address, ra.Address, NewAmount(NewBig(10), t.cid), pool.Height(), 5, 3)
+
+	pool, _ = t.statepool(st0, st1, []state.State{t.newStateSchedule(sc), t.newStateScheduleQueue(sc)})
+
+	opr := t.processor(nil, pool)
+	t.NoError(opr.Close())
+
+	var sst, rst, scst, qst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyBalance(ra.Address, t.cid):
+			rst = st.GetState()
+		case StateKeySchedule(sc.ID()):
+			scst = st.GetState()
+		case StateKeyScheduleQueue:
+			qst = st.GetState()
+		}
+	}
+
+	t.Nil(rst)
+
+	// NOTE the reserved amount is returned to sender
+	sb, err := StateBalanceValue(sst)
+	t.NoError(err)
+	t.True(NewBig(30).Equal(sb.Big()))
+
+	usc, err := StateScheduleValue(scst)
+	t.NoError(err)
+	t.Equal(ScheduleStatusFinished, usc.Status())
+
+	sq, err := StateScheduleQueueValue(qst)
+	t.NoError(err)
+	t.Empty(sq.Items())
+}
+
+func TestScheduleOperations(t *testing.T) {
+	suite.Run(t, new(testScheduleOperations))
+}
@@ -0,0 +1,247 @@
+package currency
+
+import (
+	"time"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	ScheduleRunType                = hint.Type("mitum-currency-schedule-run")
+	ScheduleRunHint                = hint.NewHint(ScheduleRunType, "v0.0.1")
+	ScheduleRunHinter              = ScheduleRun{BaseHinter: hint.NewBaseHinter(ScheduleRunHint)}
+	ScheduleRunOperationFactType   = hint.Type("mitum-currency-schedule-run-operation-fact")
+	ScheduleRunOperationFactHint   = hint.NewHint(ScheduleRunOperationFactType, "v0.0.1")
+	ScheduleRunOperationFactHinter = ScheduleRunOperationFact{
+		BaseHinter: hint.NewBaseHinter(ScheduleRunOperationFactHint),
+	}
+	ScheduleRunOperationType   = hint.Type("mitum-currency-schedule-run-operation")
+	ScheduleRunOperationHint   = hint.NewHint(ScheduleRunOperationType, "v0.0.1")
+	ScheduleRunOperationHinter = ScheduleRunOperation{BaseHinter: hint.NewBaseHinter(ScheduleRunOperationHint)}
+)
+
+// ScheduleRun is the single run of PaymentSchedule. The height is the height,
+// when the run was due; the run can be processed later, if no block was
+// processed at the due height.
+type ScheduleRun struct {
+	hint.BaseHinter
+	schedule valuehash.Hash
+	sender   base.Address
+	receiver base.Address
+	amount   Amount
+	height   base.Height
+}
+
+func NewScheduleRun(
+	schedule valuehash.Hash,
+	sender, receiver base.Address,
+	amount Amount,
+	height base.Height,
+) ScheduleRun {
+	return ScheduleRun{
+		BaseHinter: hint.NewBaseHinter(ScheduleRunHint),
+		schedule:   schedule,
+		sender:     sender,
+		receiver:   receiver,
+		amount:     amount,
+		height:     height,
+	}
+}
+
+func (sr ScheduleRun) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		sr.schedule.Bytes(),
+		sr.sender.Bytes(),
+		sr.receiver.Bytes(),
+		sr.amount.Bytes(),
+		sr.height.Bytes(),
+	)
+}
+
+func (sr ScheduleRun) IsValid([]byte) error {
+	return isvalid.Check(nil, false,
+		sr.BaseHinter,
+		sr.schedule,
+		sr.sender,
+		sr.receiver,
+		sr.amount,
+	)
+}
+
+func (sr ScheduleRun) Schedule() valuehash.Hash {
+	return sr.schedule
+}
+
+func (sr ScheduleRun) Sender() base.Address {
+	return sr.sender
+}
+
+// Receiver is the receiver of the amount. When the receiver of schedule is
+// closed, the reserved amount is returned to the sender, so the receiver is
+// the sender.
+func (sr ScheduleRun) Receiver() base.Address {
+	return sr.receiver
+}
+
+func (sr ScheduleRun) Amount() Amount {
+	return sr.amount
+}
+
+func (sr ScheduleRun) Height() base.Height {
+	return sr.height
+}
+
+// ScheduleRunOperationFact has the runs of the due payment schedules in the
+// block. Like FeeOperation, ScheduleRunOperation is not signed; it is made by
+// OperationProcessor.
+type ScheduleRunOperationFact struct {
+	hint.BaseHinter
+	h     valuehash.Hash
+	token []byte
+	runs  []ScheduleRun
+}
+
+func NewScheduleRunOperationFact(height base.Height, runs []ScheduleRun) ScheduleRunOperationFact {
+	fact := ScheduleRunOperationFact{
+		BaseHinter: hint.NewBaseHinter(ScheduleRunOperationFactHint),
+		token:      height.Bytes(),
+		runs:       runs,
+	}
+	fact.h = valuehash.NewSHA256(fact.Bytes())
+
+	return fact
+}
+
+func (fact ScheduleRunOperationFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact ScheduleRunOperationFact) Bytes() []byte {
+	bs := make([][]byte, len(fact.runs)+1)
+	bs[0] = fact.token
+
+	for i := range fact.runs {
+		bs[i+1] = fact.runs[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (fact ScheduleRunOperationFact) IsValid([]byte) error {
+	if len(fact.token) < 1 {
+		return isvalid.InvalidError.Errorf("empty token for ScheduleRunOperationFact")
+	}
+
+	if err := isvalid.Check(nil, false, fact.h); err != nil {
+		return err
+	}
+
+	if len(fact.runs) < 1 {
+		return isvalid.InvalidError.Errorf("empty runs for ScheduleRunOperationFact")
+	}
+
+	for i := range fact.runs {
+		if err := fact.runs[i].IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (fact ScheduleRunOperationFact) Token() []byte {
+	return fact.token
+}
+
+func (fact ScheduleRunOperationFact) Runs() []ScheduleRun {
+	return fact.runs
+}
+
+func (fact ScheduleRunOperationFact) Addresses() ([]base.Address, error) {
+	var as []base.Address
+	founds := map[string]struct{}{}
+	for i := range fact.runs {
+		for _, a := range []base.Address{fact.runs[i].sender, fact.runs[i].receiver} {
+			if _, found := founds[a.String()]; found {
+				continue
+			}
+
+			founds[a.String()] = struct{}{}
+			as = append(as, a)
+		}
+	}
+
+	return as, nil
+}
+
+type ScheduleRunOperation struct {
+	hint.BaseHinter
+	fact ScheduleRunOperationFact
+	h    valuehash.Hash
+}
+
+func NewScheduleRunOperation(fact ScheduleRunOperationFact) ScheduleRunOperation {
+	op := ScheduleRunOperation{BaseHinter: hint.NewBaseHinter(ScheduleRunOperationHint), fact: fact}
+	op.h = op.GenerateHash()
+
+	return op
+}
+
+func (op ScheduleRunOperation) Fact() base.Fact {
+	return op.fact
+}
+
+func (op ScheduleRunOperation) Hash() valuehash.Hash {
+	return op.h
+}
+
+func (ScheduleRunOperation) Signs() []base.FactSign {
+	return nil
+}
+
+func (op ScheduleRunOperation) IsValid([]byte) error {
+	if err := isvalid.Check(nil, false, op.BaseHinter, op.h); err != nil {
+		return err
+	}
+
+	if l := len(op.fact.Token()); l < 1 {
+		return isvalid.InvalidError.Errorf("ScheduleRunOperation has empty token")
+	} else if l > operation.MaxTokenSize {
+		return isvalid.InvalidError.Errorf("ScheduleRunOperation token size too large: %d > %d", l, operation.MaxTokenSize)
+	}
+
+	if err := op.fact.IsValid(nil); err != nil {
+		return err
+	}
+
+	if !op.Hash().Equal(op.GenerateHash()) {
+		return isvalid.InvalidError.Errorf("wrong ScheduleRunOperation hash")
+	}
+
+	return nil
+}
+
+func (op ScheduleRunOperation) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(op.Fact().Hash().Bytes())
+}
+
+func (ScheduleRunOperation) AddFactSigns(...base.FactSign) (base.FactSignUpdater, error) {
+	return nil, nil
+}
+
+func (ScheduleRunOperation) LastSignedAt() time.Time {
+	return time.Time{}
+}
+
+func (ScheduleRunOperation) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
@@ -0,0 +1,88 @@
+package currency
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (sr ScheduleRun) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(sr.Hint()),
+		bson.M{
+			"schedule": sr.schedule,
+			"sender":   sr.sender,
+			"receiver": sr.receiver,
+			"amount":   sr.amount,
+			"height":   sr.height,
+		},
+	))
+}
+
+type ScheduleRunBSONUnpacker struct {
+	SC valuehash.Bytes     `bson:"schedule"`
+	SD base.AddressDecoder `bson:"sender"`
+	RC base.AddressDecoder `bson:"receiver"`
+	AM Amount              `bson:"amount"`
+	HT base.Height         `bson:"height"`
+}
+
+func (sr *ScheduleRun) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var usr ScheduleRunBSONUnpacker
+	if err := enc.Unmarshal(b, &usr); err != nil {
+		return err
+	}
+
+	return sr.unpack(enc, usr.SC, usr.SD, usr.RC, usr.AM, usr.HT)
+}
+
+func (fact ScheduleRunOperationFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(fact.Hint()),
+			bson.M{
+				"hash":  fact.h,
+				"token": fact.token,
+				"runs":  fact.runs,
+			}))
+}
+
+type ScheduleRunOperationFactBSONUnpacker struct {
+	H  valuehash.Bytes `bson:"hash"`
+	TK []byte          `bson:"token"`
+	RS bson.Raw        `bson:"runs"`
+}
+
+func (fact *ScheduleRunOperationFact) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uft ScheduleRunOperationFactBSONUnpacker
+	if err := enc.Unmarshal(b, &uft); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, uft.H, uft.TK, uft.RS)
+}
+
+func (op ScheduleRunOperation) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(op.Hint()),
+		bson.M{
+			"hash": op.h,
+			"fact": op.fact,
+		},
+	))
+}
+
+type ScheduleRunOperationBSONUnpacker struct {
+	H  valuehash.Bytes `bson:"hash"`
+	FC bson.Raw        `bson:"fact"`
+}
+
+func (op *ScheduleRunOperation) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var upo ScheduleRunOperationBSONUnpacker
+	if err := enc.Unmarshal(b, &upo); err != nil {
+		return err
+	}
+
+	return op.unpack(enc, upo.H, upo.FC)
+}
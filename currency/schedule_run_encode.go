@@ -0,0 +1,73 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (sr *ScheduleRun) unpack(
+	enc encoder.Encoder,
+	schedule valuehash.Hash,
+	bsender,
+	breceiver base.AddressDecoder,
+	am Amount,
+	height base.Height,
+) error {
+	sender, err := bsender.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	receiver, err := breceiver.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	sr.schedule = schedule
+	sr.sender = sender
+	sr.receiver = receiver
+	sr.amount = am
+	sr.height = height
+
+	return nil
+}
+
+func (fact *ScheduleRunOperationFact) unpack(
+	enc encoder.Encoder,
+	h valuehash.Hash,
+	token []byte,
+	brs []byte,
+) error {
+	hrs, err := enc.DecodeSlice(brs)
+	if err != nil {
+		return err
+	}
+
+	runs := make([]ScheduleRun, len(hrs))
+	for i := range hrs {
+		j, ok := hrs[i].(ScheduleRun)
+		if !ok {
+			return util.WrongTypeError.Errorf("expected ScheduleRun, not %T", hrs[i])
+		}
+
+		runs[i] = j
+	}
+
+	fact.h = h
+	fact.token = token
+	fact.runs = runs
+
+	return nil
+}
+
+func (op *ScheduleRunOperation) unpack(enc encoder.Encoder, h valuehash.Hash, bfact []byte) error {
+	if err := encoder.Decode(bfact, enc, &op.fact); err != nil {
+		return err
+	}
+
+	op.h = h
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type ScheduleRunJSONPacker struct {
+	jsonenc.HintedHead
+	SC valuehash.Hash `json:"schedule"`
+	SD base.Address   `json:"sender"`
+	RC base.Address   `json:"receiver"`
+	AM Amount         `json:"amount"`
+	HT base.Height    `json:"height"`
+}
+
+func (sr ScheduleRun) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(ScheduleRunJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(sr.Hint()),
+		SC:         sr.schedule,
+		SD:         sr.sender,
+		RC:         sr.receiver,
+		AM:         sr.amount,
+		HT:         sr.height,
+	})
+}
+
+type ScheduleRunJSONUnpacker struct {
+	SC valuehash.Bytes     `json:"schedule"`
+	SD base.AddressDecoder `json:"sender"`
+	RC base.AddressDecoder `json:"receiver"`
+	AM Amount              `json:"amount"`
+	HT base.Height         `json:"height"`
+}
+
+func (sr *ScheduleRun) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var usr ScheduleRunJSONUnpacker
+	if err := enc.Unmarshal(b, &usr); err != nil {
+		return err
+	}
+
+	return sr.unpack(enc, usr.SC, usr.SD, usr.RC, usr.AM, usr.HT)
+}
+
+type ScheduleRunOperationFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	RS []ScheduleRun  `json:"runs"`
+}
+
+func (fact ScheduleRunOperationFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(ScheduleRunOperationFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		RS:         fact.runs,
+	})
+}
+
+type ScheduleRunOperationFactJSONUnpacker struct {
+	H  valuehash.Bytes `json:"hash"`
+	TK []byte          `json:"token"`
+	RS json.RawMessage `json:"runs"`
+}
+
+func (fact *ScheduleRunOperationFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uft ScheduleRunOperationFactJSONUnpacker
+	if err := enc.Unmarshal(b, &uft); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, uft.H, uft.TK, uft.RS)
+}
+
+type ScheduleRunOperationJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash           `json:"hash"`
+	FT ScheduleRunOperationFact `json:"fact"`
+}
+
+func (op ScheduleRunOperation) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(ScheduleRunOperationJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(op.Hint()),
+		H:          op.h,
+		FT:         op.fact,
+	})
+}
+
+type ScheduleRunOperationJSONUnpacker struct {
+	H  valuehash.Bytes `json:"hash"`
+	FT json.RawMessage `json:"fact"`
+}
+
+func (op *ScheduleRunOperation) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var upo ScheduleRunOperationJSONUnpacker
+	if err := enc.Unmarshal(b, &upo); err != nil {
+		return err
+	}
+
+	return op.unpack(enc, upo.H, upo.FT)
+}
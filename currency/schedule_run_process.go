@@ -0,0 +1,214 @@
+package currency
+
+import (
+	"bytes"
+
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/storage"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type scheduleRunner struct {
+	pool    *storage.Statepool
+	updates map[string]*state.StateUpdater
+}
+
+func newScheduleRunner(pool *storage.Statepool) *scheduleRunner {
+	us := pool.Updates()
+
+	updates := map[string]*state.StateUpdater{}
+	for i := range us {
+		updates[us[i].Key()] = us[i]
+	}
+
+	return &scheduleRunner{pool: pool, updates: updates}
+}
+
+// runSchedules updates the queue of payment schedules by the schedules, which
+// are created or cancelled in the block, and runs the due schedules. The runs
+// are added to the block as one ScheduleRunOperation. It is called once for the
+// block, after all the operations of the block are processed.
+//
+// NOTE mitum does not process the block without operations, so the schedules,
+// which were due at the empty blocks, are run at the next block, which has
+// operations; the runs keep their due heights.
+func runSchedules(pool *storage.Statepool) error {
+	return newScheduleRunner(pool).run()
+}
+
+func (sr *scheduleRunner) run() error {
+	qst, found, err := sr.getState(StateKeyScheduleQueue)
+	if err != nil {
+		return err
+	}
+
+	sq := NewScheduleQueue(nil)
+	if found {
+		if sq, err = StateScheduleQueueValue(qst); err != nil {
+			return err
+		}
+	}
+
+	previous := sq.Bytes()
+
+	sq, facts, err := sr.merge(sq)
+	if err != nil {
+		return err
+	}
+
+	sq, runs, sts, err := sr.runDue(sq)
+	if err != nil {
+		return err
+	}
+
+	var op ScheduleRunOperation
+	if len(runs) > 0 {
+		op = NewScheduleRunOperation(NewScheduleRunOperationFact(sr.pool.Height(), runs))
+		facts = append(facts, op.Fact().Hash())
+
+		if err := sr.pool.Set(op.Fact().Hash(), sts...); err != nil {
+			return err
+		}
+	}
+
+	if !bytes.Equal(previous, sq.Bytes()) {
+		nqst, err := SetStateScheduleQueueValue(qst, sq)
+		if err != nil {
+			return err
+		}
+
+		for i := range facts {
+			if err := sr.pool.Set(facts[i], nqst); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(runs) > 0 {
+		sr.pool.AddOperations(op)
+	}
+
+	return nil
+}
+
+// merge adds the new schedules to queue and removes the cancelled ones from
+// queue. It returns the fact hashes of the operations, which changed the
+// schedules.
+func (sr *scheduleRunner) merge(sq ScheduleQueue) (ScheduleQueue, []valuehash.Hash, error) {
+	var facts []valuehash.Hash
+
+	for _, su := range sr.pool.Updates() {
+		if !IsStateScheduleKey(su.Key()) {
+			continue
+		}
+
+		sc, err := StateScheduleValue(su.GetState())
+		if err != nil {
+			return sq, nil, err
+		}
+
+		switch exists := sq.Exists(sc.ID()); {
+		case sc.IsActive() && !exists:
+			sq = sq.Set(NewScheduleQueueItem(sc.ID(), sc.Next()))
+		case !sc.IsActive() && exists:
+			sq = sq.Remove(sc.ID())
+		default:
+			continue
+		}
+
+		if ops := su.Operations(); len(ops) > 0 {
+			facts = append(facts, ops[len(ops)-1])
+		}
+	}
+
+	return sq, facts, nil
+}
+
+func (sr *scheduleRunner) runDue(sq ScheduleQueue) (ScheduleQueue, []ScheduleRun, []state.State, error) {
+	height := sr.pool.Height()
+
+	var runs []ScheduleRun
+	var sts []state.State
+
+	for _, it := range sq.Due(height) {
+		st, found, err := sr.getState(StateKeySchedule(it.ID()))
+		if err != nil {
+			return sq, nil, nil, err
+		}
+
+		if !found {
+			sq = sq.Remove(it.ID())
+
+			continue
+		}
+
+		sc, err := StateScheduleValue(st)
+		if err != nil {
+			return sq, nil, nil, err
+		}
+
+		if !sc.IsActive() {
+			sq = sq.Remove(it.ID())
+
+			continue
+		}
+
+		if err := checkNotClosedState(sc.Receiver(), sr.getState); err != nil {
+			// NOTE the receiver is closed; the reserved amount is returned to
+			// the sender.
+			runs = append(runs, NewScheduleRun(
+				sc.ID(), sc.Sender(), sc.Sender(), sc.Amount().WithBig(sc.Reserved()), sc.Next()))
+
+			sc = sc.SetStatus(ScheduleStatusFinished)
+		} else {
+			for sc.IsActive() && sc.Next() <= height {
+				runs = append(runs, NewScheduleRun(sc.ID(), sc.Sender(), sc.Receiver(), sc.Amount(), sc.Next()))
+
+				sc = sc.Run()
+			}
+		}
+
+		nst, err := SetStateScheduleValue(st, sc)
+		if err != nil {
+			return sq, nil, nil, err
+		}
+		sts = append(sts, nst)
+
+		if sc.IsActive() {
+			sq = sq.Set(NewScheduleQueueItem(sc.ID(), sc.Next()))
+
+			continue
+		}
+
+		sq = sq.Remove(sc.ID())
+
+		ist, _, err := sr.pool.Get(StateKeyAccountIndex(sc.Sender()))
+		if err != nil {
+			return sq, nil, nil, err
+		}
+		sts = append(sts, NewAccountIndexState(ist).Remove(nst.Key()))
+	}
+
+	for i := range runs {
+		r := runs[i]
+
+		cid := r.Amount().Currency()
+		bst, _, err := sr.pool.Get(StateKeyBalance(r.Receiver(), cid))
+		if err != nil {
+			return sq, nil, nil, err
+		}
+
+		sts = append(sts, NewAmountState(bst, cid).Add(r.Amount().Big()))
+	}
+
+	return sq, runs, sts, nil
+}
+
+// getState returns the state, which may be updated in the block.
+func (sr *scheduleRunner) getState(key string) (state.State, bool, error) {
+	if su, found := sr.updates[key]; found {
+		return su.GetState(), true, nil
+	}
+
+	return sr.pool.Get(key)
+}
@@ -0,0 +1,328 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type testSchedule struct {
+	baseTest
+}
+
+func (t *testSchedule) TestNewSchedulePayment() {
+	fact := NewSchedulePaymentFact(util.UUID().Bytes(),
+		NewTestAddress(), NewTestAddress(), NewAmount(NewBig(10), t.cid), base.Height(10), 3, 4)
+	t.NoError(fact.IsValid(nil))
+
+	as, err := fact.Addresses()
+	t.NoError(err)
+	t.Equal(2, len(as))
+}
+
+func (t *testSchedule) TestSchedulePaymentSameAddresses() {
+	sender := NewTestAddress()
+
+	fact := NewSchedulePaymentFact(util.UUID().Bytes(),
+		sender, sender, NewAmount(NewBig(10), t.cid), base.Height(10), 3, 4)
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "receiver is same with sender")
+}
+
+func (t *testSchedule) TestSchedulePaymentWrongStart() {
+	fact := NewSchedulePaymentFact(util.UUID().Bytes(),
+		NewTestAddress(), NewTestAddress(), NewAmount(NewBig(10), t.cid), base.GenesisHeight, 3, 4)
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "start height")
+}
+
+func (t *testSchedule) TestSchedulePaymentWrongIntervalAndCount() {
+	fact := NewSchedulePaymentFact(util.UUID().Bytes(),
+		NewTestAddress(), NewTestAddress(), NewAmount(NewBig(10), t.cid), base.Height(10), 0, 4)
+	err := fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "interval")
+
+	fact = NewSchedulePaymentFact(util.UUID().Bytes(),
+		NewTestAddress(), NewTestAddress(), NewAmount(NewBig(10), t.cid), base.Height(10), 3, 0)
+	err = fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "count")
+
+	fact = NewSchedulePaymentFact(util.UUID().Bytes(),
+		NewTestAddress(), NewTestAddress(), NewAmount(NewBig(10), t.cid), base.Height(10), 3, MaxScheduleCount+1)
+	err = fact.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "count")
+}
+
+func (t *testSchedule) TestRun() {
+	sc := NewPaymentSchedule(valuehash.RandomSHA256(),
+		NewTestAddress(), NewTestAddress(), NewAmount(NewBig(10), t.cid), base.Height(10), 3, 2)
+	t.NoError(sc.IsValid(nil))
+	t.True(NewBig(20).Equal(sc.Reserved()))
+
+	nsc := sc.Run()
+	t.True(nsc.IsActive())
+	t.Equal(uint(1), nsc.Remaining())
+	t.Equal(base.Height(13), nsc.Next())
+	t.True(NewBig(10).Equal(nsc.Reserved()))
+
+	nsc = nsc.Run()
+	t.False(nsc.IsActive())
+	t.Equal(ScheduleStatusFinished, nsc.Status())
+	t.True(ZeroBig.Equal(nsc.Reserved()))
+
+	t.True(sc.IsActive())
+	t.Equal(uint(2), sc.Remaining())
+}
+
+func (t *testSchedule) TestQueue() {
+	a := valuehash.RandomSHA256()
+	b := valuehash.RandomSHA256()
+	c := valuehash.RandomSHA256()
+
+	sq := NewScheduleQueue([]ScheduleQueueItem{
+		NewScheduleQueueItem(a, base.Height(30)),
+		NewScheduleQueueItem(b, base.Height(10)),
+	})
+	t.NoError(sq.IsValid(nil))
+
+	sq = sq.Set(NewScheduleQueueItem(c, base.Height(20)))
+	t.Equal(3, len(sq.Items()))
+	t.True(sq.Items()[0].ID().Equal(b))
+	t.True(sq.Items()[1].ID().Equal(c))
+	t.True(sq.Items()[2].ID().Equal(a))
+
+	due := sq.Due(base.Height(20))
+	t.Equal(2, len(due))
+
+	sq = sq.Set(NewScheduleQueueItem(b, base.Height(40)))
+	t.Equal(3, len(sq.Items()))
+	t.True(sq.Items()[2].ID().Equal(b))
+
+	sq = sq.Remove(c)
+	t.Equal(2, len(sq.Items()))
+	t.False(sq.Exists(c))
+	t.True(sq.Exists(a))
+}
+
+func (t *testSchedule) TestQueueDuplicated() {
+	a := valuehash.RandomSHA256()
+
+	sq := NewScheduleQueue([]ScheduleQueueItem{
+		NewScheduleQueueItem(a, base.Height(30)),
+		NewScheduleQueueItem(a, base.Height(10)),
+	})
+
+	err := sq.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "duplicated schedule")
+}
+
+func TestSchedule(t *testing.T) {
+	suite.Run(t, new(testSchedule))
+}
+
+func testPaymentScheduleEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		return NewPaymentSchedule(valuehash.RandomSHA256(),
+			NewTestAddress(), NewTestAddress(), NewAmount(NewBig(10), CurrencyID("SHOWME")), base.Height(10), 3, 4,
+		).Run()
+	}
+
+	t.compare = func(a, b interface{}) {
+		sa := a.(PaymentSchedule)
+		sb := b.(PaymentSchedule)
+
+		t.True(sa.Hint().Equal(sb.Hint()))
+		t.True(sa.ID().Equal(sb.ID()))
+		t.True(sa.Sender().Equal(sb.Sender()))
+		t.True(sa.Receiver().Equal(sb.Receiver()))
+		t.True(sa.Amount().Equal(sb.Amount()))
+		t.Equal(sa.Interval(), sb.Interval())
+		t.Equal(sa.Remaining(), sb.Remaining())
+		t.Equal(sa.Next(), sb.Next())
+		t.Equal(sa.Status(), sb.Status())
+		t.True(sa.Hash().Equal(sb.Hash()))
+	}
+
+	return t
+}
+
+func TestPaymentScheduleEncodeJSON(t *testing.T) {
+	suite.Run(t, testPaymentScheduleEncode(jsonenc.NewEncoder()))
+}
+
+func TestPaymentScheduleEncodeBSON(t *testing.T) {
+	suite.Run(t, testPaymentScheduleEncode(bsonenc.NewEncoder()))
+}
+
+func testScheduleQueueEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		return NewScheduleQueue([]ScheduleQueueItem{
+			NewScheduleQueueItem(valuehash.RandomSHA256(), base.Height(30)),
+			NewScheduleQueueItem(valuehash.RandomSHA256(), base.Height(10)),
+		})
+	}
+
+	t.compare = func(a, b interface{}) {
+		qa := a.(ScheduleQueue)
+		qb := b.(ScheduleQueue)
+
+		t.True(qa.Hint().Equal(qb.Hint()))
+		t.Equal(len(qa.Items()), len(qb.Items()))
+		t.True(qa.Hash().Equal(qb.Hash()))
+	}
+
+	return t
+}
+
+func TestScheduleQueueEncodeJSON(t *testing.T) {
+	suite.Run(t, testScheduleQueueEncode(jsonenc.NewEncoder()))
+}
+
+func TestScheduleQueueEncodeBSON(t *testing.T) {
+	suite.Run(t, testScheduleQueueEncode(bsonenc.NewEncoder()))
+}
+
+func testSchedulePaymentEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.NewBasePrivatekey()
+
+		fact := NewSchedulePaymentFact(util.UUID().Bytes(),
+			NewTestAddress(), NewTestAddress(), NewAmount(NewBig(10), CurrencyID("SHOWME")), base.Height(10), 3, 4)
+		sig, err := base.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		op, err := NewSchedulePayment(fact, []base.FactSign{base.NewBaseFactSign(pk.Publickey(), sig)}, "")
+		t.NoError(err)
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		fact := a.(SchedulePayment).Fact().(SchedulePaymentFact)
+		ufact := b.(SchedulePayment).Fact().(SchedulePaymentFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.receiver.Equal(ufact.receiver))
+		t.True(fact.amount.Equal(ufact.amount))
+		t.Equal(fact.start, ufact.start)
+		t.Equal(fact.interval, ufact.interval)
+		t.Equal(fact.count, ufact.count)
+	}
+
+	return t
+}
+
+func TestSchedulePaymentEncodeJSON(t *testing.T) {
+	suite.Run(t, testSchedulePaymentEncode(jsonenc.NewEncoder()))
+}
+
+func TestSchedulePaymentEncodeBSON(t *testing.T) {
+	suite.Run(t, testSchedulePaymentEncode(bsonenc.NewEncoder()))
+}
+
+func testCancelScheduleEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		pk := key.NewBasePrivatekey()
+
+		fact := NewCancelScheduleFact(util.UUID().Bytes(), NewTestAddress(), valuehash.RandomSHA256())
+		sig, err := base.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		op, err := NewCancelSchedule(fact, []base.FactSign{base.NewBaseFactSign(pk.Publickey(), sig)}, "")
+		t.NoError(err)
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		fact := a.(CancelSchedule).Fact().(CancelScheduleFact)
+		ufact := b.(CancelSchedule).Fact().(CancelScheduleFact)
+
+		t.True(fact.sender.Equal(ufact.sender))
+		t.True(fact.schedule.Equal(ufact.schedule))
+	}
+
+	return t
+}
+
+func TestCancelScheduleEncodeJSON(t *testing.T) {
+	suite.Run(t, testCancelScheduleEncode(jsonenc.NewEncoder()))
+}
+
+func TestCancelScheduleEncodeBSON(t *testing.T) {
+	suite.Run(t, testCancelScheduleEncode(bsonenc.NewEncoder()))
+}
+
+func testScheduleRunOperationEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		am := NewAmount(NewBig(10), CurrencyID("SHOWME"))
+
+		op := NewScheduleRunOperation(NewScheduleRunOperationFact(base.Height(33), []ScheduleRun{
+			NewScheduleRun(valuehash.RandomSHA256(), NewTestAddress(), NewTestAddress(), am, base.Height(32)),
+			NewScheduleRun(valuehash.RandomSHA256(), NewTestAddress(), NewTestAddress(), am, base.Height(33)),
+		}))
+		t.NoError(op.IsValid(nil))
+
+		return op
+	}
+
+	t.compare = func(a, b interface{}) {
+		fact := a.(ScheduleRunOperation).Fact().(ScheduleRunOperationFact)
+		ufact := b.(ScheduleRunOperation).Fact().(ScheduleRunOperationFact)
+
+		t.Equal(len(fact.Runs()), len(ufact.Runs()))
+		for i := range fact.Runs() {
+			r := fact.Runs()[i]
+			ur := ufact.Runs()[i]
+
+			t.True(r.Schedule().Equal(ur.Schedule()))
+			t.True(r.Sender().Equal(ur.Sender()))
+			t.True(r.Receiver().Equal(ur.Receiver()))
+			t.True(r.Amount().Equal(ur.Amount()))
+			t.Equal(r.Height(), ur.Height())
+		}
+	}
+
+	return t
+}
+
+func TestScheduleRunOperationEncodeJSON(t *testing.T) {
+	suite.Run(t, testScheduleRunOperationEncode(jsonenc.NewEncoder()))
+}
+
+func TestScheduleRunOperationEncodeBSON(t *testing.T) {
+	suite.Run(t, testScheduleRunOperationEncode(bsonenc.NewEncoder()))
+}
@@ -49,3 +49,47 @@ func checkFactSignsByState(
 
 	return nil
 }
+
+// checkFactSignsByStates checks the signs pass the thresholds of all the
+// accounts; each sign should be signed by the key of one of the accounts.
+func checkFactSignsByStates(
+	addresses []base.Address,
+	fs []base.FactSign,
+	getState func(string) (state.State, bool, error),
+) error {
+	known := make([]bool, len(fs))
+
+	for i := range addresses {
+		st, err := existsState(StateKeyAccount(addresses[i]), "keys of account", getState)
+		if err != nil {
+			return err
+		}
+		keys, err := StateKeysValue(st)
+		switch {
+		case err != nil:
+			return operation.NewBaseReasonErrorFromError(err)
+		case keys == nil:
+			return operation.NewBaseReasonError("empty keys found")
+		}
+
+		var afs []base.FactSign
+		for j := range fs {
+			if _, found := keys.Key(fs[j].Signer()); found {
+				afs = append(afs, fs[j])
+				known[j] = true
+			}
+		}
+
+		if err := checkThreshold(afs, keys); err != nil {
+			return operation.NewBaseReasonError("account, %q: %w", addresses[i], err)
+		}
+	}
+
+	for i := range known {
+		if !known[i] {
+			return operation.NewBaseReasonError("unknown key found, %s", fs[i].Signer())
+		}
+	}
+
+	return nil
+}
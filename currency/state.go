@@ -10,12 +10,24 @@ import (
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/base/state"
 	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
 )
 
 var (
-	StateKeyAccountSuffix        = ":account"
-	StateKeyBalanceSuffix        = ":balance"
-	StateKeyCurrencyDesignPrefix = "currencydesign:"
+	StateKeyAccountSuffix         = ":account"
+	StateKeyBalanceSuffix         = ":balance"
+	StateKeyLockedBalanceSuffix   = ":lockedbalance"
+	StateKeyCurrencyDesignPrefix  = "currencydesign:"
+	StateKeyEscrowPrefix          = "escrow:"
+	StateKeyAllowanceSuffix       = ":allowance"
+	StateKeyAliasPrefix           = "alias:"
+	StateKeyAccountMetadataSuffix = ":metadata"
+	StateKeyMemoPolicy            = "memopolicy"
+	StateKeyAccountPolicy         = "accountpolicy"
+	StateKeySchedulePrefix        = "schedule:"
+	StateKeyScheduleQueue         = "schedulequeue"
+	StateKeyHTLCPrefix            = "htlc:"
+	StateKeyAccountIndexSuffix    = ":index"
 )
 
 func StateBalanceKeyPrefix(a base.Address, cid CurrencyID) string {
@@ -113,6 +125,35 @@ func SetStateBalanceValue(st state.State, v Amount) (state.State, error) {
 	return st.SetValue(uv)
 }
 
+func StateKeyLockedBalance(a base.Address, cid CurrencyID) string {
+	return fmt.Sprintf("%s%s", StateBalanceKeyPrefix(a, cid), StateKeyLockedBalanceSuffix)
+}
+
+func IsStateLockedBalanceKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyLockedBalanceSuffix)
+}
+
+func StateLockedBalanceValue(st state.State) (LockedAmount, error) {
+	v := st.Value()
+	if v == nil {
+		return LockedAmount{}, util.NotFoundError.Errorf("locked balance not found in State")
+	}
+
+	s, ok := v.Interface().(LockedAmount)
+	if !ok {
+		return LockedAmount{}, errors.Errorf("invalid locked balance value found, %T", v.Interface())
+	}
+	return s, nil
+}
+
+func SetStateLockedBalanceValue(st state.State, v LockedAmount) (state.State, error) {
+	uv, err := state.NewHintedValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return st.SetValue(uv)
+}
+
 func IsStateCurrencyDesignKey(key string) bool {
 	return strings.HasPrefix(key, StateKeyCurrencyDesignPrefix)
 }
@@ -142,6 +183,284 @@ func SetStateCurrencyDesignValue(st state.State, v CurrencyDesign) (state.State,
 	return st.SetValue(uv)
 }
 
+func StateKeyEscrow(id valuehash.Hash) string {
+	return fmt.Sprintf("%s%s", StateKeyEscrowPrefix, id.String())
+}
+
+func IsStateEscrowKey(key string) bool {
+	return strings.HasPrefix(key, StateKeyEscrowPrefix)
+}
+
+func StateEscrowValue(st state.State) (Escrow, error) {
+	v := st.Value()
+	if v == nil {
+		return Escrow{}, util.NotFoundError.Errorf("escrow not found in State")
+	}
+
+	s, ok := v.Interface().(Escrow)
+	if !ok {
+		return Escrow{}, errors.Errorf("invalid escrow value found, %T", v.Interface())
+	}
+	return s, nil
+}
+
+func SetStateEscrowValue(st state.State, v Escrow) (state.State, error) {
+	uv, err := state.NewHintedValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return st.SetValue(uv)
+}
+
+func StateKeyAllowance(owner, spender base.Address, cid CurrencyID) string {
+	return fmt.Sprintf("%s-%s-%s%s", owner.String(), spender.String(), cid, StateKeyAllowanceSuffix)
+}
+
+func IsStateAllowanceKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyAllowanceSuffix)
+}
+
+func StateAllowanceValue(st state.State) (Allowance, error) {
+	v := st.Value()
+	if v == nil {
+		return Allowance{}, util.NotFoundError.Errorf("allowance not found in State")
+	}
+
+	s, ok := v.Interface().(Allowance)
+	if !ok {
+		return Allowance{}, errors.Errorf("invalid allowance value found, %T", v.Interface())
+	}
+	return s, nil
+}
+
+func SetStateAllowanceValue(st state.State, v Allowance) (state.State, error) {
+	uv, err := state.NewHintedValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return st.SetValue(uv)
+}
+
+func StateKeyAlias(name string) string {
+	return fmt.Sprintf("%s%s", StateKeyAliasPrefix, name)
+}
+
+func IsStateAliasKey(key string) bool {
+	return strings.HasPrefix(key, StateKeyAliasPrefix)
+}
+
+func StateAliasValue(st state.State) (Alias, error) {
+	v := st.Value()
+	if v == nil {
+		return Alias{}, util.NotFoundError.Errorf("alias not found in State")
+	}
+
+	s, ok := v.Interface().(Alias)
+	if !ok {
+		return Alias{}, errors.Errorf("invalid alias value found, %T", v.Interface())
+	}
+	return s, nil
+}
+
+func SetStateAliasValue(st state.State, v Alias) (state.State, error) {
+	uv, err := state.NewHintedValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return st.SetValue(uv)
+}
+
+func StateKeyAccountMetadata(a base.Address) string {
+	return fmt.Sprintf("%s%s", a.String(), StateKeyAccountMetadataSuffix)
+}
+
+func IsStateAccountMetadataKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyAccountMetadataSuffix)
+}
+
+func StateAccountMetadataValue(st state.State) (AccountMetadata, error) {
+	v := st.Value()
+	if v == nil {
+		return AccountMetadata{}, util.NotFoundError.Errorf("account metadata not found in State")
+	}
+
+	s, ok := v.Interface().(AccountMetadata)
+	if !ok {
+		return AccountMetadata{}, errors.Errorf("invalid account metadata value found, %T", v.Interface())
+	}
+	return s, nil
+}
+
+func SetStateAccountMetadataValue(st state.State, v AccountMetadata) (state.State, error) {
+	uv, err := state.NewHintedValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return st.SetValue(uv)
+}
+
+func IsStateMemoPolicyKey(key string) bool {
+	return key == StateKeyMemoPolicy
+}
+
+func StateMemoPolicyValue(st state.State) (MemoPolicy, error) {
+	v := st.Value()
+	if v == nil {
+		return MemoPolicy{}, util.NotFoundError.Errorf("memo policy not found in State")
+	}
+
+	s, ok := v.Interface().(MemoPolicy)
+	if !ok {
+		return MemoPolicy{}, errors.Errorf("invalid memo policy value found, %T", v.Interface())
+	}
+	return s, nil
+}
+
+func SetStateMemoPolicyValue(st state.State, v MemoPolicy) (state.State, error) {
+	uv, err := state.NewHintedValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return st.SetValue(uv)
+}
+
+func IsStateAccountPolicyKey(key string) bool {
+	return key == StateKeyAccountPolicy
+}
+
+func StateAccountPolicyValue(st state.State) (AccountPolicy, error) {
+	v := st.Value()
+	if v == nil {
+		return AccountPolicy{}, util.NotFoundError.Errorf("account policy not found in State")
+	}
+
+	s, ok := v.Interface().(AccountPolicy)
+	if !ok {
+		return AccountPolicy{}, errors.Errorf("invalid account policy value found, %T", v.Interface())
+	}
+	return s, nil
+}
+
+func SetStateAccountPolicyValue(st state.State, v AccountPolicy) (state.State, error) {
+	uv, err := state.NewHintedValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return st.SetValue(uv)
+}
+
+func StateKeySchedule(id valuehash.Hash) string {
+	return fmt.Sprintf("%s%s", StateKeySchedulePrefix, id.String())
+}
+
+func IsStateScheduleKey(key string) bool {
+	return strings.HasPrefix(key, StateKeySchedulePrefix)
+}
+
+func StateScheduleValue(st state.State) (PaymentSchedule, error) {
+	v := st.Value()
+	if v == nil {
+		return PaymentSchedule{}, util.NotFoundError.Errorf("payment schedule not found in State")
+	}
+
+	s, ok := v.Interface().(PaymentSchedule)
+	if !ok {
+		return PaymentSchedule{}, errors.Errorf("invalid payment schedule value found, %T", v.Interface())
+	}
+	return s, nil
+}
+
+func SetStateScheduleValue(st state.State, v PaymentSchedule) (state.State, error) {
+	uv, err := state.NewHintedValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return st.SetValue(uv)
+}
+
+func IsStateScheduleQueueKey(key string) bool {
+	return key == StateKeyScheduleQueue
+}
+
+func StateScheduleQueueValue(st state.State) (ScheduleQueue, error) {
+	v := st.Value()
+	if v == nil {
+		return ScheduleQueue{}, util.NotFoundError.Errorf("schedule queue not found in State")
+	}
+
+	s, ok := v.Interface().(ScheduleQueue)
+	if !ok {
+		return ScheduleQueue{}, errors.Errorf("invalid schedule queue value found, %T", v.Interface())
+	}
+	return s, nil
+}
+
+func SetStateScheduleQueueValue(st state.State, v ScheduleQueue) (state.State, error) {
+	uv, err := state.NewHintedValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return st.SetValue(uv)
+}
+
+func StateKeyHTLC(id valuehash.Hash) string {
+	return fmt.Sprintf("%s%s", StateKeyHTLCPrefix, id.String())
+}
+
+func IsStateHTLCKey(key string) bool {
+	return strings.HasPrefix(key, StateKeyHTLCPrefix)
+}
+
+func StateHTLCValue(st state.State) (HTLC, error) {
+	v := st.Value()
+	if v == nil {
+		return HTLC{}, util.NotFoundError.Errorf("htlc not found in State")
+	}
+
+	s, ok := v.Interface().(HTLC)
+	if !ok {
+		return HTLC{}, errors.Errorf("invalid htlc value found, %T", v.Interface())
+	}
+	return s, nil
+}
+
+func SetStateHTLCValue(st state.State, v HTLC) (state.State, error) {
+	uv, err := state.NewHintedValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return st.SetValue(uv)
+}
+
+func StateKeyAccountIndex(a base.Address) string {
+	return fmt.Sprintf("%s%s", a.String(), StateKeyAccountIndexSuffix)
+}
+
+func IsStateAccountIndexKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyAccountIndexSuffix)
+}
+
+func StateAccountIndexValue(st state.State) (AccountIndex, error) {
+	v := st.Value()
+	if v == nil {
+		return AccountIndex{}, util.NotFoundError.Errorf("account index not found in State")
+	}
+
+	s, ok := v.Interface().(AccountIndex)
+	if !ok {
+		return AccountIndex{}, errors.Errorf("invalid account index value found, %T", v.Interface())
+	}
+	return s, nil
+}
+
+func SetStateAccountIndexValue(st state.State, v AccountIndex) (state.State, error) {
+	uv, err := state.NewHintedValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return st.SetValue(uv)
+}
+
 func checkExistsState(
 	key string,
 	getState func(key string) (state.State, bool, error),
@@ -171,6 +490,61 @@ func existsState(
 	}
 }
 
+// checkActiveAccountState checks the account of address is neither frozen nor
+// closed. The missing account is ignored.
+func checkActiveAccountState(
+	a base.Address,
+	getState func(key string) (state.State, bool, error),
+) error {
+	switch ac, found, err := loadAccountState(a, getState); {
+	case err != nil:
+		return err
+	case !found:
+		return nil
+	case ac.IsFrozen():
+		return operation.NewBaseReasonError("account, %q frozen", a)
+	case ac.IsClosed():
+		return operation.NewBaseReasonError("account, %q closed", a)
+	default:
+		return nil
+	}
+}
+
+// checkNotClosedState checks the account of address is not closed; closed
+// account can not receive. The missing account is ignored.
+func checkNotClosedState(
+	a base.Address,
+	getState func(key string) (state.State, bool, error),
+) error {
+	switch ac, found, err := loadAccountState(a, getState); {
+	case err != nil:
+		return err
+	case found && ac.IsClosed():
+		return operation.NewBaseReasonError("account, %q closed", a)
+	default:
+		return nil
+	}
+}
+
+func loadAccountState(
+	a base.Address,
+	getState func(key string) (state.State, bool, error),
+) (Account, bool, error) {
+	switch st, found, err := getState(StateKeyAccount(a)); {
+	case err != nil:
+		return Account{}, false, err
+	case !found:
+		return Account{}, false, nil
+	default:
+		ac, e := LoadStateAccountValue(st)
+		if e != nil {
+			return Account{}, false, operation.NewBaseReasonErrorFromError(e)
+		}
+
+		return ac, true, nil
+	}
+}
+
 func notExistsState(
 	k,
 	name string,
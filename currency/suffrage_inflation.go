@@ -40,6 +40,14 @@ func (item SuffrageInflationItem) Bytes() []byte {
 	return util.ConcatBytesSlice(br, item.amount.Bytes())
 }
 
+func (item SuffrageInflationItem) Receiver() base.Address {
+	return item.receiver
+}
+
+func (item SuffrageInflationItem) Amount() Amount {
+	return item.amount
+}
+
 func (item SuffrageInflationItem) IsValid([]byte) error {
 	if err := isvalid.Check(nil, false, item.receiver, item.amount); err != nil {
 		return isvalid.InvalidError.Errorf("invalid SuffrageInflationItem: %w", err)
@@ -33,7 +33,6 @@ type SuffrageInflationProcessor struct {
 	threshold base.Threshold
 	ast       map[string]AmountState
 	dst       map[CurrencyID]state.State
-	dc        map[CurrencyID]CurrencyDesign
 }
 
 func NewSuffrageInflationProcessor(cp *CurrencyPool, pubs []key.Publickey, threshold base.Threshold) GetNewProcessor {
@@ -69,6 +68,7 @@ func (opp *SuffrageInflationProcessor) PreProcess(
 	ast := map[string]AmountState{}
 	dst := map[CurrencyID]state.State{}
 	dc := map[CurrencyID]CurrencyDesign{}
+	inc := map[CurrencyID]Big{}
 	for i := range items {
 		item := items[i]
 		cid := item.amount.Currency()
@@ -93,11 +93,22 @@ func (opp *SuffrageInflationProcessor) PreProcess(
 		}
 
 		dc[cid], _ = opp.cp.Get(cid)
+
+		if b, found := inc[cid]; found {
+			inc[cid] = b.Add(item.amount.Big())
+		} else {
+			inc[cid] = item.amount.Big()
+		}
+	}
+
+	for cid := range inc {
+		if err := dc[cid].Policy().checkMaxSupply(dc[cid].Aggregate().Add(inc[cid])); err != nil {
+			return nil, errors.Wrapf(err, "failed to inflate %q", cid)
+		}
 	}
 
 	opp.ast = ast
 	opp.dst = dst
-	opp.dc = dc
 
 	return opp, nil
 }
@@ -115,7 +126,12 @@ func (opp *SuffrageInflationProcessor) Process(
 		item := items[i]
 		aid := StateKeyBalance(item.receiver, item.amount.Currency())
 		opp.ast[aid] = opp.ast[aid].Add(item.amount.Big())
-		inc[item.amount.Currency()] = item.amount.Big()
+
+		if b, found := inc[item.amount.Currency()]; found {
+			inc[item.amount.Currency()] = b.Add(item.amount.Big())
+		} else {
+			inc[item.amount.Currency()] = item.amount.Big()
+		}
 	}
 
 	var i int
@@ -125,17 +141,7 @@ func (opp *SuffrageInflationProcessor) Process(
 	}
 
 	for cid := range inc {
-		dc, err := opp.dc[cid].AddAggregate(inc[cid])
-		if err != nil {
-			return operation.NewBaseReasonErrorFromError(err)
-		}
-
-		j, err := SetStateCurrencyDesignValue(opp.dst[cid], dc)
-		if err != nil {
-			return err
-		}
-
-		sts[i] = j
+		sts[i] = NewCurrencyDesignState(opp.dst[cid], cid).AddAggregate(inc[cid])
 		i++
 	}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/spikeekips/mitum/base/key"
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/base/prprocessor"
+	"github.com/spikeekips/mitum/base/state"
 	"github.com/spikeekips/mitum/storage"
 	"github.com/spikeekips/mitum/util"
 	"github.com/stretchr/testify/suite"
@@ -118,6 +119,70 @@ func (t *testSuffrageInflationOperations) TestNew() {
 	t.True(tcid[cids[2]].Aggregate().Equal(NewBig(199)))
 }
 
+func (t *testSuffrageInflationOperations) TestSameCurrency() {
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	sb, stsb := t.newAccount(true, []Amount{NewAmount(NewBig(20), t.cid)})
+	pool, _ := t.statepool(sts, stsb)
+	privs, opr, cp := t.processor(2, pool)
+
+	cd := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))
+	t.NoError(cp.Set(cd))
+
+	items := []SuffrageInflationItem{
+		NewSuffrageInflationItem(sa.Address, NewAmount(NewBig(100), t.cid)),
+		NewSuffrageInflationItem(sb.Address, NewAmount(NewBig(33), t.cid)),
+	}
+
+	op := t.newOperation(privs, items)
+	t.NoError(op.IsValid(nil))
+
+	t.NoError(opr.Process(op))
+	t.NoError(opr.Close())
+
+	tb := map[string]Amount{}
+	var de CurrencyDesign
+
+	for _, st := range pool.Updates() {
+		switch {
+		case IsStateBalanceKey(st.Key()):
+			i, err := StateBalanceValue(st.GetState())
+			t.NoError(err)
+
+			tb[st.Key()] = i
+		case IsStateCurrencyDesignKey(st.Key()):
+			i, err := StateCurrencyDesignValue(st.GetState())
+			t.NoError(err)
+
+			de = i
+		}
+	}
+
+	t.True(tb[StateKeyBalance(sa.Address, t.cid)].Big().Equal(NewBig(110)))
+	t.True(tb[StateKeyBalance(sb.Address, t.cid)].Big().Equal(NewBig(53)))
+
+	// NOTE the aggregate is increased by all the items of same currency
+	t.True(de.Aggregate().Equal(NewBig(99 + 100 + 33)))
+}
+
+func (t *testSuffrageInflationOperations) TestDuplicatedCurrency() {
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	pool, _ := t.statepool(sts)
+	privs, opr, cp := t.processor(2, pool)
+
+	cd := t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))
+	t.NoError(cp.Set(cd))
+
+	op := t.newOperation(privs, []SuffrageInflationItem{NewSuffrageInflationItem(sa.Address, NewAmount(NewBig(100), t.cid))})
+	t.NoError(opr.Process(op))
+
+	op = t.newOperation(privs, []SuffrageInflationItem{NewSuffrageInflationItem(sa.Address, NewAmount(NewBig(1), t.cid))})
+	err := opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "duplicated currency id")
+}
+
 func (t *testSuffrageInflationOperations) TestUnknownReceiver() {
 	cids := make([]CurrencyID, 3)
 	for i := 0; i < 3; i++ {
@@ -183,6 +248,35 @@ func (t *testSuffrageInflationOperations) TestUnknownCurrency() {
 	t.Contains(err.Error(), "unknown currency")
 }
 
+func (t *testSuffrageInflationOperations) TestOverMaxSupply() {
+	cid := CurrencyID("XX0")
+
+	sa, sts := t.newAccount(true, []Amount{NewAmount(NewBig(10), cid)})
+	pool, _ := t.statepool(sts)
+	privs, opr, cp := t.processor(2, pool)
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(sa.Address, ZeroBig)).SetMaxSupply(NewBig(150))
+	de := NewCurrencyDesign(NewAmount(NewBig(99), cid), NewTestAddress(), po)
+
+	st, err := state.NewStateV0(StateKeyCurrencyDesign(cid), nil, base.NilHeight)
+	t.NoError(err)
+	dst, err := SetStateCurrencyDesignValue(st, de)
+	t.NoError(err)
+	t.NoError(cp.Set(dst))
+
+	op := t.newOperation(privs, []SuffrageInflationItem{NewSuffrageInflationItem(sa.Address, NewAmount(NewBig(100), cid))})
+	t.NoError(op.IsValid(nil))
+
+	err = opr.Process(op)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "over max supply")
+
+	op = t.newOperation(privs, []SuffrageInflationItem{NewSuffrageInflationItem(sa.Address, NewAmount(NewBig(51), cid))})
+	t.NoError(opr.Process(op))
+}
+
 func TestSuffrageInflationOperations(t *testing.T) {
 	suite.Run(t, new(testSuffrageInflationOperations))
 }
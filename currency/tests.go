@@ -93,6 +93,9 @@ func (t *baseTest) compareCurrencyDesign(a, b CurrencyDesign) {
 		t.True(a.GenesisAccount().Equal(a.GenesisAccount()))
 	}
 	t.Equal(a.Policy(), b.Policy())
+	t.True(a.Aggregate().Equal(b.Aggregate()))
+	t.True(a.Burned().Equal(b.Burned()))
+	t.Equal(a.Decimals(), b.Decimals())
 }
 
 type baseTestOperationProcessor struct { // nolint: unused
@@ -128,6 +131,69 @@ func (t *baseTestOperationProcessor) newStateKeys(a base.Address, keys AccountKe
 	return su
 }
 
+// freezeAccountState freezes the account state of the given address in sts.
+func (t *baseTestOperationProcessor) freezeAccountState(a base.Address, sts []state.State) []state.State {
+	for i := range sts {
+		if sts[i].Key() != StateKeyAccount(a) {
+			continue
+		}
+
+		ac, err := LoadStateAccountValue(sts[i])
+		t.NoError(err)
+
+		nst, err := SetStateAccountValue(sts[i], ac.SetFrozen(true))
+		t.NoError(err)
+
+		sts[i] = nst
+	}
+
+	return sts
+}
+
+// closeAccountState closes the account state of the given address in sts.
+func (t *baseTestOperationProcessor) closeAccountState(a base.Address, sts []state.State) []state.State {
+	for i := range sts {
+		if sts[i].Key() != StateKeyAccount(a) {
+			continue
+		}
+
+		ac, err := LoadStateAccountValue(sts[i])
+		t.NoError(err)
+
+		nst, err := SetStateAccountValue(sts[i], ac.SetClosed(true))
+		t.NoError(err)
+
+		sts[i] = nst
+	}
+
+	return sts
+}
+
+// recoveryAccountState sets the recovery of the account state of the given
+// address in sts.
+func (t *baseTestOperationProcessor) recoveryAccountState(
+	a base.Address, ar AccountRecovery, sts []state.State,
+) []state.State {
+	for i := range sts {
+		if sts[i].Key() != StateKeyAccount(a) {
+			continue
+		}
+
+		ac, err := LoadStateAccountValue(sts[i])
+		t.NoError(err)
+
+		nac, err := ac.SetRecovery(ar)
+		t.NoError(err)
+
+		nst, err := SetStateAccountValue(sts[i], nac)
+		t.NoError(err)
+
+		sts[i] = nst
+	}
+
+	return sts
+}
+
 func (t *baseTestOperationProcessor) newKey(pub key.Publickey, w uint) BaseAccountKey {
 	k, err := NewBaseAccountKey(pub, w)
 	if err != nil {
@@ -172,8 +238,37 @@ func (t *baseTestOperationProcessor) newStateBalance(a base.Address, big Big, ci
 	return su
 }
 
+func (t *baseTestOperationProcessor) newStateLockedAmount(a base.Address, la LockedAmount) state.State {
+	st, err := state.NewStateV0(StateKeyLockedBalance(a, la.Currency()), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateLockedBalanceValue(st, la)
+	t.NoError(err)
+
+	return nst
+}
+
+func (t *baseTestOperationProcessor) newStateAccountIndex(a base.Address, keys ...string) state.State {
+	st, err := state.NewStateV0(StateKeyAccountIndex(a), nil, base.NilHeight)
+	t.NoError(err)
+
+	nst, err := SetStateAccountIndexValue(st, NewAccountIndex(keys))
+	t.NoError(err)
+
+	return nst
+}
+
 func (t *baseTestOperationProcessor) newCurrencyDesignState(cid CurrencyID, big Big, genesisAccount base.Address, feeer Feeer) state.State {
-	de := NewCurrencyDesign(NewAmount(big, cid), genesisAccount, NewCurrencyPolicy(ZeroBig, feeer))
+	return t.newCurrencyDesignStateWithPolicy(cid, big, genesisAccount, NewCurrencyPolicy(ZeroBig, feeer))
+}
+
+func (t *baseTestOperationProcessor) newCurrencyDesignStateWithPolicy(
+	cid CurrencyID,
+	big Big,
+	genesisAccount base.Address,
+	po CurrencyPolicy,
+) state.State {
+	de := NewCurrencyDesign(NewAmount(big, cid), genesisAccount, po)
 
 	st, err := state.NewStateV0(StateKeyCurrencyDesign(cid), nil, base.NilHeight)
 	t.NoError(err)
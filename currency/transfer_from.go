@@ -0,0 +1,136 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var (
+	TransferFromFactType   = hint.Type("mitum-currency-transfer-from-operation-fact")
+	TransferFromFactHint   = hint.NewHint(TransferFromFactType, "v0.0.1")
+	TransferFromFactHinter = TransferFromFact{BaseHinter: hint.NewBaseHinter(TransferFromFactHint)}
+	TransferFromType       = hint.Type("mitum-currency-transfer-from-operation")
+	TransferFromHint       = hint.NewHint(TransferFromType, "v0.0.1")
+	TransferFromHinter     = TransferFrom{BaseOperation: operationHinter(TransferFromHint)}
+)
+
+// TransferFromFact transfers the amount from the balance of owner to receiver
+// by the allowance, which owner approved to sender.
+type TransferFromFact struct {
+	hint.BaseHinter
+	h        valuehash.Hash
+	token    []byte
+	sender   base.Address
+	owner    base.Address
+	receiver base.Address
+	amount   Amount
+}
+
+func NewTransferFromFact(
+	token []byte,
+	sender, owner, receiver base.Address,
+	amount Amount,
+) TransferFromFact {
+	fact := TransferFromFact{
+		BaseHinter: hint.NewBaseHinter(TransferFromFactHint),
+		token:      token,
+		sender:     sender,
+		owner:      owner,
+		receiver:   receiver,
+		amount:     amount,
+	}
+	fact.h = fact.GenerateHash()
+
+	return fact
+}
+
+func (fact TransferFromFact) Hash() valuehash.Hash {
+	return fact.h
+}
+
+func (fact TransferFromFact) GenerateHash() valuehash.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact TransferFromFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.token,
+		fact.sender.Bytes(),
+		fact.owner.Bytes(),
+		fact.receiver.Bytes(),
+		fact.amount.Bytes(),
+	)
+}
+
+func (fact TransferFromFact) IsValid(b []byte) error {
+	if err := IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := isvalid.Check(nil, false,
+		fact.sender,
+		fact.owner,
+		fact.receiver,
+		fact.amount,
+	); err != nil {
+		return err
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return isvalid.InvalidError.Errorf("amount should be over zero")
+	}
+
+	switch {
+	case fact.sender.Equal(fact.owner):
+		return isvalid.InvalidError.Errorf("owner is same with sender, %q", fact.sender)
+	case fact.receiver.Equal(fact.owner):
+		return isvalid.InvalidError.Errorf("receiver is same with owner, %q", fact.owner)
+	}
+
+	return nil
+}
+
+func (fact TransferFromFact) Token() []byte {
+	return fact.token
+}
+
+func (fact TransferFromFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact TransferFromFact) Owner() base.Address {
+	return fact.owner
+}
+
+func (fact TransferFromFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact TransferFromFact) Amount() Amount {
+	return fact.amount
+}
+
+func (fact TransferFromFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{fact.sender, fact.owner}
+	if !fact.receiver.Equal(fact.sender) {
+		as = append(as, fact.receiver)
+	}
+
+	return as, nil
+}
+
+type TransferFrom struct {
+	BaseOperation
+}
+
+func NewTransferFrom(fact TransferFromFact, fs []base.FactSign, memo string) (TransferFrom, error) {
+	bo, err := NewBaseOperationFromFact(TransferFromHint, fact, fs, memo)
+	if err != nil {
+		return TransferFrom{}, err
+	}
+
+	return TransferFrom{BaseOperation: bo}, nil
+}
@@ -0,0 +1,58 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+type TransferFromFactJSONPacker struct {
+	jsonenc.HintedHead
+	H  valuehash.Hash `json:"hash"`
+	TK []byte         `json:"token"`
+	SD base.Address   `json:"sender"`
+	OW base.Address   `json:"owner"`
+	RC base.Address   `json:"receiver"`
+	AM Amount         `json:"amount"`
+}
+
+func (fact TransferFromFact) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(TransferFromFactJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(fact.Hint()),
+		H:          fact.h,
+		TK:         fact.token,
+		SD:         fact.sender,
+		OW:         fact.owner,
+		RC:         fact.receiver,
+		AM:         fact.amount,
+	})
+}
+
+type TransferFromFactJSONUnpacker struct {
+	H  valuehash.Bytes     `json:"hash"`
+	TK []byte              `json:"token"`
+	SD base.AddressDecoder `json:"sender"`
+	OW base.AddressDecoder `json:"owner"`
+	RC base.AddressDecoder `json:"receiver"`
+	AM Amount              `json:"amount"`
+}
+
+func (fact *TransferFromFact) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ufact TransferFromFactJSONUnpacker
+	if err := enc.Unmarshal(b, &ufact); err != nil {
+		return err
+	}
+
+	return fact.unpack(enc, ufact.H, ufact.TK, ufact.SD, ufact.OW, ufact.RC, ufact.AM)
+}
+
+func (op *TransferFrom) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var ubo BaseOperation
+	if err := ubo.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
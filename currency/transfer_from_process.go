@@ -0,0 +1,186 @@
+package currency
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+var transferFromProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(TransferFromProcessor)
+	},
+}
+
+func (TransferFrom) Process(
+	func(key string) (state.State, bool, error),
+	func(valuehash.Hash, ...state.State) error,
+) error {
+	return nil
+}
+
+// TransferFromProcessor charges the amount and fee to owner; the allowance is
+// also decreased by the amount and fee, so owner does not pay over the
+// allowance. When the fee is charged in another fee currency, the allowance is
+// decreased only by the amount.
+type TransferFromProcessor struct {
+	cp *CurrencyPool
+	TransferFrom
+	as  state.State
+	al  Allowance
+	ob  AmountState
+	rb  AmountState
+	fee feePayment
+}
+
+func NewTransferFromProcessor(cp *CurrencyPool) GetNewProcessor {
+	return func(op state.Processor) (state.Processor, error) {
+		i, ok := op.(TransferFrom)
+		if !ok {
+			return nil, errors.Errorf("not TransferFrom, %T", op)
+		}
+
+		opp := transferFromProcessorPool.Get().(*TransferFromProcessor)
+
+		opp.cp = cp
+		opp.TransferFrom = i
+		opp.as = nil
+		opp.al = Allowance{}
+		opp.ob = AmountState{}
+		opp.rb = AmountState{}
+		opp.fee = feePayment{}
+
+		return opp, nil
+	}
+}
+
+func (opp *TransferFromProcessor) PreProcess(
+	getState func(string) (state.State, bool, error),
+	_ func(valuehash.Hash, ...state.State) error,
+) (state.Processor, error) {
+	fact := opp.Fact().(TransferFromFact)
+
+	if err := checkExistsState(StateKeyAccount(fact.sender), getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkActiveAccountState(fact.sender, getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkFactSignsByState(fact.sender, opp.Signs(), getState); err != nil {
+		return nil, errors.Wrap(err, "invalid signing")
+	}
+
+	if _, err := existsState(StateKeyAccount(fact.owner), "owner", getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkActiveAccountState(fact.owner, getState); err != nil {
+		return nil, err
+	}
+
+	receiver, err := resolveAddress(fact.receiver, getState)
+	switch {
+	case err != nil:
+		return nil, err
+	case receiver.Equal(fact.owner):
+		return nil, operation.NewBaseReasonError("receiver is same with owner, %q", fact.owner)
+	}
+
+	if _, err := existsState(StateKeyAccount(receiver), "receiver", getState); err != nil {
+		return nil, err
+	}
+
+	if err := checkNotClosedState(receiver, getState); err != nil {
+		return nil, err
+	}
+
+	cid := fact.amount.Currency()
+	policy, found := opp.cp.Policy(cid)
+	if !found {
+		return nil, operation.NewBaseReasonError("currency, %q not found of TransferFrom", cid)
+	}
+
+	as, err := existsState(StateKeyAllowance(fact.owner, fact.sender, cid), "allowance", getState)
+	if err != nil {
+		return nil, err
+	}
+
+	al, err := StateAllowanceValue(as)
+	if err != nil {
+		return nil, operation.NewBaseReasonErrorFromError(err)
+	}
+
+	fee, err := loadFeePayment(opp.cp, policy, fact.owner, cid, fact.amount.Big(), getState)
+	if err != nil {
+		return nil, err
+	}
+
+	required := fee.required(fact.amount.Big())
+	if al.Amount().Big().Compare(required) < 0 {
+		return nil, operation.NewBaseReasonError("insufficient allowance; %v < %v", al.Amount().Big(), required)
+	}
+
+	st, err := existsState(StateKeyBalance(fact.owner, cid), "balance of owner", getState)
+	if err != nil {
+		return nil, err
+	}
+	ob := NewAmountState(st, cid)
+
+	switch b, e := StateBalanceValue(ob); {
+	case e != nil:
+		return nil, operation.NewBaseReasonErrorFromError(e)
+	case b.Big().Compare(required) < 0:
+		return nil, operation.NewBaseReasonError("insufficient balance of owner with fee")
+	}
+
+	st, _, err = getState(StateKeyBalance(receiver, cid))
+	if err != nil {
+		return nil, err
+	}
+
+	opp.as = as
+	opp.al = al
+	opp.ob = ob
+	opp.rb = NewAmountState(st, cid)
+	opp.fee = fee
+
+	return opp, nil
+}
+
+func (opp *TransferFromProcessor) Process(
+	_ func(key string) (state.State, bool, error),
+	setState func(valuehash.Hash, ...state.State) error,
+) error {
+	fact := opp.Fact().(TransferFromFact)
+
+	required := opp.fee.required(fact.amount.Big())
+
+	as, err := SetStateAllowanceValue(opp.as, opp.al.WithBig(opp.al.Amount().Big().Sub(required)))
+	if err != nil {
+		return operation.NewBaseReasonErrorFromError(err)
+	}
+
+	sts := append([]state.State{as}, opp.fee.pay(opp.ob, fact.amount.Big())...)
+
+	return setState(fact.Hash(), append(sts, opp.rb.Add(fact.amount.Big()))...)
+}
+
+func (opp *TransferFromProcessor) Close() error {
+	opp.cp = nil
+	opp.TransferFrom = TransferFrom{}
+	opp.as = nil
+	opp.al = Allowance{}
+	opp.ob = AmountState{}
+	opp.rb = AmountState{}
+	opp.fee = feePayment{}
+
+	transferFromProcessorPool.Put(opp)
+
+	return nil
+}
@@ -30,3 +30,35 @@ func (it *BaseTransfersItem) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 
 	return it.unpack(enc, uit.RC, uit.AM)
 }
+
+func (it TransfersItemLockedAmounts) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bsonenc.MergeBSONM(bsonenc.NewHintedDoc(it.Hint()),
+			bson.M{
+				"receiver":      it.receiver,
+				"amounts":       it.amounts,
+				"unlock_height": it.unlockHeight,
+			}),
+	)
+}
+
+type TransfersItemLockedAmountsBSONUnpacker struct {
+	UH base.Height `bson:"unlock_height"`
+}
+
+func (it *TransfersItemLockedAmounts) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var bit BaseTransfersItem
+	if err := bit.UnpackBSON(b, enc); err != nil {
+		return err
+	}
+
+	var uit TransfersItemLockedAmountsBSONUnpacker
+	if err := enc.Unmarshal(b, &uit); err != nil {
+		return err
+	}
+
+	it.BaseTransfersItem = bit
+	it.unlockHeight = uit.UH
+
+	return nil
+}
@@ -34,3 +34,40 @@ func (it *BaseTransfersItem) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 
 	return it.unpack(enc, uit.RC, uit.AM)
 }
+
+type TransfersItemLockedAmountsJSONPacker struct {
+	TransfersItemJSONPacker
+	UH base.Height `json:"unlock_height"`
+}
+
+func (it TransfersItemLockedAmounts) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(TransfersItemLockedAmountsJSONPacker{
+		TransfersItemJSONPacker: TransfersItemJSONPacker{
+			HintedHead: jsonenc.NewHintedHead(it.Hint()),
+			RC:         it.receiver,
+			AM:         it.amounts,
+		},
+		UH: it.unlockHeight,
+	})
+}
+
+type TransfersItemLockedAmountsJSONUnpacker struct {
+	UH base.Height `json:"unlock_height"`
+}
+
+func (it *TransfersItemLockedAmounts) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var bit BaseTransfersItem
+	if err := bit.UnpackJSON(b, enc); err != nil {
+		return err
+	}
+
+	var uit TransfersItemLockedAmountsJSONUnpacker
+	if err := enc.Unmarshal(b, &uit); err != nil {
+		return err
+	}
+
+	it.BaseTransfersItem = bit
+	it.unlockHeight = uit.UH
+
+	return nil
+}
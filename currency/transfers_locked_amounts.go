@@ -0,0 +1,65 @@
+package currency
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	TransfersItemLockedAmountsType   = hint.Type("mitum-currency-transfers-item-locked-amounts")
+	TransfersItemLockedAmountsHint   = hint.NewHint(TransfersItemLockedAmountsType, "v0.0.1")
+	TransfersItemLockedAmountsHinter = TransfersItemLockedAmounts{
+		BaseTransfersItem: BaseTransfersItem{BaseHinter: hint.NewBaseHinter(TransfersItemLockedAmountsHint)},
+	}
+)
+
+// TransfersItemLockedAmounts transfers the amounts to the locked balance of
+// receiver; the receiver can unlock them by BalanceUnlock at the unlock height
+// or after.
+type TransfersItemLockedAmounts struct {
+	BaseTransfersItem
+	unlockHeight base.Height
+}
+
+func NewTransfersItemLockedAmounts(
+	receiver base.Address,
+	amounts []Amount,
+	unlockHeight base.Height,
+) TransfersItemLockedAmounts {
+	return TransfersItemLockedAmounts{
+		BaseTransfersItem: NewBaseTransfersItem(TransfersItemLockedAmountsHint, receiver, amounts),
+		unlockHeight:      unlockHeight,
+	}
+}
+
+func (it TransfersItemLockedAmounts) Bytes() []byte {
+	return util.ConcatBytesSlice(it.BaseTransfersItem.Bytes(), it.unlockHeight.Bytes())
+}
+
+func (it TransfersItemLockedAmounts) IsValid([]byte) error {
+	if err := it.BaseTransfersItem.IsValid(nil); err != nil {
+		return err
+	}
+
+	if n := len(it.amounts); n > maxCurenciesTransfersItemMultiAmounts {
+		return isvalid.InvalidError.Errorf("amounts over allowed; %d > %d", n, maxCurenciesTransfersItemMultiAmounts)
+	}
+
+	if it.unlockHeight <= base.GenesisHeight {
+		return isvalid.InvalidError.Errorf("unlock height should be over genesis height, %v", it.unlockHeight)
+	}
+
+	return nil
+}
+
+func (it TransfersItemLockedAmounts) UnlockHeight() base.Height {
+	return it.unlockHeight
+}
+
+func (it TransfersItemLockedAmounts) Rebuild() TransfersItem {
+	it.BaseTransfersItem = it.BaseTransfersItem.Rebuild().(BaseTransfersItem)
+
+	return it
+}
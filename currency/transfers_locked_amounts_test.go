@@ -0,0 +1,100 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/stretchr/testify/suite"
+)
+
+type testTransfersItemLockedAmounts struct {
+	suite.Suite
+}
+
+func (t *testTransfersItemLockedAmounts) TestNew() {
+	r := MustAddress(util.UUID().String())
+
+	item := NewTransfersItemLockedAmounts(r, []Amount{NewAmount(NewBig(11), CurrencyID("SHOWME"))}, base.Height(33))
+	t.NoError(item.IsValid(nil))
+	t.Equal(base.Height(33), item.UnlockHeight())
+
+	t.NotEqual(
+		NewTransfersItemMultiAmounts(r, item.Amounts()).Bytes(),
+		item.Bytes(),
+	)
+}
+
+func (t *testTransfersItemLockedAmounts) TestWrongUnlockHeight() {
+	r := MustAddress(util.UUID().String())
+
+	item := NewTransfersItemLockedAmounts(r, []Amount{NewAmount(NewBig(11), CurrencyID("SHOWME"))}, base.GenesisHeight)
+
+	err := item.IsValid(nil)
+	t.True(errors.Is(err, isvalid.InvalidError))
+	t.Contains(err.Error(), "unlock height")
+}
+
+func TestTransfersItemLockedAmounts(t *testing.T) {
+	suite.Run(t, new(testTransfersItemLockedAmounts))
+}
+
+func testTransfersItemLockedAmountsEncode(enc encoder.Encoder) suite.TestingSuite {
+	t := new(baseTestOperationEncode)
+
+	t.enc = enc
+	t.newObject = func() interface{} {
+		s := MustAddress(util.UUID().String())
+		r := MustAddress(util.UUID().String())
+
+		items := []TransfersItem{
+			NewTransfersItemLockedAmounts(r, []Amount{NewAmount(NewBig(33), CurrencyID("SHOWME"))}, base.Height(10)),
+			NewTransfersItemMultiAmounts(r, []Amount{NewAmount(NewBig(44), CurrencyID("FINDME"))}),
+		}
+		fact := NewTransfersFact(util.UUID().Bytes(), s, items)
+
+		pk := key.NewBasePrivatekey()
+		sig, err := base.NewFactSignature(pk, fact, nil)
+		t.NoError(err)
+
+		tf, err := NewTransfers(fact, []base.FactSign{base.NewBaseFactSign(pk.Publickey(), sig)}, "")
+		t.NoError(err)
+
+		return tf
+	}
+
+	t.compare = func(a, b interface{}) {
+		fact := a.(Transfers).Fact().(TransfersFact)
+		ufact := b.(Transfers).Fact().(TransfersFact)
+
+		t.Equal(len(fact.Items()), len(ufact.Items()))
+
+		ai := fact.Items()[0].(TransfersItemLockedAmounts)
+		bi, ok := ufact.Items()[0].(TransfersItemLockedAmounts)
+		t.True(ok)
+
+		t.True(ai.Hint().Equal(bi.Hint()))
+		t.True(ai.Receiver().Equal(bi.Receiver()))
+		t.True(ai.Amounts()[0].Equal(bi.Amounts()[0]))
+		t.Equal(ai.UnlockHeight(), bi.UnlockHeight())
+
+		_, ok = ufact.Items()[1].(TransfersItemMultiAmounts)
+		t.True(ok)
+	}
+
+	return t
+}
+
+func TestTransfersItemLockedAmountsEncodeJSON(t *testing.T) {
+	suite.Run(t, testTransfersItemLockedAmountsEncode(jsonenc.NewEncoder()))
+}
+
+func TestTransfersItemLockedAmountsEncodeBSON(t *testing.T) {
+	suite.Run(t, testTransfersItemLockedAmountsEncode(bsonenc.NewEncoder()))
+}
@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/base/state"
 	"github.com/spikeekips/mitum/util/valuehash"
@@ -30,23 +31,45 @@ func (Transfers) Process(
 }
 
 type TransfersItemProcessor struct {
-	cp *CurrencyPool
-	h  valuehash.Hash
+	cp     *CurrencyPool
+	h      valuehash.Hash
+	height base.Height
 
-	item TransfersItem
+	item     TransfersItem
+	receiver base.Address
 
 	rb map[CurrencyID]AmountState
+	lb map[CurrencyID]LockedAmountState
 }
 
 func (opp *TransfersItemProcessor) PreProcess(
 	getState func(key string) (state.State, bool, error),
 	_ func(valuehash.Hash, ...state.State) error,
 ) error {
-	if _, err := existsState(StateKeyAccount(opp.item.Receiver()), "receiver", getState); err != nil {
+	receiver, err := resolveAddress(opp.item.Receiver(), getState)
+	if err != nil {
+		return err
+	}
+	opp.receiver = receiver
+
+	if _, err := existsState(StateKeyAccount(receiver), "receiver", getState); err != nil {
 		return err
 	}
 
+	if err := checkNotClosedState(receiver, getState); err != nil {
+		return err
+	}
+
+	var unlockHeight base.Height
+	locked, isLocked := opp.item.(TransfersItemLockedAmounts)
+	if isLocked {
+		if unlockHeight = locked.UnlockHeight(); unlockHeight <= opp.height {
+			return errors.Errorf("unlock height, %v should be over current height, %v", unlockHeight, opp.height)
+		}
+	}
+
 	rb := map[CurrencyID]AmountState{}
+	lb := map[CurrencyID]LockedAmountState{}
 	for i := range opp.item.Amounts() {
 		am := opp.item.Amounts()[i]
 
@@ -56,7 +79,17 @@ func (opp *TransfersItemProcessor) PreProcess(
 			}
 		}
 
-		st, _, err := getState(StateKeyBalance(opp.item.Receiver(), am.Currency()))
+		if isLocked {
+			st, _, err := getState(StateKeyLockedBalance(receiver, am.Currency()))
+			if err != nil {
+				return err
+			}
+			lb[am.Currency()] = NewLockedAmountState(st, am.Currency())
+
+			continue
+		}
+
+		st, _, err := getState(StateKeyBalance(receiver, am.Currency()))
 		if err != nil {
 			return err
 		}
@@ -64,6 +97,7 @@ func (opp *TransfersItemProcessor) PreProcess(
 	}
 
 	opp.rb = rb
+	opp.lb = lb
 
 	return nil
 }
@@ -75,6 +109,13 @@ func (opp *TransfersItemProcessor) Process(
 	sts := make([]state.State, len(opp.item.Amounts()))
 	for i := range opp.item.Amounts() {
 		am := opp.item.Amounts()[i]
+
+		if lst, found := opp.lb[am.Currency()]; found {
+			sts[i] = lst.Lock(am.Big(), opp.item.(TransfersItemLockedAmounts).UnlockHeight())
+
+			continue
+		}
+
 		sts[i] = opp.rb[am.Currency()].Add(am.Big())
 	}
 
@@ -84,8 +125,11 @@ func (opp *TransfersItemProcessor) Process(
 func (opp *TransfersItemProcessor) Close() error {
 	opp.cp = nil
 	opp.h = nil
+	opp.height = base.NilHeight
 	opp.item = nil
+	opp.receiver = nil
 	opp.rb = nil
+	opp.lb = nil
 
 	transfersItemProcessorPool.Put(opp)
 
@@ -95,6 +139,7 @@ func (opp *TransfersItemProcessor) Close() error {
 type TransfersProcessor struct {
 	cp *CurrencyPool
 	Transfers
+	height   base.Height
 	sb       map[CurrencyID]AmountState
 	rb       []*TransfersItemProcessor
 	required map[CurrencyID][2]Big
@@ -111,6 +156,7 @@ func NewTransfersProcessor(cp *CurrencyPool) GetNewProcessor {
 
 		opp.cp = cp
 		opp.Transfers = i
+		opp.height = base.NilHeight
 		opp.sb = nil
 		opp.rb = nil
 		opp.required = nil
@@ -129,6 +175,10 @@ func (opp *TransfersProcessor) PreProcess(
 		return nil, err
 	}
 
+	if err := checkActiveAccountState(fact.sender, getState); err != nil {
+		return nil, err
+	}
+
 	if required, err := opp.calculateItemsFee(); err != nil {
 		return nil, operation.NewBaseReasonErrorFromError(err)
 	} else if sb, err := CheckEnoughBalance(fact.sender, required, getState); err != nil {
@@ -139,16 +189,29 @@ func (opp *TransfersProcessor) PreProcess(
 	}
 
 	rb := make([]*TransfersItemProcessor, len(fact.items))
+	receivers := map[string]struct{}{}
 	for i := range fact.items {
 		c := transfersItemProcessorPool.Get().(*TransfersItemProcessor)
 		c.cp = opp.cp
 		c.h = opp.Hash()
+		c.height = opp.height
 		c.item = fact.items[i]
 
 		if err := c.PreProcess(getState, setState); err != nil {
 			return nil, operation.NewBaseReasonErrorFromError(err)
 		}
 
+		// NOTE alias receiver is checked again after it is resolved
+		k := c.receiver.String()
+		switch _, found := receivers[k]; {
+		case found:
+			return nil, operation.NewBaseReasonError("duplicated receiver found, %q", c.receiver)
+		case fact.sender.Equal(c.receiver):
+			return nil, operation.NewBaseReasonError("receiver is same with sender, %q", fact.sender)
+		default:
+			receivers[k] = struct{}{}
+		}
+
 		rb[i] = c
 	}
 
@@ -184,6 +247,10 @@ func (opp *TransfersProcessor) Process( // nolint:dupl
 	return setState(fact.Hash(), sts...)
 }
 
+func (opp *TransfersProcessor) setHeight(height base.Height) {
+	opp.height = height
+}
+
 func (opp *TransfersProcessor) Close() error {
 	for i := range opp.rb {
 		_ = opp.rb[i].Close()
@@ -200,6 +267,16 @@ func (opp *TransfersProcessor) Close() error {
 	return nil
 }
 
+// receivers returns the receivers of items; the alias receivers are resolved.
+func (opp *TransfersProcessor) receivers() []base.Address {
+	as := make([]base.Address, len(opp.rb))
+	for i := range opp.rb {
+		as[i] = opp.rb[i].receiver
+	}
+
+	return as
+}
+
 func (opp *TransfersProcessor) calculateItemsFee() (map[CurrencyID][2]Big, error) {
 	fact := opp.Fact().(TransfersFact)
 
@@ -208,5 +285,5 @@ func (opp *TransfersProcessor) calculateItemsFee() (map[CurrencyID][2]Big, error
 		items[i] = fact.items[i]
 	}
 
-	return CalculateItemsFee(opp.cp, items)
+	return CalculateSenderItemsFee(opp.cp, fact.sender, items)
 }
@@ -111,6 +111,28 @@ func (t *testTransfersOperations) TestReceiverNotExist() {
 	t.Contains(err.Error(), "receiver does not exist")
 }
 
+func (t *testTransfersOperations) TestFrozenSender() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(t.freezeAccountState(sa.Address, st0), st1)
+	feeer := NewFixedFeeer(sa.Address, ZeroBig)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), feeer)))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{t.newTransfersItem(ra.Address, NewBig(3))}
+	tf := t.newTransfer(sa.Address, sa.Privs(), items)
+
+	err := opr.Process(tf)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "frozen")
+}
+
 func (t *testTransfersOperations) TestInsufficientBalance() {
 	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
 	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
@@ -194,6 +216,294 @@ func (t *testTransfersOperations) TestSufficientBalance() {
 	t.Equal(fee, fof.Amounts()[0].Big())
 }
 
+func (t *testTransfersOperations) TestLockedAmounts() {
+	saBalance := NewAmount(NewBig(33), t.cid)
+	raBalance := NewAmount(NewBig(1), t.cid)
+	sa, st0 := t.newAccount(true, []Amount{saBalance})
+	ra, st1 := t.newAccount(true, []Amount{raBalance})
+
+	lst0 := t.newStateLockedAmount(ra.Address, NewLockedAmount(t.cid, []AmountLock{NewAmountLock(NewBig(3), base.Height(20))}))
+
+	pool, _ := t.statepool(st0, st1, []state.State{lst0})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	opr := t.processor(cp, pool)
+
+	items := []TransfersItem{
+		NewTransfersItemLockedAmounts(ra.Address, []Amount{NewAmount(NewBig(10), t.cid)}, base.Height(10)),
+	}
+	tf := t.newTransfer(sa.Address, sa.Privs(), items)
+
+	t.NoError(opr.Process(tf))
+	t.NoError(opr.Close())
+
+	var sst, rst, lst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyBalance(ra.Address, t.cid):
+			rst = st.GetState()
+		case StateKeyLockedBalance(ra.Address, t.cid):
+			lst = st.GetState()
+		}
+	}
+
+	sstv, err := StateBalanceValue(sst)
+	t.NoError(err)
+	t.True(sstv.Big().Equal(NewBig(23)))
+
+	t.Nil(rst) // NOTE spendable balance of receiver is not changed
+
+	lb, err := StateLockedBalanceValue(lst)
+	t.NoError(err)
+	t.Equal(2, len(lb.Locks()))
+	t.True(lb.Total().Equal(NewBig(13)))
+	t.Equal(base.Height(10), lb.Locks()[0].Height())
+	t.Equal(base.Height(20), lb.Locks()[1].Height())
+}
+
+func (t *testTransfersOperations) TestLockedAmountsUnderCurrentHeight() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignState(t.cid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, ZeroBig))))
+
+	items := []TransfersItem{
+		NewTransfersItemLockedAmounts(ra.Address, []Amount{NewAmount(NewBig(10), t.cid)}, base.Height(10)),
+	}
+	tf := t.newTransfer(sa.Address, sa.Privs(), items)
+
+	opp, err := NewTransfersProcessor(cp)(tf)
+	t.NoError(err)
+
+	opp.(*TransfersProcessor).setHeight(base.Height(10))
+
+	_, err = opp.(*TransfersProcessor).PreProcess(pool.Get, pool.Set)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "should be over current height")
+}
+
+func (t *testTransfersOperations) TestFeeReceivers() {
+	saBalance := NewAmount(NewBig(33), t.cid)
+	sa, st0 := t.newAccount(true, []Amount{saBalance})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+	fa0, st2 := t.newAccount(true, []Amount{NewAmount(ZeroBig, t.cid)})
+	fa1, st3 := t.newAccount(true, nil)
+
+	pool, _ := t.statepool(st0, st1, st2, st3)
+
+	fee := NewBig(5)
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(fa0.Address, fee)).SetFeeReceivers([]FeeReceiver{
+		NewFeeReceiver(fa0.Address, 1),
+		NewFeeReceiver(fa1.Address, 2),
+	})
+	de := NewCurrencyDesign(NewAmount(NewBig(99), t.cid), NewTestAddress(), po)
+
+	st, err := state.NewStateV0(StateKeyCurrencyDesign(t.cid), nil, base.NilHeight)
+	t.NoError(err)
+	dst, err := SetStateCurrencyDesignValue(st, de)
+	t.NoError(err)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	tf := t.newTransfer(sa.Address, sa.Privs(), []TransfersItem{t.newTransfersItem(ra.Address, NewBig(10))})
+
+	t.NoError(opr.Process(tf))
+	t.NoError(opr.Close())
+
+	var fst0, fst1 state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(fa0.Address, t.cid):
+			fst0 = st.GetState()
+		case StateKeyBalance(fa1.Address, t.cid):
+			fst1 = st.GetState()
+		}
+	}
+
+	fstv0, err := StateBalanceValue(fst0)
+	t.NoError(err)
+	t.Equal(NewBig(2), fstv0.Big())
+
+	fstv1, err := StateBalanceValue(fst1)
+	t.NoError(err)
+	t.Equal(NewBig(3), fstv1.Big())
+}
+
+func (t *testTransfersOperations) TestBurnFee() {
+	saBalance := NewAmount(NewBig(33), t.cid)
+	sa, st0 := t.newAccount(true, []Amount{saBalance})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+	fa, st2 := t.newAccount(true, []Amount{NewAmount(ZeroBig, t.cid)})
+
+	fee := NewBig(10)
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(fa.Address, fee)).SetFeeBurnRatio(0.4)
+	de := NewCurrencyDesign(NewAmount(NewBig(99), t.cid), NewTestAddress(), po)
+
+	st, err := state.NewStateV0(StateKeyCurrencyDesign(t.cid), nil, base.NilHeight)
+	t.NoError(err)
+	dst, err := SetStateCurrencyDesignValue(st, de)
+	t.NoError(err)
+
+	pool, _ := t.statepool(st0, st1, st2, []state.State{dst})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+
+	opr := t.processor(cp, pool)
+
+	tf := t.newTransfer(sa.Address, sa.Privs(), []TransfersItem{t.newTransfersItem(ra.Address, NewBig(10))})
+
+	t.NoError(opr.Process(tf))
+	t.NoError(opr.Close())
+
+	var fst, ust state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(fa.Address, t.cid):
+			fst = st.GetState()
+		case StateKeyCurrencyDesign(t.cid):
+			ust = st.GetState()
+		}
+	}
+
+	fstv, err := StateBalanceValue(fst)
+	t.NoError(err)
+	t.Equal(NewBig(6), fstv.Big())
+
+	ude, err := StateCurrencyDesignValue(ust)
+	t.NoError(err)
+	t.True(ude.Burned().Equal(NewBig(4)))
+	t.True(ude.Aggregate().Equal(NewBig(95)))
+}
+
+func (t *testTransfersOperations) TestFeeCurrency() {
+	fcid := CurrencyID("FEE")
+
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid), NewAmount(NewBig(10), fcid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1)
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(sa.Address, NewBig(4))).SetFeeCurrency(fcid, 0.5)
+	de := NewCurrencyDesign(NewAmount(NewBig(99), t.cid), NewTestAddress(), po)
+
+	st, err := state.NewStateV0(StateKeyCurrencyDesign(t.cid), nil, base.NilHeight)
+	t.NoError(err)
+	dst, err := SetStateCurrencyDesignValue(st, de)
+	t.NoError(err)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+	t.NoError(cp.Set(t.newCurrencyDesignState(fcid, NewBig(99), NewTestAddress(), NewFixedFeeer(sa.Address, NewBig(1)))))
+
+	opr := t.processor(cp, pool)
+
+	tf := t.newTransfer(sa.Address, sa.Privs(), []TransfersItem{t.newTransfersItem(ra.Address, NewBig(10))})
+	t.NoError(opr.Process(tf))
+
+	var sst, fst state.State
+	for _, st := range pool.Updates() {
+		switch st.Key() {
+		case StateKeyBalance(sa.Address, t.cid):
+			sst = st.GetState()
+		case StateKeyBalance(sa.Address, fcid):
+			fst = st.GetState()
+		}
+	}
+
+	sam, err := StateBalanceValue(sst)
+	t.NoError(err)
+	t.Equal(NewBig(23), sam.Big())
+	t.Equal(ZeroBig, sst.(AmountState).Fee())
+
+	fam, err := StateBalanceValue(fst)
+	t.NoError(err)
+	t.Equal(NewBig(8), fam.Big())
+	t.Equal(NewBig(2), fst.(AmountState).Fee())
+}
+
+func (t *testTransfersOperations) TestFeeCurrencyWithoutBalance() {
+	fcid := CurrencyID("FEE")
+
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(33), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+
+	pool, _ := t.statepool(st0, st1)
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(sa.Address, NewBig(4))).SetFeeCurrency(fcid, 0)
+	de := NewCurrencyDesign(NewAmount(NewBig(99), t.cid), NewTestAddress(), po)
+
+	st, err := state.NewStateV0(StateKeyCurrencyDesign(t.cid), nil, base.NilHeight)
+	t.NoError(err)
+	dst, err := SetStateCurrencyDesignValue(st, de)
+	t.NoError(err)
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(dst))
+	t.NoError(cp.Set(t.newCurrencyDesignState(fcid, NewBig(99), NewTestAddress(), NewNilFeeer())))
+
+	opr := t.processor(cp, pool)
+
+	tf := t.newTransfer(sa.Address, sa.Privs(), []TransfersItem{t.newTransfersItem(ra.Address, NewBig(10))})
+
+	err = opr.Process(tf)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "currency of holder does not exist")
+}
+
+func (t *testTransfersOperations) TestFeeExempted() {
+	sa, st0 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+	ra, st1 := t.newAccount(true, []Amount{NewAmount(NewBig(1), t.cid)})
+	oa, st2 := t.newAccount(true, []Amount{NewAmount(NewBig(10), t.cid)})
+
+	pool, _ := t.statepool(st0, st1, st2)
+
+	po := NewCurrencyPolicy(ZeroBig, NewFixedFeeer(ra.Address, NewBig(4))).SetFeeExemptions([]base.Address{sa.Address})
+
+	cp := NewCurrencyPool()
+	t.NoError(cp.Set(t.newCurrencyDesignStateWithPolicy(t.cid, NewBig(99), NewTestAddress(), po)))
+
+	opr := t.processor(cp, pool)
+
+	tf := t.newTransfer(sa.Address, sa.Privs(), []TransfersItem{t.newTransfersItem(ra.Address, NewBig(10))})
+	t.NoError(opr.Process(tf))
+
+	// NOTE not exempted sender pays fee
+	tf = t.newTransfer(oa.Address, oa.Privs(), []TransfersItem{t.newTransfersItem(ra.Address, NewBig(10))})
+
+	err := opr.Process(tf)
+
+	var oper operation.ReasonError
+	t.True(errors.As(err, &oper))
+	t.Contains(err.Error(), "insufficient balance")
+
+	var nst state.State
+	for _, st := range pool.Updates() {
+		if st.Key() == StateKeyBalance(sa.Address, t.cid) {
+			nst = st.GetState()
+		}
+	}
+
+	nb, err := StateBalanceValue(nst)
+	t.NoError(err)
+	t.True(nb.Big().IsZero())
+	t.True(nst.(AmountState).Fee().IsZero())
+}
+
 func (t *testTransfersOperations) TestMultipleItemsWithFee() {
 	saBalance := NewAmount(NewBig(33), t.cid)
 	sa, st0 := t.newAccount(true, []Amount{saBalance})
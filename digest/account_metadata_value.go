@@ -0,0 +1,63 @@
+package digest
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+var (
+	AccountMetadataValueType = hint.Type("mitum-currency-account-metadata-value")
+	AccountMetadataValueHint = hint.NewHint(AccountMetadataValueType, "v0.0.1")
+)
+
+type AccountMetadataValue struct {
+	metadata currency.AccountMetadata
+	height   base.Height
+}
+
+func NewAccountMetadataValue(st state.State) (AccountMetadataValue, error) {
+	md, err := currency.StateAccountMetadataValue(st)
+	if err != nil {
+		return AccountMetadataValue{}, errors.Wrap(err, "AccountMetadataValue needs AccountMetadata state")
+	}
+
+	return AccountMetadataValue{
+		metadata: md,
+		height:   st.Height(),
+	}, nil
+}
+
+func (AccountMetadataValue) Hint() hint.Hint {
+	return AccountMetadataValueHint
+}
+
+func (va AccountMetadataValue) Metadata() currency.AccountMetadata {
+	return va.metadata
+}
+
+// Height returns the height, when the metadata was updated.
+func (va AccountMetadataValue) Height() base.Height {
+	return va.height
+}
+
+func (va AccountMetadataValue) MarshalBSON() ([]byte, error) {
+	return marshalStateValueBSON(va.Hint(), "metadata", va.metadata, va.height)
+}
+
+func (va *AccountMetadataValue) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	return unpackStateValueBSON(b, enc, "metadata", &va.metadata, &va.height)
+}
+
+func (va AccountMetadataValue) MarshalJSON() ([]byte, error) {
+	return marshalStateValueJSON(va.Hint(), "metadata", va.metadata, va.height)
+}
+
+func (va *AccountMetadataValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	return unpackStateValueJSON(b, enc, "metadata", &va.metadata, &va.height)
+}
@@ -17,8 +17,13 @@ var (
 type AccountValue struct {
 	ac             currency.Account
 	balance        []currency.Amount
+	locked         []currency.LockedAmount
 	height         base.Height
 	previousHeight base.Height
+	frozenHeight   base.Height
+	closedHeight   base.Height
+	aliases        []string
+	metadata       map[string]string
 }
 
 func NewAccountValue(st state.State) (AccountValue, error) {
@@ -32,10 +37,22 @@ func NewAccountValue(st state.State) (AccountValue, error) {
 		ac = a
 	}
 
+	frozenHeight := base.NilHeight
+	if ac.IsFrozen() {
+		frozenHeight = st.Height()
+	}
+
+	closedHeight := base.NilHeight
+	if ac.IsClosed() {
+		closedHeight = st.Height()
+	}
+
 	return AccountValue{
 		ac:             ac,
 		height:         st.Height(),
 		previousHeight: st.PreviousHeight(),
+		frozenHeight:   frozenHeight,
+		closedHeight:   closedHeight,
 	}, nil
 }
 
@@ -51,6 +68,12 @@ func (va AccountValue) Balance() []currency.Amount {
 	return va.balance
 }
 
+// LockedBalance returns the locked amounts, which can not be spent until
+// their unlock heights; Balance returns only the spendable amounts.
+func (va AccountValue) LockedBalance() []currency.LockedAmount {
+	return va.locked
+}
+
 func (va AccountValue) Height() base.Height {
 	return va.height
 }
@@ -71,8 +94,49 @@ func (va AccountValue) SetPreviousHeight(height base.Height) AccountValue {
 	return va
 }
 
+// FrozenHeight returns the height, when the account was frozen; if not frozen,
+// base.NilHeight.
+func (va AccountValue) FrozenHeight() base.Height {
+	return va.frozenHeight
+}
+
+// ClosedHeight returns the height, when the account was closed by
+// AccountMerge; if not closed, base.NilHeight.
+func (va AccountValue) ClosedHeight() base.Height {
+	return va.closedHeight
+}
+
 func (va AccountValue) SetBalance(balance []currency.Amount) AccountValue {
 	va.balance = balance
 
 	return va
 }
+
+// Aliases returns the alias names, which are registered for the account.
+func (va AccountValue) Aliases() []string {
+	return va.aliases
+}
+
+func (va AccountValue) SetAliases(aliases []string) AccountValue {
+	va.aliases = aliases
+
+	return va
+}
+
+// Metadata returns the latest metadata of the account, which is updated by
+// AccountMetadataUpdater.
+func (va AccountValue) Metadata() map[string]string {
+	return va.metadata
+}
+
+func (va AccountValue) SetMetadata(metadata map[string]string) AccountValue {
+	va.metadata = metadata
+
+	return va
+}
+
+func (va AccountValue) SetLockedBalance(locked []currency.LockedAmount) AccountValue {
+	va.locked = locked
+
+	return va
+}
@@ -12,17 +12,23 @@ func (va AccountValue) MarshalBSON() ([]byte, error) {
 		bson.M{
 			"ac":              va.ac,
 			"balance":         va.balance,
+			"locked_balance":  va.locked,
 			"height":          va.height,
 			"previous_height": va.previousHeight,
+			"frozen_height":   va.frozenHeight,
+			"closed_height":   va.closedHeight,
 		},
 	))
 }
 
 type AccountValueBSONUnpacker struct {
-	AC bson.Raw    `bson:"ac"`
-	BL bson.Raw    `bson:"balance"`
-	HT base.Height `bson:"height"`
-	PT base.Height `bson:"previous_height"`
+	AC bson.Raw     `bson:"ac"`
+	BL bson.Raw     `bson:"balance"`
+	LB bson.Raw     `bson:"locked_balance"`
+	HT base.Height  `bson:"height"`
+	PT base.Height  `bson:"previous_height"`
+	FH *base.Height `bson:"frozen_height"`
+	CH *base.Height `bson:"closed_height"`
 }
 
 func (va *AccountValue) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -31,5 +37,5 @@ func (va *AccountValue) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 		return err
 	}
 
-	return va.unpack(enc, uva.AC, uva.BL, uva.HT, uva.PT)
+	return va.unpack(enc, uva.AC, uva.BL, uva.LB, uva.HT, uva.PT, uva.FH, uva.CH)
 }
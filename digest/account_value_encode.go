@@ -7,7 +7,15 @@ import (
 	"github.com/spikeekips/mitum/util/encoder"
 )
 
-func (va *AccountValue) unpack(enc encoder.Encoder, bac []byte, bl []byte, height, previousHeight base.Height) error {
+func (va *AccountValue) unpack(
+	enc encoder.Encoder,
+	bac []byte,
+	bl []byte,
+	blb []byte,
+	height, previousHeight base.Height,
+	frozenHeight,
+	closedHeight *base.Height,
+) error {
 	if err := encoder.Decode(bac, enc, &va.ac); err != nil {
 		return err
 	}
@@ -26,9 +34,36 @@ func (va *AccountValue) unpack(enc encoder.Encoder, bac []byte, bl []byte, heigh
 		balance[i] = j
 	}
 
+	hlb, err := enc.DecodeSlice(blb)
+	if err != nil {
+		return err
+	}
+
+	locked := make([]currency.LockedAmount, len(hlb))
+	for i := range hlb {
+		j, ok := hlb[i].(currency.LockedAmount)
+		if !ok {
+			return util.WrongTypeError.Errorf("expected currency.LockedAmount, not %T", hlb[i])
+		}
+		locked[i] = j
+	}
+
 	va.balance = balance
+	va.locked = locked
 	va.height = height
 	va.previousHeight = previousHeight
 
+	// NOTE frozen_height is missing in the documents before account freezing
+	va.frozenHeight = base.NilHeight
+	if frozenHeight != nil {
+		va.frozenHeight = *frozenHeight
+	}
+
+	// NOTE closed_height is missing in the documents before account merging
+	va.closedHeight = base.NilHeight
+	if closedHeight != nil {
+		va.closedHeight = *closedHeight
+	}
+
 	return nil
 }
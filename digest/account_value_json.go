@@ -11,9 +11,14 @@ import (
 type AccountValueJSONPacker struct {
 	jsonenc.HintedHead
 	currency.AccountPackerJSON
-	BL []currency.Amount `json:"balance,omitempty"`
-	HT base.Height       `json:"height"`
-	PT base.Height       `json:"previous_height"`
+	BL []currency.Amount       `json:"balance,omitempty"`
+	LB []currency.LockedAmount `json:"locked_balance,omitempty"`
+	HT base.Height             `json:"height"`
+	PT base.Height             `json:"previous_height"`
+	FH base.Height             `json:"frozen_height"`
+	CH base.Height             `json:"closed_height"`
+	AS []string                `json:"aliases,omitempty"`
+	MD map[string]string       `json:"metadata,omitempty"`
 }
 
 func (va AccountValue) MarshalJSON() ([]byte, error) {
@@ -21,15 +26,25 @@ func (va AccountValue) MarshalJSON() ([]byte, error) {
 		HintedHead:        jsonenc.NewHintedHead(va.Hint()),
 		AccountPackerJSON: va.ac.PackerJSON(),
 		BL:                va.balance,
+		LB:                va.locked,
 		HT:                va.height,
 		PT:                va.previousHeight,
+		FH:                va.frozenHeight,
+		CH:                va.closedHeight,
+		AS:                va.aliases,
+		MD:                va.metadata,
 	})
 }
 
 type AccountValueJSONUnpacker struct {
-	BL json.RawMessage `json:"balance"`
-	HT base.Height     `json:"height"`
-	PT base.Height     `json:"previous_height"`
+	BL json.RawMessage   `json:"balance"`
+	LB json.RawMessage   `json:"locked_balance"`
+	HT base.Height       `json:"height"`
+	PT base.Height       `json:"previous_height"`
+	FH *base.Height      `json:"frozen_height"`
+	CH *base.Height      `json:"closed_height"`
+	AS []string          `json:"aliases"`
+	MD map[string]string `json:"metadata"`
 }
 
 func (va *AccountValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -39,12 +54,14 @@ func (va *AccountValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 	}
 
 	ac := new(currency.Account)
-	if err := va.unpack(enc, nil, uva.BL, uva.HT, uva.PT); err != nil {
+	if err := va.unpack(enc, nil, uva.BL, uva.LB, uva.HT, uva.PT, uva.FH, uva.CH); err != nil {
 		return err
 	} else if err := ac.UnpackJSON(b, enc); err != nil {
 		return err
 	} else {
 		va.ac = *ac
+		va.aliases = uva.AS
+		va.metadata = uva.MD
 
 		return nil
 	}
@@ -0,0 +1,63 @@
+package digest
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+var (
+	AliasValueType = hint.Type("mitum-currency-alias-value")
+	AliasValueHint = hint.NewHint(AliasValueType, "v0.0.1")
+)
+
+type AliasValue struct {
+	alias  currency.Alias
+	height base.Height
+}
+
+func NewAliasValue(st state.State) (AliasValue, error) {
+	al, err := currency.StateAliasValue(st)
+	if err != nil {
+		return AliasValue{}, errors.Wrap(err, "AliasValue needs Alias state")
+	}
+
+	return AliasValue{
+		alias:  al,
+		height: st.Height(),
+	}, nil
+}
+
+func (AliasValue) Hint() hint.Hint {
+	return AliasValueHint
+}
+
+func (va AliasValue) Alias() currency.Alias {
+	return va.alias
+}
+
+// Height returns the height, when the alias was registered.
+func (va AliasValue) Height() base.Height {
+	return va.height
+}
+
+func (va AliasValue) MarshalBSON() ([]byte, error) {
+	return marshalStateValueBSON(va.Hint(), "alias", va.alias, va.height)
+}
+
+func (va *AliasValue) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	return unpackStateValueBSON(b, enc, "alias", &va.alias, &va.height)
+}
+
+func (va AliasValue) MarshalJSON() ([]byte, error) {
+	return marshalStateValueJSON(va.Hint(), "alias", va.alias, va.height)
+}
+
+func (va *AliasValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	return unpackStateValueJSON(b, enc, "alias", &va.alias, &va.height)
+}
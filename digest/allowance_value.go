@@ -0,0 +1,63 @@
+package digest
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+var (
+	AllowanceValueType = hint.Type("mitum-currency-allowance-value")
+	AllowanceValueHint = hint.NewHint(AllowanceValueType, "v0.0.1")
+)
+
+type AllowanceValue struct {
+	allowance currency.Allowance
+	height    base.Height
+}
+
+func NewAllowanceValue(st state.State) (AllowanceValue, error) {
+	al, err := currency.StateAllowanceValue(st)
+	if err != nil {
+		return AllowanceValue{}, errors.Wrap(err, "AllowanceValue needs Allowance state")
+	}
+
+	return AllowanceValue{
+		allowance: al,
+		height:    st.Height(),
+	}, nil
+}
+
+func (AllowanceValue) Hint() hint.Hint {
+	return AllowanceValueHint
+}
+
+func (va AllowanceValue) Allowance() currency.Allowance {
+	return va.allowance
+}
+
+// Height returns the height, when the allowance was approved or spent.
+func (va AllowanceValue) Height() base.Height {
+	return va.height
+}
+
+func (va AllowanceValue) MarshalBSON() ([]byte, error) {
+	return marshalStateValueBSON(va.Hint(), "allowance", va.allowance, va.height)
+}
+
+func (va *AllowanceValue) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	return unpackStateValueBSON(b, enc, "allowance", &va.allowance, &va.height)
+}
+
+func (va AllowanceValue) MarshalJSON() ([]byte, error) {
+	return marshalStateValueJSON(va.Hint(), "allowance", va.allowance, va.height)
+}
+
+func (va *AllowanceValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	return unpackStateValueJSON(b, enc, "allowance", &va.allowance, &va.height)
+}
@@ -0,0 +1,111 @@
+package digest
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/valuehash"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+var (
+	BalanceHistoryValueType = hint.Type("mitum-currency-balance-history-value")
+	BalanceHistoryValueHint = hint.NewHint(BalanceHistoryValueType, "v0.0.1")
+	AccountBalanceValueType = hint.Type("mitum-currency-account-balance-value")
+	AccountBalanceValueHint = hint.NewHint(AccountBalanceValueType, "v0.0.1")
+)
+
+// BalanceHistoryValue is the balance of account for one currency at the
+// height, when the balance was changed. The delta is the difference with the
+// previous balance and the operations are the facts, which changed the
+// balance.
+type BalanceHistoryValue struct {
+	address        base.Address
+	amount         currency.Amount
+	delta          currency.Big
+	height         base.Height
+	previousHeight base.Height
+	operations     []valuehash.Hash
+}
+
+func NewBalanceHistoryValue(address base.Address, st state.State, previous currency.Big) (BalanceHistoryValue, error) {
+	am, err := currency.StateBalanceValue(st)
+	if err != nil {
+		return BalanceHistoryValue{}, errors.Wrap(err, "BalanceHistoryValue needs balance state")
+	}
+
+	return BalanceHistoryValue{
+		address:        address,
+		amount:         am,
+		delta:          am.Big().Sub(previous),
+		height:         st.Height(),
+		previousHeight: st.PreviousHeight(),
+		operations:     st.Operations(),
+	}, nil
+}
+
+func (BalanceHistoryValue) Hint() hint.Hint {
+	return BalanceHistoryValueHint
+}
+
+func (va BalanceHistoryValue) Address() base.Address {
+	return va.address
+}
+
+func (va BalanceHistoryValue) Amount() currency.Amount {
+	return va.amount
+}
+
+// Delta returns the changed amount from the previous balance; it can be
+// negative.
+func (va BalanceHistoryValue) Delta() currency.Big {
+	return va.delta
+}
+
+func (va BalanceHistoryValue) Height() base.Height {
+	return va.height
+}
+
+func (va BalanceHistoryValue) PreviousHeight() base.Height {
+	return va.previousHeight
+}
+
+// Operations returns the fact hashes of the operations, which changed the
+// balance.
+func (va BalanceHistoryValue) Operations() []valuehash.Hash {
+	return va.operations
+}
+
+// AccountBalanceValue is the balance of account as of the given height.
+type AccountBalanceValue struct {
+	address base.Address
+	balance []currency.Amount
+	height  base.Height
+}
+
+func NewAccountBalanceValue(address base.Address, balance []currency.Amount, height base.Height) AccountBalanceValue {
+	return AccountBalanceValue{
+		address: address,
+		balance: balance,
+		height:  height,
+	}
+}
+
+func (AccountBalanceValue) Hint() hint.Hint {
+	return AccountBalanceValueHint
+}
+
+func (va AccountBalanceValue) Address() base.Address {
+	return va.address
+}
+
+func (va AccountBalanceValue) Balance() []currency.Amount {
+	return va.balance
+}
+
+// Height returns the height, which the balance is as of.
+func (va AccountBalanceValue) Height() base.Height {
+	return va.height
+}
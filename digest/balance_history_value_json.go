@@ -0,0 +1,106 @@
+package digest
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/valuehash"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type BalanceHistoryValueJSONPacker struct {
+	jsonenc.HintedHead
+	AD base.Address     `json:"address"`
+	AM currency.Amount  `json:"amount"`
+	DT currency.Big     `json:"delta"`
+	HT base.Height      `json:"height"`
+	PT base.Height      `json:"previous_height"`
+	OP []valuehash.Hash `json:"operations"`
+}
+
+func (va BalanceHistoryValue) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(BalanceHistoryValueJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(va.Hint()),
+		AD:         va.address,
+		AM:         va.amount,
+		DT:         va.delta,
+		HT:         va.height,
+		PT:         va.previousHeight,
+		OP:         va.operations,
+	})
+}
+
+type BalanceHistoryValueJSONUnpacker struct {
+	AD base.AddressDecoder `json:"address"`
+	AM currency.Amount     `json:"amount"`
+	DT currency.Big        `json:"delta"`
+	HT base.Height         `json:"height"`
+	PT base.Height         `json:"previous_height"`
+	OP []valuehash.Bytes   `json:"operations"`
+}
+
+func (va *BalanceHistoryValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uva BalanceHistoryValueJSONUnpacker
+	if err := enc.Unmarshal(b, &uva); err != nil {
+		return err
+	}
+
+	a, err := uva.AD.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	ops := make([]valuehash.Hash, len(uva.OP))
+	for i := range uva.OP {
+		ops[i] = uva.OP[i]
+	}
+
+	va.address = a
+	va.amount = uva.AM
+	va.delta = uva.DT
+	va.height = uva.HT
+	va.previousHeight = uva.PT
+	va.operations = ops
+
+	return nil
+}
+
+type AccountBalanceValueJSONPacker struct {
+	jsonenc.HintedHead
+	AD base.Address      `json:"address"`
+	BL []currency.Amount `json:"balance"`
+	HT base.Height       `json:"height"`
+}
+
+func (va AccountBalanceValue) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(AccountBalanceValueJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(va.Hint()),
+		AD:         va.address,
+		BL:         va.balance,
+		HT:         va.height,
+	})
+}
+
+type AccountBalanceValueJSONUnpacker struct {
+	AD base.AddressDecoder `json:"address"`
+	BL []currency.Amount   `json:"balance"`
+	HT base.Height         `json:"height"`
+}
+
+func (va *AccountBalanceValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uva AccountBalanceValueJSONUnpacker
+	if err := enc.Unmarshal(b, &uva); err != nil {
+		return err
+	}
+
+	a, err := uva.AD.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	va.address = a
+	va.balance = uva.BL
+	va.height = uva.HT
+
+	return nil
+}
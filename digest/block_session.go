@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
@@ -28,8 +29,17 @@ type BlockSession struct {
 	st              *Database
 	opsTreeNodes    map[string]operation.FixedTreeNode
 	operationModels []mongo.WriteModel
+	operations      []OperationValue
 	accountModels   []mongo.WriteModel
 	balanceModels   []mongo.WriteModel
+	holderModels    []mongo.WriteModel
+	lockedModels    []mongo.WriteModel
+	escrowModels    []mongo.WriteModel
+	scheduleModels  []mongo.WriteModel
+	htlcModels      []mongo.WriteModel
+	allowanceModels []mongo.WriteModel
+	aliasModels     []mongo.WriteModel
+	metadataModels  []mongo.WriteModel
 	statesValue     *sync.Map
 }
 
@@ -84,7 +94,48 @@ func (bs *BlockSession) Commit(ctx context.Context) error {
 		return err
 	}
 
-	return bs.writeModels(ctx, defaultColNameBalance, bs.balanceModels)
+	if err := bs.writeModels(ctx, defaultColNameBalance, bs.balanceModels); err != nil {
+		return err
+	}
+
+	if err := bs.writeModels(ctx, defaultColNameHolder, bs.holderModels); err != nil {
+		return err
+	}
+
+	if err := bs.writeModels(ctx, defaultColNameLockedBalance, bs.lockedModels); err != nil {
+		return err
+	}
+
+	if err := bs.writeModels(ctx, defaultColNameEscrow, bs.escrowModels); err != nil {
+		return err
+	}
+
+	if err := bs.writeModels(ctx, defaultColNameSchedule, bs.scheduleModels); err != nil {
+		return err
+	}
+
+	if err := bs.writeModels(ctx, defaultColNameHTLC, bs.htlcModels); err != nil {
+		return err
+	}
+
+	if err := bs.writeModels(ctx, defaultColNameAllowance, bs.allowanceModels); err != nil {
+		return err
+	}
+
+	if err := bs.writeModels(ctx, defaultColNameAlias, bs.aliasModels); err != nil {
+		return err
+	}
+
+	return bs.writeModels(ctx, defaultColNameAccountMetadata, bs.metadataModels)
+}
+
+// OperationValues returns the prepared OperationValues of block. After Commit,
+// it returns nil.
+func (bs *BlockSession) OperationValues() []OperationValue {
+	bs.RLock()
+	defer bs.RUnlock()
+
+	return bs.operations
 }
 
 func (bs *BlockSession) Close() error {
@@ -126,6 +177,9 @@ func (bs *BlockSession) prepareOperations() error {
 	}
 
 	bs.operationModels = make([]mongo.WriteModel, len(bs.block.Operations()))
+	bs.operations = make([]OperationValue, len(bs.block.Operations()))
+
+	rs := newBlockAccountResolver(bs.st, bs.block.States())
 
 	for i := range bs.block.Operations() {
 		op := bs.block.Operations()[i]
@@ -135,19 +189,16 @@ func (bs *BlockSession) prepareOperations() error {
 			return util.NotFoundError.Errorf("operation, %s not found in operations tree", op.Fact().Hash().String())
 		}
 
-		doc, err := NewOperationDoc(
-			op,
+		doc, err := newOperationDoc(
+			NewOperationValue(op, bs.block.Height(), bs.block.ConfirmedAt(), inState, reason, uint64(i)),
 			bs.st.database.Encoder(),
-			bs.block.Height(),
-			bs.block.ConfirmedAt(),
-			inState,
-			reason,
-			uint64(i),
+			rs,
 		)
 		if err != nil {
 			return err
 		}
 		bs.operationModels[i] = mongo.NewInsertOneModel().SetDocument(doc)
+		bs.operations[i] = doc.va
 	}
 
 	return nil
@@ -160,6 +211,14 @@ func (bs *BlockSession) prepareAccounts() error {
 
 	var accountModels []mongo.WriteModel
 	var balanceModels []mongo.WriteModel
+	var holderModels []mongo.WriteModel
+	var lockedModels []mongo.WriteModel
+	var escrowModels []mongo.WriteModel
+	var scheduleModels []mongo.WriteModel
+	var htlcModels []mongo.WriteModel
+	var allowanceModels []mongo.WriteModel
+	var aliasModels []mongo.WriteModel
+	var metadataModels []mongo.WriteModel
 	for i := range bs.block.States() {
 		st := bs.block.States()[i]
 		switch {
@@ -175,6 +234,54 @@ func (bs *BlockSession) prepareAccounts() error {
 				return err
 			}
 			balanceModels = append(balanceModels, j...)
+
+			k, err := bs.handleHolderState(st)
+			if err != nil {
+				return err
+			}
+			holderModels = append(holderModels, k...)
+		case currency.IsStateLockedBalanceKey(st.Key()):
+			j, err := bs.handleLockedBalanceState(st)
+			if err != nil {
+				return err
+			}
+			lockedModels = append(lockedModels, j...)
+		case currency.IsStateEscrowKey(st.Key()):
+			j, err := bs.handleEscrowState(st)
+			if err != nil {
+				return err
+			}
+			escrowModels = append(escrowModels, j...)
+		case currency.IsStateScheduleKey(st.Key()):
+			j, err := bs.handleScheduleState(st)
+			if err != nil {
+				return err
+			}
+			scheduleModels = append(scheduleModels, j...)
+		case currency.IsStateHTLCKey(st.Key()):
+			j, err := bs.handleHTLCState(st)
+			if err != nil {
+				return err
+			}
+			htlcModels = append(htlcModels, j...)
+		case currency.IsStateAllowanceKey(st.Key()):
+			j, err := bs.handleAllowanceState(st)
+			if err != nil {
+				return err
+			}
+			allowanceModels = append(allowanceModels, j...)
+		case currency.IsStateAliasKey(st.Key()):
+			j, err := bs.handleAliasState(st)
+			if err != nil {
+				return err
+			}
+			aliasModels = append(aliasModels, j...)
+		case currency.IsStateAccountMetadataKey(st.Key()):
+			j, err := bs.handleAccountMetadataState(st)
+			if err != nil {
+				return err
+			}
+			metadataModels = append(metadataModels, j...)
 		default:
 			continue
 		}
@@ -182,6 +289,14 @@ func (bs *BlockSession) prepareAccounts() error {
 
 	bs.accountModels = accountModels
 	bs.balanceModels = balanceModels
+	bs.holderModels = holderModels
+	bs.lockedModels = lockedModels
+	bs.escrowModels = escrowModels
+	bs.scheduleModels = scheduleModels
+	bs.htlcModels = htlcModels
+	bs.allowanceModels = allowanceModels
+	bs.aliasModels = aliasModels
+	bs.metadataModels = metadataModels
 
 	return nil
 }
@@ -204,6 +319,89 @@ func (bs *BlockSession) handleBalanceState(st state.State) ([]mongo.WriteModel,
 	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
 }
 
+// handleHolderState replaces the latest balance of holder.
+func (bs *BlockSession) handleHolderState(st state.State) ([]mongo.WriteModel, error) {
+	doc, err := NewHolderDoc(st, bs.st.database.Encoder())
+	if err != nil {
+		return nil, err
+	}
+
+	return []mongo.WriteModel{
+		mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"_id": doc.ID()}).
+			SetReplacement(doc).
+			SetUpsert(true),
+	}, nil
+}
+
+func (bs *BlockSession) handleLockedBalanceState(st state.State) ([]mongo.WriteModel, error) {
+	doc, err := NewLockedBalanceDoc(st, bs.st.database.Encoder())
+	if err != nil {
+		return nil, err
+	}
+	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+}
+
+func (bs *BlockSession) handleEscrowState(st state.State) ([]mongo.WriteModel, error) {
+	if va, err := NewEscrowValue(st); err != nil {
+		return nil, err
+	} else if doc, err := NewEscrowDoc(va, bs.st.database.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+	}
+}
+
+func (bs *BlockSession) handleScheduleState(st state.State) ([]mongo.WriteModel, error) {
+	if va, err := NewScheduleValue(st); err != nil {
+		return nil, err
+	} else if doc, err := NewScheduleDoc(va, bs.st.database.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+	}
+}
+
+func (bs *BlockSession) handleHTLCState(st state.State) ([]mongo.WriteModel, error) {
+	if va, err := NewHTLCValue(st); err != nil {
+		return nil, err
+	} else if doc, err := NewHTLCDoc(va, bs.st.database.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+	}
+}
+
+func (bs *BlockSession) handleAllowanceState(st state.State) ([]mongo.WriteModel, error) {
+	if va, err := NewAllowanceValue(st); err != nil {
+		return nil, err
+	} else if doc, err := NewAllowanceDoc(va, bs.st.database.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+	}
+}
+
+func (bs *BlockSession) handleAliasState(st state.State) ([]mongo.WriteModel, error) {
+	if va, err := NewAliasValue(st); err != nil {
+		return nil, err
+	} else if doc, err := NewAliasDoc(va, bs.st.database.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+	}
+}
+
+func (bs *BlockSession) handleAccountMetadataState(st state.State) ([]mongo.WriteModel, error) {
+	if va, err := NewAccountMetadataValue(st); err != nil {
+		return nil, err
+	} else if doc, err := NewAccountMetadataDoc(va, bs.st.database.Encoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+	}
+}
+
 func (bs *BlockSession) writeModels(ctx context.Context, col string, models []mongo.WriteModel) error {
 	started := time.Now()
 	defer func() {
@@ -241,7 +439,7 @@ func (bs *BlockSession) writeModelsChunk(ctx context.Context, col string, models
 	opts := options.BulkWrite().SetOrdered(false)
 	if res, err := bs.st.database.Client().Collection(col).BulkWrite(ctx, models, opts); err != nil {
 		return storage.MergeStorageError(err)
-	} else if res != nil && res.InsertedCount < 1 {
+	} else if res != nil && res.InsertedCount < 1 && res.UpsertedCount < 1 && res.MatchedCount < 1 {
 		return errors.Errorf("not inserted to %s", col)
 	}
 
@@ -251,8 +449,17 @@ func (bs *BlockSession) writeModelsChunk(ctx context.Context, col string, models
 func (bs *BlockSession) close() error {
 	bs.block = nil
 	bs.operationModels = nil
+	bs.operations = nil
 	bs.accountModels = nil
 	bs.balanceModels = nil
+	bs.holderModels = nil
+	bs.lockedModels = nil
+	bs.escrowModels = nil
+	bs.scheduleModels = nil
+	bs.htlcModels = nil
+	bs.allowanceModels = nil
+	bs.aliasModels = nil
+	bs.metadataModels = nil
 
 	return bs.st.Close()
 }
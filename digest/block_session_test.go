@@ -83,7 +83,7 @@ func (t *testDatabase) TestBlockSessionWithOperations() {
 	for _, address := range addrs {
 		var i int
 		ops := make([]string, len(opsByAddress[address.String()]))
-		err := st.OperationsByAddress(address, true, false, "", 0, func(fh valuehash.Hash, va OperationValue) (bool, error) {
+		err := st.OperationsByAddress(address, OperationFilter{}, true, false, "", 0, func(fh valuehash.Hash, va OperationValue) (bool, error) {
 			ops[i] = va.Operation().Fact().Hash().String()
 			i++
 
@@ -98,7 +98,7 @@ func (t *testDatabase) TestBlockSessionWithOperations() {
 		// reverse
 		ops = make([]string, len(opsByAddress[address.String()]))
 		i = len(ops) - 1
-		err = st.OperationsByAddress(address, true, true, "", 0, func(fh valuehash.Hash, va OperationValue) (bool, error) {
+		err = st.OperationsByAddress(address, OperationFilter{}, true, true, "", 0, func(fh valuehash.Hash, va OperationValue) (bool, error) {
 			ops[i] = va.Operation().Fact().Hash().String()
 			i--
 
@@ -160,6 +160,18 @@ func (t *testDatabase) TestBlockSessionWithStates() {
 		t.Equal(1, len(uac.Balance()))
 		t.compareAmount(balances[ac.Address().String()], uac.Balance()[0])
 	}
+
+	// NOTE holders are updated by balance states
+	t.NoError(st.Holders(t.cid, "", 0, func(va HolderValue) (bool, error) {
+		t.compareAmount(balances[va.Address().String()], va.Amount())
+		t.Equal(blk.Height(), va.Height())
+
+		return true, nil
+	}))
+
+	n, err := st.HoldersCount(t.cid)
+	t.NoError(err)
+	t.Equal(int64(len(acs)), n)
 }
 
 func (t *testDatabase) TestBlockSessionWithCurrencyPool() {
@@ -47,6 +47,12 @@ func NewBuilder(enc encoder.Encoder, networkID base.NetworkID) Builder {
 }
 
 func (bl Builder) FactTemplate(ht hint.Hint) (Hal, error) {
+	return bl.FactTemplateWithFeeer(ht, currency.FeeerNil)
+}
+
+// FactTemplateWithFeeer returns fact template; feeerType is used for the
+// operations, which has CurrencyPolicy.
+func (bl Builder) FactTemplateWithFeeer(ht hint.Hint, feeerType string) (Hal, error) {
 	switch ht.Type() {
 	case currency.CreateAccountsType:
 		return bl.templateCreateAccountsFact(), nil
@@ -55,9 +61,9 @@ func (bl Builder) FactTemplate(ht hint.Hint) (Hal, error) {
 	case currency.TransfersType:
 		return bl.templateTransfersFact(), nil
 	case currency.CurrencyRegisterType:
-		return bl.templateCurrencyRegisterFact(), nil
+		return bl.templateCurrencyRegisterFact(feeerType)
 	case currency.CurrencyPolicyUpdaterType:
-		return bl.templateCurrencyPolicyUpdaterFact(), nil
+		return bl.templateCurrencyPolicyUpdaterFact(feeerType)
 	default:
 		return nil, errors.Errorf("unknown operation, %q", ht)
 	}
@@ -127,8 +133,47 @@ func (Builder) templateTransfersFact() Hal {
 	})
 }
 
-func (Builder) templateCurrencyRegisterFact() Hal {
-	po := currency.NewCurrencyPolicy(templateBig, currency.NewNilFeeer())
+func (Builder) templateFeeer(feeerType string) (currency.Feeer, map[string]interface{}, error) {
+	switch feeerType {
+	case currency.FeeerNil, "":
+		return currency.NewNilFeeer(), nil, nil
+	case currency.FeeerFixed:
+		return currency.NewFixedFeeer(templateReceiver, templateBig), map[string]interface{}{
+			"policy.feeer.receiver": templateReceiver,
+			"policy.feeer.amount":   templateBig,
+		}, nil
+	case currency.FeeerRatio:
+		return currency.NewRatioFeeer(templateReceiver, 0, templateBig, currency.UnlimitedMaxFeeAmount),
+			map[string]interface{}{
+				"policy.feeer.receiver": templateReceiver,
+				"policy.feeer.ratio":    0,
+				"policy.feeer.min":      templateBig,
+				"policy.feeer.max":      currency.UnlimitedMaxFeeAmount,
+			}, nil
+	case currency.FeeerTiered:
+		brackets := []currency.FeeBracket{
+			currency.NewFeeBracket(templateBig, templateBig, 0),
+			currency.NewFeeBracket(currency.UnlimitedMaxFeeAmount, templateBig, 0),
+		}
+
+		return currency.NewTieredFeeer(templateReceiver, brackets), map[string]interface{}{
+			"policy.feeer.receiver":        templateReceiver,
+			"policy.feeer.brackets.upper":  templateBig,
+			"policy.feeer.brackets.amount": templateBig,
+			"policy.feeer.brackets.ratio":  0,
+		}, nil
+	default:
+		return nil, nil, errors.Errorf("unknown feeer type, %q", feeerType)
+	}
+}
+
+func (bl Builder) templateCurrencyRegisterFact(feeerType string) (Hal, error) {
+	feeer, fextras, err := bl.templateFeeer(feeerType)
+	if err != nil {
+		return nil, err
+	}
+
+	po := currency.NewCurrencyPolicy(templateBig, feeer)
 	de := currency.NewCurrencyDesign(
 		currency.NewAmount(templateBig, templateCurrencyID),
 		templateReceiver,
@@ -138,26 +183,41 @@ func (Builder) templateCurrencyRegisterFact() Hal {
 
 	hal := NewBaseHal(fact, HalLink{})
 
-	return hal.AddExtras("default", map[string]interface{}{
+	extras := map[string]interface{}{
 		"token":                    templateToken,
 		"amount.amount":            templateBig,
 		"amount.currency":          templateCurrencyID,
 		"currency.genesis_account": templateReceiver,
 		"currency.policy.new_account_min_balance": templateBig,
-	})
+	}
+	for k := range fextras {
+		extras["currency."+k] = fextras[k]
+	}
+
+	return hal.AddExtras("default", extras), nil
 }
 
-func (Builder) templateCurrencyPolicyUpdaterFact() Hal {
-	po := currency.NewCurrencyPolicy(templateBig, currency.NewNilFeeer())
+func (bl Builder) templateCurrencyPolicyUpdaterFact(feeerType string) (Hal, error) {
+	feeer, fextras, err := bl.templateFeeer(feeerType)
+	if err != nil {
+		return nil, err
+	}
+
+	po := currency.NewCurrencyPolicy(templateBig, feeer)
 	fact := currency.NewCurrencyPolicyUpdaterFact(templateToken, templateCurrencyID, po)
 
 	hal := NewBaseHal(fact, HalLink{})
 
-	return hal.AddExtras("default", map[string]interface{}{
+	extras := map[string]interface{}{
 		"token":                          templateToken,
 		"currency":                       templateCurrencyID,
 		"policy.new_account_min_balance": templateBig,
-	})
+	}
+	for k := range fextras {
+		extras[k] = fextras[k]
+	}
+
+	return hal.AddExtras("default", extras), nil
 }
 
 func (bl Builder) BuildFact(b []byte) (Hal, error) {
@@ -453,7 +513,7 @@ func (Builder) isValidFactCurrencyRegister(fact currency.CurrencyRegisterFact) e
 		return errors.Errorf("Please set new_account_min_balance; new_account_min_balance is same with template default")
 	}
 
-	return nil
+	return isValidTemplateFeeer(fact.Currency().Policy().Feeer())
 }
 
 func (Builder) isValidFactCurrencyPolicyUpdater(fact currency.CurrencyPolicyUpdaterFact) error {
@@ -465,6 +525,14 @@ func (Builder) isValidFactCurrencyPolicyUpdater(fact currency.CurrencyPolicyUpda
 		return errors.Errorf("Please set token; token same with template default")
 	}
 
+	return isValidTemplateFeeer(fact.Policy().Feeer())
+}
+
+func isValidTemplateFeeer(feeer currency.Feeer) error {
+	if receiver := feeer.Receiver(); receiver != nil && receiver.Equal(templateReceiver) {
+		return errors.Errorf("Please set feeer receiver; receiver is same with template default")
+	}
+
 	return nil
 }
 
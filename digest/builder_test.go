@@ -379,6 +379,49 @@ func (t *testBuilder) TestBuildFactCurrencyPolicyUpdater() {
 	_ = t.buildOperation(uop, sb.([]byte))
 }
 
+func (t *testBuilder) TestBuildFactCurrencyPolicyUpdaterTieredFeeer() {
+	bl := NewBuilder(t.JSONEnc, t.networkID)
+
+	_, err := bl.FactTemplateWithFeeer(currency.CurrencyPolicyUpdaterHinter.Hint(), "showme")
+	t.Contains(err.Error(), "unknown feeer type")
+
+	hal, err := bl.FactTemplateWithFeeer(currency.CurrencyPolicyUpdaterHinter.Hint(), currency.FeeerTiered)
+	t.NoError(err)
+
+	b, err := t.JSONEnc.Marshal(hal)
+	t.NoError(err)
+	rhal := t.decodeHal(b)
+
+	templateTokenEncoded := base64.StdEncoding.EncodeToString(templateToken)
+
+	newReceiver := currency.NewAddress("new-father")
+	newBig := currency.NewBig(99)
+	newToken := util.UUID().Bytes()
+	newTokenEncoded := base64.StdEncoding.EncodeToString(newToken)
+	newCurrencyID := currency.CurrencyID("XXX")
+
+	b = bytes.ReplaceAll(rhal.RawInterface(), []byte(templateReceiver.String()), []byte(newReceiver.String()))
+	b = bytes.ReplaceAll(b, []byte(templateBig.String()), []byte(newBig.String()))
+	b = bytes.ReplaceAll(b, []byte(templateTokenEncoded), []byte(newTokenEncoded))
+	b = bytes.ReplaceAll(b, []byte(templateCurrencyID), newCurrencyID.Bytes())
+
+	uhal, err := bl.BuildFact(b)
+	t.NoError(err)
+
+	uop, ok := uhal.Interface().(currency.CurrencyPolicyUpdater)
+	t.True(ok)
+
+	ufact := uop.Fact().(currency.CurrencyPolicyUpdaterFact)
+
+	feeer, ok := ufact.Policy().Feeer().(currency.TieredFeeer)
+	t.True(ok)
+	t.True(feeer.Receiver().Equal(newReceiver))
+	t.Equal(2, len(feeer.Brackets()))
+	t.Equal(newBig, feeer.Brackets()[0].Upper())
+	t.Equal(newBig, feeer.Brackets()[1].Amount())
+	t.True(feeer.Brackets()[1].Upper().Equal(currency.UnlimitedMaxFeeAmount))
+}
+
 func (t *testBuilder) buildOperation(op operation.Operation, sb []byte) operation.Operation {
 	priv := key.NewBasePrivatekey()
 	sig, err := priv.Sign(sb)
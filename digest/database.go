@@ -30,15 +30,33 @@ import (
 var maxLimit int64 = 50
 
 var (
-	defaultColNameAccount   = "digest_ac"
-	defaultColNameBalance   = "digest_bl"
-	defaultColNameOperation = "digest_op"
+	defaultColNameAccount         = "digest_ac"
+	defaultColNameBalance         = "digest_bl"
+	defaultColNameLockedBalance   = "digest_lbl"
+	defaultColNameOperation       = "digest_op"
+	defaultColNameEscrow          = "digest_es"
+	defaultColNameSchedule        = "digest_sch"
+	defaultColNameHTLC            = "digest_htlc"
+	defaultColNameAllowance       = "digest_al"
+	defaultColNameAlias           = "digest_als"
+	defaultColNameAccountMetadata = "digest_md"
+	defaultColNameHolder          = "digest_hd"
+	defaultColNameWebhook         = "digest_wh"
+	defaultColNameWebhookDelivery = "digest_whd"
 )
 
 var AllCollections = []string{
 	defaultColNameAccount,
 	defaultColNameBalance,
+	defaultColNameLockedBalance,
 	defaultColNameOperation,
+	defaultColNameEscrow,
+	defaultColNameSchedule,
+	defaultColNameHTLC,
+	defaultColNameAllowance,
+	defaultColNameAlias,
+	defaultColNameAccountMetadata,
+	defaultColNameHolder,
 }
 
 var DigestStorageLastBlockKey = "digest_last_block"
@@ -117,6 +135,14 @@ func (st *Database) Initialize() error {
 			if err := st.cleanByHeight(context.Background(), h+1); err != nil {
 				return err
 			}
+
+			if err := st.backfillHolders(context.Background()); err != nil {
+				return err
+			}
+
+			if err := st.backfillOperations(context.Background()); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -186,7 +212,15 @@ func (st *Database) clean(ctx context.Context) error {
 	for _, col := range []string{
 		defaultColNameAccount,
 		defaultColNameBalance,
+		defaultColNameLockedBalance,
 		defaultColNameOperation,
+		defaultColNameEscrow,
+		defaultColNameSchedule,
+		defaultColNameHTLC,
+		defaultColNameAllowance,
+		defaultColNameAlias,
+		defaultColNameAccountMetadata,
+		defaultColNameHolder,
 	} {
 		if err := st.database.Client().Collection(col).Drop(ctx); err != nil {
 			return storage.MergeStorageError(err)
@@ -226,7 +260,14 @@ func (st *Database) cleanByHeight(ctx context.Context, height base.Height) error
 	for _, col := range []string{
 		defaultColNameAccount,
 		defaultColNameBalance,
+		defaultColNameLockedBalance,
 		defaultColNameOperation,
+		defaultColNameEscrow,
+		defaultColNameSchedule,
+		defaultColNameHTLC,
+		defaultColNameAllowance,
+		defaultColNameAlias,
+		defaultColNameAccountMetadata,
 	} {
 		res, err := st.database.Client().Collection(col).BulkWrite(
 			ctx,
@@ -240,9 +281,232 @@ func (st *Database) cleanByHeight(ctx context.Context, height base.Height) error
 		st.Log().Debug().Str("collection", col).Interface("result", res).Msg("clean collection by height")
 	}
 
+	if err := st.cleanHoldersByHeight(ctx, height); err != nil {
+		return err
+	}
+
 	return st.setLastBlock(height - 1)
 }
 
+// backfillHolders fills the empty holders with the latest balances. The digest
+// database, which was digested before the holders, has only the balances, so
+// the holders should be filled from them.
+func (st *Database) backfillHolders(ctx context.Context) error {
+	switch found, err := st.database.Client().Exists(defaultColNameHolder, bson.D{}); {
+	case err != nil:
+		return err
+	case found:
+		return nil
+	}
+
+	var models []mongo.WriteModel
+	var last string
+	var n int
+
+	write := func() error {
+		if len(models) < 1 {
+			return nil
+		}
+
+		if err := st.database.Client().Bulk(ctx, defaultColNameHolder, models, false); err != nil {
+			return err
+		}
+
+		n += len(models)
+		models = nil
+
+		return nil
+	}
+
+	if err := st.database.Client().Find(
+		ctx,
+		defaultColNameBalance,
+		bson.M{},
+		func(cursor *mongo.Cursor) (bool, error) {
+			sta, err := LoadBalance(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			if sta.Key() == last { // NOTE older balance
+				return true, nil
+			}
+			last = sta.Key()
+
+			doc, err := NewHolderDoc(sta, st.database.Encoder())
+			if err != nil {
+				return false, err
+			}
+
+			models = append(models, mongo.NewReplaceOneModel().
+				SetFilter(bson.M{"_id": doc.ID()}).
+				SetReplacement(doc).
+				SetUpsert(true),
+			)
+
+			if len(models) < bulkWriteLimit {
+				return true, nil
+			}
+
+			return true, write()
+		},
+		options.Find().SetSort(util.NewBSONFilter("address", 1).Add("currency", 1).Add("height", -1).D()),
+	); err != nil {
+		return err
+	}
+
+	if err := write(); err != nil {
+		return err
+	}
+
+	if n > 0 {
+		st.Log().Debug().Int("holders", n).Msg("holders backfilled from balances")
+	}
+
+	return nil
+}
+
+// backfillOperations fills the fields for OperationFilter, like debits and
+// credits, of the operations, which were digested by the older version.
+func (st *Database) backfillOperations(ctx context.Context) error {
+	filter := util.NewBSONFilter("debits", bson.M{"$exists": false}).D()
+	switch found, err := st.database.Client().Exists(defaultColNameOperation, filter); {
+	case err != nil:
+		return err
+	case !found:
+		return nil
+	}
+
+	rs := newBlockAccountResolver(st, nil)
+
+	var models []mongo.WriteModel
+	var n int
+
+	write := func() error {
+		if len(models) < 1 {
+			return nil
+		}
+
+		if err := st.database.Client().Bulk(ctx, defaultColNameOperation, models, false); err != nil {
+			return err
+		}
+
+		n += len(models)
+		models = nil
+
+		return nil
+	}
+
+	if err := st.database.Client().Find(
+		ctx,
+		defaultColNameOperation,
+		filter,
+		func(cursor *mongo.Cursor) (bool, error) {
+			va, err := LoadOperation(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			doc, err := newOperationDoc(va, st.database.Encoder(), rs)
+			if err != nil {
+				return false, err
+			}
+
+			models = append(models, mongo.NewReplaceOneModel().
+				SetFilter(bson.M{"_id": cursor.Current.Lookup("_id")}).
+				SetReplacement(doc),
+			)
+
+			if len(models) < bulkWriteLimit {
+				return true, nil
+			}
+
+			return true, write()
+		},
+	); err != nil {
+		return err
+	}
+
+	if err := write(); err != nil {
+		return err
+	}
+
+	if n > 0 {
+		st.Log().Debug().Int("operations", n).Msg("operations backfilled")
+	}
+
+	return nil
+}
+
+// cleanHoldersByHeight restores the holders, which were updated since the
+// given height, with the latest balances before the height.
+func (st *Database) cleanHoldersByHeight(ctx context.Context, height base.Height) error {
+	var vas []HolderValue
+	if err := st.database.Client().Find(
+		ctx,
+		defaultColNameHolder,
+		bson.M{"height": bson.M{"$gte": height}},
+		func(cursor *mongo.Cursor) (bool, error) {
+			va, err := LoadHolderValue(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, va)
+
+			return true, nil
+		},
+	); err != nil {
+		return err
+	}
+
+	for i := range vas {
+		va := vas[i]
+
+		var sta state.State
+		switch err := st.database.Client().GetByFilter(
+			defaultColNameBalance,
+			util.NewBSONFilter("address", va.Address().String()).
+				Add("currency", va.Amount().Currency().String()).D(),
+			func(res *mongo.SingleResult) error {
+				j, err := LoadBalance(res.Decode, st.database.Encoders())
+				if err != nil {
+					return err
+				}
+				sta = j
+
+				return nil
+			},
+			options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+		); {
+		case err == nil:
+		case errors.Is(err, util.NotFoundError):
+			if _, err := st.database.Client().Delete(
+				defaultColNameHolder,
+				util.NewBSONFilter("_id", currency.StateKeyBalance(va.Address(), va.Amount().Currency())).D(),
+			); err != nil {
+				return err
+			}
+
+			continue
+		default:
+			return err
+		}
+
+		doc, err := NewHolderDoc(sta, st.database.Encoder())
+		if err != nil {
+			return err
+		}
+
+		if _, err := st.database.Client().Set(defaultColNameHolder, doc); err != nil {
+			return err
+		}
+	}
+
+	st.Log().Debug().Int("holders", len(vas)).Msg("clean holders by height")
+
+	return nil
+}
+
 func (st *Database) ManifestByHeight(height base.Height) (block.Manifest, bool, error) {
 	return st.mitum.ManifestByHeight(height)
 }
@@ -280,19 +544,21 @@ func (st *Database) Manifests(
 // OperationsByAddress finds the operation.Operations, which are related with
 // the given Address. The returned valuehash.Hash is the
 // operation.Operation.Fact().Hash().
+// *      of: filters the operations; empty OperationFilter does not filter.
 // *    load:if true, load operation.Operation and returns it. If not, just hash will be returned
 // * reverse: order by height; if true, higher height will be returned first.
 // *  offset: returns from next of offset, usually it is combination of
 // "<height>,<fact>".
 func (st *Database) OperationsByAddress(
 	address base.Address,
+	of OperationFilter,
 	load,
 	reverse bool,
 	offset string,
 	limit int64,
 	callback func(valuehash.Hash /* fact hash */, OperationValue) (bool, error),
 ) error {
-	filter, err := buildOperationsFilterByAddress(address, offset, reverse)
+	filter, err := buildOperationsFilterByAddress(address, of, offset, reverse)
 	if err != nil {
 		return err
 	}
@@ -431,124 +697,990 @@ func (st *Database) Operations(
 	)
 }
 
-// Account returns AccountValue.
-func (st *Database) Account(a base.Address) (AccountValue, bool /* exists */, error) {
-	var rs AccountValue
+// Escrow returns the latest EscrowValue of the given escrow id.
+func (st *Database) Escrow(id valuehash.Hash) (EscrowValue, bool /* exists */, error) {
+	var va EscrowValue
 	if err := st.database.Client().GetByFilter(
-		defaultColNameAccount,
-		util.NewBSONFilter("address", a.String()).D(),
+		defaultColNameEscrow,
+		util.NewBSONFilter("id", id.String()).D(),
 		func(res *mongo.SingleResult) error {
-			i, err := LoadAccountValue(res.Decode, st.database.Encoders())
+			i, err := LoadEscrowValue(res.Decode, st.database.Encoders())
 			if err != nil {
 				return err
 			}
-			rs = i
+			va = i
 
 			return nil
 		},
 		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
 	); err != nil {
 		if errors.Is(err, util.NotFoundError) {
-			return rs, false, nil
+			return EscrowValue{}, false, nil
 		}
 
-		return rs, false, err
-	}
-
-	// NOTE load balance
-	switch am, lastHeight, previousHeight, err := st.balance(a); {
-	case err != nil:
-		return rs, false, err
-	default:
-		rs = rs.SetBalance(am).
-			SetHeight(lastHeight).
-			SetPreviousHeight(previousHeight)
+		return EscrowValue{}, false, err
 	}
 
-	return rs, true, nil
+	return va, true, nil
 }
 
-// AccountsByPublickey finds Accounts, which are related with the given
-// Publickey.
-// *  offset: returns from next of offset, usually it is "<height>,<address>".
-func (st *Database) AccountsByPublickey(
-	pub key.Publickey,
-	loadBalance bool,
-	offsetHeight base.Height,
-	offsetAddress string,
+// EscrowsByAddress finds the latest EscrowValues, which the given address is
+// the sender, beneficiary or arbiter of. The escrows are ordered by id.
+// *  offset: returns from next of offset, it is the escrow id.
+func (st *Database) EscrowsByAddress(
+	address base.Address,
+	offset string,
 	limit int64,
-	callback func(AccountValue) (bool, error),
+	callback func(EscrowValue) (bool, error),
 ) error {
-	if offsetHeight <= base.NilHeight {
-		return errors.Errorf("offset height should be over nil height")
+	filter := bson.M{"addresses": bson.M{"$in": []string{address.String()}}}
+	if len(offset) > 0 {
+		filter["id"] = bson.M{"$gt": offset}
 	}
 
-	filter := buildAccountsFilterByPublickey(pub)
-	filter["height"] = bson.M{"$lte": offsetHeight}
+	opt := options.Find().SetSort(
+		util.NewBSONFilter("id", 1).Add("height", -1).D(),
+	)
 
-	var sas []string
-	switch i, err := st.addressesByPublickey(filter); {
-	case err != nil:
-		return err
-	default:
-		sas = i
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		limit = maxLimit
 	}
 
-	if len(sas) < 1 {
-		return nil
-	}
+	var lastID string
+	var called int64
+	return st.database.Client().Find(
+		context.Background(),
+		defaultColNameEscrow,
+		filter,
+		func(cursor *mongo.Cursor) (bool, error) {
+			if limit > 0 && called == limit {
+				return false, nil
+			}
 
-	var filteredAddress []string
-	if len(offsetAddress) < 1 {
-		filteredAddress = sas
-	} else {
-		var found bool
-		for i := range sas {
-			a := sas[i]
-			if !found {
-				if offsetAddress == a {
-					found = true
-				}
+			va, err := LoadEscrowValue(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
 
-				continue
+			id := va.Escrow().ID().String()
+			if lastID == id { // NOTE skip the older states of same escrow
+				return true, nil
 			}
+			lastID = id
 
-			filteredAddress = append(filteredAddress, a)
+			called++
+
+			return callback(va)
+		},
+		opt,
+	)
+}
+
+// Schedule returns the latest ScheduleValue of the given schedule id.
+func (st *Database) Schedule(id valuehash.Hash) (ScheduleValue, bool /* exists */, error) {
+	var va ScheduleValue
+	if err := st.database.Client().GetByFilter(
+		defaultColNameSchedule,
+		util.NewBSONFilter("id", id.String()).D(),
+		func(res *mongo.SingleResult) error {
+			i, err := LoadScheduleValue(res.Decode, st.database.Encoders())
+			if err != nil {
+				return err
+			}
+			va = i
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		if errors.Is(err, util.NotFoundError) {
+			return ScheduleValue{}, false, nil
 		}
-	}
 
-	if len(filteredAddress) < 1 {
-		return nil
+		return ScheduleValue{}, false, err
 	}
 
-end:
-	for i := int64(0); i < int64(math.Ceil(float64(len(filteredAddress))/50.0)); i++ {
-		l := (i + 1) + 50
-		if n := int64(len(filteredAddress)); l > n {
-			l = n
-		}
+	return va, true, nil
+}
 
-		limited := filteredAddress[i*50 : l]
-		switch done, err := st.filterAccountByPublickey(
-			pub, limited, limit, loadBalance, callback,
-		); {
+// SchedulesByAddress finds the latest ScheduleValues, which the given address
+// is the sender or receiver of. The schedules are ordered by id.
+// *  offset: returns from next of offset, it is the schedule id.
+func (st *Database) SchedulesByAddress(
+	address base.Address,
+	offset string,
+	limit int64,
+	callback func(ScheduleValue) (bool, error),
+) error {
+	filter := bson.M{"addresses": bson.M{"$in": []string{address.String()}}}
+	if len(offset) > 0 {
+		filter["id"] = bson.M{"$gt": offset}
+	}
+
+	opt := options.Find().SetSort(
+		util.NewBSONFilter("id", 1).Add("height", -1).D(),
+	)
+
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		limit = maxLimit
+	}
+
+	var lastID string
+	var called int64
+	return st.database.Client().Find(
+		context.Background(),
+		defaultColNameSchedule,
+		filter,
+		func(cursor *mongo.Cursor) (bool, error) {
+			if limit > 0 && called == limit {
+				return false, nil
+			}
+
+			va, err := LoadScheduleValue(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			id := va.Schedule().ID().String()
+			if lastID == id { // NOTE skip the older states of same schedule
+				return true, nil
+			}
+			lastID = id
+
+			called++
+
+			return callback(va)
+		},
+		opt,
+	)
+}
+
+// HTLC returns the latest HTLCValue of the given htlc id.
+func (st *Database) HTLC(id valuehash.Hash) (HTLCValue, bool /* exists */, error) {
+	var va HTLCValue
+	if err := st.database.Client().GetByFilter(
+		defaultColNameHTLC,
+		util.NewBSONFilter("id", id.String()).D(),
+		func(res *mongo.SingleResult) error {
+			i, err := LoadHTLCValue(res.Decode, st.database.Encoders())
+			if err != nil {
+				return err
+			}
+			va = i
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		if errors.Is(err, util.NotFoundError) {
+			return HTLCValue{}, false, nil
+		}
+
+		return HTLCValue{}, false, err
+	}
+
+	return va, true, nil
+}
+
+// HTLCsByAddress finds the latest HTLCValues, which the given address
+// is the sender or receiver of. The htlcs are ordered by id.
+// *  offset: returns from next of offset, it is the htlc id.
+func (st *Database) HTLCsByAddress(
+	address base.Address,
+	offset string,
+	limit int64,
+	callback func(HTLCValue) (bool, error),
+) error {
+	filter := bson.M{"addresses": bson.M{"$in": []string{address.String()}}}
+	if len(offset) > 0 {
+		filter["id"] = bson.M{"$gt": offset}
+	}
+
+	opt := options.Find().SetSort(
+		util.NewBSONFilter("id", 1).Add("height", -1).D(),
+	)
+
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		limit = maxLimit
+	}
+
+	var lastID string
+	var called int64
+	return st.database.Client().Find(
+		context.Background(),
+		defaultColNameHTLC,
+		filter,
+		func(cursor *mongo.Cursor) (bool, error) {
+			if limit > 0 && called == limit {
+				return false, nil
+			}
+
+			va, err := LoadHTLCValue(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			id := va.HTLC().ID().String()
+			if lastID == id { // NOTE skip the older states of same htlc
+				return true, nil
+			}
+			lastID = id
+
+			called++
+
+			return callback(va)
+		},
+		opt,
+	)
+}
+
+// AllowancesByAddress finds the latest AllowanceValues, which the given
+// address is the owner or spender of. The allowances are ordered by the state
+// key of allowance.
+// *  offset: returns from next of offset, it is the state key of allowance.
+func (st *Database) AllowancesByAddress(
+	address base.Address,
+	offset string,
+	limit int64,
+	callback func(AllowanceValue) (bool, error),
+) error {
+	filter := bson.M{"addresses": bson.M{"$in": []string{address.String()}}}
+	if len(offset) > 0 {
+		filter["key"] = bson.M{"$gt": offset}
+	}
+
+	opt := options.Find().SetSort(
+		util.NewBSONFilter("key", 1).Add("height", -1).D(),
+	)
+
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		limit = maxLimit
+	}
+
+	var lastKey string
+	var called int64
+	return st.database.Client().Find(
+		context.Background(),
+		defaultColNameAllowance,
+		filter,
+		func(cursor *mongo.Cursor) (bool, error) {
+			if limit > 0 && called == limit {
+				return false, nil
+			}
+
+			va, err := LoadAllowanceValue(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			al := va.Allowance()
+			key := currency.StateKeyAllowance(al.Owner(), al.Spender(), al.Amount().Currency())
+			if lastKey == key { // NOTE skip the older states of same allowance
+				return true, nil
+			}
+			lastKey = key
+
+			called++
+
+			return callback(va)
+		},
+		opt,
+	)
+}
+
+// Alias returns the AliasValue of the given alias name.
+func (st *Database) Alias(name string) (AliasValue, bool /* exists */, error) {
+	var va AliasValue
+	if err := st.database.Client().GetByFilter(
+		defaultColNameAlias,
+		util.NewBSONFilter("name", name).D(),
+		func(res *mongo.SingleResult) error {
+			i, err := LoadAliasValue(res.Decode, st.database.Encoders())
+			if err != nil {
+				return err
+			}
+			va = i
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		if errors.Is(err, util.NotFoundError) {
+			return AliasValue{}, false, nil
+		}
+
+		return AliasValue{}, false, err
+	}
+
+	return va, true, nil
+}
+
+// AccountMetadata returns the latest AccountMetadataValue of the given address.
+func (st *Database) AccountMetadata(a base.Address) (AccountMetadataValue, bool /* exists */, error) {
+	var va AccountMetadataValue
+	if err := st.database.Client().GetByFilter(
+		defaultColNameAccountMetadata,
+		util.NewBSONFilter("address", a.String()).D(),
+		func(res *mongo.SingleResult) error {
+			i, err := LoadAccountMetadataValue(res.Decode, st.database.Encoders())
+			if err != nil {
+				return err
+			}
+			va = i
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		if errors.Is(err, util.NotFoundError) {
+			return AccountMetadataValue{}, false, nil
+		}
+
+		return AccountMetadataValue{}, false, err
+	}
+
+	return va, true, nil
+}
+
+// AccountsByMetadata finds the AccountValues, which the latest metadata has
+// the given key and value. The accounts are ordered by address.
+// *  offset: returns from next of offset, it is the account address.
+func (st *Database) AccountsByMetadata(
+	k, v string,
+	offset string,
+	limit int64,
+	callback func(AccountValue) (bool, error),
+) error {
+	filter := bson.M{"pairs": accountMetadataPair(k, v)}
+	if len(offset) > 0 {
+		filter["address"] = bson.M{"$gt": offset}
+	}
+
+	opt := options.Find().SetSort(
+		util.NewBSONFilter("address", 1).Add("height", -1).D(),
+	)
+
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		limit = maxLimit
+	}
+
+	var lastAddress string
+	var called int64
+	return st.database.Client().Find(
+		context.Background(),
+		defaultColNameAccountMetadata,
+		filter,
+		func(cursor *mongo.Cursor) (bool, error) {
+			if limit > 0 && called == limit {
+				return false, nil
+			}
+
+			va, err := LoadAccountMetadataValue(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			a := va.Metadata().Address()
+			if lastAddress == a.String() { // NOTE skip the older states of same account
+				return true, nil
+			}
+			lastAddress = a.String()
+
+			// NOTE the newer metadata, which does not match, replaces it
+			switch latest, found, err := st.AccountMetadata(a); {
+			case err != nil:
+				return false, err
+			case !found || latest.Height() != va.Height():
+				return true, nil
+			}
+
+			switch ac, found, err := st.Account(a); {
+			case err != nil:
+				return false, err
+			case !found:
+				return true, nil
+			default:
+				called++
+
+				return callback(ac)
+			}
+		},
+		opt,
+	)
+}
+
+// Account returns AccountValue.
+func (st *Database) Account(a base.Address) (AccountValue, bool /* exists */, error) {
+	var rs AccountValue
+	if err := st.database.Client().GetByFilter(
+		defaultColNameAccount,
+		util.NewBSONFilter("address", a.String()).D(),
+		func(res *mongo.SingleResult) error {
+			i, err := LoadAccountValue(res.Decode, st.database.Encoders())
+			if err != nil {
+				return err
+			}
+			rs = i
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		if errors.Is(err, util.NotFoundError) {
+			return rs, false, nil
+		}
+
+		return rs, false, err
+	}
+
+	// NOTE load balance
+	switch am, lastHeight, previousHeight, err := st.balance(a); {
+	case err != nil:
+		return rs, false, err
+	default:
+		rs = rs.SetBalance(am).
+			SetHeight(lastHeight).
+			SetPreviousHeight(previousHeight)
+	}
+
+	// NOTE load locked balance
+	switch la, err := st.lockedBalance(a); {
+	case err != nil:
+		return rs, false, err
+	default:
+		rs = rs.SetLockedBalance(la)
+	}
+
+	// NOTE load aliases
+	switch as, err := st.aliases(a); {
+	case err != nil:
+		return rs, false, err
+	default:
+		rs = rs.SetAliases(as)
+	}
+
+	// NOTE load metadata
+	switch va, found, err := st.AccountMetadata(a); {
+	case err != nil:
+		return rs, false, err
+	case found:
+		rs = rs.SetMetadata(va.Metadata().Data())
+	}
+
+	return rs, true, nil
+}
+
+// AccountsByPublickey finds Accounts, which are related with the given
+// Publickey.
+// *  offset: returns from next of offset, usually it is "<height>,<address>".
+func (st *Database) AccountsByPublickey(
+	pub key.Publickey,
+	loadBalance bool,
+	offsetHeight base.Height,
+	offsetAddress string,
+	limit int64,
+	callback func(AccountValue) (bool, error),
+) error {
+	if offsetHeight <= base.NilHeight {
+		return errors.Errorf("offset height should be over nil height")
+	}
+
+	filter := buildAccountsFilterByPublickey(pub)
+	filter["height"] = bson.M{"$lte": offsetHeight}
+
+	var sas []string
+	switch i, err := st.addressesByPublickey(filter); {
+	case err != nil:
+		return err
+	default:
+		sas = i
+	}
+
+	if len(sas) < 1 {
+		return nil
+	}
+
+	var filteredAddress []string
+	if len(offsetAddress) < 1 {
+		filteredAddress = sas
+	} else {
+		var found bool
+		for i := range sas {
+			a := sas[i]
+			if !found {
+				if offsetAddress == a {
+					found = true
+				}
+
+				continue
+			}
+
+			filteredAddress = append(filteredAddress, a)
+		}
+	}
+
+	if len(filteredAddress) < 1 {
+		return nil
+	}
+
+end:
+	for i := int64(0); i < int64(math.Ceil(float64(len(filteredAddress))/50.0)); i++ {
+		l := (i + 1) + 50
+		if n := int64(len(filteredAddress)); l > n {
+			l = n
+		}
+
+		limited := filteredAddress[i*50 : l]
+		switch done, err := st.filterAccountByPublickey(
+			pub, limited, limit, loadBalance, callback,
+		); {
 		case err != nil:
 			return err
-		case done:
-			break end
+		case done:
+			break end
+		}
+	}
+
+	return nil
+}
+
+// AddWebhook stores new Webhook.
+func (st *Database) AddWebhook(wh Webhook) error {
+	if st.readonly {
+		return errors.Errorf("readonly mode")
+	}
+
+	doc, err := NewWebhookDoc(wh, st.database.Encoder())
+	if err != nil {
+		return err
+	}
+
+	_, err = st.database.Client().Add(defaultColNameWebhook, doc)
+
+	return err
+}
+
+// RemoveWebhook removes Webhook and it's delivery logs.
+func (st *Database) RemoveWebhook(id string) (bool, error) {
+	if st.readonly {
+		return false, errors.Errorf("readonly mode")
+	}
+
+	res, err := st.database.Client().Delete(defaultColNameWebhook, util.NewBSONFilter("id", id).D())
+	switch {
+	case err != nil:
+		return false, storage.MergeStorageError(err)
+	case res.DeletedCount < 1:
+		return false, nil
+	}
+
+	if _, err := st.database.Client().Delete(
+		defaultColNameWebhookDelivery, util.NewBSONFilter("webhook", id).D(),
+	); err != nil {
+		return true, storage.MergeStorageError(err)
+	}
+
+	return true, nil
+}
+
+func (st *Database) Webhook(id string) (Webhook, bool /* exists */, error) {
+	var wh Webhook
+	if err := st.database.Client().GetByFilter(
+		defaultColNameWebhook,
+		util.NewBSONFilter("id", id).D(),
+		func(res *mongo.SingleResult) error {
+			i, err := LoadWebhook(res.Decode, st.database.Encoders())
+			if err != nil {
+				return err
+			}
+			wh = i
+
+			return nil
+		},
+	); err != nil {
+		if errors.Is(err, util.NotFoundError) {
+			return Webhook{}, false, nil
+		}
+
+		return Webhook{}, false, err
+	}
+
+	return wh, true, nil
+}
+
+// Webhooks returns the Webhooks ordered by id.
+// *  offset: returns from next of offset, it is the webhook id.
+func (st *Database) Webhooks(
+	offset string,
+	limit int64,
+	callback func(Webhook) (bool, error),
+) error {
+	filter := bson.M{}
+	if len(offset) > 0 {
+		filter["id"] = bson.M{"$gt": offset}
+	}
+
+	opt := options.Find().SetSort(util.NewBSONFilter("id", 1).D())
+
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		opt = opt.SetLimit(maxLimit)
+	default:
+		opt = opt.SetLimit(limit)
+	}
+
+	return st.webhooks(filter, callback, opt)
+}
+
+// WebhooksByAddresses returns the Webhooks, which watch one of the given
+// addresses.
+func (st *Database) WebhooksByAddresses(
+	addresses []string,
+	callback func(Webhook) (bool, error),
+) error {
+	if len(addresses) < 1 {
+		return nil
+	}
+
+	return st.webhooks(
+		bson.M{"addresses": bson.M{"$in": addresses}},
+		callback,
+		options.Find().SetSort(util.NewBSONFilter("id", 1).D()),
+	)
+}
+
+func (st *Database) webhooks(
+	filter bson.M,
+	callback func(Webhook) (bool, error),
+	opt *options.FindOptions,
+) error {
+	return st.database.Client().Find(
+		context.Background(),
+		defaultColNameWebhook,
+		filter,
+		func(cursor *mongo.Cursor) (bool, error) {
+			wh, err := LoadWebhook(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			return callback(wh)
+		},
+		opt,
+	)
+}
+
+// SetWebhookDelivery stores WebhookDelivery; the existing one with same id is
+// replaced.
+func (st *Database) SetWebhookDelivery(dl WebhookDelivery) error {
+	if st.readonly {
+		return errors.Errorf("readonly mode")
+	}
+
+	doc, err := NewWebhookDeliveryDoc(dl, st.database.Encoder())
+	if err != nil {
+		return err
+	}
+
+	_, err = st.database.Client().Set(defaultColNameWebhookDelivery, doc)
+
+	return err
+}
+
+// WebhookDeliveries returns the WebhookDeliveries of the given webhook. The
+// newer delivery will be returned first.
+// *  offset: returns from next of offset, "<height>,<delivery id>".
+func (st *Database) WebhookDeliveries(
+	webhook string,
+	offset string,
+	limit int64,
+	callback func(WebhookDelivery) (bool, error),
+) error {
+	filter := bson.M{"webhook": webhook}
+	if len(offset) > 0 {
+		height, id, err := parseOffsetByString(offset)
+		if err != nil {
+			return err
+		}
+
+		filter["$or"] = []bson.M{
+			{"height": bson.M{"$lt": height}},
+			{"height": height, "id": bson.M{"$lt": id}},
+		}
+	}
+
+	opt := options.Find().SetSort(
+		util.NewBSONFilter("height", -1).Add("id", -1).D(),
+	)
+
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		opt = opt.SetLimit(maxLimit)
+	default:
+		opt = opt.SetLimit(limit)
+	}
+
+	return st.database.Client().Find(
+		context.Background(),
+		defaultColNameWebhookDelivery,
+		filter,
+		func(cursor *mongo.Cursor) (bool, error) {
+			dl, err := LoadWebhookDelivery(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			return callback(dl)
+		},
+		opt,
+	)
+}
+
+// PendingWebhookDeliveries returns the pending WebhookDeliveries, which are not
+// finished yet, ordered by height.
+func (st *Database) PendingWebhookDeliveries(callback func(WebhookDelivery) (bool, error)) error {
+	return st.database.Client().Find(
+		context.Background(),
+		defaultColNameWebhookDelivery,
+		bson.M{"status": WebhookDeliveryStatusPending},
+		func(cursor *mongo.Cursor) (bool, error) {
+			dl, err := LoadWebhookDelivery(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			return callback(dl)
+		},
+		options.Find().SetSort(util.NewBSONFilter("height", 1).Add("id", 1).D()),
+	)
+}
+
+// BalanceByHeight returns the balance of the given address as of the given
+// height.
+func (st *Database) BalanceByHeight(a base.Address, height base.Height) (
+	AccountBalanceValue, bool /* exists */, error,
+) {
+	switch am, _, _, err := st.balanceByHeight(a, height); {
+	case err != nil:
+		return AccountBalanceValue{}, false, err
+	case len(am) < 1:
+		return AccountBalanceValue{}, false, nil
+	default:
+		return NewAccountBalanceValue(a, am, height), true, nil
+	}
+}
+
+// BalanceHistory returns the balances of the given address and currency by
+// height; offset is the height. The delta of each BalanceHistoryValue is
+// calculated from the balance of the previous height.
+func (st *Database) BalanceHistory(
+	address base.Address,
+	cid currency.CurrencyID,
+	offset string,
+	reverse bool,
+	limit int64,
+	callback func(BalanceHistoryValue) (bool, error),
+) error {
+	filter, err := buildBalanceHistoryFilter(address, cid, offset, reverse)
+	if err != nil {
+		return err
+	}
+
+	sr := 1
+	if reverse {
+		sr = -1
+	}
+
+	opt := options.Find().SetSort(util.NewBSONFilter("height", sr).D())
+
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		opt = opt.SetLimit(maxLimit)
+	default:
+		opt = opt.SetLimit(limit)
+	}
+
+	var sts []state.State
+	if err := st.database.Client().Find(
+		context.Background(),
+		defaultColNameBalance,
+		filter,
+		func(cursor *mongo.Cursor) (bool, error) {
+			sta, err := LoadBalance(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+			sts = append(sts, sta)
+
+			return true, nil
+		},
+		opt,
+	); err != nil {
+		return err
+	}
+
+	if len(sts) < 1 {
+		return nil
+	}
+
+	// NOTE the rows are sorted by height; the previous balance of each row is
+	// the next row in reverse, and the row before in forward except the
+	// boundary one.
+	var boundary state.State
+	if reverse {
+		boundary = sts[len(sts)-1]
+	} else {
+		boundary = sts[0]
+	}
+
+	previous, err := st.balanceBefore(address, cid, boundary.Height())
+	if err != nil {
+		return err
+	}
+
+	vas := make([]BalanceHistoryValue, len(sts))
+	if reverse {
+		for i := len(sts) - 1; i >= 0; i-- {
+			va, err := NewBalanceHistoryValue(address, sts[i], previous)
+			if err != nil {
+				return err
+			}
+			vas[i] = va
+			previous = va.Amount().Big()
+		}
+	} else {
+		for i := range sts {
+			va, err := NewBalanceHistoryValue(address, sts[i], previous)
+			if err != nil {
+				return err
+			}
+			vas[i] = va
+			previous = va.Amount().Big()
+		}
+	}
+
+	for i := range vas {
+		if keep, err := callback(vas[i]); err != nil {
+			return err
+		} else if !keep {
+			break
 		}
 	}
 
 	return nil
 }
 
+// balanceBefore returns the balance of the given address and currency before
+// the given height; if not found, returns zero.
+func (st *Database) balanceBefore(a base.Address, cid currency.CurrencyID, height base.Height) (currency.Big, error) {
+	previous := currency.ZeroBig
+	if err := st.database.Client().GetByFilter(
+		defaultColNameBalance,
+		util.NewBSONFilter("address", a.String()).
+			Add("currency", cid.String()).
+			Add("height", bson.M{"$lt": height}).D(),
+		func(res *mongo.SingleResult) error {
+			sta, err := LoadBalance(res.Decode, st.database.Encoders())
+			if err != nil {
+				return err
+			}
+
+			am, err := currency.StateBalanceValue(sta)
+			if err != nil {
+				return err
+			}
+			previous = am.Big()
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil && !errors.Is(err, util.NotFoundError) {
+		return previous, err
+	}
+
+	return previous, nil
+}
+
+// Holders returns the holders of the given currency, sorted by the latest
+// balance in descending order; the accounts without balance are excluded.
+// offset is the combination of "<balance>,<address>".
+func (st *Database) Holders(
+	cid currency.CurrencyID,
+	offset string,
+	limit int64,
+	callback func(HolderValue) (bool, error),
+) error {
+	filter, err := buildHoldersFilter(cid, offset)
+	if err != nil {
+		return err
+	}
+
+	opt := options.Find().SetSort(util.NewBSONFilter("balance_key", -1).Add("address", 1).D())
+
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		opt = opt.SetLimit(maxLimit)
+	default:
+		opt = opt.SetLimit(limit)
+	}
+
+	return st.database.Client().Find(
+		context.Background(),
+		defaultColNameHolder,
+		filter,
+		func(cursor *mongo.Cursor) (bool, error) {
+			va, err := LoadHolderValue(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			return callback(va)
+		},
+		opt,
+	)
+}
+
+// HoldersCount returns the number of accounts, which hold the given currency.
+func (st *Database) HoldersCount(cid currency.CurrencyID) (int64, error) {
+	return st.database.Client().Count(
+		context.Background(),
+		defaultColNameHolder,
+		bson.M{"currency": cid.String(), "holding": true},
+	)
+}
+
 func (st *Database) balance(a base.Address) ([]currency.Amount, base.Height, base.Height, error) {
+	return st.balanceByHeight(a, base.NilHeight)
+}
+
+// balanceByHeight returns the latest balance of the given address until the
+// given height; with base.NilHeight, the latest balance is returned.
+func (st *Database) balanceByHeight(a base.Address, height base.Height) (
+	[]currency.Amount, base.Height, base.Height, error,
+) {
 	lastHeight, previousHeight := base.NilHeight, base.NilHeight
 	var cids []string
 
 	amm := map[currency.CurrencyID]currency.Amount{}
 	for {
 		filter := util.NewBSONFilter("address", a.String())
+		if height > base.NilHeight {
+			filter = filter.Add("height", bson.M{"$lte": height})
+		}
 
 		var q primitive.D
 		if len(cids) < 1 {
@@ -603,6 +1735,83 @@ func (st *Database) balance(a base.Address) ([]currency.Amount, base.Height, bas
 	return ams, lastHeight, previousHeight, nil
 }
 
+// lockedBalance returns the latest LockedAmounts of the given address; the
+// LockedAmount without locks is ignored.
+func (st *Database) lockedBalance(a base.Address) ([]currency.LockedAmount, error) {
+	var cids []string
+
+	var las []currency.LockedAmount
+	for {
+		filter := util.NewBSONFilter("address", a.String())
+
+		var q primitive.D
+		if len(cids) < 1 {
+			q = filter.D()
+		} else {
+			q = filter.Add("currency", bson.M{"$nin": cids}).D()
+		}
+
+		var sta state.State
+		if err := st.database.Client().GetByFilter(
+			defaultColNameLockedBalance,
+			q,
+			func(res *mongo.SingleResult) error {
+				i, err := LoadBalance(res.Decode, st.database.Encoders())
+				if err != nil {
+					return err
+				}
+				sta = i
+
+				return nil
+			},
+			options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+		); err != nil {
+			if errors.Is(err, util.NotFoundError) {
+				break
+			}
+
+			return nil, err
+		}
+
+		i, err := currency.StateLockedBalanceValue(sta)
+		if err != nil {
+			return nil, err
+		}
+
+		cids = append(cids, i.Currency().String())
+
+		if len(i.Locks()) > 0 {
+			las = append(las, i)
+		}
+	}
+
+	return las, nil
+}
+
+// aliases returns the alias names of the given address; the names are sorted.
+func (st *Database) aliases(a base.Address) ([]string, error) {
+	var names []string
+	if err := st.database.Client().Find(
+		context.Background(),
+		defaultColNameAlias,
+		bson.M{"address": a.String()},
+		func(cursor *mongo.Cursor) (bool, error) {
+			va, err := LoadAliasValue(cursor.Decode, st.database.Encoders())
+			if err != nil {
+				return false, err
+			}
+			names = append(names, va.Alias().Name())
+
+			return true, nil
+		},
+		options.Find().SetSort(util.NewBSONFilter("name", 1).D()),
+	); err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
+
 func (st *Database) topHeightByPublickey(pub key.Publickey) (base.Height, error) {
 	var sas []string
 	switch r, err := st.database.Client().Collection(defaultColNameAccount).Distinct(
@@ -736,6 +1945,12 @@ func (st *Database) filterAccountByPublickey(
 						SetHeight(lastHeight).
 						SetPreviousHeight(previousHeight)
 				}
+
+				la, err := st.lockedBalance(va.Account().Address())
+				if err != nil {
+					return false, err
+				}
+				va = va.SetLockedBalance(la)
 			}
 
 			called++
@@ -791,8 +2006,12 @@ func buildOffset(height base.Height, index uint64) string {
 	return fmt.Sprintf("%d,%d", height, index)
 }
 
-func buildOperationsFilterByAddress(address base.Address, offset string, reverse bool) (bson.M, error) {
+func buildOperationsFilterByAddress(
+	address base.Address, of OperationFilter, offset string, reverse bool,
+) (bson.M, error) {
 	filter := bson.M{"addresses": bson.M{"$in": []string{address.String()}}}
+	of.addBSON(filter, address)
+
 	if len(offset) > 0 {
 		height, index, err := parseOffset(offset)
 		if err != nil {
@@ -821,6 +2040,67 @@ func buildOperationsFilterByAddress(address base.Address, offset string, reverse
 	return filter, nil
 }
 
+func buildBalanceHistoryFilter(
+	address base.Address, cid currency.CurrencyID, offset string, reverse bool,
+) (bson.M, error) {
+	filter := bson.M{"address": address.String(), "currency": cid.String()}
+	if len(offset) > 0 {
+		height, err := base.NewHeightFromString(offset)
+		if err != nil {
+			return nil, err
+		}
+
+		if reverse {
+			filter["height"] = bson.M{"$lt": height}
+		} else {
+			filter["height"] = bson.M{"$gt": height}
+		}
+	}
+
+	return filter, nil
+}
+
+func buildHoldersFilter(cid currency.CurrencyID, offset string) (bson.M, error) {
+	filter := bson.M{"currency": cid.String(), "holding": true}
+	if len(offset) > 0 {
+		big, address, err := parseHoldersOffset(offset)
+		if err != nil {
+			return nil, err
+		}
+
+		key := holderBalanceKey(big)
+		filter["$or"] = []bson.M{
+			{"balance_key": bson.M{"$lt": key}},
+			{"$and": []bson.M{
+				{"balance_key": key},
+				{"address": bson.M{"$gt": address}},
+			}},
+		}
+	}
+
+	return filter, nil
+}
+
+func buildHoldersOffset(big currency.Big, address base.Address) string {
+	return fmt.Sprintf("%s,%s", big.String(), address.String())
+}
+
+func parseHoldersOffset(s string) (currency.Big, string, error) {
+	n := strings.SplitN(s, ",", 2)
+	if len(n) < 2 || len(n[1]) < 1 {
+		return currency.Big{}, "", errors.Errorf("invalid offset, %q", s)
+	}
+
+	big, err := currency.NewBigFromString(n[0])
+	if err != nil {
+		return currency.Big{}, "", errors.Wrapf(err, "invalid balance of offset, %q", s)
+	} else if !big.OverZero() {
+		return currency.Big{}, "", errors.Errorf("invalid balance of offset, %q", s)
+	}
+
+	return big, n[1], nil
+}
+
 func parseOffsetByString(s string) (base.Height, string, error) {
 	var a, b string
 	switch n := strings.SplitN(s, ",", 2); {
@@ -6,11 +6,17 @@ package digest
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"sort"
 	"testing"
 
 	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/base/state"
 	"github.com/spikeekips/mitum/util"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/localtime"
 	"github.com/spikeekips/mitum/util/valuehash"
 	"github.com/stretchr/testify/suite"
@@ -76,6 +82,7 @@ func (t *testDatabase) TestOperationByAddress() {
 		var uhashes []string
 		t.NoError(st.OperationsByAddress(
 			sender,
+			OperationFilter{},
 			false,
 			false,
 			"",
@@ -93,6 +100,7 @@ func (t *testDatabase) TestOperationByAddress() {
 		var uhashes []string
 		t.NoError(st.OperationsByAddress(
 			receiver0,
+			OperationFilter{},
 			false,
 			false,
 			"",
@@ -110,6 +118,7 @@ func (t *testDatabase) TestOperationByAddress() {
 		var uhashes []string
 		t.NoError(st.OperationsByAddress(
 			receiver1,
+			OperationFilter{},
 			false,
 			false,
 			"",
@@ -160,6 +169,7 @@ func (t *testDatabase) TestOperationByAddressOrderByHeight() {
 		var uhashes []string
 		t.NoError(st.OperationsByAddress(
 			sender,
+			OperationFilter{},
 			false,
 			false,
 			"",
@@ -177,6 +187,7 @@ func (t *testDatabase) TestOperationByAddressOrderByHeight() {
 		var uhashes []string
 		t.NoError(st.OperationsByAddress(
 			sender,
+			OperationFilter{},
 			false,
 			true,
 			"",
@@ -221,6 +232,7 @@ func (t *testDatabase) TestOperationByAddressOffset() {
 		var uhashes []string
 		t.NoError(st.OperationsByAddress(
 			sender,
+			OperationFilter{},
 			false,
 			false,
 			"",
@@ -240,6 +252,7 @@ func (t *testDatabase) TestOperationByAddressOffset() {
 		var uhashes []string
 		t.NoError(st.OperationsByAddress(
 			sender,
+			OperationFilter{},
 			false,
 			false,
 			offset,
@@ -259,6 +272,7 @@ func (t *testDatabase) TestOperationByAddressOffset() {
 		var uhashes []string
 		t.NoError(st.OperationsByAddress(
 			sender,
+			OperationFilter{},
 			false,
 			false,
 			offset,
@@ -296,6 +310,7 @@ func (t *testDatabase) TestOperationByAddressLimit() {
 		var uhashes []string
 		t.NoError(st.OperationsByAddress(
 			sender,
+			OperationFilter{},
 			false,
 			false,
 			"",
@@ -321,6 +336,7 @@ func (t *testDatabase) TestOperationByAddressLimit() {
 		var uhashes []string
 		t.NoError(st.OperationsByAddress(
 			sender,
+			OperationFilter{},
 			false,
 			true,
 			"",
@@ -340,6 +356,7 @@ func (t *testDatabase) TestOperationByAddressLimit() {
 		var uhashes []string
 		t.NoError(st.OperationsByAddress(
 			sender,
+			OperationFilter{},
 			false,
 			false,
 			"",
@@ -359,6 +376,7 @@ func (t *testDatabase) TestOperationByAddressLimit() {
 		var uhashes []string
 		t.NoError(st.OperationsByAddress(
 			sender,
+			OperationFilter{},
 			false,
 			false,
 			"",
@@ -378,6 +396,7 @@ func (t *testDatabase) TestOperationByAddressLimit() {
 		var uhashes []string
 		t.NoError(st.OperationsByAddress(
 			sender,
+			OperationFilter{},
 			false,
 			false,
 			"",
@@ -393,6 +412,206 @@ func (t *testDatabase) TestOperationByAddressLimit() {
 	}
 }
 
+func (t *testDatabase) TestOperationByAddressFilter() {
+	st, _ := t.Database()
+
+	sender := currency.MustAddress(util.UUID().String())
+	other := currency.MustAddress(util.UUID().String())
+
+	var outgoing, incoming, failed []string
+	for i := 0; i < 6; i++ {
+		height := base.Height(i)
+
+		var tf currency.Transfers
+		inState := true
+		switch {
+		case i%3 == 0:
+			tf = t.newTransfer(other, sender)
+			incoming = append(incoming, tf.Fact().Hash().String())
+		case i%3 == 1:
+			tf = t.newTransfer(sender, other)
+			outgoing = append(outgoing, tf.Fact().Hash().String())
+		default:
+			tf = t.newTransfer(sender, other)
+			outgoing = append(outgoing, tf.Fact().Hash().String())
+			failed = append(failed, tf.Fact().Hash().String())
+			inState = false
+		}
+
+		doc, err := NewOperationDoc(tf, t.BSONEnc, height, localtime.UTCNow(), inState, nil, 0)
+		t.NoError(err)
+		t.insertDoc(st, defaultColNameOperation, doc)
+	}
+
+	load := func(of OperationFilter, offset string, reverse bool) []string {
+		var hashes []string
+		t.NoError(st.OperationsByAddress(
+			sender,
+			of,
+			false,
+			reverse,
+			offset,
+			100,
+			func(h valuehash.Hash, _ OperationValue) (bool, error) {
+				hashes = append(hashes, h.String())
+
+				return true, nil
+			},
+		))
+
+		return hashes
+	}
+
+	t.Equal(6, len(load(OperationFilter{}, "", false)))
+
+	t.Equal(outgoing, load(NewOperationFilter(nil, nil, OperationDirectionOutgoing, "", base.NilHeight, base.NilHeight), "", false))
+	t.Equal(incoming, load(NewOperationFilter(nil, nil, OperationDirectionIncoming, "", base.NilHeight, base.NilHeight), "", false))
+	t.Equal(failed, load(NewOperationFilter(nil, nil, "", OperationStateFailed, base.NilHeight, base.NilHeight), "", false))
+	t.Equal(4, len(load(NewOperationFilter(nil, nil, "", OperationStateInState, base.NilHeight, base.NilHeight), "", false)))
+
+	t.Equal(6, len(load(NewOperationFilter([]hint.Type{currency.TransfersType}, nil, "", "", base.NilHeight, base.NilHeight), "", false)))
+	t.Empty(load(NewOperationFilter([]hint.Type{currency.CreateAccountsType}, nil, "", "", base.NilHeight, base.NilHeight), "", false))
+
+	t.Equal(6, len(load(NewOperationFilter(nil, []currency.CurrencyID{t.cid}, "", "", base.NilHeight, base.NilHeight), "", false)))
+	t.Empty(load(NewOperationFilter(nil, []currency.CurrencyID{"FINDME"}, "", "", base.NilHeight, base.NilHeight), "", false))
+
+	// NOTE height range with offset
+	of := NewOperationFilter(nil, nil, OperationDirectionOutgoing, "", base.Height(1), base.Height(4))
+	t.Equal(outgoing[:3], load(of, "", false))
+	t.Equal(outgoing[1:3], load(of, buildOffset(base.Height(1), 0), false))
+	t.Equal([]string{outgoing[1], outgoing[0]}, load(of, buildOffset(base.Height(4), 0), true))
+}
+
+func (t *testDatabase) TestOperationByAddressFilterDirection() {
+	st, _ := t.Database()
+
+	ac := t.newAccount()
+	other := currency.MustAddress(util.UUID().String())
+	arbiter := currency.MustAddress(util.UUID().String())
+
+	fs := func(fact base.Fact) []base.FactSign {
+		pk := key.NewBasePrivatekey()
+		sig, err := base.NewFactSignature(pk, fact, t.networkID)
+		t.NoError(err)
+
+		return []base.FactSign{base.NewBaseFactSign(pk.Publickey(), sig)}
+	}
+
+	// NOTE KeyUpdaterFact has no sender, but the fee is charged to target
+	kufact := currency.NewKeyUpdaterFact(util.UUID().Bytes(), ac.Address(), t.newAccount().Keys(), t.cid)
+	ku, err := currency.NewKeyUpdater(kufact, fs(kufact), "")
+	t.NoError(err)
+
+	// NOTE other spends the allowance of ac
+	tffact := currency.NewTransferFromFact(
+		util.UUID().Bytes(), other, ac.Address(), other, currency.MustNewAmount(currency.NewBig(10), t.cid))
+	tf, err := currency.NewTransferFrom(tffact, fs(tffact), "")
+	t.NoError(err)
+
+	// NOTE ac claims the htlc of other
+	hcfact := currency.NewHTLCClaimFact(util.UUID().Bytes(), ac.Address(), valuehash.RandomSHA256(), util.UUID().Bytes())
+	hc, err := currency.NewHTLCClaim(hcfact, fs(hcfact), "")
+	t.NoError(err)
+
+	// NOTE arbiter releases the escrow of other to ac
+	es := currency.NewEscrow(
+		valuehash.RandomSHA256(), other, ac.Address(), arbiter, currency.MustNewAmount(currency.NewBig(10), t.cid), base.Height(33))
+	erfact := currency.NewEscrowReleaseFact(util.UUID().Bytes(), arbiter, es.ID())
+	er, err := currency.NewEscrowRelease(erfact, fs(erfact), "")
+	t.NoError(err)
+
+	est := t.newStateFromValue(currency.StateKeyEscrow(es.ID()), es, base.Height(3))
+	rs := newBlockAccountResolver(st, []state.State{est})
+
+	for i, op := range []operation.Operation{ku, tf, hc, er} {
+		va := NewOperationValue(op, base.Height(i), localtime.UTCNow(), true, nil, 0)
+		doc, err := newOperationDoc(va, t.BSONEnc, rs)
+		t.NoError(err)
+		t.insertDoc(st, defaultColNameOperation, doc)
+	}
+
+	load := func(address base.Address, direction string) []string {
+		var hashes []string
+		t.NoError(st.OperationsByAddress(
+			address,
+			NewOperationFilter(nil, nil, direction, "", base.NilHeight, base.NilHeight),
+			false,
+			false,
+			"",
+			100,
+			func(h valuehash.Hash, _ OperationValue) (bool, error) {
+				hashes = append(hashes, h.String())
+
+				return true, nil
+			},
+		))
+
+		return hashes
+	}
+
+	t.Equal([]string{ku.Fact().Hash().String(), tf.Fact().Hash().String()}, load(ac.Address(), OperationDirectionOutgoing))
+	t.Equal([]string{hc.Fact().Hash().String(), er.Fact().Hash().String()}, load(ac.Address(), OperationDirectionIncoming))
+
+	t.Equal([]string{tf.Fact().Hash().String()}, load(other, OperationDirectionIncoming))
+	t.Empty(load(other, OperationDirectionOutgoing))
+	t.Empty(load(arbiter, OperationDirectionOutgoing))
+	t.Empty(load(arbiter, OperationDirectionIncoming))
+}
+
+func (t *testDatabase) TestOperationByAddressAliasReceiver() {
+	st, _ := t.Database()
+
+	sender := currency.MustAddress(util.UUID().String())
+	acA := t.newAccount()
+	acB := t.newAccount()
+
+	// NOTE alias of acA was digested before; alias of acB is registered in
+	// same block
+	alA := currency.NewAlias("alias-a", acA.Address())
+	alB := currency.NewAlias("alias-b", acB.Address())
+
+	va, err := NewAliasValue(t.newStateFromValue(currency.StateKeyAlias(alA.Name()), alA, base.Height(2)))
+	t.NoError(err)
+	doc, err := NewAliasDoc(va, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameAlias, doc)
+
+	rs := newBlockAccountResolver(st, []state.State{
+		t.newStateFromValue(currency.StateKeyAlias(alB.Name()), alB, base.Height(3)),
+	})
+
+	tfA := t.newTransfer(sender, alA.AliasAddress())
+	tfB := t.newTransfer(sender, alB.AliasAddress())
+
+	for i, op := range []operation.Operation{tfA, tfB} {
+		doc, err := newOperationDoc(NewOperationValue(op, base.Height(3), localtime.UTCNow(), true, nil, uint64(i)), t.BSONEnc, rs)
+		t.NoError(err)
+		t.insertDoc(st, defaultColNameOperation, doc)
+	}
+
+	load := func(address base.Address) []string {
+		var hashes []string
+		t.NoError(st.OperationsByAddress(
+			address,
+			NewOperationFilter(nil, nil, OperationDirectionIncoming, "", base.NilHeight, base.NilHeight),
+			false,
+			false,
+			"",
+			100,
+			func(h valuehash.Hash, _ OperationValue) (bool, error) {
+				hashes = append(hashes, h.String())
+
+				return true, nil
+			},
+		))
+
+		return hashes
+	}
+
+	t.Equal([]string{tfA.Fact().Hash().String()}, load(acA.Address()))
+	t.Equal([]string{tfB.Fact().Hash().String()}, load(acB.Address()))
+}
+
 func (t *testDatabase) TestOperationsFact() {
 	st, _ := t.Database()
 	height := base.Height(3)
@@ -453,6 +672,7 @@ func (t *testDatabase) TestClean() {
 	var uhashes []string
 	t.NoError(st.OperationsByAddress(
 		sender,
+		OperationFilter{},
 		false,
 		false,
 		"",
@@ -495,6 +715,7 @@ func (t *testDatabase) TestCleanByHeight() {
 	var uhashes []string
 	t.NoError(st.OperationsByAddress(
 		sender,
+		OperationFilter{},
 		false,
 		false,
 		"",
@@ -558,6 +779,639 @@ func (t *testDatabase) TestAccount() {
 	t.Equal(stA.PreviousHeight(), urs.previousHeight)
 	t.Equal(1, len(urs.balance))
 	t.compareAmount(am, urs.balance[0])
+	t.False(urs.ac.IsFrozen())
+	t.Equal(base.NilHeight, urs.frozenHeight)
+	t.False(urs.ac.IsClosed())
+	t.Equal(base.NilHeight, urs.closedHeight)
+}
+
+func (t *testDatabase) TestAccountFrozen() {
+	st, _ := t.Database()
+
+	height := base.Height(33)
+	ac := t.newAccount().SetFrozen(true)
+
+	stA := t.newAccountState(ac, height)
+
+	va, err := NewAccountValue(stA)
+	t.NoError(err)
+	t.Equal(height, va.FrozenHeight())
+
+	docA, err := NewAccountDoc(va, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameAccount, docA)
+
+	stB := t.newBalanceState(ac, height, currency.MustNewAmount(t.randomBig(), t.cid))
+	docB, err := NewBalanceDoc(stB, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameBalance, docB)
+
+	urs, found, err := st.Account(ac.Address())
+	t.NoError(err)
+	t.True(found)
+
+	t.True(urs.ac.IsFrozen())
+	t.Equal(height, urs.frozenHeight)
+}
+
+func (t *testDatabase) TestAccountClosed() {
+	st, _ := t.Database()
+
+	height := base.Height(33)
+	ac := t.newAccount().SetClosed(true)
+
+	va, err := NewAccountValue(t.newAccountState(ac, height))
+	t.NoError(err)
+	t.Equal(height, va.ClosedHeight())
+	t.Equal(base.NilHeight, va.FrozenHeight())
+
+	docA, err := NewAccountDoc(va, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameAccount, docA)
+
+	stB := t.newBalanceState(ac, height, currency.MustNewAmount(currency.ZeroBig, t.cid))
+	docB, err := NewBalanceDoc(stB, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameBalance, docB)
+
+	urs, found, err := st.Account(ac.Address())
+	t.NoError(err)
+	t.True(found)
+
+	t.True(urs.ac.IsClosed())
+	t.Equal(height, urs.closedHeight)
+}
+
+func (t *testDatabase) TestAccountRecovery() {
+	st, _ := t.Database()
+
+	height := base.Height(33)
+	ac := t.newAccount()
+
+	rkeys := t.newAccount().Keys()
+	nkeys := t.newAccount().Keys()
+	ac, err := ac.SetRecovery(currency.NewAccountRecovery(rkeys, 10).Initiate(nkeys, height))
+	t.NoError(err)
+
+	am := currency.MustNewAmount(t.randomBig(), t.cid)
+	_, _ = t.insertAccount(st, height, ac, am)
+
+	urs, found, err := st.Account(ac.Address())
+	t.NoError(err)
+	t.True(found)
+
+	t.True(urs.ac.HasRecovery())
+
+	ar := urs.ac.Recovery()
+	t.True(ar.Keys().Equal(rkeys))
+	t.Equal(uint64(10), ar.Delay())
+	t.True(ar.Pending().Equal(nkeys))
+	t.Equal(height+10, ar.Height())
+	t.True(ac.Hash().Equal(urs.ac.Hash()))
+}
+
+func (t *testDatabase) TestAccountLockedBalance() {
+	st, _ := t.Database()
+
+	height := base.Height(33)
+	ac := t.newAccount()
+
+	am := currency.MustNewAmount(t.randomBig(), t.cid)
+	_, _ = t.insertAccount(st, height, ac, am)
+
+	cid := currency.CurrencyID("FINDME")
+
+	la0 := currency.NewLockedAmount(t.cid, []currency.AmountLock{
+		currency.NewAmountLock(currency.NewBig(10), height+10),
+	})
+	key0 := currency.StateKeyLockedBalance(ac.Address(), la0.Currency())
+	doc0, err := NewLockedBalanceDoc(t.newStateFromValue(key0, la0, height), t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameLockedBalance, doc0)
+
+	// NOTE all unlocked
+	la1 := currency.NewZeroLockedAmount(cid)
+	key1 := currency.StateKeyLockedBalance(ac.Address(), la1.Currency())
+	doc1, err := NewLockedBalanceDoc(t.newStateFromValue(key1, la1, height), t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameLockedBalance, doc1)
+
+	urs, found, err := st.Account(ac.Address())
+	t.NoError(err)
+	t.True(found)
+
+	t.Equal(1, len(urs.Balance()))
+	t.compareAmount(am, urs.Balance()[0])
+
+	t.Equal(1, len(urs.LockedBalance()))
+	t.Equal(t.cid, urs.LockedBalance()[0].Currency())
+	t.True(la0.Total().Equal(urs.LockedBalance()[0].Total()))
+}
+
+func (t *testDatabase) insertEscrow(st *Database, es currency.Escrow, height base.Height) {
+	va, err := NewEscrowValue(t.newStateFromValue(currency.StateKeyEscrow(es.ID()), es, height))
+	t.NoError(err)
+
+	doc, err := NewEscrowDoc(va, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameEscrow, doc)
+}
+
+func (t *testDatabase) TestEscrow() {
+	st, _ := t.Database()
+
+	sender := currency.MustAddress(util.UUID().String())
+	beneficiary := currency.MustAddress(util.UUID().String())
+	arbiter := currency.MustAddress(util.UUID().String())
+
+	es := currency.NewEscrow(
+		valuehash.RandomSHA256(), sender, beneficiary, arbiter,
+		currency.MustNewAmount(currency.NewBig(10), t.cid), base.Height(100),
+	)
+	t.insertEscrow(st, es, base.Height(33))
+	t.insertEscrow(st, es.SetStatus(currency.EscrowStatusReleased), base.Height(34))
+
+	va, found, err := st.Escrow(es.ID())
+	t.NoError(err)
+	t.True(found)
+	t.Equal(base.Height(34), va.Height())
+	t.Equal(currency.EscrowStatusReleased, va.Escrow().Status())
+	t.True(es.ID().Equal(va.Escrow().ID()))
+
+	_, found, err = st.Escrow(valuehash.RandomSHA256())
+	t.NoError(err)
+	t.False(found)
+}
+
+func (t *testDatabase) TestEscrowsByAddress() {
+	st, _ := t.Database()
+
+	sender := currency.MustAddress(util.UUID().String())
+	arbiter := currency.MustAddress(util.UUID().String())
+
+	var ess []currency.Escrow
+	for i := 0; i < 5; i++ {
+		es := currency.NewEscrow(
+			valuehash.RandomSHA256(), sender, currency.MustAddress(util.UUID().String()), arbiter,
+			currency.MustNewAmount(currency.NewBig(10), t.cid), base.Height(100),
+		)
+		t.insertEscrow(st, es, base.Height(33))
+
+		ess = append(ess, es)
+	}
+
+	// NOTE settled one has 2 states
+	t.insertEscrow(st, ess[0].SetStatus(currency.EscrowStatusRefunded), base.Height(101))
+
+	// NOTE unrelated escrow
+	t.insertEscrow(st, currency.NewEscrow(
+		valuehash.RandomSHA256(),
+		currency.MustAddress(util.UUID().String()),
+		currency.MustAddress(util.UUID().String()),
+		currency.MustAddress(util.UUID().String()),
+		currency.MustNewAmount(currency.NewBig(10), t.cid), base.Height(100),
+	), base.Height(33))
+
+	sort.Slice(ess, func(i, j int) bool {
+		return ess[i].ID().String() < ess[j].ID().String()
+	})
+
+	var ids []string
+	t.NoError(st.EscrowsByAddress(arbiter, "", 0, func(va EscrowValue) (bool, error) {
+		ids = append(ids, va.Escrow().ID().String())
+		if va.Escrow().Status() == currency.EscrowStatusRefunded {
+			t.Equal(base.Height(101), va.Height())
+		}
+
+		return true, nil
+	}))
+
+	t.Equal(len(ess), len(ids))
+	for i := range ess {
+		t.Equal(ess[i].ID().String(), ids[i])
+	}
+
+	// NOTE with offset and limit
+	ids = nil
+	t.NoError(st.EscrowsByAddress(sender, ess[1].ID().String(), 2, func(va EscrowValue) (bool, error) {
+		ids = append(ids, va.Escrow().ID().String())
+
+		return true, nil
+	}))
+
+	t.Equal([]string{ess[2].ID().String(), ess[3].ID().String()}, ids)
+}
+
+func (t *testDatabase) insertSchedule(st *Database, sc currency.PaymentSchedule, height base.Height) {
+	va, err := NewScheduleValue(t.newStateFromValue(currency.StateKeySchedule(sc.ID()), sc, height))
+	t.NoError(err)
+
+	doc, err := NewScheduleDoc(va, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameSchedule, doc)
+}
+
+func (t *testDatabase) TestSchedulesByAddress() {
+	st, _ := t.Database()
+
+	sender := currency.MustAddress(util.UUID().String())
+
+	var scs []currency.PaymentSchedule
+	for i := 0; i < 5; i++ {
+		sc := currency.NewPaymentSchedule(
+			valuehash.RandomSHA256(), sender, currency.MustAddress(util.UUID().String()),
+			currency.MustNewAmount(currency.NewBig(10), t.cid), base.Height(40), 10, 3,
+		)
+		t.insertSchedule(st, sc, base.Height(33))
+
+		scs = append(scs, sc)
+	}
+
+	// NOTE run one has 2 states
+	t.insertSchedule(st, scs[0].Run(), base.Height(40))
+
+	// NOTE unrelated schedule
+	t.insertSchedule(st, currency.NewPaymentSchedule(
+		valuehash.RandomSHA256(),
+		currency.MustAddress(util.UUID().String()),
+		currency.MustAddress(util.UUID().String()),
+		currency.MustNewAmount(currency.NewBig(10), t.cid), base.Height(40), 10, 3,
+	), base.Height(33))
+
+	va, found, err := st.Schedule(scs[0].ID())
+	t.NoError(err)
+	t.True(found)
+	t.Equal(base.Height(40), va.Height())
+	t.Equal(uint(2), va.Schedule().Remaining())
+	t.Equal(base.Height(50), va.Schedule().Next())
+
+	_, found, err = st.Schedule(valuehash.RandomSHA256())
+	t.NoError(err)
+	t.False(found)
+
+	sort.Slice(scs, func(i, j int) bool {
+		return scs[i].ID().String() < scs[j].ID().String()
+	})
+
+	var ids []string
+	t.NoError(st.SchedulesByAddress(sender, "", 0, func(va ScheduleValue) (bool, error) {
+		ids = append(ids, va.Schedule().ID().String())
+
+		return true, nil
+	}))
+
+	t.Equal(len(scs), len(ids))
+	for i := range scs {
+		t.Equal(scs[i].ID().String(), ids[i])
+	}
+
+	// NOTE by receiver
+	ids = nil
+	t.NoError(st.SchedulesByAddress(scs[1].Receiver(), "", 0, func(va ScheduleValue) (bool, error) {
+		ids = append(ids, va.Schedule().ID().String())
+
+		return true, nil
+	}))
+	t.Equal([]string{scs[1].ID().String()}, ids)
+
+	// NOTE with offset and limit
+	ids = nil
+	t.NoError(st.SchedulesByAddress(sender, scs[1].ID().String(), 2, func(va ScheduleValue) (bool, error) {
+		ids = append(ids, va.Schedule().ID().String())
+
+		return true, nil
+	}))
+
+	t.Equal([]string{scs[2].ID().String(), scs[3].ID().String()}, ids)
+}
+
+func (t *testDatabase) insertHTLC(st *Database, hl currency.HTLC, height base.Height) {
+	va, err := NewHTLCValue(t.newStateFromValue(currency.StateKeyHTLC(hl.ID()), hl, height))
+	t.NoError(err)
+
+	doc, err := NewHTLCDoc(va, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameHTLC, doc)
+}
+
+func (t *testDatabase) TestHTLC() {
+	st, _ := t.Database()
+
+	sender := currency.MustAddress(util.UUID().String())
+	receiver := currency.MustAddress(util.UUID().String())
+
+	preimage := []byte(util.UUID().String())
+	hl := currency.NewHTLC(
+		valuehash.RandomSHA256(), sender, receiver,
+		currency.MustNewAmount(currency.NewBig(10), t.cid), currency.HTLCHashlock(preimage), base.Height(40),
+	)
+	t.insertHTLC(st, hl, base.Height(33))
+	t.insertHTLC(st, hl.Claim(preimage), base.Height(34))
+
+	va, found, err := st.HTLC(hl.ID())
+	t.NoError(err)
+	t.True(found)
+	t.Equal(base.Height(34), va.Height())
+	t.Equal(currency.HTLCStatusClaimed, va.HTLC().Status())
+	t.Equal(preimage, va.HTLC().Preimage())
+
+	_, found, err = st.HTLC(valuehash.RandomSHA256())
+	t.NoError(err)
+	t.False(found)
+
+	// NOTE unrelated htlc
+	t.insertHTLC(st, currency.NewHTLC(
+		valuehash.RandomSHA256(),
+		currency.MustAddress(util.UUID().String()),
+		currency.MustAddress(util.UUID().String()),
+		currency.MustNewAmount(currency.NewBig(10), t.cid), currency.HTLCHashlock(preimage), base.Height(40),
+	), base.Height(33))
+
+	for _, a := range []base.Address{sender, receiver} {
+		var vas []HTLCValue
+		t.NoError(st.HTLCsByAddress(a, "", 0, func(va HTLCValue) (bool, error) {
+			vas = append(vas, va)
+
+			return true, nil
+		}))
+
+		t.Equal(1, len(vas))
+		t.True(hl.ID().Equal(vas[0].HTLC().ID()))
+		t.Equal(currency.HTLCStatusClaimed, vas[0].HTLC().Status())
+	}
+}
+
+func (t *testDatabase) TestWebhook() {
+	st, _ := t.Database()
+
+	a := currency.MustAddress(util.UUID().String())
+	b := currency.MustAddress(util.UUID().String())
+
+	wh := NewWebhook(util.UUID().String(), "https://localhost/hook", []base.Address{a, b}, util.UUID().String(), localtime.UTCNow())
+	t.NoError(st.AddWebhook(wh))
+
+	other := NewWebhook(util.UUID().String(), "https://localhost/hook", []base.Address{currency.MustAddress(util.UUID().String())}, util.UUID().String(), localtime.UTCNow())
+	t.NoError(st.AddWebhook(other))
+
+	uwh, found, err := st.Webhook(wh.ID())
+	t.NoError(err)
+	t.True(found)
+	t.Equal(wh.URL(), uwh.URL())
+	t.Equal(wh.Secret(), uwh.Secret())
+	t.Equal(len(wh.Addresses()), len(uwh.Addresses()))
+	for i := range wh.Addresses() {
+		t.True(wh.Addresses()[i].Equal(uwh.Addresses()[i]))
+	}
+
+	var ids []string
+	t.NoError(st.WebhooksByAddresses([]string{b.String()}, func(i Webhook) (bool, error) {
+		ids = append(ids, i.ID())
+
+		return true, nil
+	}))
+	t.Equal([]string{wh.ID()}, ids)
+
+	ids = nil
+	t.NoError(st.Webhooks("", 0, func(i Webhook) (bool, error) {
+		ids = append(ids, i.ID())
+
+		return true, nil
+	}))
+	t.Equal(2, len(ids))
+
+	// NOTE deliveries
+	var dls []WebhookDelivery
+	for i := 0; i < 4; i++ {
+		dl := NewWebhookDelivery(util.UUID().String(), wh, base.Height(i+3), localtime.UTCNow())
+		t.NoError(st.SetWebhookDelivery(dl))
+
+		dls = append(dls, dl)
+	}
+
+	// NOTE update delivery
+	udl := dls[3].attempted(http.StatusOK, nil, true, localtime.UTCNow())
+	t.NoError(st.SetWebhookDelivery(udl))
+
+	var uds []WebhookDelivery
+	t.NoError(st.WebhookDeliveries(wh.ID(), "", 2, func(dl WebhookDelivery) (bool, error) {
+		uds = append(uds, dl)
+
+		return true, nil
+	}))
+	t.Equal(2, len(uds))
+	t.Equal(udl.ID(), uds[0].ID())
+	t.Equal(WebhookDeliveryStatusSuccess, uds[0].Status())
+	t.Equal(uint(1), uds[0].Attempts())
+	t.Equal(dls[2].ID(), uds[1].ID())
+
+	offset := buildOffsetByString(uds[1].Height(), uds[1].ID())
+
+	uds = nil
+	t.NoError(st.WebhookDeliveries(wh.ID(), offset, 0, func(dl WebhookDelivery) (bool, error) {
+		uds = append(uds, dl)
+
+		return true, nil
+	}))
+	t.Equal(2, len(uds))
+	t.Equal(dls[1].ID(), uds[0].ID())
+	t.Equal(dls[0].ID(), uds[1].ID())
+
+	// NOTE remove
+	removed, err := st.RemoveWebhook(wh.ID())
+	t.NoError(err)
+	t.True(removed)
+
+	_, found, err = st.Webhook(wh.ID())
+	t.NoError(err)
+	t.False(found)
+
+	uds = nil
+	t.NoError(st.WebhookDeliveries(wh.ID(), "", 0, func(dl WebhookDelivery) (bool, error) {
+		uds = append(uds, dl)
+
+		return true, nil
+	}))
+	t.Empty(uds)
+
+	removed, err = st.RemoveWebhook(wh.ID())
+	t.NoError(err)
+	t.False(removed)
+}
+
+func (t *testDatabase) insertAllowance(st *Database, al currency.Allowance, height base.Height) {
+	key := currency.StateKeyAllowance(al.Owner(), al.Spender(), al.Amount().Currency())
+	va, err := NewAllowanceValue(t.newStateFromValue(key, al, height))
+	t.NoError(err)
+
+	doc, err := NewAllowanceDoc(va, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameAllowance, doc)
+}
+
+func (t *testDatabase) TestAllowancesByAddress() {
+	st, _ := t.Database()
+
+	owner := currency.MustAddress(util.UUID().String())
+
+	var als []currency.Allowance
+	for i := 0; i < 5; i++ {
+		al := currency.NewAllowance(
+			owner, currency.MustAddress(util.UUID().String()), currency.MustNewAmount(currency.NewBig(10), t.cid),
+		)
+		t.insertAllowance(st, al, base.Height(33))
+
+		als = append(als, al)
+	}
+
+	// NOTE spent one has 2 states
+	t.insertAllowance(st, als[0].WithBig(currency.NewBig(3)), base.Height(34))
+
+	// NOTE unrelated allowance
+	t.insertAllowance(st, currency.NewAllowance(
+		currency.MustAddress(util.UUID().String()),
+		currency.MustAddress(util.UUID().String()),
+		currency.MustNewAmount(currency.NewBig(10), t.cid),
+	), base.Height(33))
+
+	keyOf := func(al currency.Allowance) string {
+		return currency.StateKeyAllowance(al.Owner(), al.Spender(), al.Amount().Currency())
+	}
+
+	sort.Slice(als, func(i, j int) bool {
+		return keyOf(als[i]) < keyOf(als[j])
+	})
+
+	var keys []string
+	t.NoError(st.AllowancesByAddress(owner, "", 0, func(va AllowanceValue) (bool, error) {
+		keys = append(keys, keyOf(va.Allowance()))
+		if va.Height() == base.Height(34) {
+			t.True(currency.NewBig(3).Equal(va.Allowance().Amount().Big()))
+		}
+
+		return true, nil
+	}))
+
+	t.Equal(len(als), len(keys))
+	for i := range als {
+		t.Equal(keyOf(als[i]), keys[i])
+	}
+
+	// NOTE by spender
+	keys = nil
+	t.NoError(st.AllowancesByAddress(als[2].Spender(), "", 0, func(va AllowanceValue) (bool, error) {
+		keys = append(keys, keyOf(va.Allowance()))
+
+		return true, nil
+	}))
+	t.Equal([]string{keyOf(als[2])}, keys)
+
+	// NOTE with offset and limit
+	keys = nil
+	t.NoError(st.AllowancesByAddress(owner, keyOf(als[1]), 2, func(va AllowanceValue) (bool, error) {
+		keys = append(keys, keyOf(va.Allowance()))
+
+		return true, nil
+	}))
+
+	t.Equal([]string{keyOf(als[2]), keyOf(als[3])}, keys)
+}
+
+func (t *testDatabase) insertAlias(st *Database, al currency.Alias, height base.Height) {
+	va, err := NewAliasValue(t.newStateFromValue(currency.StateKeyAlias(al.Name()), al, height))
+	t.NoError(err)
+
+	doc, err := NewAliasDoc(va, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameAlias, doc)
+}
+
+func (t *testDatabase) TestAlias() {
+	st, _ := t.Database()
+
+	height := base.Height(33)
+	ac := t.newAccount()
+
+	am := currency.MustNewAmount(t.randomBig(), t.cid)
+	_, _ = t.insertAccount(st, height, ac, am)
+
+	t.insertAlias(st, currency.NewAlias("showme", ac.Address()), height)
+	t.insertAlias(st, currency.NewAlias("findme", ac.Address()), height+1)
+	t.insertAlias(st, currency.NewAlias("other", currency.MustAddress(util.UUID().String())), height)
+
+	va, found, err := st.Alias("showme")
+	t.NoError(err)
+	t.True(found)
+	t.Equal("showme", va.Alias().Name())
+	t.True(ac.Address().Equal(va.Alias().Address()))
+	t.Equal(height, va.Height())
+
+	_, found, err = st.Alias("unknown")
+	t.NoError(err)
+	t.False(found)
+
+	// NOTE reverse lookup by account
+	urs, found, err := st.Account(ac.Address())
+	t.NoError(err)
+	t.True(found)
+	t.Equal([]string{"findme", "showme"}, urs.Aliases())
+}
+
+func (t *testDatabase) insertAccountMetadata(st *Database, md currency.AccountMetadata, height base.Height) {
+	va, err := NewAccountMetadataValue(t.newStateFromValue(currency.StateKeyAccountMetadata(md.Address()), md, height))
+	t.NoError(err)
+
+	doc, err := NewAccountMetadataDoc(va, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameAccountMetadata, doc)
+}
+
+func (t *testDatabase) TestAccountsByMetadata() {
+	st, _ := t.Database()
+
+	height := base.Height(33)
+
+	var acs []currency.Account
+	for i := 0; i < 3; i++ {
+		ac := t.newAccount()
+		am := currency.MustNewAmount(t.randomBig(), t.cid)
+		_, _ = t.insertAccount(st, height, ac, am)
+
+		acs = append(acs, ac)
+	}
+
+	t.insertAccountMetadata(st, currency.NewAccountMetadata(acs[0].Address(), map[string]string{"kyc": "passed"}), height)
+	t.insertAccountMetadata(st, currency.NewAccountMetadata(acs[1].Address(), map[string]string{"kyc": "passed"}), height)
+	t.insertAccountMetadata(st, currency.NewAccountMetadata(acs[2].Address(), map[string]string{"kyc": "pending"}), height)
+
+	// NOTE metadata of acs[1] is updated; the old one should not be matched
+	t.insertAccountMetadata(st,
+		currency.NewAccountMetadata(acs[1].Address(), map[string]string{"kyc": "expired", "name": "showme"}), height+1)
+
+	var founds []string
+	t.NoError(st.AccountsByMetadata("kyc", "passed", "", 10, func(va AccountValue) (bool, error) {
+		founds = append(founds, va.Account().Address().String())
+
+		return true, nil
+	}))
+
+	t.Equal([]string{acs[0].Address().String()}, founds)
+
+	founds = nil
+	t.NoError(st.AccountsByMetadata("name", "showme", "", 10, func(va AccountValue) (bool, error) {
+		t.Equal(map[string]string{"kyc": "expired", "name": "showme"}, va.Metadata())
+
+		founds = append(founds, va.Account().Address().String())
+
+		return true, nil
+	}))
+
+	t.Equal([]string{acs[1].Address().String()}, founds)
+
+	// NOTE metadata is loaded with account
+	va, found, err := st.Account(acs[2].Address())
+	t.NoError(err)
+	t.True(found)
+	t.Equal(map[string]string{"kyc": "pending"}, va.Metadata())
 }
 
 func (t *testDatabase) TestAccountBalanceUpdated() {
@@ -653,6 +1507,330 @@ func (t *testDatabase) TestAccountMultiCurrencies() {
 	t.compareAmount(amC, amE)
 }
 
+func (t *testDatabase) insertBalanceHistory(st *Database, ac currency.Account, bigs []int64) []state.State {
+	sts := make([]state.State, len(bigs))
+	for i := range bigs {
+		sts[i] = t.newBalanceState(ac, base.Height(i*2+3), currency.MustNewAmount(currency.NewBig(bigs[i]), t.cid))
+		doc, err := NewBalanceDoc(sts[i], t.BSONEnc)
+		t.NoError(err)
+		t.insertDoc(st, defaultColNameBalance, doc)
+	}
+
+	return sts
+}
+
+func (t *testDatabase) TestBalanceHistory() {
+	st, _ := t.Database()
+
+	ac := t.newAccount()
+	bigs := []int64{10, 30, 25, 25, 40}
+	sts := t.insertBalanceHistory(st, ac, bigs)
+
+	// NOTE other currency is ignored
+	doc, err := NewBalanceDoc(t.newBalanceState(ac, base.Height(4), currency.MustNewAmount(t.randomBig(), "EATME")), t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameBalance, doc)
+
+	load := func(offset string, reverse bool, limit int64) []BalanceHistoryValue {
+		var vas []BalanceHistoryValue
+		t.NoError(st.BalanceHistory(ac.Address(), t.cid, offset, reverse, limit, func(va BalanceHistoryValue) (bool, error) {
+			vas = append(vas, va)
+
+			return true, nil
+		}))
+
+		return vas
+	}
+
+	deltas := []int64{10, 20, -5, 0, 15}
+
+	vas := load("", false, 0)
+	t.Equal(len(bigs), len(vas))
+	for i := range vas {
+		t.Equal(sts[i].Height(), vas[i].Height())
+		t.Equal(currency.NewBig(bigs[i]).String(), vas[i].Amount().Big().String())
+		t.Equal(currency.NewBig(deltas[i]).String(), vas[i].Delta().String())
+		t.Equal(1, len(vas[i].Operations()))
+		t.True(sts[i].Operations()[0].Equal(vas[i].Operations()[0]))
+	}
+
+	// NOTE offset; the delta of boundary is from the previous balance
+	vas = load(sts[1].Height().String(), false, 2)
+	t.Equal(2, len(vas))
+	t.Equal(sts[2].Height(), vas[0].Height())
+	t.Equal(currency.NewBig(-5).String(), vas[0].Delta().String())
+	t.Equal(sts[3].Height(), vas[1].Height())
+
+	// NOTE reverse
+	vas = load(sts[4].Height().String(), true, 2)
+	t.Equal(2, len(vas))
+	t.Equal(sts[3].Height(), vas[0].Height())
+	t.Equal(currency.NewBig(0).String(), vas[0].Delta().String())
+	t.Equal(sts[2].Height(), vas[1].Height())
+	t.Equal(currency.NewBig(-5).String(), vas[1].Delta().String())
+
+	vas = nil
+	t.NoError(st.BalanceHistory(ac.Address(), "FINDME", "", false, 0, func(va BalanceHistoryValue) (bool, error) {
+		vas = append(vas, va)
+
+		return true, nil
+	}))
+	t.Empty(vas)
+
+	err = st.BalanceHistory(ac.Address(), t.cid, "a", false, 0, func(BalanceHistoryValue) (bool, error) {
+		return true, nil
+	})
+	t.Error(err)
+}
+
+func (t *testDatabase) TestBalanceByHeight() {
+	st, _ := t.Database()
+
+	ac := t.newAccount()
+	sts := t.insertBalanceHistory(st, ac, []int64{10, 30, 25})
+
+	cidC := currency.CurrencyID("EATME")
+	amC := currency.MustNewAmount(t.randomBig(), cidC)
+	doc, err := NewBalanceDoc(t.newBalanceState(ac, sts[1].Height(), amC), t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameBalance, doc)
+
+	_, found, err := st.BalanceByHeight(ac.Address(), sts[0].Height()-1)
+	t.NoError(err)
+	t.False(found)
+
+	va, found, err := st.BalanceByHeight(ac.Address(), sts[0].Height())
+	t.NoError(err)
+	t.True(found)
+	t.Equal(sts[0].Height(), va.Height())
+	t.Equal(1, len(va.Balance()))
+	t.Equal(currency.NewBig(10).String(), va.Balance()[0].Big().String())
+
+	va, found, err = st.BalanceByHeight(ac.Address(), sts[1].Height()+1)
+	t.NoError(err)
+	t.True(found)
+	t.Equal(2, len(va.Balance()))
+
+	balances := map[currency.CurrencyID]currency.Amount{}
+	for i := range va.Balance() {
+		balances[va.Balance()[i].Currency()] = va.Balance()[i]
+	}
+	t.Equal(currency.NewBig(30).String(), balances[t.cid].Big().String())
+	t.compareAmount(amC, balances[cidC])
+}
+
+func (t *testDatabase) TestHolders() {
+	st, _ := t.Database()
+
+	acs := make([]currency.Account, 5)
+	for i := range acs {
+		acs[i] = t.newAccount()
+	}
+
+	t.insertHolder(st, acs[0], 9, base.Height(3))
+	t.insertHolder(st, acs[1], 100, base.Height(3))
+	t.insertHolder(st, acs[2], 33, base.Height(3))
+	t.insertHolder(st, acs[3], 10, base.Height(3))
+	t.insertHolder(st, acs[4], 77, base.Height(3))
+
+	// NOTE updated by latest balance
+	t.insertHolder(st, acs[2], 7, base.Height(4))
+	t.insertHolder(st, acs[4], 0, base.Height(4))
+
+	load := func(offset string, limit int64) []string {
+		var as []string
+		t.NoError(st.Holders(t.cid, offset, limit, func(va HolderValue) (bool, error) {
+			as = append(as, va.Address().String())
+
+			return true, nil
+		}))
+
+		return as
+	}
+
+	expected := []string{acs[1].Address().String(), acs[3].Address().String(), acs[0].Address().String(), acs[2].Address().String()}
+	t.Equal(expected, load("", 0))
+	t.Equal(expected[:2], load("", 2))
+	t.Equal(expected[2:], load(buildHoldersOffset(currency.NewBig(10), acs[3].Address()), 2))
+
+	n, err := st.HoldersCount(t.cid)
+	t.NoError(err)
+	t.Equal(int64(4), n)
+
+	n, err = st.HoldersCount("FINDME")
+	t.NoError(err)
+	t.Equal(int64(0), n)
+
+	_, err = buildHoldersFilter(t.cid, "a,b")
+	t.Error(err)
+	_, err = buildHoldersFilter(t.cid, "10")
+	t.Error(err)
+}
+
+func (t *testDatabase) TestInitializeBackfillHolders() {
+	st, mst := t.Database()
+
+	acA := t.newAccount()
+	acB := t.newAccount()
+	acC := t.newAccount()
+
+	// NOTE balances digested before the holders
+	insertBalance := func(ac currency.Account, big int64, height base.Height) {
+		doc, err := NewBalanceDoc(t.newBalanceState(ac, height, currency.MustNewAmount(currency.NewBig(big), t.cid)), t.BSONEnc)
+		t.NoError(err)
+		t.insertDoc(st, defaultColNameBalance, doc)
+	}
+
+	insertBalance(acA, 10, base.Height(3))
+	insertBalance(acA, 40, base.Height(4))
+	insertBalance(acB, 30, base.Height(3))
+	insertBalance(acC, 20, base.Height(3))
+	insertBalance(acC, 0, base.Height(4))
+
+	t.NoError(st.SetLastBlock(base.Height(4)))
+
+	n, err := st.HoldersCount(t.cid)
+	t.NoError(err)
+	t.Equal(int64(0), n)
+
+	nst, err := NewDatabase(mst, st.database)
+	t.NoError(err)
+	t.NoError(nst.Initialize())
+
+	var vas []HolderValue
+	t.NoError(nst.Holders(t.cid, "", 0, func(va HolderValue) (bool, error) {
+		vas = append(vas, va)
+
+		return true, nil
+	}))
+
+	t.Equal(2, len(vas))
+	t.True(acA.Address().Equal(vas[0].Address()))
+	t.True(currency.NewBig(40).Equal(vas[0].Amount().Big()))
+	t.Equal(base.Height(4), vas[0].Height())
+	t.True(acB.Address().Equal(vas[1].Address()))
+	t.True(currency.NewBig(30).Equal(vas[1].Amount().Big()))
+
+	n, err = nst.HoldersCount(t.cid)
+	t.NoError(err)
+	t.Equal(int64(2), n)
+
+	// NOTE existing holders are not backfilled again
+	insertBalance(acB, 50, base.Height(5))
+	t.NoError(nst.SetLastBlock(base.Height(5)))
+
+	nst, err = NewDatabase(mst, st.database)
+	t.NoError(err)
+	t.NoError(nst.Initialize())
+
+	vas = nil
+	t.NoError(nst.Holders(t.cid, "", 0, func(va HolderValue) (bool, error) {
+		vas = append(vas, va)
+
+		return true, nil
+	}))
+
+	t.Equal(2, len(vas))
+	t.True(acA.Address().Equal(vas[0].Address()))
+}
+
+// oldOperationDoc is OperationDoc, which was digested by the older version
+// without the fields for OperationFilter.
+type oldOperationDoc struct {
+	OperationDoc
+}
+
+func (doc oldOperationDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["d"] = NewOperationValue(doc.va.op, doc.va.height, doc.va.confirmedAt, doc.va.inState, doc.va.reason, doc.va.index)
+	m["addresses"] = doc.addresses
+	m["fact"] = doc.op.Fact().Hash()
+	m["height"] = doc.height
+	m["index"] = doc.va.index
+
+	return bsonenc.Marshal(m)
+}
+
+func (t *testDatabase) TestInitializeBackfillOperations() {
+	st, mst := t.Database()
+
+	owner := t.newAccount().Address()
+	sender := currency.MustAddress(util.UUID().String())
+
+	fact := currency.NewTransferFromFact(
+		util.UUID().Bytes(), sender, owner, sender, currency.MustNewAmount(currency.NewBig(10), t.cid))
+
+	pk := key.NewBasePrivatekey()
+	sig, err := base.NewFactSignature(pk, fact, t.networkID)
+	t.NoError(err)
+
+	tf, err := currency.NewTransferFrom(fact, []base.FactSign{base.NewBaseFactSign(pk.Publickey(), sig)}, "")
+	t.NoError(err)
+
+	doc, err := NewOperationDoc(tf, t.BSONEnc, base.Height(3), localtime.UTCNow(), true, nil, 0)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameOperation, oldOperationDoc{OperationDoc: doc})
+
+	t.NoError(st.SetLastBlock(base.Height(3)))
+
+	load := func(st *Database, of OperationFilter) []OperationValue {
+		var vas []OperationValue
+		t.NoError(st.OperationsByAddress(owner, of, true, false, "", 100,
+			func(_ valuehash.Hash, va OperationValue) (bool, error) {
+				vas = append(vas, va)
+
+				return true, nil
+			},
+		))
+
+		return vas
+	}
+
+	of := NewOperationFilter([]hint.Type{currency.TransferFromType}, nil, OperationDirectionOutgoing, "", base.NilHeight, base.NilHeight)
+	t.Empty(load(st, of))
+
+	nst, err := NewDatabase(mst, st.database)
+	t.NoError(err)
+	t.NoError(nst.Initialize())
+
+	vas := load(nst, of)
+	t.Equal(1, len(vas))
+	t.True(tf.Fact().Hash().Equal(vas[0].Operation().Fact().Hash()))
+	t.Equal(1, len(vas[0].Debits()))
+	t.True(owner.Equal(vas[0].Debits()[0]))
+	t.Equal(1, len(vas[0].Credits()))
+	t.True(sender.Equal(vas[0].Credits()[0]))
+}
+
+func (t *testDatabase) TestCleanHoldersByHeight() {
+	st, _ := t.Database()
+
+	acA := t.newAccount()
+	acB := t.newAccount()
+
+	t.insertHolder(st, acA, 10, base.Height(3))
+	t.insertHolder(st, acA, 20, base.Height(5))
+	t.insertHolder(st, acB, 30, base.Height(5))
+
+	t.NoError(st.CleanByHeight(context.Background(), base.Height(4)))
+
+	var vas []HolderValue
+	t.NoError(st.Holders(t.cid, "", 0, func(va HolderValue) (bool, error) {
+		vas = append(vas, va)
+
+		return true, nil
+	}))
+
+	t.Equal(1, len(vas))
+	t.True(acA.Address().Equal(vas[0].Address()))
+	t.Equal(base.Height(3), vas[0].Height())
+	t.Equal(currency.NewBig(10).String(), vas[0].Amount().Big().String())
+}
+
 func (t *testDatabase) TestOperations() {
 	st, _ := t.Database()
 
@@ -54,6 +54,158 @@ func LoadAccountValue(decoder func(interface{}) error, encs *encoder.Encoders) (
 	return rs, nil
 }
 
+func LoadEscrowValue(decoder func(interface{}) error, encs *encoder.Encoders) (EscrowValue, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return EscrowValue{}, err
+	}
+
+	_, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs)
+	if err != nil {
+		return EscrowValue{}, err
+	}
+
+	va, ok := hinter.(EscrowValue)
+	if !ok {
+		return EscrowValue{}, errors.Errorf("not EscrowValue: %T", hinter)
+	}
+
+	return va, nil
+}
+
+func LoadScheduleValue(decoder func(interface{}) error, encs *encoder.Encoders) (ScheduleValue, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return ScheduleValue{}, err
+	}
+
+	_, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs)
+	if err != nil {
+		return ScheduleValue{}, err
+	}
+
+	va, ok := hinter.(ScheduleValue)
+	if !ok {
+		return ScheduleValue{}, errors.Errorf("not ScheduleValue: %T", hinter)
+	}
+
+	return va, nil
+}
+
+func LoadHTLCValue(decoder func(interface{}) error, encs *encoder.Encoders) (HTLCValue, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return HTLCValue{}, err
+	}
+
+	_, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs)
+	if err != nil {
+		return HTLCValue{}, err
+	}
+
+	va, ok := hinter.(HTLCValue)
+	if !ok {
+		return HTLCValue{}, errors.Errorf("not HTLCValue: %T", hinter)
+	}
+
+	return va, nil
+}
+
+func LoadAllowanceValue(decoder func(interface{}) error, encs *encoder.Encoders) (AllowanceValue, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return AllowanceValue{}, err
+	}
+
+	_, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs)
+	if err != nil {
+		return AllowanceValue{}, err
+	}
+
+	va, ok := hinter.(AllowanceValue)
+	if !ok {
+		return AllowanceValue{}, errors.Errorf("not AllowanceValue: %T", hinter)
+	}
+
+	return va, nil
+}
+
+func LoadAliasValue(decoder func(interface{}) error, encs *encoder.Encoders) (AliasValue, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return AliasValue{}, err
+	}
+
+	_, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs)
+	if err != nil {
+		return AliasValue{}, err
+	}
+
+	va, ok := hinter.(AliasValue)
+	if !ok {
+		return AliasValue{}, errors.Errorf("not AliasValue: %T", hinter)
+	}
+
+	return va, nil
+}
+
+func LoadAccountMetadataValue(decoder func(interface{}) error, encs *encoder.Encoders) (AccountMetadataValue, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return AccountMetadataValue{}, err
+	}
+
+	_, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs)
+	if err != nil {
+		return AccountMetadataValue{}, err
+	}
+
+	va, ok := hinter.(AccountMetadataValue)
+	if !ok {
+		return AccountMetadataValue{}, errors.Errorf("not AccountMetadataValue: %T", hinter)
+	}
+
+	return va, nil
+}
+
+func LoadWebhook(decoder func(interface{}) error, encs *encoder.Encoders) (Webhook, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return Webhook{}, err
+	}
+
+	_, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs)
+	if err != nil {
+		return Webhook{}, err
+	}
+
+	wh, ok := hinter.(Webhook)
+	if !ok {
+		return Webhook{}, errors.Errorf("not Webhook: %T", hinter)
+	}
+
+	return wh, nil
+}
+
+func LoadWebhookDelivery(decoder func(interface{}) error, encs *encoder.Encoders) (WebhookDelivery, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return WebhookDelivery{}, err
+	}
+
+	_, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs)
+	if err != nil {
+		return WebhookDelivery{}, err
+	}
+
+	dl, ok := hinter.(WebhookDelivery)
+	if !ok {
+		return WebhookDelivery{}, errors.Errorf("not WebhookDelivery: %T", hinter)
+	}
+
+	return dl, nil
+}
+
 func LoadBalance(decoder func(interface{}) error, encs *encoder.Encoders) (state.State, error) {
 	var b bson.Raw
 	if err := decoder(&b); err != nil {
@@ -68,3 +220,22 @@ func LoadBalance(decoder func(interface{}) error, encs *encoder.Encoders) (state
 		return st, nil
 	}
 }
+
+func LoadHolderValue(decoder func(interface{}) error, encs *encoder.Encoders) (HolderValue, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return HolderValue{}, err
+	}
+
+	_, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs)
+	if err != nil {
+		return HolderValue{}, err
+	}
+
+	va, ok := hinter.(HolderValue)
+	if !ok {
+		return HolderValue{}, errors.Errorf("not HolderValue: %T", hinter)
+	}
+
+	return va, nil
+}
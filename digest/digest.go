@@ -50,6 +50,8 @@ type Digester struct {
 	database  *Database
 	blockChan chan block.Block
 	errChan   chan error
+	streamer  *Streamer
+	webhook   *WebhookDispatcher
 }
 
 func NewDigester(st *Database, errChan chan error) *Digester {
@@ -60,6 +62,7 @@ func NewDigester(st *Database, errChan chan error) *Digester {
 		database:  st,
 		blockChan: make(chan block.Block, 100),
 		errChan:   errChan,
+		streamer:  NewStreamer(),
 	}
 
 	di.ContextDaemon = util.NewContextDaemon("digester", di.start)
@@ -110,6 +113,37 @@ end:
 	return nil
 }
 
+func (di *Digester) SetLogging(l *logging.Logging) *logging.Logging {
+	_ = di.streamer.SetLogging(l)
+
+	return di.Logging.SetLogging(l)
+}
+
+// Streamer returns the Streamer, which receives the digested blocks.
+func (di *Digester) Streamer() *Streamer {
+	return di.streamer
+}
+
+// SetWebhookDispatcher sets the WebhookDispatcher, which receives the digested
+// blocks.
+func (di *Digester) SetWebhookDispatcher(wd *WebhookDispatcher) *Digester {
+	di.Lock()
+	defer di.Unlock()
+
+	di.webhook = wd
+
+	return di
+}
+
+// WebhookDispatcher returns the WebhookDispatcher; if webhook is disabled, it
+// returns nil.
+func (di *Digester) WebhookDispatcher() *WebhookDispatcher {
+	di.RLock()
+	defer di.RUnlock()
+
+	return di.webhook
+}
+
 func (di *Digester) Digest(blocks []block.Block) {
 	sort.Slice(blocks, func(i, j int) bool {
 		return blocks[i].Height() < blocks[j].Height()
@@ -127,25 +161,49 @@ func (di *Digester) digest(ctx context.Context, blk block.Block) error {
 	di.Lock()
 	defer di.Unlock()
 
-	if err := DigestBlock(ctx, di.database, blk); err != nil {
+	ops, err := digestBlock(ctx, di.database, blk)
+	if err != nil {
 		return err
 	}
 
-	return di.database.SetLastBlock(blk.Height())
+	if err := di.database.SetLastBlock(blk.Height()); err != nil {
+		return err
+	}
+
+	sb := NewStreamBlock(blk.Manifest(), ops)
+	di.streamer.Publish(sb)
+
+	if di.webhook != nil {
+		di.webhook.Dispatch(sb)
+	}
+
+	return nil
 }
 
 func DigestBlock(ctx context.Context, st *Database, blk block.Block) error {
+	_, err := digestBlock(ctx, st, blk)
+
+	return err
+}
+
+func digestBlock(ctx context.Context, st *Database, blk block.Block) ([]OperationValue, error) {
 	bs, err := NewBlockSession(st, blk)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer func() {
 		_ = bs.Close()
 	}()
 
 	if err := bs.Prepare(); err != nil {
-		return err
+		return nil, err
+	}
+
+	ops := bs.OperationValues()
+
+	if err := bs.Commit(ctx); err != nil {
+		return nil, err
 	}
 
-	return bs.Commit(ctx)
+	return ops, nil
 }
@@ -12,9 +12,13 @@ import (
 
 type AccountDoc struct {
 	mongodbstorage.BaseDoc
-	address string
-	height  base.Height
-	pubs    []string
+	address      string
+	height       base.Height
+	pubs         []string
+	frozen       bool
+	frozenHeight base.Height
+	closed       bool
+	closedHeight base.Height
 }
 
 func NewAccountDoc(rs AccountValue, enc encoder.Encoder) (AccountDoc, error) {
@@ -35,10 +39,14 @@ func NewAccountDoc(rs AccountValue, enc encoder.Encoder) (AccountDoc, error) {
 
 	address := rs.ac.Address()
 	return AccountDoc{
-		BaseDoc: b,
-		address: address.String(),
-		height:  rs.height,
-		pubs:    pubs,
+		BaseDoc:      b,
+		address:      address.String(),
+		height:       rs.height,
+		pubs:         pubs,
+		frozen:       rs.ac.IsFrozen(),
+		frozenHeight: rs.frozenHeight,
+		closed:       rs.ac.IsClosed(),
+		closedHeight: rs.closedHeight,
 	}, nil
 }
 
@@ -51,6 +59,10 @@ func (doc AccountDoc) MarshalBSON() ([]byte, error) {
 	m["address"] = doc.address
 	m["height"] = doc.height
 	m["pubs"] = doc.pubs
+	m["frozen"] = doc.frozen
+	m["frozen_height"] = doc.frozenHeight
+	m["closed"] = doc.closed
+	m["closed_height"] = doc.closedHeight
 
 	return bsonenc.Marshal(m)
 }
@@ -86,10 +98,48 @@ func (doc BalanceDoc) MarshalBSON() ([]byte, error) {
 		return nil, err
 	}
 
-	address := doc.st.Key()[:len(doc.st.Key())-len(currency.StateKeyBalanceSuffix)-len(doc.am.Currency())-1]
-	m["address"] = address
+	m["address"] = balanceStateAddress(doc.st, doc.am.Currency())
 	m["currency"] = doc.am.Currency().String()
 	m["height"] = doc.st.Height()
 
 	return bsonenc.Marshal(m)
 }
+
+type LockedBalanceDoc struct {
+	mongodbstorage.BaseDoc
+	st state.State
+	la currency.LockedAmount
+}
+
+// NewLockedBalanceDoc gets the State of LockedAmount
+func NewLockedBalanceDoc(st state.State, enc encoder.Encoder) (LockedBalanceDoc, error) {
+	la, err := currency.StateLockedBalanceValue(st)
+	if err != nil {
+		return LockedBalanceDoc{}, errors.Wrap(err, "LockedBalanceDoc needs LockedAmount state")
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return LockedBalanceDoc{}, err
+	}
+
+	return LockedBalanceDoc{
+		BaseDoc: b,
+		st:      st,
+		la:      la,
+	}, nil
+}
+
+func (doc LockedBalanceDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	address := doc.st.Key()[:len(doc.st.Key())-len(currency.StateKeyLockedBalanceSuffix)-len(doc.la.Currency())-1]
+	m["address"] = address
+	m["currency"] = doc.la.Currency().String()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
@@ -0,0 +1,77 @@
+package digest
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	mongodbstorage "github.com/spikeekips/mitum/storage/mongodb"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+// HolderDoc keeps the latest balance of account for one currency; the state
+// key of balance is used as id, so the document is replaced by the new
+// balance.
+type HolderDoc struct {
+	mongodbstorage.BaseDoc
+	va HolderValue
+}
+
+// NewHolderDoc gets the State of Amount
+func NewHolderDoc(st state.State, enc encoder.Encoder) (HolderDoc, error) {
+	am, err := currency.StateBalanceValue(st)
+	if err != nil {
+		return HolderDoc{}, errors.Wrap(err, "HolderDoc needs Amount state")
+	}
+
+	a, err := base.DecodeAddressFromString(balanceStateAddress(st, am.Currency()), enc)
+	if err != nil {
+		return HolderDoc{}, err
+	}
+
+	va := NewHolderValue(a, am, st.Height())
+
+	b, err := mongodbstorage.NewBaseDoc(st.Key(), va, enc)
+	if err != nil {
+		return HolderDoc{}, err
+	}
+
+	return HolderDoc{
+		BaseDoc: b,
+		va:      va,
+	}, nil
+}
+
+func (doc HolderDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	big := doc.va.amount.Big()
+
+	m["address"] = doc.va.address.String()
+	m["currency"] = doc.va.amount.Currency().String()
+	m["height"] = doc.va.height
+	m["balance_key"] = holderBalanceKey(big)
+	m["holding"] = big.OverZero()
+
+	return bsonenc.Marshal(m)
+}
+
+// holderBalanceKey returns the sortable string of balance; the digits is
+// prefixed by the length of digits, so the longer balance is sorted first by
+// descending order.
+func holderBalanceKey(big currency.Big) string {
+	s := big.String()
+
+	return fmt.Sprintf("%04d%s", len(s), s)
+}
+
+func balanceStateAddress(st state.State, cid currency.CurrencyID) string {
+	return st.Key()[:len(st.Key())-len(currency.StateKeyBalanceSuffix)-len(cid)-1]
+}
@@ -3,7 +3,6 @@ package digest
 import (
 	"time"
 
-	"github.com/spikeekips/mitum-currency/currency"
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/operation"
 	mongodbstorage "github.com/spikeekips/mitum/storage/mongodb"
@@ -28,19 +27,31 @@ func NewOperationDoc(
 	reason operation.ReasonError,
 	index uint64,
 ) (OperationDoc, error) {
-	var addresses []string
-	if ads, ok := op.Fact().(currency.Addresses); ok {
-		as, err := ads.Addresses()
-		if err != nil {
-			return OperationDoc{}, err
-		}
-		addresses = make([]string, len(as))
-		for i := range as {
-			addresses[i] = as[i].String()
-		}
+	return newOperationDoc(NewOperationValue(op, height, confirmedAt, inState, reason, index), enc, nil)
+}
+
+// newOperationDoc resolves the debited and credited accounts of operation by
+// rs; when rs is nil, the accounts, which are not carried by fact, are
+// ignored.
+func newOperationDoc(va OperationValue, enc encoder.Encoder, rs accountResolver) (OperationDoc, error) {
+	if rs == nil {
+		rs = blockAccountResolver{}
+	}
+
+	op := va.Operation()
+
+	debits, credits, err := factAccounts(op.Fact(), rs)
+	if err != nil {
+		return OperationDoc{}, err
+	}
+
+	va = va.setAccounts(debits, credits)
+
+	as, err := va.Addresses()
+	if err != nil {
+		return OperationDoc{}, err
 	}
 
-	va := NewOperationValue(op, height, confirmedAt, inState, reason, index)
 	b, err := mongodbstorage.NewBaseDoc(nil, va, enc)
 	if err != nil {
 		return OperationDoc{}, err
@@ -50,8 +61,8 @@ func NewOperationDoc(
 		BaseDoc:   b,
 		va:        va,
 		op:        op,
-		addresses: addresses,
-		height:    height,
+		addresses: addressStrings(as),
+		height:    va.Height(),
 	}, nil
 }
 
@@ -65,6 +76,41 @@ func (doc OperationDoc) MarshalBSON() ([]byte, error) {
 	m["fact"] = doc.op.Fact().Hash()
 	m["height"] = doc.height
 	m["index"] = doc.va.index
+	m["type"] = doc.op.Hint().Type().String()
+	m["currencies"] = doc.currencies()
+	m["in_state"] = doc.va.inState
+	m["debits"] = addressStrings(doc.va.debits)
+	m["credits"] = addressStrings(doc.va.credits)
 
 	return bsonenc.Marshal(m)
 }
+
+// currencies returns the unique currencies of operation fact for
+// OperationFilter.
+func (doc OperationDoc) currencies() []string {
+	cids := factCurrencies(doc.op.Fact())
+
+	founds := map[string]struct{}{}
+
+	var ss []string
+	for i := range cids {
+		s := cids[i].String()
+		if _, found := founds[s]; found {
+			continue
+		}
+
+		founds[s] = struct{}{}
+		ss = append(ss, s)
+	}
+
+	return ss
+}
+
+func addressStrings(as []base.Address) []string {
+	ss := make([]string, len(as))
+	for i := range as {
+		ss[i] = as[i].String()
+	}
+
+	return ss
+}
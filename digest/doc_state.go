@@ -0,0 +1,121 @@
+package digest
+
+import (
+	"github.com/spikeekips/mitum/base"
+	mongodbstorage "github.com/spikeekips/mitum/storage/mongodb"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+// StateDoc is the document of the digested state value, like EscrowValue; the
+// fields are the searchable fields of value.
+type StateDoc struct {
+	mongodbstorage.BaseDoc
+	fields bson.M
+	height base.Height
+}
+
+func newStateDoc(va interface{}, height base.Height, enc encoder.Encoder, fields bson.M) (StateDoc, error) {
+	b, err := mongodbstorage.NewBaseDoc(nil, va, enc)
+	if err != nil {
+		return StateDoc{}, err
+	}
+
+	return StateDoc{
+		BaseDoc: b,
+		fields:  fields,
+		height:  height,
+	}, nil
+}
+
+func (doc StateDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	for k := range doc.fields {
+		m[k] = doc.fields[k]
+	}
+	m["height"] = doc.height
+
+	return bsonenc.Marshal(m)
+}
+
+func NewEscrowDoc(va EscrowValue, enc encoder.Encoder) (StateDoc, error) {
+	return newStateDoc(va, va.height, enc, bson.M{
+		"id":        va.escrow.ID().String(),
+		"addresses": addressStrings(va.escrow.Addresses()),
+		"status":    va.escrow.Status(),
+	})
+}
+
+func NewScheduleDoc(va ScheduleValue, enc encoder.Encoder) (StateDoc, error) {
+	return newStateDoc(va, va.height, enc, bson.M{
+		"id":        va.schedule.ID().String(),
+		"addresses": addressStrings(va.schedule.Addresses()),
+		"status":    va.schedule.Status(),
+	})
+}
+
+func NewHTLCDoc(va HTLCValue, enc encoder.Encoder) (StateDoc, error) {
+	return newStateDoc(va, va.height, enc, bson.M{
+		"id":        va.htlc.ID().String(),
+		"addresses": addressStrings(va.htlc.Addresses()),
+		"status":    va.htlc.Status(),
+	})
+}
+
+func NewAllowanceDoc(va AllowanceValue, enc encoder.Encoder) (StateDoc, error) {
+	al := va.allowance
+
+	return newStateDoc(va, va.height, enc, bson.M{
+		"key":       currency.StateKeyAllowance(al.Owner(), al.Spender(), al.Amount().Currency()),
+		"owner":     al.Owner().String(),
+		"spender":   al.Spender().String(),
+		"addresses": []string{al.Owner().String(), al.Spender().String()},
+		"currency":  al.Amount().Currency().String(),
+	})
+}
+
+func NewAliasDoc(va AliasValue, enc encoder.Encoder) (StateDoc, error) {
+	al := va.alias
+
+	return newStateDoc(va, va.height, enc, bson.M{
+		"key":     currency.StateKeyAlias(al.Name()),
+		"name":    al.Name(),
+		"address": al.Address().String(),
+	})
+}
+
+func NewAccountMetadataDoc(va AccountMetadataValue, enc encoder.Encoder) (StateDoc, error) {
+	md := va.metadata
+
+	return newStateDoc(va, va.height, enc, bson.M{
+		"address": md.Address().String(),
+		"pairs":   accountMetadataPairs(md),
+	})
+}
+
+// accountMetadataPairs returns the "<key>:<value>" strings of metadata for
+// searching accounts by metadata.
+func accountMetadataPairs(md currency.AccountMetadata) []string {
+	data := md.Data()
+
+	pairs := make([]string, len(data))
+
+	var i int
+	for k := range data {
+		pairs[i] = accountMetadataPair(k, data[k])
+		i++
+	}
+
+	return pairs
+}
+
+func accountMetadataPair(k, v string) string {
+	return k + ":" + v
+}
@@ -0,0 +1,74 @@
+package digest
+
+import (
+	mongodbstorage "github.com/spikeekips/mitum/storage/mongodb"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+)
+
+type WebhookDoc struct {
+	mongodbstorage.BaseDoc
+	wh Webhook
+}
+
+func NewWebhookDoc(wh Webhook, enc encoder.Encoder) (WebhookDoc, error) {
+	b, err := mongodbstorage.NewBaseDoc(wh.ID(), wh, enc)
+	if err != nil {
+		return WebhookDoc{}, err
+	}
+
+	return WebhookDoc{
+		BaseDoc: b,
+		wh:      wh,
+	}, nil
+}
+
+func (doc WebhookDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	as := doc.wh.Addresses()
+	addresses := make([]string, len(as))
+	for i := range as {
+		addresses[i] = as[i].String()
+	}
+
+	m["id"] = doc.wh.ID()
+	m["addresses"] = addresses
+	m["created_at"] = doc.wh.CreatedAt()
+
+	return bsonenc.Marshal(m)
+}
+
+type WebhookDeliveryDoc struct {
+	mongodbstorage.BaseDoc
+	dl WebhookDelivery
+}
+
+func NewWebhookDeliveryDoc(dl WebhookDelivery, enc encoder.Encoder) (WebhookDeliveryDoc, error) {
+	b, err := mongodbstorage.NewBaseDoc(dl.ID(), dl, enc)
+	if err != nil {
+		return WebhookDeliveryDoc{}, err
+	}
+
+	return WebhookDeliveryDoc{
+		BaseDoc: b,
+		dl:      dl,
+	}, nil
+}
+
+func (doc WebhookDeliveryDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["id"] = doc.dl.ID()
+	m["webhook"] = doc.dl.Webhook()
+	m["height"] = doc.dl.Height()
+	m["status"] = doc.dl.Status()
+
+	return bsonenc.Marshal(m)
+}
@@ -0,0 +1,63 @@
+package digest
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+var (
+	EscrowValueType = hint.Type("mitum-currency-escrow-value")
+	EscrowValueHint = hint.NewHint(EscrowValueType, "v0.0.1")
+)
+
+type EscrowValue struct {
+	escrow currency.Escrow
+	height base.Height
+}
+
+func NewEscrowValue(st state.State) (EscrowValue, error) {
+	es, err := currency.StateEscrowValue(st)
+	if err != nil {
+		return EscrowValue{}, errors.Wrap(err, "EscrowValue needs Escrow state")
+	}
+
+	return EscrowValue{
+		escrow: es,
+		height: st.Height(),
+	}, nil
+}
+
+func (EscrowValue) Hint() hint.Hint {
+	return EscrowValueHint
+}
+
+func (va EscrowValue) Escrow() currency.Escrow {
+	return va.escrow
+}
+
+// Height returns the height, when the escrow was created or settled.
+func (va EscrowValue) Height() base.Height {
+	return va.height
+}
+
+func (va EscrowValue) MarshalBSON() ([]byte, error) {
+	return marshalStateValueBSON(va.Hint(), "escrow", va.escrow, va.height)
+}
+
+func (va *EscrowValue) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	return unpackStateValueBSON(b, enc, "escrow", &va.escrow, &va.height)
+}
+
+func (va EscrowValue) MarshalJSON() ([]byte, error) {
+	return marshalStateValueJSON(va.Hint(), "escrow", va.escrow, va.height)
+}
+
+func (va *EscrowValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	return unpackStateValueJSON(b, enc, "escrow", &va.escrow, &va.height)
+}
@@ -31,6 +31,7 @@ var (
 	HandlerPathNodeInfo                   = `/`
 	HandlerPathCurrencies                 = `/currency`
 	HandlerPathCurrency                   = `/currency/{currencyid:.*}`
+	HandlerPathCurrencyHolders            = `/currency/{currencyid:[^/]+}/holders`
 	HandlerPathManifests                  = `/block/manifests`
 	HandlerPathOperations                 = `/block/operations`
 	HandlerPathOperation                  = `/block/operation/{hash:(?i)[0-9a-z][0-9a-z]+}`
@@ -39,9 +40,23 @@ var (
 	HandlerPathOperationsByHeight         = `/block/{height:[0-9]+}/operations`
 	HandlerPathManifestByHeight           = `/block/{height:[0-9]+}/manifest`
 	HandlerPathManifestByHash             = `/block/{hash:(?i)[0-9a-z][0-9a-z]+}/manifest`
-	HandlerPathAccount                    = `/account/{address:(?i)` + base.REStringAddressString + `}`            // revive:disable-line:line-length-limit
-	HandlerPathAccountOperations          = `/account/{address:(?i)` + base.REStringAddressString + `}/operations` // revive:disable-line:line-length-limit
+	HandlerPathAccount                    = `/account/{address:(?i)` + base.REStringAddressString + `}`                 // revive:disable-line:line-length-limit
+	HandlerPathAccountOperations          = `/account/{address:(?i)` + base.REStringAddressString + `}/operations`      // revive:disable-line:line-length-limit
+	HandlerPathAccountEscrows             = `/account/{address:(?i)` + base.REStringAddressString + `}/escrows`         // revive:disable-line:line-length-limit
+	HandlerPathAccountAllowances          = `/account/{address:(?i)` + base.REStringAddressString + `}/allowances`      // revive:disable-line:line-length-limit
+	HandlerPathAccountSchedules           = `/account/{address:(?i)` + base.REStringAddressString + `}/schedules`       // revive:disable-line:line-length-limit
+	HandlerPathAccountHTLCs               = `/account/{address:(?i)` + base.REStringAddressString + `}/htlcs`           // revive:disable-line:line-length-limit
+	HandlerPathAccountBalance             = `/account/{address:(?i)` + base.REStringAddressString + `}/balance`         // revive:disable-line:line-length-limit
+	HandlerPathAccountBalanceHistory      = `/account/{address:(?i)` + base.REStringAddressString + `}/balance/history` // revive:disable-line:line-length-limit
 	HandlerPathAccounts                   = `/accounts`
+	HandlerPathEscrow                     = `/escrow/{hash:(?i)[0-9a-z][0-9a-z]+}`
+	HandlerPathSchedule                   = `/schedule/{hash:(?i)[0-9a-z][0-9a-z]+}`
+	HandlerPathHTLC                       = `/htlc/{hash:(?i)[0-9a-z][0-9a-z]+}`
+	HandlerPathAlias                      = `/alias/{name:[a-z0-9][a-z0-9_\-]*}`
+	HandlerPathStream                     = `/stream`
+	HandlerPathWebhooks                   = `/webhooks`
+	HandlerPathWebhook                    = `/webhook/{id:[0-9a-f\-]+}`
+	HandlerPathWebhookDeliveries          = `/webhook/{id:[0-9a-f\-]+}/deliveries`
 	HandlerPathOperationBuildFactTemplate = `/builder/operation/fact/template/{fact:[\w][\w\-]*}`
 	HandlerPathOperationBuildFact         = `/builder/operation/fact`
 	HandlerPathOperationBuildSign         = `/builder/operation/sign`
@@ -53,6 +68,7 @@ var RateLimitHandlerMap = map[string]string{
 	"node-info":                       HandlerPathNodeInfo,
 	"currencies":                      HandlerPathCurrencies,
 	"currency":                        HandlerPathCurrency,
+	"currency-holders":                HandlerPathCurrencyHolders,
 	"block-manifests":                 HandlerPathManifests,
 	"block-operations":                HandlerPathOperations,
 	"block-operation":                 HandlerPathOperation,
@@ -63,7 +79,21 @@ var RateLimitHandlerMap = map[string]string{
 	"block-manifest-by-hash":          HandlerPathManifestByHash,
 	"account":                         HandlerPathAccount,
 	"account-operations":              HandlerPathAccountOperations,
+	"account-escrows":                 HandlerPathAccountEscrows,
+	"account-allowances":              HandlerPathAccountAllowances,
+	"account-schedules":               HandlerPathAccountSchedules,
+	"account-htlcs":                   HandlerPathAccountHTLCs,
+	"account-balance":                 HandlerPathAccountBalance,
+	"account-balance-history":         HandlerPathAccountBalanceHistory,
 	"accounts":                        HandlerPathAccounts,
+	"escrow":                          HandlerPathEscrow,
+	"schedule":                        HandlerPathSchedule,
+	"htlc":                            HandlerPathHTLC,
+	"alias":                           HandlerPathAlias,
+	"stream":                          HandlerPathStream,
+	"webhooks":                        HandlerPathWebhooks,
+	"webhook":                         HandlerPathWebhook,
+	"webhook-deliveries":              HandlerPathWebhookDeliveries,
 	"builder-operation-fact-template": HandlerPathOperationBuildFactTemplate,
 	"builder-operation-fact":          HandlerPathOperationBuildFact,
 	"builder-operation-sign":          HandlerPathOperationBuildSign,
@@ -96,6 +126,8 @@ type Handlers struct {
 	cp              *currency.CurrencyPool
 	nodeInfoHandler network.NodeInfoHandler
 	send            func(interface{}) (seal.Seal, error)
+	streamer        *Streamer
+	webhookToken    string
 	router          *mux.Router
 	routes          map[ /* path */ string]*mux.Route
 	itemsLimiter    func(string /* request type */) int64
@@ -134,8 +166,8 @@ func NewHandlers(
 
 func (hd *Handlers) Initialize() error {
 	cors := handlers.CORS(
-		handlers.AllowedMethods([]string{"GET", "HEAD", "POST", "PUT", "OPTIONS"}),
-		handlers.AllowedHeaders([]string{"content-type"}),
+		handlers.AllowedMethods([]string{"GET", "HEAD", "POST", "PUT", "DELETE", "OPTIONS"}),
+		handlers.AllowedHeaders([]string{"content-type", "authorization"}),
 		handlers.AllowedOrigins([]string{"*"}),
 		handlers.AllowCredentials(),
 	)
@@ -167,6 +199,9 @@ func (hd *Handlers) Handler() http.Handler {
 func (hd *Handlers) setHandlers() {
 	_ = hd.setHandler(HandlerPathCurrencies, hd.handleCurrencies, true).
 		Methods(http.MethodOptions, "GET")
+	// NOTE HandlerPathCurrencyHolders should be before HandlerPathCurrency
+	_ = hd.setHandler(HandlerPathCurrencyHolders, hd.handleCurrencyHolders, true).
+		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathCurrency, hd.handleCurrency, true).
 		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathManifests, hd.handleManifests, true).
@@ -189,8 +224,36 @@ func (hd *Handlers) setHandlers() {
 		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathAccountOperations, hd.handleAccountOperations, true).
 		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathAccountEscrows, hd.handleAccountEscrows, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathAccountAllowances, hd.handleAccountAllowances, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathAccountSchedules, hd.handleAccountSchedules, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathAccountHTLCs, hd.handleAccountHTLCs, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathAccountBalance, hd.handleAccountBalance, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathAccountBalanceHistory, hd.handleAccountBalanceHistory, true).
+		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathAccounts, hd.handleAccounts, true).
 		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathEscrow, hd.handleEscrow, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathSchedule, hd.handleSchedule, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathHTLC, hd.handleHTLC, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathAlias, hd.handleAlias, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathStream, hd.handleStream, false).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathWebhooks, hd.handleWebhooks, false).
+		Methods(http.MethodOptions, http.MethodGet, http.MethodPost)
+	_ = hd.setHandler(HandlerPathWebhookDeliveries, hd.handleWebhookDeliveries, false).
+		Methods(http.MethodOptions, http.MethodGet)
+	_ = hd.setHandler(HandlerPathWebhook, hd.handleWebhook, false).
+		Methods(http.MethodOptions, http.MethodGet, http.MethodDelete)
 	_ = hd.setHandler(HandlerPathOperationBuildFactTemplate, hd.handleOperationBuildFactTemplate, true).
 		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathOperationBuildFact, hd.handleOperationBuildFact, false).
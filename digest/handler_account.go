@@ -12,7 +12,10 @@ import (
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/key"
 	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/valuehash"
+
+	"github.com/spikeekips/mitum-currency/currency"
 )
 
 func (hd *Handlers) handleAccount(w http.ResponseWriter, r *http.Request) {
@@ -76,6 +79,7 @@ func (hd *Handlers) buildAccountHal(va AccountValue) (Hal, error) {
 
 	var hal Hal
 	hal = NewBaseHal(va, NewHalLink(h, nil))
+	hal = hal.AddExtras("balance", hd.formatAmounts(va.Balance()))
 
 	h, err = hd.combineURL(HandlerPathAccountOperations, "address", hinted)
 	if err != nil {
@@ -86,6 +90,63 @@ func (hd *Handlers) buildAccountHal(va AccountValue) (Hal, error) {
 		AddLink("operations:{offset}", NewHalLink(h+"?offset={offset}", nil).SetTemplated()).
 		AddLink("operations:{offset,reverse}", NewHalLink(h+"?offset={offset}&reverse=1", nil).SetTemplated())
 
+	h, err = hd.combineURL(HandlerPathAccountEscrows, "address", hinted)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.
+		AddLink("escrows", NewHalLink(h, nil)).
+		AddLink("escrows:{offset}", NewHalLink(h+"?offset={offset}", nil).SetTemplated())
+
+	h, err = hd.combineURL(HandlerPathAccountAllowances, "address", hinted)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.
+		AddLink("allowances", NewHalLink(h, nil)).
+		AddLink("allowances:{offset}", NewHalLink(h+"?offset={offset}", nil).SetTemplated())
+
+	h, err = hd.combineURL(HandlerPathAccountSchedules, "address", hinted)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.
+		AddLink("schedules", NewHalLink(h, nil)).
+		AddLink("schedules:{offset}", NewHalLink(h+"?offset={offset}", nil).SetTemplated())
+
+	h, err = hd.combineURL(HandlerPathAccountHTLCs, "address", hinted)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.
+		AddLink("htlcs", NewHalLink(h, nil)).
+		AddLink("htlcs:{offset}", NewHalLink(h+"?offset={offset}", nil).SetTemplated())
+
+	h, err = hd.combineURL(HandlerPathAccountBalance, "address", hinted)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("balance:{height}", NewHalLink(h+"?height={height}", nil).SetTemplated())
+
+	h, err = hd.combineURL(HandlerPathAccountBalanceHistory, "address", hinted)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.
+		AddLink("balance_history:{currency}", NewHalLink(h+"?currency={currency}", nil).SetTemplated()).
+		AddLink(
+			"balance_history:{currency,offset,reverse}",
+			NewHalLink(h+"?currency={currency}&offset={offset}&reverse=1", nil).SetTemplated(),
+		)
+
+	for i := range va.Aliases() {
+		h, err = hd.combineURL(HandlerPathAlias, "name", va.Aliases()[i])
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink("alias:"+va.Aliases()[i], NewHalLink(h, nil))
+	}
+
 	h, err = hd.combineURL(HandlerPathBlockByHeight, "height", va.Height().String())
 	if err != nil {
 		return nil, err
@@ -122,16 +183,23 @@ func (hd *Handlers) handleAccountOperations(w http.ResponseWriter, r *http.Reque
 		address = a
 	}
 
+	of, err := parseOperationFilter(r.URL.Query())
+	if err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
 	offset := parseOffsetQuery(r.URL.Query().Get("offset"))
 	reverse := parseBoolQuery(r.URL.Query().Get("reverse"))
 
-	cachekey := CacheKey(r.URL.Path, stringOffsetQuery(offset), stringBoolQuery("reverse", reverse))
+	cachekey := CacheKey(r.URL.Path, of.Query(), stringOffsetQuery(offset), stringBoolQuery("reverse", reverse))
 	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
 		return
 	}
 
 	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
-		i, filled, err := hd.handleAccountOperationsInGroup(address, offset, reverse)
+		i, filled, err := hd.handleAccountOperationsInGroup(address, of, offset, reverse)
 
 		return []interface{}{i, filled}, err
 	}); err != nil {
@@ -160,13 +228,14 @@ func (hd *Handlers) handleAccountOperations(w http.ResponseWriter, r *http.Reque
 
 func (hd *Handlers) handleAccountOperationsInGroup(
 	address base.Address,
+	of OperationFilter,
 	offset string,
 	reverse bool,
 ) ([]byte, bool, error) {
 	limit := hd.itemsLimiter("account-operations")
 	var vas []Hal
 	if err := hd.database.OperationsByAddress(
-		address, true, reverse, offset, limit,
+		address, of, true, reverse, offset, limit,
 		func(_ valuehash.Hash, va OperationValue) (bool, error) {
 			hal, err := hd.buildOperationHal(va)
 			if err != nil {
@@ -182,7 +251,7 @@ func (hd *Handlers) handleAccountOperationsInGroup(
 		return nil, false, util.NotFoundError.Errorf("operations not found")
 	}
 
-	i, err := hd.buildAccountOperationsHal(address, vas, offset, reverse)
+	i, err := hd.buildAccountOperationsHal(address, of, vas, offset, reverse)
 	if err != nil {
 		return nil, false, err
 	}
@@ -193,6 +262,7 @@ func (hd *Handlers) handleAccountOperationsInGroup(
 
 func (hd *Handlers) buildAccountOperationsHal(
 	address base.Address,
+	of OperationFilter,
 	vas []Hal,
 	offset string,
 	reverse bool,
@@ -201,6 +271,7 @@ func (hd *Handlers) buildAccountOperationsHal(
 	if err != nil {
 		return nil, err
 	}
+	baseSelf = addQueryValue(baseSelf, of.Query())
 
 	self := baseSelf
 	if len(offset) > 0 {
@@ -243,7 +314,54 @@ func (hd *Handlers) buildAccountOperationsHal(
 	return hal, nil
 }
 
+func parseOperationFilter(q url.Values) (OperationFilter, error) {
+	ts := make([]hint.Type, len(q["type"]))
+	for i, s := range q["type"] {
+		ts[i] = hint.Type(strings.TrimSpace(s))
+	}
+
+	cids := make([]currency.CurrencyID, len(q["currency"]))
+	for i, s := range q["currency"] {
+		cids[i] = currency.CurrencyID(strings.TrimSpace(s))
+	}
+
+	heights := [2]base.Height{base.NilHeight, base.NilHeight}
+	for i, k := range []string{"from_height", "to_height"} {
+		s := strings.TrimSpace(q.Get(k))
+		if len(s) < 1 {
+			continue
+		}
+
+		h, err := base.NewHeightFromString(s)
+		switch {
+		case err != nil:
+			return OperationFilter{}, errors.Wrapf(err, "invalid %s, %q", k, s)
+		case h <= base.NilHeight:
+			return OperationFilter{}, errors.Errorf("invalid %s, %q", k, s)
+		}
+		heights[i] = h
+	}
+
+	of := NewOperationFilter(
+		ts, cids,
+		strings.TrimSpace(q.Get("direction")),
+		strings.TrimSpace(q.Get("state")),
+		heights[0], heights[1],
+	)
+	if err := of.IsValid(nil); err != nil {
+		return OperationFilter{}, err
+	}
+
+	return of, nil
+}
+
 func (hd *Handlers) handleAccounts(w http.ResponseWriter, r *http.Request) {
+	if q := r.URL.Query().Get("metadata"); len(q) > 0 {
+		hd.handleAccountsByMetadata(w, r, q)
+
+		return
+	}
+
 	offset := parseOffsetQuery(r.URL.Query().Get("offset"))
 
 	var pub key.Publickey
@@ -431,3 +549,86 @@ func (hd *Handlers) accountsByPublickey(
 
 	return offsetHeight, items, lastaddress, nil
 }
+
+func (hd *Handlers) handleAccountsByMetadata(w http.ResponseWriter, r *http.Request, q string) {
+	mk, mv, err := parseAccountsMetadataQuery(q)
+	if err != nil {
+		HTTP2ProblemWithError(w, fmt.Errorf("invalid accounts query: %w", err), http.StatusBadRequest)
+
+		return
+	}
+
+	offset := parseOffsetQuery(r.URL.Query().Get("offset"))
+
+	cachekey := CacheKey(r.URL.Path, "metadata", q, stringOffsetQuery(offset))
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleAccountsByMetadataInGroup(q, mk, mv, offset)
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, hd.expireNotFilled)
+		}
+	}
+}
+
+func (hd *Handlers) handleAccountsByMetadataInGroup(q, k, v, offset string) ([]byte, error) {
+	limit := hd.itemsLimiter("accounts")
+
+	var vas []Hal
+	var lastAddress string
+	if err := hd.database.AccountsByMetadata(
+		k, v, offset, limit,
+		func(va AccountValue) (bool, error) {
+			hal, err := hd.buildAccountHal(va)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, hal)
+			lastAddress = va.Account().Address().String()
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, err
+	} else if len(vas) < 1 {
+		return nil, util.NotFoundError.Errorf("accounts not found")
+	}
+
+	baseSelf := HandlerPathAccounts + "?" + url.Values{"metadata": []string{q}}.Encode()
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = addQueryValue(baseSelf, stringOffsetQuery(offset))
+	}
+
+	var hal Hal
+	hal = NewBaseHal(vas, NewHalLink(self, nil))
+
+	if int64(len(vas)) == limit {
+		hal = hal.AddLink("next", NewHalLink(addQueryValue(baseSelf, stringOffsetQuery(lastAddress)), nil))
+	}
+
+	return hd.enc.Marshal(hal)
+}
+
+// parseAccountsMetadataQuery parses the metadata query of accounts,
+// "<key>:<value>".
+func parseAccountsMetadataQuery(q string) (string, string, error) {
+	l := strings.SplitN(q, ":", 2)
+	if len(l) != 2 {
+		return "", "", errors.Errorf(`wrong formatted metadata query; "<key>:<value>"`)
+	}
+
+	if err := currency.IsValidAccountMetadata(map[string]string{l[0]: l[1]}); err != nil {
+		return "", "", err
+	}
+
+	return l[0], l[1], nil
+}
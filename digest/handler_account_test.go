@@ -62,6 +62,15 @@ func (t *testHandlerAccount) TestAccount() {
 	t.True(ok)
 
 	t.compareAccountValue(va, uva)
+
+	balance, ok := hal.Extras()["balance"].([]interface{})
+	t.True(ok)
+	t.Equal(1, len(balance))
+
+	fam := balance[0].(map[string]interface{})
+	t.Equal(am.Currency().String(), fam["currency"])
+	t.Equal(am.Big().String(), fam["amount"])
+	t.Equal(am.Big().String(), fam["formatted"])
 }
 
 func (t *testHandlerAccount) TestAccountNotFound() {
@@ -146,6 +155,44 @@ func (t *testHandlerAccount) TestAccountOperations() {
 	t.Equal(int(limit), len(em))
 }
 
+func (t *testHandlerAccount) TestAccountOperationsFilter() {
+	st, _ := t.Database()
+
+	sender := currency.MustAddress(util.UUID().String())
+
+	var outgoing []string
+	for i := 0; i < 6; i++ {
+		var tf currency.Transfers
+		if i%2 == 0 {
+			tf = t.newTransfer(currency.MustAddress(util.UUID().String()), sender)
+		} else {
+			tf = t.newTransfer(sender, currency.MustAddress(util.UUID().String()))
+			outgoing = append(outgoing, tf.Fact().Hash().String())
+		}
+
+		doc, err := NewOperationDoc(tf, t.BSONEnc, base.Height(i), localtime.UTCNow(), true, nil, 0)
+		t.NoError(err)
+		_ = t.insertDoc(st, defaultColNameOperation, doc)
+	}
+
+	handlers := t.handlers(st, DummyCache{})
+	_ = handlers.SetLimiter(func(string) int64 {
+		return 2
+	})
+
+	of := NewOperationFilter(nil, nil, OperationDirectionOutgoing, "", base.NilHeight, base.NilHeight)
+
+	self, err := handlers.router.Get(HandlerPathAccountOperations).URLPath("address", sender.String())
+	t.NoError(err)
+	self.RawQuery = of.Query()
+
+	t.Equal(outgoing, t.getHashes(handlers, 2, self))
+
+	for _, q := range []string{"direction=findme", "state=findme", "from_height=a", "from_height=4&to_height=3", "type=a"} {
+		_, _ = t.request400(handlers, "GET", self.Path+"?"+q, nil)
+	}
+}
+
 func (t *testHandlerAccount) getHashes(handlers *Handlers, limit int, self *url.URL) []string {
 	l := t.getItems(handlers, limit, self, func(b []byte) (interface{}, error) {
 		hinter, err := t.JSONEnc.Decode(b)
@@ -360,6 +407,68 @@ func (t *testHandlerAccount) TestAccountOperationsReverseCache() {
 	}
 }
 
+func (t *testHandlerAccount) TestAccountBalanceHistory() {
+	st, _ := t.Database()
+
+	ac := t.newAccount()
+	height := base.Height(33)
+
+	am0 := currency.MustNewAmount(currency.NewBig(30), t.cid)
+	_, _ = t.insertAccount(st, height, ac, am0)
+
+	stB := t.newBalanceState(ac, height+3, currency.MustNewAmount(currency.NewBig(20), t.cid))
+	doc, err := NewBalanceDoc(stB, t.BSONEnc)
+	t.NoError(err)
+	_ = t.insertDoc(st, defaultColNameBalance, doc)
+
+	handlers := t.handlers(st, DummyCache{})
+
+	self, err := handlers.router.Get(HandlerPathAccountBalanceHistory).URLPath("address", ac.Address().String())
+	t.NoError(err)
+	self.RawQuery = "currency=" + t.cid.String()
+
+	w := t.requestOK(handlers, "GET", self.String(), nil)
+
+	b, err := io.ReadAll(w.Result().Body)
+	t.NoError(err)
+
+	hal := t.loadHal(b)
+	t.Equal(self.String(), hal.Links()["self"].Href())
+
+	var em []BaseHal
+	t.NoError(jsonenc.Unmarshal(hal.RawInterface(), &em))
+	t.Equal(2, len(em))
+
+	hinter, err := t.JSONEnc.Decode(em[1].RawInterface())
+	t.NoError(err)
+	va, ok := hinter.(BalanceHistoryValue)
+	t.True(ok)
+
+	t.Equal(stB.Height(), va.Height())
+	t.Equal(currency.NewBig(-10).String(), va.Delta().String())
+	t.Equal("-10", em[1].Extras()["delta"])
+
+	_, problem := t.request400(handlers, "GET", self.Path, nil)
+	t.Contains(problem.Error(), "invalid currency")
+
+	// NOTE balance as of height
+	bself, err := handlers.router.Get(HandlerPathAccountBalance).URLPath("address", ac.Address().String())
+	t.NoError(err)
+	bself.RawQuery = "height=" + (height + 1).String()
+
+	w = t.requestOK(handlers, "GET", bself.String(), nil)
+
+	b, err = io.ReadAll(w.Result().Body)
+	t.NoError(err)
+
+	hinter, err = t.JSONEnc.Decode(t.loadHal(b).RawInterface())
+	t.NoError(err)
+	bva, ok := hinter.(AccountBalanceValue)
+	t.True(ok)
+	t.Equal(1, len(bva.Balance()))
+	t.compareAmount(am0, bva.Balance()[0])
+}
+
 func (t *testHandlerAccount) TestAccounts() {
 	st, _ := t.Database()
 
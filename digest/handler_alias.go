@@ -0,0 +1,80 @@
+package digest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+func (hd *Handlers) handleAlias(w http.ResponseWriter, r *http.Request) {
+	cachekey := CacheKeyPath(r)
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	name := strings.TrimSpace(mux.Vars(r)["name"])
+	if err := currency.IsValidAliasName(name); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleAliasInGroup(name)
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, time.Second*3)
+		}
+	}
+}
+
+func (hd *Handlers) handleAliasInGroup(name string) ([]byte, error) {
+	switch va, found, err := hd.database.Alias(name); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, util.NotFoundError.Errorf("alias, %q not found", name)
+	default:
+		hal, err := hd.buildAliasHal(va)
+		if err != nil {
+			return nil, err
+		}
+
+		return hd.enc.Marshal(hal)
+	}
+}
+
+func (hd *Handlers) buildAliasHal(va AliasValue) (Hal, error) {
+	al := va.Alias()
+
+	h, err := hd.combineURL(HandlerPathAlias, "name", al.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(va, NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathAccount, "address", al.Address().String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("account", NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathBlockByHeight, "height", va.Height().String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("block", NewHalLink(h, nil))
+
+	return hal, nil
+}
@@ -0,0 +1,123 @@
+package digest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+func (hd *Handlers) handleAccountAllowances(w http.ResponseWriter, r *http.Request) {
+	var address base.Address
+	if a, err := base.DecodeAddressFromString(strings.TrimSpace(mux.Vars(r)["address"]), hd.enc); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else {
+		address = a
+	}
+
+	offset := parseOffsetQuery(r.URL.Query().Get("offset"))
+
+	cachekey := CacheKey(r.URL.Path, stringOffsetQuery(offset))
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleAccountAllowancesInGroup(address, offset)
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, hd.expireNotFilled)
+		}
+	}
+}
+
+func (hd *Handlers) handleAccountAllowancesInGroup(address base.Address, offset string) ([]byte, error) {
+	limit := hd.itemsLimiter("account-allowances")
+
+	var vas []Hal
+	var lastKey string
+	if err := hd.database.AllowancesByAddress(
+		address, offset, limit,
+		func(va AllowanceValue) (bool, error) {
+			hal, err := hd.buildAllowanceHal(va)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, hal)
+
+			al := va.Allowance()
+			lastKey = currency.StateKeyAllowance(al.Owner(), al.Spender(), al.Amount().Currency())
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, err
+	} else if len(vas) < 1 {
+		return nil, util.NotFoundError.Errorf("allowances not found")
+	}
+
+	baseSelf, err := hd.combineURL(HandlerPathAccountAllowances, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = addQueryValue(baseSelf, stringOffsetQuery(offset))
+	}
+
+	var hal Hal
+	hal = NewBaseHal(vas, NewHalLink(self, nil))
+
+	h, err := hd.combineURL(HandlerPathAccount, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("account", NewHalLink(h, nil))
+
+	if int64(len(vas)) == limit {
+		hal = hal.AddLink("next", NewHalLink(addQueryValue(baseSelf, stringOffsetQuery(lastKey)), nil))
+	}
+
+	return hd.enc.Marshal(hal)
+}
+
+func (hd *Handlers) buildAllowanceHal(va AllowanceValue) (Hal, error) {
+	al := va.Allowance()
+
+	var hal Hal
+	hal = NewBaseHal(va, HalLink{})
+
+	for k, a := range map[string]base.Address{
+		"owner":   al.Owner(),
+		"spender": al.Spender(),
+	} {
+		h, err := hd.combineURL(HandlerPathAccount, "address", a.String())
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink(k, NewHalLink(h, nil))
+	}
+
+	h, err := hd.combineURL(HandlerPathBlockByHeight, "height", va.Height().String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("block", NewHalLink(h, nil))
+
+	return hal, nil
+}
@@ -0,0 +1,287 @@
+package digest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+func (hd *Handlers) handleAccountBalance(w http.ResponseWriter, r *http.Request) {
+	var address base.Address
+	if a, err := base.DecodeAddressFromString(strings.TrimSpace(mux.Vars(r)["address"]), hd.enc); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else {
+		address = a
+	}
+
+	height := base.NilHeight
+	if s := strings.TrimSpace(r.URL.Query().Get("height")); len(s) > 0 {
+		h, err := base.NewHeightFromString(s)
+		if err != nil {
+			HTTP2ProblemWithError(w, errors.Wrap(err, "invalid height"), http.StatusBadRequest)
+
+			return
+		} else if h <= base.NilHeight {
+			HTTP2ProblemWithError(w, errors.Errorf("invalid height, %v", h), http.StatusBadRequest)
+
+			return
+		}
+		height = h
+	}
+
+	cachekey := CacheKey(r.URL.Path, "height="+height.String())
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleAccountBalanceInGroup(address, height)
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			expire := time.Second * 2
+			if height > base.NilHeight {
+				expire = time.Hour * 30
+			}
+
+			HTTP2WriteCache(w, cachekey, expire)
+		}
+	}
+}
+
+func (hd *Handlers) handleAccountBalanceInGroup(address base.Address, height base.Height) ([]byte, error) {
+	if height <= base.NilHeight {
+		height = hd.database.LastBlock()
+	}
+
+	switch va, found, err := hd.database.BalanceByHeight(address, height); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, util.NotFoundError.Errorf("balance of account, %s not found at height, %v", address, height)
+	default:
+		hal, err := hd.buildAccountBalanceHal(va)
+		if err != nil {
+			return nil, err
+		}
+
+		return hd.enc.Marshal(hal)
+	}
+}
+
+func (hd *Handlers) buildAccountBalanceHal(va AccountBalanceValue) (Hal, error) {
+	hinted := va.Address().String()
+	h, err := hd.combineURL(HandlerPathAccountBalance, "address", hinted)
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(va, NewHalLink(addQueryValue(h, "height="+va.Height().String()), nil))
+	hal = hal.AddExtras("balance", hd.formatAmounts(va.Balance()))
+
+	h, err = hd.combineURL(HandlerPathAccount, "address", hinted)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("account", NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathBlockByHeight, "height", va.Height().String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("block", NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathAccountBalanceHistory, "address", hinted)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("history:{currency}", NewHalLink(h+"?currency={currency}", nil).SetTemplated())
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleAccountBalanceHistory(w http.ResponseWriter, r *http.Request) {
+	var address base.Address
+	if a, err := base.DecodeAddressFromString(strings.TrimSpace(mux.Vars(r)["address"]), hd.enc); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else {
+		address = a
+	}
+
+	cid := currency.CurrencyID(strings.TrimSpace(r.URL.Query().Get("currency")))
+	if err := cid.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, errors.Wrapf(err, "invalid currency, %q", cid), http.StatusBadRequest)
+
+		return
+	}
+
+	offset := parseOffsetQuery(r.URL.Query().Get("offset"))
+	if len(offset) > 0 {
+		if _, err := base.NewHeightFromString(offset); err != nil {
+			HTTP2ProblemWithError(w, errors.Wrap(err, "invalid offset"), http.StatusBadRequest)
+
+			return
+		}
+	}
+
+	reverse := parseBoolQuery(r.URL.Query().Get("reverse"))
+
+	cachekey := CacheKey(
+		r.URL.Path, "currency="+cid.String(), stringOffsetQuery(offset), stringBoolQuery("reverse", reverse),
+	)
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		i, filled, err := hd.handleAccountBalanceHistoryInGroup(address, cid, offset, reverse)
+
+		return []interface{}{i, filled}, err
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		var b []byte
+		var filled bool
+		{
+			l := v.([]interface{})
+			b = l[0].([]byte)
+			filled = l[1].(bool)
+		}
+
+		HTTP2WriteHalBytes(hd.enc, w, b, http.StatusOK)
+
+		if !shared {
+			expire := hd.expireNotFilled
+			if len(offset) > 0 && filled {
+				expire = time.Hour * 30
+			}
+
+			HTTP2WriteCache(w, cachekey, expire)
+		}
+	}
+}
+
+func (hd *Handlers) handleAccountBalanceHistoryInGroup(
+	address base.Address,
+	cid currency.CurrencyID,
+	offset string,
+	reverse bool,
+) ([]byte, bool, error) {
+	limit := hd.itemsLimiter("account-balance-history")
+
+	var vas []Hal
+	var last BalanceHistoryValue
+	if err := hd.database.BalanceHistory(
+		address, cid, offset, reverse, limit,
+		func(va BalanceHistoryValue) (bool, error) {
+			hal, err := hd.buildBalanceHistoryHal(va)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, hal)
+			last = va
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, false, err
+	} else if len(vas) < 1 {
+		return nil, false, util.NotFoundError.Errorf("balance history not found")
+	}
+
+	baseSelf, err := hd.combineURL(HandlerPathAccountBalanceHistory, "address", address.String())
+	if err != nil {
+		return nil, false, err
+	}
+	baseSelf = addQueryValue(baseSelf, "currency="+cid.String())
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = addQueryValue(self, stringOffsetQuery(offset))
+	}
+	if reverse {
+		self = addQueryValue(self, stringBoolQuery("reverse", reverse))
+	}
+
+	var hal Hal
+	hal = NewBaseHal(vas, NewHalLink(self, nil))
+
+	h, err := hd.combineURL(HandlerPathAccount, "address", address.String())
+	if err != nil {
+		return nil, false, err
+	}
+	hal = hal.AddLink("account", NewHalLink(h, nil))
+
+	next := addQueryValue(baseSelf, stringOffsetQuery(last.Height().String()))
+	if reverse {
+		next = addQueryValue(next, stringBoolQuery("reverse", reverse))
+	}
+	hal = hal.AddLink("next", NewHalLink(next, nil))
+
+	hal = hal.AddLink("reverse", NewHalLink(addQueryValue(baseSelf, stringBoolQuery("reverse", !reverse)), nil))
+
+	b, err := hd.enc.Marshal(hal)
+
+	return b, int64(len(vas)) == limit, err
+}
+
+func (hd *Handlers) buildBalanceHistoryHal(va BalanceHistoryValue) (Hal, error) {
+	var hal Hal
+	hal = NewBaseHal(va, HalLink{})
+
+	var decimals uint
+	if hd.cp != nil {
+		if de, found := hd.cp.Get(va.Amount().Currency()); found {
+			decimals = de.Decimals()
+		}
+	}
+
+	hal = hal.AddExtras("amount", hd.formatAmounts([]currency.Amount{va.Amount()})[0]).
+		AddExtras("delta", va.Delta().DecimalString(decimals))
+
+	h, err := hd.combineURL(HandlerPathAccountBalance, "address", va.Address().String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("balance", NewHalLink(addQueryValue(h, "height="+va.Height().String()), nil))
+
+	h, err = hd.combineURL(HandlerPathBlockByHeight, "height", va.Height().String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("block", NewHalLink(h, nil))
+
+	for i := range va.Operations() {
+		fh := va.Operations()[i].String()
+		h, err := hd.combineURL(HandlerPathOperation, "hash", fh)
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink("operation:"+fh, NewHalLink(h, nil))
+	}
+
+	return hal, nil
+}
@@ -107,6 +107,15 @@ func (hd *Handlers) handleCurrencyInGroup(cid string) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	if hd.database != nil {
+		n, err := hd.database.HoldersCount(de.Currency())
+		if err != nil {
+			return nil, err
+		}
+		i = i.AddExtras("holders", n)
+	}
+
 	return hd.enc.Marshal(i)
 }
 
@@ -118,9 +127,22 @@ func (hd *Handlers) buildCurrency(de currency.CurrencyDesign, st state.State) (H
 
 	var hal Hal
 	hal = NewBaseHal(de, NewHalLink(h, nil))
+	hal = hal.AddExtras("burned", de.Burned())
+	hal = hal.AddExtras("circulating_supply", de.Aggregate())
+	hal = hal.AddExtras("formatted", map[string]string{
+		"amount":             de.FormatBig(de.Big()),
+		"burned":             de.FormatBig(de.Burned()),
+		"circulating_supply": de.FormatBig(de.Aggregate()),
+	})
 
 	hal = hal.AddLink("currency:{currencyid}", NewHalLink(HandlerPathCurrency, nil).SetTemplated())
 
+	h, err = hd.combineURL(HandlerPathCurrencyHolders, "currencyid", de.Currency().String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("holders", NewHalLink(h, nil))
+
 	h, err = hd.combineURL(HandlerPathBlockByHeight, "height", st.Height().String())
 	if err != nil {
 		return nil, err
@@ -137,3 +159,145 @@ func (hd *Handlers) buildCurrency(de currency.CurrencyDesign, st state.State) (H
 
 	return hal, nil
 }
+
+func (hd *Handlers) handleCurrencyHolders(w http.ResponseWriter, r *http.Request) {
+	if hd.cp == nil {
+		HTTP2NotSupported(w, errors.Errorf("empty CurrencyPool"))
+
+		return
+	}
+
+	cid := currency.CurrencyID(strings.TrimSpace(mux.Vars(r)["currencyid"]))
+	if _, found := hd.cp.Get(cid); !found {
+		HTTP2HandleError(w, util.NotFoundError.Errorf("unknown currency id, %q", cid))
+
+		return
+	}
+
+	offset := parseOffsetQuery(r.URL.Query().Get("offset"))
+	if len(offset) > 0 {
+		if _, _, err := parseHoldersOffset(offset); err != nil {
+			HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+			return
+		}
+	}
+
+	cachekey := CacheKey(r.URL.Path, stringOffsetQuery(offset))
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleCurrencyHoldersInGroup(cid, offset)
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, time.Second*3)
+		}
+	}
+}
+
+func (hd *Handlers) handleCurrencyHoldersInGroup(cid currency.CurrencyID, offset string) ([]byte, error) {
+	limit := hd.itemsLimiter("currency-holders")
+
+	var vas []Hal
+	var last HolderValue
+	if err := hd.database.Holders(
+		cid, offset, limit,
+		func(va HolderValue) (bool, error) {
+			hal, err := hd.buildHolderHal(va)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, hal)
+			last = va
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, err
+	} else if len(vas) < 1 {
+		return nil, util.NotFoundError.Errorf("holders not found")
+	}
+
+	baseSelf, err := hd.combineURL(HandlerPathCurrencyHolders, "currencyid", cid.String())
+	if err != nil {
+		return nil, err
+	}
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = addQueryValue(baseSelf, stringOffsetQuery(offset))
+	}
+
+	var hal Hal
+	hal = NewBaseHal(vas, NewHalLink(self, nil))
+
+	h, err := hd.combineURL(HandlerPathCurrency, "currencyid", cid.String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("currency", NewHalLink(h, nil))
+
+	if int64(len(vas)) == limit {
+		next := buildHoldersOffset(last.Amount().Big(), last.Address())
+		hal = hal.AddLink("next", NewHalLink(addQueryValue(baseSelf, stringOffsetQuery(next)), nil))
+	}
+
+	return hd.enc.Marshal(hal)
+}
+
+func (hd *Handlers) buildHolderHal(va HolderValue) (Hal, error) {
+	h, err := hd.combineURL(HandlerPathAccount, "address", va.Address().String())
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(va, NewHalLink(h, nil))
+	hal = hal.AddExtras("amount", hd.formatAmounts([]currency.Amount{va.Amount()})[0])
+
+	h, err = hd.combineURL(HandlerPathBlockByHeight, "height", va.Height().String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("block", NewHalLink(h, nil))
+
+	return hal, nil
+}
+
+// FormattedAmount has the raw amount and the amount formatted by the decimals
+// of currency.
+type FormattedAmount struct {
+	Currency  currency.CurrencyID `json:"currency"`
+	Amount    currency.Big        `json:"amount"`
+	Formatted string              `json:"formatted"`
+	Decimals  uint                `json:"decimals"`
+}
+
+func (hd *Handlers) formatAmounts(ams []currency.Amount) []FormattedAmount {
+	fams := make([]FormattedAmount, len(ams))
+	for i := range ams {
+		am := ams[i]
+
+		var decimals uint
+		if hd.cp != nil {
+			if de, found := hd.cp.Get(am.Currency()); found {
+				decimals = de.Decimals()
+			}
+		}
+
+		fams[i] = FormattedAmount{
+			Currency:  am.Currency(),
+			Amount:    am.Big(),
+			Formatted: am.Big().DecimalString(decimals),
+			Decimals:  decimals,
+		}
+	}
+
+	return fams
+}
@@ -87,6 +87,10 @@ func (t *testHandlerCurrency) TestCurrency() {
 			),
 		)
 
+		var err error
+		de, err = de.SetDecimals(1).Burn(currency.NewBig(3))
+		t.NoError(err)
+
 		st, err := state.NewStateV0(currency.StateKeyCurrencyDesign(de.Currency()), nil, base.Height(33))
 		t.NoError(err)
 
@@ -117,6 +121,82 @@ func (t *testHandlerCurrency) TestCurrency() {
 	t.True(ok)
 
 	t.compareCurrencyDesign(de, ude)
+	t.True(ude.Burned().Equal(currency.NewBig(3)))
+
+	t.Equal("3", hal.Extras()["burned"])
+	t.Equal("30", hal.Extras()["circulating_supply"])
+
+	t.Equal(map[string]interface{}{
+		"amount":             "3.3",
+		"burned":             "0.3",
+		"circulating_supply": "3",
+	}, hal.Extras()["formatted"])
+}
+
+func (t *testHandlerCurrency) TestCurrencyHolders() {
+	cp := currency.NewCurrencyPool()
+	{
+		de := currency.NewCurrencyDesign(
+			currency.MustNewAmount(currency.NewBig(33), t.cid),
+			currency.NewTestAddress(),
+			currency.NewCurrencyPolicy(currency.NewBig(1), currency.NewNilFeeer()),
+		)
+
+		st, err := state.NewStateV0(currency.StateKeyCurrencyDesign(de.Currency()), nil, base.Height(33))
+		t.NoError(err)
+
+		nst, err := currency.SetStateCurrencyDesignValue(st, de)
+		t.NoError(err)
+
+		cp.Set(nst)
+	}
+
+	st, _ := t.Database()
+
+	acs := make([]currency.Account, 3)
+	for i := range acs {
+		acs[i] = t.newAccount()
+		t.insertHolder(st, acs[i], int64(i+1), base.Height(3))
+	}
+
+	handlers := NewHandlers(t.networkID, t.Encs, t.JSONEnc, st, DummyCache{}, cp)
+	t.NoError(handlers.Initialize())
+	_ = handlers.SetLimiter(func(string) int64 {
+		return 2
+	})
+
+	self, err := handlers.router.Get(HandlerPathCurrencyHolders).URLPath("currencyid", t.cid.String())
+	t.NoError(err)
+
+	var addresses []string
+	for _, i := range t.getItems(handlers, 2, self, func(b []byte) (interface{}, error) {
+		hinter, err := t.JSONEnc.Decode(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return hinter.(HolderValue).Address().String(), nil
+	}) {
+		addresses = append(addresses, i.(string))
+	}
+
+	t.Equal([]string{acs[2].Address().String(), acs[1].Address().String(), acs[0].Address().String()}, addresses)
+
+	// NOTE holders count in currency
+	currencyLink, err := handlers.router.Get(HandlerPathCurrency).URLPath("currencyid", t.cid.String())
+	t.NoError(err)
+
+	w := t.requestOK(handlers, "GET", currencyLink.Path, nil)
+
+	b, err := io.ReadAll(w.Result().Body)
+	t.NoError(err)
+
+	hal := t.loadHal(b)
+	t.Equal(float64(3), hal.Extras()["holders"])
+	t.Equal(self.Path, hal.Links()["holders"].Href())
+
+	_, _ = t.request400(handlers, "GET", self.Path+"?offset=a", nil)
+	_ = t.request404(handlers, "GET", "/currency/FINDME/holders", nil)
 }
 
 func TestHandlerCurrency(t *testing.T) {
@@ -0,0 +1,176 @@
+package digest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (hd *Handlers) handleEscrow(w http.ResponseWriter, r *http.Request) {
+	cachekey := CacheKeyPath(r)
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	h, err := parseHashFromPath(mux.Vars(r)["hash"])
+	if err != nil {
+		HTTP2ProblemWithError(w, errors.Wrap(err, "invalid hash for escrow by hash"), http.StatusBadRequest)
+
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleEscrowInGroup(h)
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, time.Second*3)
+		}
+	}
+}
+
+func (hd *Handlers) handleEscrowInGroup(h valuehash.Hash) ([]byte, error) {
+	switch va, found, err := hd.database.Escrow(h); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, util.NotFoundError.Errorf("escrow not found")
+	default:
+		hal, err := hd.buildEscrowHal(va)
+		if err != nil {
+			return nil, err
+		}
+
+		return hd.enc.Marshal(hal)
+	}
+}
+
+func (hd *Handlers) buildEscrowHal(va EscrowValue) (Hal, error) {
+	es := va.Escrow()
+
+	h, err := hd.combineURL(HandlerPathEscrow, "hash", es.ID().String())
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(va, NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathOperation, "hash", es.ID().String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("operation", NewHalLink(h, nil))
+
+	for k, a := range map[string]base.Address{
+		"sender":      es.Sender(),
+		"beneficiary": es.Beneficiary(),
+		"arbiter":     es.Arbiter(),
+	} {
+		h, err = hd.combineURL(HandlerPathAccount, "address", a.String())
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink(k, NewHalLink(h, nil))
+	}
+
+	h, err = hd.combineURL(HandlerPathBlockByHeight, "height", va.Height().String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("block", NewHalLink(h, nil))
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleAccountEscrows(w http.ResponseWriter, r *http.Request) {
+	var address base.Address
+	if a, err := base.DecodeAddressFromString(strings.TrimSpace(mux.Vars(r)["address"]), hd.enc); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else {
+		address = a
+	}
+
+	offset := parseOffsetQuery(r.URL.Query().Get("offset"))
+
+	cachekey := CacheKey(r.URL.Path, stringOffsetQuery(offset))
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleAccountEscrowsInGroup(address, offset)
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, hd.expireNotFilled)
+		}
+	}
+}
+
+func (hd *Handlers) handleAccountEscrowsInGroup(address base.Address, offset string) ([]byte, error) {
+	limit := hd.itemsLimiter("account-escrows")
+
+	var vas []Hal
+	var lastID string
+	if err := hd.database.EscrowsByAddress(
+		address, offset, limit,
+		func(va EscrowValue) (bool, error) {
+			hal, err := hd.buildEscrowHal(va)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, hal)
+			lastID = va.Escrow().ID().String()
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, err
+	} else if len(vas) < 1 {
+		return nil, util.NotFoundError.Errorf("escrows not found")
+	}
+
+	baseSelf, err := hd.combineURL(HandlerPathAccountEscrows, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = addQueryValue(baseSelf, stringOffsetQuery(offset))
+	}
+
+	var hal Hal
+	hal = NewBaseHal(vas, NewHalLink(self, nil))
+
+	h, err := hd.combineURL(HandlerPathAccount, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("account", NewHalLink(h, nil))
+
+	if int64(len(vas)) == limit {
+		hal = hal.AddLink("next", NewHalLink(addQueryValue(baseSelf, stringOffsetQuery(lastID)), nil))
+	}
+
+	return hd.enc.Marshal(hal)
+}
@@ -0,0 +1,175 @@
+package digest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/valuehash"
+)
+
+func (hd *Handlers) handleHTLC(w http.ResponseWriter, r *http.Request) {
+	cachekey := CacheKeyPath(r)
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	h, err := parseHashFromPath(mux.Vars(r)["hash"])
+	if err != nil {
+		HTTP2ProblemWithError(w, errors.Wrap(err, "invalid hash for htlc by hash"), http.StatusBadRequest)
+
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleHTLCInGroup(h)
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, time.Second*3)
+		}
+	}
+}
+
+func (hd *Handlers) handleHTLCInGroup(h valuehash.Hash) ([]byte, error) {
+	switch va, found, err := hd.database.HTLC(h); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, util.NotFoundError.Errorf("htlc not found")
+	default:
+		hal, err := hd.buildHTLCHal(va)
+		if err != nil {
+			return nil, err
+		}
+
+		return hd.enc.Marshal(hal)
+	}
+}
+
+func (hd *Handlers) buildHTLCHal(va HTLCValue) (Hal, error) {
+	hl := va.HTLC()
+
+	h, err := hd.combineURL(HandlerPathHTLC, "hash", hl.ID().String())
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(va, NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathOperation, "hash", hl.ID().String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("operation", NewHalLink(h, nil))
+
+	for k, a := range map[string]base.Address{
+		"sender":   hl.Sender(),
+		"receiver": hl.Receiver(),
+	} {
+		h, err = hd.combineURL(HandlerPathAccount, "address", a.String())
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink(k, NewHalLink(h, nil))
+	}
+
+	h, err = hd.combineURL(HandlerPathBlockByHeight, "height", va.Height().String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("block", NewHalLink(h, nil))
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleAccountHTLCs(w http.ResponseWriter, r *http.Request) {
+	var address base.Address
+	if a, err := base.DecodeAddressFromString(strings.TrimSpace(mux.Vars(r)["address"]), hd.enc); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else {
+		address = a
+	}
+
+	offset := parseOffsetQuery(r.URL.Query().Get("offset"))
+
+	cachekey := CacheKey(r.URL.Path, stringOffsetQuery(offset))
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleAccountHTLCsInGroup(address, offset)
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, hd.expireNotFilled)
+		}
+	}
+}
+
+func (hd *Handlers) handleAccountHTLCsInGroup(address base.Address, offset string) ([]byte, error) {
+	limit := hd.itemsLimiter("account-htlcs")
+
+	var vas []Hal
+	var lastID string
+	if err := hd.database.HTLCsByAddress(
+		address, offset, limit,
+		func(va HTLCValue) (bool, error) {
+			hal, err := hd.buildHTLCHal(va)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, hal)
+			lastID = va.HTLC().ID().String()
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, err
+	} else if len(vas) < 1 {
+		return nil, util.NotFoundError.Errorf("htlcs not found")
+	}
+
+	baseSelf, err := hd.combineURL(HandlerPathAccountHTLCs, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = addQueryValue(baseSelf, stringOffsetQuery(offset))
+	}
+
+	var hal Hal
+	hal = NewBaseHal(vas, NewHalLink(self, nil))
+
+	h, err := hd.combineURL(HandlerPathAccount, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("account", NewHalLink(h, nil))
+
+	if int64(len(vas)) == limit {
+		hal = hal.AddLink("next", NewHalLink(addQueryValue(baseSelf, stringOffsetQuery(lastID)), nil))
+	}
+
+	return hd.enc.Marshal(hal)
+}
@@ -51,7 +51,10 @@ func (hd *Handlers) handleOperationBuild(w http.ResponseWriter, r *http.Request)
 }
 
 func (hd *Handlers) handleOperationBuildFactTemplate(w http.ResponseWriter, r *http.Request) {
-	if err := LoadFromCache(hd.cache, CacheKeyPath(r), w); err == nil {
+	feeerType := r.URL.Query().Get("feeer")
+
+	cachekey := CacheKey(CacheKeyPath(r), feeerType)
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
 		return
 	}
 
@@ -64,7 +67,7 @@ func (hd *Handlers) handleOperationBuildFactTemplate(w http.ResponseWriter, r *h
 	}
 
 	builder := NewBuilder(hd.enc, hd.networkID)
-	hal, err := builder.FactTemplate(hinter.Hint())
+	hal, err := builder.FactTemplateWithFeeer(hinter.Hint(), feeerType)
 	if err != nil {
 		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
 
@@ -80,7 +83,7 @@ func (hd *Handlers) handleOperationBuildFactTemplate(w http.ResponseWriter, r *h
 	hal = hal.SetSelf(NewHalLink(h, nil))
 
 	HTTP2WriteHal(hd.enc, w, hal, http.StatusOK)
-	HTTP2WriteCache(w, CacheKeyPath(r), time.Hour*100*100*100)
+	HTTP2WriteCache(w, cachekey, time.Hour*100*100*100)
 }
 
 func (hd *Handlers) handleOperationBuildFact(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,216 @@
+package digest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/hint"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+var (
+	StreamMimetype        = "text/event-stream; charset=utf-8"
+	streamPingInterval    = time.Second * 15
+	maxStreamReplayBlocks = base.Height(100)
+)
+
+func (hd *Handlers) SetStreamer(sr *Streamer) *Handlers {
+	hd.streamer = sr
+
+	return hd
+}
+
+// handleStream pushes the digested blocks and their operations by
+// Server-Sent Events. Each event id is the block height; when the client
+// reconnects with the "Last-Event-ID" header or the "last" query, the missed
+// blocks are replayed from the digest database, up to maxStreamReplayBlocks.
+func (hd *Handlers) handleStream(w http.ResponseWriter, r *http.Request) {
+	if hd.streamer == nil {
+		HTTP2NotSupported(w, nil)
+
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		HTTP2NotSupported(w, errors.Errorf("streaming not supported"))
+
+		return
+	}
+
+	filter, err := hd.parseStreamFilter(r.URL.Query())
+	if err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	last, err := parseStreamLastHeight(r)
+	if err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	sub := hd.streamer.Subscribe(filter)
+	defer hd.streamer.Unsubscribe(sub)
+
+	w.Header().Set("Content-Type", StreamMimetype)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	// NOTE the server WriteTimeout is for the normal requests; the stream
+	// connection should be kept until the client closes it.
+	if err := clearWriteDeadline(w); err != nil {
+		hd.Log().Debug().Err(err).Msg("failed to clear write deadline of stream")
+	}
+
+	if last > base.NilHeight {
+		i, err := hd.replayStream(w, filter, last)
+		if err != nil {
+			hd.Log().Error().Err(err).Int64("last", last.Int64()).Msg("failed to replay stream")
+
+			return
+		}
+		last = i
+		flusher.Flush()
+	}
+
+	ticker := time.NewTicker(streamPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		case sb, notclosed := <-sub.Blocks():
+			if !notclosed {
+				return
+			}
+
+			if sb.Height() <= last { // NOTE already sent by replay
+				continue
+			}
+
+			if err := hd.writeStreamBlock(w, sb); err != nil {
+				hd.Log().Debug().Err(err).Msg("failed to write stream block")
+
+				return
+			}
+			last = sb.Height()
+		}
+
+		flusher.Flush()
+	}
+}
+
+func (hd *Handlers) replayStream(w http.ResponseWriter, filter StreamFilter, last base.Height) (base.Height, error) {
+	top := hd.database.LastBlock()
+	if top-last > maxStreamReplayBlocks {
+		last = top - maxStreamReplayBlocks
+	}
+
+	for height := last + 1; height <= top; height++ {
+		sb, err := loadStreamBlock(hd.database, height, filter)
+		if err != nil {
+			return last, err
+		}
+
+		if err := hd.writeStreamBlock(w, sb); err != nil {
+			return last, err
+		}
+		last = height
+	}
+
+	return last, nil
+}
+
+func (hd *Handlers) writeStreamBlock(w http.ResponseWriter, sb StreamBlock) error {
+	b, err := hd.enc.Marshal(sb)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", sb.Height().String(), "block", b)
+
+	return err
+}
+
+func (hd *Handlers) parseStreamFilter(q url.Values) (StreamFilter, error) {
+	as := make([]base.Address, len(q["address"]))
+	for i, s := range q["address"] {
+		a, err := base.DecodeAddressFromString(strings.TrimSpace(s), hd.enc)
+		if err != nil {
+			return StreamFilter{}, errors.Wrapf(err, "invalid address, %q", s)
+		} else if err := a.IsValid(nil); err != nil {
+			return StreamFilter{}, errors.Wrapf(err, "invalid address, %q", s)
+		}
+		as[i] = a
+	}
+
+	ts := make([]hint.Type, len(q["type"]))
+	for i, s := range q["type"] {
+		t := hint.Type(strings.TrimSpace(s))
+		if err := t.IsValid(nil); err != nil {
+			return StreamFilter{}, errors.Wrapf(err, "invalid operation type, %q", s)
+		}
+		ts[i] = t
+	}
+
+	cids := make([]currency.CurrencyID, len(q["currency"]))
+	for i, s := range q["currency"] {
+		cid := currency.CurrencyID(strings.TrimSpace(s))
+		if err := cid.IsValid(nil); err != nil {
+			return StreamFilter{}, errors.Wrapf(err, "invalid currency, %q", s)
+		}
+		cids[i] = cid
+	}
+
+	return NewStreamFilter(as, ts, cids), nil
+}
+
+// clearWriteDeadline removes the write deadline of the underlying connection
+// like http.ResponseController.SetWriteDeadline; the wrapped
+// http.ResponseWriter is unwrapped until it supports SetWriteDeadline.
+func clearWriteDeadline(w http.ResponseWriter) error {
+	for {
+		switch t := w.(type) {
+		case interface{ SetWriteDeadline(time.Time) error }:
+			return t.SetWriteDeadline(time.Time{})
+		case interface{ Unwrap() http.ResponseWriter }:
+			w = t.Unwrap()
+		default:
+			return errors.Errorf("write deadline not supported, %T", w)
+		}
+	}
+}
+
+func parseStreamLastHeight(r *http.Request) (base.Height, error) {
+	s := strings.TrimSpace(r.Header.Get("Last-Event-ID"))
+	if len(s) < 1 {
+		s = strings.TrimSpace(r.URL.Query().Get("last"))
+	}
+
+	if len(s) < 1 {
+		return base.NilHeight, nil
+	}
+
+	h, err := parseHeightFromPath(s)
+	if err != nil {
+		return base.NilHeight, errors.Wrap(err, "invalid last height")
+	}
+
+	return h, nil
+}
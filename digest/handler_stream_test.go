@@ -0,0 +1,325 @@
+//go:build mongodb
+// +build mongodb
+
+package digest
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/key"
+	"github.com/spikeekips/mitum/base/operation"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/localtime"
+	"github.com/spikeekips/mitum/util/valuehash"
+	"github.com/stretchr/testify/suite"
+)
+
+type testHandlerStream struct {
+	baseTestHandlers
+}
+
+func (t *testHandlerStream) newOperationValue(sender base.Address, height base.Height) OperationValue {
+	tf := t.newTransfer(sender, currency.MustAddress(util.UUID().String()))
+
+	return NewOperationValue(tf, height, localtime.UTCNow(), true, nil, 0)
+}
+
+// stream requests the stream path and publishes the given blocks after
+// subscribed. The body is returned after all the published blocks are sent.
+func (t *testHandlerStream) stream(handlers *Handlers, path string, sbs ...StreamBlock) *httptest.ResponseRecorder {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r, err := http.NewRequestWithContext(ctx, "GET", "http://localhost"+path, nil)
+	t.NoError(err)
+
+	w := httptest.NewRecorder()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		handlers.Handler().ServeHTTP(w, r)
+	}()
+
+	sr := handlers.streamer
+	for i := 0; i < 100 && sr.Len() < 1; i++ {
+		<-time.After(time.Millisecond * 10)
+	}
+	t.Equal(1, sr.Len())
+
+	for i := range sbs {
+		sr.Publish(sbs[i])
+	}
+
+	// NOTE closed subscription still delivers the buffered blocks
+	sr.Lock()
+	for sub := range sr.subs {
+		delete(sr.subs, sub)
+		sub.close()
+	}
+	sr.Unlock()
+
+	select {
+	case <-time.After(time.Second * 3):
+		t.Fail("stream not finished")
+	case <-done:
+	}
+
+	return w
+}
+
+func (t *testHandlerStream) TestNotSupported() {
+	st, _ := t.Database()
+
+	handlers := t.handlers(st, DummyCache{})
+
+	_, _ = t.request500(handlers, "GET", HandlerPathStream, nil)
+}
+
+func (t *testHandlerStream) TestStream() {
+	st, _ := t.Database()
+
+	handlers := t.handlers(st, DummyCache{})
+	_ = handlers.SetStreamer(NewStreamer())
+
+	sender := currency.MustAddress(util.UUID().String())
+
+	var sbs []StreamBlock
+	for i := int64(3); i < 5; i++ {
+		height := base.Height(i)
+		blk := t.newBlock(height, t.MongodbDatabase())
+		sbs = append(sbs, NewStreamBlock(blk.Manifest(), []OperationValue{t.newOperationValue(sender, height)}))
+	}
+
+	w := t.stream(handlers, HandlerPathStream, sbs...)
+
+	t.Equal(http.StatusOK, w.Result().StatusCode)
+	t.Equal(StreamMimetype, w.Result().Header.Get("content-type"))
+
+	body := w.Body.String()
+	for i := range sbs {
+		sb := sbs[i]
+		t.Contains(body, "id: "+sb.Height().String()+"\nevent: block\n")
+		t.Contains(body, sb.Manifest().Hash().String())
+		t.Contains(body, sb.Operations()[0].Operation().Fact().Hash().String())
+	}
+}
+
+func (t *testHandlerStream) TestStreamFilter() {
+	st, _ := t.Database()
+
+	handlers := t.handlers(st, DummyCache{})
+	_ = handlers.SetStreamer(NewStreamer())
+
+	sender := currency.MustAddress(util.UUID().String())
+	other := currency.MustAddress(util.UUID().String())
+
+	height := base.Height(3)
+	blk := t.newBlock(height, t.MongodbDatabase())
+	matched := t.newOperationValue(sender, height)
+	unmatched := t.newOperationValue(other, height)
+
+	query := url.Values{}
+	query.Add("address", sender.String())
+	query.Add("type", currency.TransfersType.String())
+
+	w := t.stream(handlers, HandlerPathStream+"?"+query.Encode(),
+		NewStreamBlock(blk.Manifest(), []OperationValue{matched, unmatched}))
+
+	t.Equal(http.StatusOK, w.Result().StatusCode)
+
+	body := w.Body.String()
+	t.Contains(body, "id: "+height.String()+"\n")
+	t.Contains(body, matched.Operation().Fact().Hash().String())
+	t.NotContains(body, unmatched.Operation().Fact().Hash().String())
+}
+
+func (t *testHandlerStream) TestStreamOverWriteTimeout() {
+	st, _ := t.Database()
+
+	handlers := t.handlers(st, DummyCache{})
+	_ = handlers.SetStreamer(NewStreamer())
+
+	ts := httptest.NewUnstartedServer(handlers.Handler())
+	ts.Config.WriteTimeout = time.Millisecond * 300
+	ts.Start()
+	defer ts.Close()
+
+	res, err := ts.Client().Get(ts.URL + HandlerPathStream)
+	t.NoError(err)
+	defer res.Body.Close()
+
+	t.Equal(http.StatusOK, res.StatusCode)
+
+	sr := handlers.streamer
+	for i := 0; i < 100 && sr.Len() < 1; i++ {
+		<-time.After(time.Millisecond * 10)
+	}
+	t.Equal(1, sr.Len())
+
+	<-time.After(ts.Config.WriteTimeout * 2)
+
+	blk := t.newBlock(base.Height(3), t.MongodbDatabase())
+	sr.Publish(NewStreamBlock(blk.Manifest(), nil))
+
+	found := make(chan error, 1)
+	go func() {
+		br := bufio.NewReader(res.Body)
+		for {
+			l, err := br.ReadString('\n')
+			if err != nil {
+				found <- err
+
+				return
+			}
+
+			if l == "id: "+blk.Height().String()+"\n" {
+				found <- nil
+
+				return
+			}
+		}
+	}()
+
+	select {
+	case <-time.After(time.Second * 3):
+		t.Fail("block not streamed")
+	case err := <-found:
+		t.NoError(err)
+	}
+}
+
+func (t *testHandlerStream) TestStreamBadFilter() {
+	st, _ := t.Database()
+
+	handlers := t.handlers(st, DummyCache{})
+	_ = handlers.SetStreamer(NewStreamer())
+
+	_, problem := t.request400(handlers, "GET", HandlerPathStream+"?currency=a", nil)
+	t.Contains(problem.Error(), "invalid currency")
+}
+
+func (t *testHandlerStream) TestFilterMatch() {
+	sender := currency.MustAddress(util.UUID().String())
+	va := t.newOperationValue(sender, base.Height(3))
+
+	cases := []struct {
+		name    string
+		filter  StreamFilter
+		matched bool
+	}{
+		{name: "empty", filter: NewStreamFilter(nil, nil, nil), matched: true},
+		{name: "address", filter: NewStreamFilter([]base.Address{sender}, nil, nil), matched: true},
+		{
+			name:    "unknown address",
+			filter:  NewStreamFilter([]base.Address{currency.MustAddress(util.UUID().String())}, nil, nil),
+			matched: false,
+		},
+		{name: "type", filter: NewStreamFilter(nil, []hint.Type{currency.TransfersType}, nil), matched: true},
+		{name: "other type", filter: NewStreamFilter(nil, []hint.Type{currency.CreateAccountsType}, nil), matched: false},
+		{name: "currency", filter: NewStreamFilter(nil, nil, []currency.CurrencyID{t.cid}), matched: true},
+		{name: "other currency", filter: NewStreamFilter(nil, nil, []currency.CurrencyID{"FINDME"}), matched: false},
+		{
+			name: "address and other currency",
+			filter: NewStreamFilter(
+				[]base.Address{sender}, nil, []currency.CurrencyID{"FINDME"}),
+			matched: false,
+		},
+		{
+			name: "one of currencies",
+			filter: NewStreamFilter(
+				[]base.Address{sender}, nil, []currency.CurrencyID{"FINDME", t.cid}),
+			matched: true,
+		},
+	}
+
+	for i, c := range cases {
+		i := i
+		c := c
+		t.Run(c.name, func() {
+			t.Equal(c.matched, c.filter.Match(va), "%d: %v", i, c.name)
+		})
+	}
+}
+
+func (t *testHandlerStream) TestFilterMatchEscrowSettled() {
+	sender := currency.MustAddress(util.UUID().String())
+	beneficiary := currency.MustAddress(util.UUID().String())
+	arbiter := currency.MustAddress(util.UUID().String())
+
+	es := currency.NewEscrow(
+		valuehash.RandomSHA256(), sender, beneficiary, arbiter, currency.MustNewAmount(currency.NewBig(10), t.cid), base.Height(33))
+	est := t.newStateFromValue(currency.StateKeyEscrow(es.ID()), es, base.Height(3))
+	rs := newBlockAccountResolver(nil, []state.State{est})
+
+	newValue := func(fact base.Fact) OperationValue {
+		pk := key.NewBasePrivatekey()
+		sig, err := base.NewFactSignature(pk, fact, t.networkID)
+		t.NoError(err)
+		fs := []base.FactSign{base.NewBaseFactSign(pk.Publickey(), sig)}
+
+		var op operation.Operation
+		switch f := fact.(type) {
+		case currency.EscrowReleaseFact:
+			op, err = currency.NewEscrowRelease(f, fs, "")
+		case currency.EscrowRefundFact:
+			op, err = currency.NewEscrowRefund(f, fs, "")
+		}
+		t.NoError(err)
+
+		doc, err := newOperationDoc(NewOperationValue(op, base.Height(3), localtime.UTCNow(), true, nil, 0), t.BSONEnc, rs)
+		t.NoError(err)
+
+		return doc.va
+	}
+
+	// NOTE the escrow is settled by arbiter; fact carries only arbiter
+	release := newValue(currency.NewEscrowReleaseFact(util.UUID().Bytes(), arbiter, es.ID()))
+	refund := newValue(currency.NewEscrowRefundFact(util.UUID().Bytes(), arbiter, es.ID()))
+
+	t.True(NewStreamFilter([]base.Address{beneficiary}, nil, nil).Match(release))
+	t.False(NewStreamFilter([]base.Address{sender}, nil, nil).Match(release))
+	t.True(NewStreamFilter([]base.Address{sender}, nil, nil).Match(refund))
+	t.False(NewStreamFilter([]base.Address{beneficiary}, nil, nil).Match(refund))
+
+	t.Equal(
+		[]string{arbiter.String(), beneficiary.String(), sender.String()},
+		streamBlockAddresses(NewStreamBlock(nil, []OperationValue{release, refund})),
+	)
+}
+
+func (t *testHandlerStream) TestSlowSubscription() {
+	sr := NewStreamer()
+	sr.bufsize = 1
+
+	sub := sr.Subscribe(NewStreamFilter(nil, nil, nil))
+	t.Equal(1, sr.Len())
+
+	blk := t.newBlock(base.Height(3), t.MongodbDatabase())
+	sr.Publish(NewStreamBlock(blk.Manifest(), nil))
+	sr.Publish(NewStreamBlock(blk.Manifest(), nil))
+
+	t.Equal(0, sr.Len())
+
+	sb, notclosed := <-sub.Blocks()
+	t.True(notclosed)
+	t.Equal(blk.Height(), sb.Height())
+
+	_, notclosed = <-sub.Blocks()
+	t.False(notclosed)
+}
+
+func TestHandlerStream(t *testing.T) {
+	suite.Run(t, new(testHandlerStream))
+}
@@ -0,0 +1,336 @@
+package digest
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/localtime"
+)
+
+// MaxWebhookRequestSize limits the size of WebhookRequest body.
+var MaxWebhookRequestSize int64 = 1 << 16
+
+// WebhookRequest is the request body to register Webhook.
+type WebhookRequest struct {
+	URL       string   `json:"url"`
+	Addresses []string `json:"addresses"`
+	Secret    string   `json:"secret"`
+}
+
+// SetWebhookToken sets the bearer token for the webhook handlers. Without
+// token, the webhook handlers are not supported.
+func (hd *Handlers) SetWebhookToken(token string) *Handlers {
+	hd.webhookToken = token
+
+	return hd
+}
+
+func (hd *Handlers) handleWebhooks(w http.ResponseWriter, r *http.Request) {
+	if !hd.checkWebhookAuth(w, r) {
+		return
+	}
+
+	if r.Method == http.MethodPost {
+		hd.handleNewWebhook(w, r)
+
+		return
+	}
+
+	offset := parseOffsetQuery(r.URL.Query().Get("offset"))
+
+	hal, err := hd.buildWebhooksHal(offset)
+	if err != nil {
+		HTTP2HandleError(w, err)
+
+		return
+	}
+
+	HTTP2WriteHal(hd.enc, w, hal, http.StatusOK)
+}
+
+func (hd *Handlers) handleNewWebhook(w http.ResponseWriter, r *http.Request) {
+	body := &bytes.Buffer{}
+	if _, err := io.Copy(body, http.MaxBytesReader(w, r.Body, MaxWebhookRequestSize)); err != nil {
+		if int64(body.Len()) >= MaxWebhookRequestSize {
+			HTTP2ProblemWithError(w, errors.Errorf("too large request body"), http.StatusRequestEntityTooLarge)
+
+			return
+		}
+
+		HTTP2ProblemWithError(w, err, http.StatusInternalServerError)
+
+		return
+	}
+
+	var req WebhookRequest
+	if err := jsonenc.Unmarshal(body.Bytes(), &req); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	as := make([]base.Address, len(req.Addresses))
+	for i := range req.Addresses {
+		a, err := base.DecodeAddressFromString(strings.TrimSpace(req.Addresses[i]), hd.enc)
+		if err != nil {
+			HTTP2ProblemWithError(w, errors.Wrapf(err, "invalid address, %q", req.Addresses[i]), http.StatusBadRequest)
+
+			return
+		}
+		as[i] = a
+	}
+
+	wh := NewWebhook(util.UUID().String(), strings.TrimSpace(req.URL), as, req.Secret, localtime.UTCNow())
+	if err := wh.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	if err := hd.database.AddWebhook(wh); err != nil {
+		HTTP2HandleError(w, err)
+
+		return
+	}
+
+	hal, err := hd.buildWebhookHal(wh)
+	if err != nil {
+		HTTP2HandleError(w, err)
+
+		return
+	}
+
+	HTTP2WriteHal(hd.enc, w, hal, http.StatusCreated)
+}
+
+func (hd *Handlers) handleWebhook(w http.ResponseWriter, r *http.Request) {
+	if !hd.checkWebhookAuth(w, r) {
+		return
+	}
+
+	id := strings.TrimSpace(mux.Vars(r)["id"])
+
+	if r.Method == http.MethodDelete {
+		switch removed, err := hd.database.RemoveWebhook(id); {
+		case err != nil:
+			HTTP2HandleError(w, err)
+		case !removed:
+			HTTP2HandleError(w, util.NotFoundError.Errorf("webhook not found"))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+
+		return
+	}
+
+	switch wh, found, err := hd.database.Webhook(id); {
+	case err != nil:
+		HTTP2HandleError(w, err)
+	case !found:
+		HTTP2HandleError(w, util.NotFoundError.Errorf("webhook not found"))
+	default:
+		hal, err := hd.buildWebhookHal(wh)
+		if err != nil {
+			HTTP2HandleError(w, err)
+
+			return
+		}
+
+		HTTP2WriteHal(hd.enc, w, hal, http.StatusOK)
+	}
+}
+
+func (hd *Handlers) handleWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	if !hd.checkWebhookAuth(w, r) {
+		return
+	}
+
+	id := strings.TrimSpace(mux.Vars(r)["id"])
+
+	offset := parseOffsetQuery(r.URL.Query().Get("offset"))
+	if len(offset) > 0 {
+		if _, _, err := parseOffsetByString(offset); err != nil {
+			HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+			return
+		}
+	}
+
+	switch _, found, err := hd.database.Webhook(id); {
+	case err != nil:
+		HTTP2HandleError(w, err)
+
+		return
+	case !found:
+		HTTP2HandleError(w, util.NotFoundError.Errorf("webhook not found"))
+
+		return
+	}
+
+	hal, err := hd.buildWebhookDeliveriesHal(id, offset)
+	if err != nil {
+		HTTP2HandleError(w, err)
+
+		return
+	}
+
+	HTTP2WriteHal(hd.enc, w, hal, http.StatusOK)
+}
+
+// checkWebhookAuth checks the bearer token of "Authorization" header.
+func (hd *Handlers) checkWebhookAuth(w http.ResponseWriter, r *http.Request) bool {
+	if len(hd.webhookToken) < 1 {
+		HTTP2NotSupported(w, nil)
+
+		return false
+	}
+
+	s := r.Header.Get("Authorization")
+	if !strings.HasPrefix(s, "Bearer ") ||
+		subtle.ConstantTimeCompare([]byte(strings.TrimSpace(s[7:])), []byte(hd.webhookToken)) != 1 {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		HTTP2ProblemWithError(w, errors.Errorf("unauthorized"), http.StatusUnauthorized)
+
+		return false
+	}
+
+	return true
+}
+
+func (hd *Handlers) buildWebhookHal(wh Webhook) (Hal, error) {
+	h, err := hd.combineURL(HandlerPathWebhook, "id", wh.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(wh, NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathWebhookDeliveries, "id", wh.ID())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("deliveries", NewHalLink(h, nil))
+
+	return hal, nil
+}
+
+func (hd *Handlers) buildWebhooksHal(offset string) (Hal, error) {
+	limit := hd.itemsLimiter("webhooks")
+
+	var vas []Hal
+	var lastID string
+	if err := hd.database.Webhooks(
+		offset, limit,
+		func(wh Webhook) (bool, error) {
+			hal, err := hd.buildWebhookHal(wh)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, hal)
+			lastID = wh.ID()
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, err
+	} else if len(vas) < 1 {
+		return nil, util.NotFoundError.Errorf("webhooks not found")
+	}
+
+	baseSelf, err := hd.combineURL(HandlerPathWebhooks)
+	if err != nil {
+		return nil, err
+	}
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = addQueryValue(baseSelf, stringOffsetQuery(offset))
+	}
+
+	var hal Hal
+	hal = NewBaseHal(vas, NewHalLink(self, nil))
+
+	if int64(len(vas)) == limit {
+		hal = hal.AddLink("next", NewHalLink(addQueryValue(baseSelf, stringOffsetQuery(lastID)), nil))
+	}
+
+	return hal, nil
+}
+
+func (hd *Handlers) buildWebhookDeliveryHal(dl WebhookDelivery) (Hal, error) {
+	var hal Hal
+	hal = NewBaseHal(dl, HalLink{})
+
+	h, err := hd.combineURL(HandlerPathWebhook, "id", dl.Webhook())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("webhook", NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathBlockByHeight, "height", dl.Height().String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("block", NewHalLink(h, nil))
+
+	return hal, nil
+}
+
+func (hd *Handlers) buildWebhookDeliveriesHal(id, offset string) (Hal, error) {
+	limit := hd.itemsLimiter("webhook-deliveries")
+
+	var vas []Hal
+	var last WebhookDelivery
+	if err := hd.database.WebhookDeliveries(
+		id, offset, limit,
+		func(dl WebhookDelivery) (bool, error) {
+			hal, err := hd.buildWebhookDeliveryHal(dl)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, hal)
+			last = dl
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, err
+	} else if len(vas) < 1 {
+		return nil, util.NotFoundError.Errorf("webhook deliveries not found")
+	}
+
+	baseSelf, err := hd.combineURL(HandlerPathWebhookDeliveries, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = addQueryValue(baseSelf, stringOffsetQuery(offset))
+	}
+
+	var hal Hal
+	hal = NewBaseHal(vas, NewHalLink(self, nil))
+
+	h, err := hd.combineURL(HandlerPathWebhook, "id", id)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("webhook", NewHalLink(h, nil))
+
+	if int64(len(vas)) == limit {
+		next := buildOffsetByString(last.Height(), last.ID())
+		hal = hal.AddLink("next", NewHalLink(addQueryValue(baseSelf, stringOffsetQuery(next)), nil))
+	}
+
+	return hal, nil
+}
@@ -0,0 +1,328 @@
+//go:build mongodb
+// +build mongodb
+
+package digest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/localtime"
+	"github.com/stretchr/testify/suite"
+)
+
+type testHandlerWebhook struct {
+	baseTestHandlers
+	token string
+}
+
+func (t *testHandlerWebhook) SetupTest() {
+	t.token = util.UUID().String()
+}
+
+func (t *testHandlerWebhook) requestAuth(
+	handlers *Handlers, method, path string, data []byte, token string,
+) *httptest.ResponseRecorder {
+	var body io.Reader
+	if data != nil {
+		body = strings.NewReader(string(data))
+	}
+
+	r, err := http.NewRequest(method, "http://localhost"+path, body)
+	t.NoError(err)
+
+	if len(token) > 0 {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	w := httptest.NewRecorder()
+	handlers.Handler().ServeHTTP(w, r)
+
+	return w
+}
+
+func (t *testHandlerWebhook) register(handlers *Handlers, a base.Address) Webhook {
+	b, err := jsonenc.Marshal(WebhookRequest{
+		URL:       "https://localhost/hook",
+		Addresses: []string{a.String()},
+		Secret:    util.UUID().String(),
+	})
+	t.NoError(err)
+
+	w := t.requestAuth(handlers, "POST", HandlerPathWebhooks, b, t.token)
+	t.Equal(http.StatusCreated, w.Result().StatusCode)
+
+	hal := t.loadHal(w.Body.Bytes())
+
+	hinter, err := t.JSONEnc.Decode(hal.RawInterface())
+	t.NoError(err)
+
+	wh, ok := hinter.(Webhook)
+	t.True(ok)
+	t.Empty(wh.Secret())
+
+	return wh
+}
+
+// waitDelivery waits until the delivery of Webhook is finished.
+func (t *testHandlerWebhook) waitDelivery(st *Database, wh Webhook) WebhookDelivery {
+	var dl WebhookDelivery
+	for i := 0; i < 100; i++ {
+		var dls []WebhookDelivery
+		t.NoError(st.WebhookDeliveries(wh.ID(), "", 0, func(i WebhookDelivery) (bool, error) {
+			dls = append(dls, i)
+
+			return true, nil
+		}))
+		t.Equal(1, len(dls))
+
+		dl = dls[0]
+		if dl.Status() != WebhookDeliveryStatusPending {
+			break
+		}
+
+		<-time.After(time.Millisecond * 10)
+	}
+
+	return dl
+}
+
+func (t *testHandlerWebhook) TestNotSupported() {
+	st, _ := t.Database()
+
+	handlers := t.handlers(st, DummyCache{})
+
+	w := t.requestAuth(handlers, "GET", HandlerPathWebhooks, nil, t.token)
+	t.Equal(http.StatusInternalServerError, w.Result().StatusCode)
+}
+
+func (t *testHandlerWebhook) TestUnauthorized() {
+	st, _ := t.Database()
+
+	handlers := t.handlers(st, DummyCache{}).SetWebhookToken(t.token)
+
+	w := t.requestAuth(handlers, "GET", HandlerPathWebhooks, nil, "")
+	t.Equal(http.StatusUnauthorized, w.Result().StatusCode)
+
+	w = t.requestAuth(handlers, "GET", HandlerPathWebhooks, nil, t.token+"a")
+	t.Equal(http.StatusUnauthorized, w.Result().StatusCode)
+}
+
+func (t *testHandlerWebhook) TestRegister() {
+	st, _ := t.Database()
+
+	handlers := t.handlers(st, DummyCache{}).SetWebhookToken(t.token)
+
+	a := currency.MustAddress(util.UUID().String())
+	wh := t.register(handlers, a)
+	t.Equal(1, len(wh.Addresses()))
+	t.True(a.Equal(wh.Addresses()[0]))
+
+	uwh, found, err := st.Webhook(wh.ID())
+	t.NoError(err)
+	t.True(found)
+	t.NotEmpty(uwh.Secret())
+
+	self, err := handlers.router.Get(HandlerPathWebhook).URLPath("id", wh.ID())
+	t.NoError(err)
+
+	w := t.requestAuth(handlers, "GET", self.String(), nil, t.token)
+	t.Equal(http.StatusOK, w.Result().StatusCode)
+
+	w = t.requestAuth(handlers, "DELETE", self.String(), nil, t.token)
+	t.Equal(http.StatusNoContent, w.Result().StatusCode)
+
+	w = t.requestAuth(handlers, "GET", self.String(), nil, t.token)
+	t.Equal(http.StatusNotFound, w.Result().StatusCode)
+}
+
+func (t *testHandlerWebhook) TestRegisterInvalid() {
+	st, _ := t.Database()
+
+	handlers := t.handlers(st, DummyCache{}).SetWebhookToken(t.token)
+
+	b, err := jsonenc.Marshal(WebhookRequest{
+		URL:       "https://localhost/hook",
+		Addresses: []string{currency.MustAddress(util.UUID().String()).String()},
+		Secret:    "short",
+	})
+	t.NoError(err)
+
+	w := t.requestAuth(handlers, "POST", HandlerPathWebhooks, b, t.token)
+	t.Equal(http.StatusBadRequest, w.Result().StatusCode)
+}
+
+func (t *testHandlerWebhook) TestRegisterTooLarge() {
+	st, _ := t.Database()
+
+	handlers := t.handlers(st, DummyCache{}).SetWebhookToken(t.token)
+
+	b := []byte(`{"url":"` + strings.Repeat("a", int(MaxWebhookRequestSize)) + `"}`)
+
+	w := t.requestAuth(handlers, "POST", HandlerPathWebhooks, b, t.token)
+	t.Equal(http.StatusRequestEntityTooLarge, w.Result().StatusCode)
+}
+
+func (t *testHandlerWebhook) TestDispatch() {
+	st, _ := t.Database()
+
+	received := make(chan []byte, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		received <- b
+	}))
+	defer ts.Close()
+
+	sender := currency.MustAddress(util.UUID().String())
+	wh := NewWebhook(util.UUID().String(), ts.URL, []base.Address{sender}, util.UUID().String(), localtime.UTCNow())
+	t.NoError(st.AddWebhook(wh))
+
+	height := base.Height(3)
+	blk := t.newBlock(height, t.MongodbDatabase())
+
+	tf := t.newTransfer(sender, currency.MustAddress(util.UUID().String()))
+	unmatched := t.newTransfer(currency.MustAddress(util.UUID().String()), currency.MustAddress(util.UUID().String()))
+	sb := NewStreamBlock(blk.Manifest(), []OperationValue{
+		NewOperationValue(tf, height, localtime.UTCNow(), true, nil, 0),
+		NewOperationValue(unmatched, height, localtime.UTCNow(), true, nil, 1),
+	})
+
+	wd := NewWebhookDispatcher(st)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.NoError(wd.dispatch(ctx, sb))
+
+	select {
+	case <-time.After(time.Second * 3):
+		t.Fail("webhook not delivered")
+
+		return
+	case b := <-received:
+		t.Contains(string(b), tf.Fact().Hash().String())
+		t.NotContains(string(b), unmatched.Fact().Hash().String())
+	}
+
+	dl := t.waitDelivery(st, wh)
+	t.Equal(WebhookDeliveryStatusSuccess, dl.Status())
+	t.Equal(height, dl.Height())
+	t.Equal(uint(1), dl.Attempts())
+}
+
+func (t *testHandlerWebhook) TestDispatchBeforeStart() {
+	st, mst := t.Database()
+
+	received := make(chan []byte, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		received <- b
+	}))
+	defer ts.Close()
+
+	sender := currency.MustAddress(util.UUID().String())
+	wh := NewWebhook(util.UUID().String(), ts.URL, []base.Address{sender}, util.UUID().String(), localtime.UTCNow())
+	t.NoError(st.AddWebhook(wh))
+
+	height := base.Height(3)
+	blk := t.newBlock(height, mst)
+
+	tf := t.newTransfer(sender, currency.MustAddress(util.UUID().String()))
+	doc, err := NewOperationDoc(tf, t.BSONEnc, height, localtime.UTCNow(), true, nil, 0)
+	t.NoError(err)
+	_ = t.insertDoc(st, defaultColNameOperation, doc)
+
+	wd := NewWebhookDispatcher(st)
+	wd.Dispatch(NewStreamBlock(blk.Manifest(), []OperationValue{
+		NewOperationValue(tf, height, localtime.UTCNow(), true, nil, 0),
+	}))
+
+	select {
+	case <-time.After(time.Millisecond * 300):
+	case <-received:
+		t.Fail("webhook delivered before started")
+
+		return
+	}
+
+	dl := t.waitDelivery(st, wh)
+	t.Equal(WebhookDeliveryStatusPending, dl.Status())
+
+	t.NoError(wd.Start())
+	defer wd.Stop()
+
+	select {
+	case <-time.After(time.Second * 3):
+		t.Fail("pending webhook not delivered")
+
+		return
+	case b := <-received:
+		t.Contains(string(b), tf.Fact().Hash().String())
+		t.Contains(string(b), dl.ID())
+	}
+
+	dl = t.waitDelivery(st, wh)
+	t.Equal(WebhookDeliveryStatusSuccess, dl.Status())
+	t.Equal(uint(1), dl.Attempts())
+}
+
+func (t *testHandlerWebhook) TestResumePending() {
+	st, mst := t.Database()
+
+	received := make(chan []byte, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		received <- b
+	}))
+	defer ts.Close()
+
+	sender := currency.MustAddress(util.UUID().String())
+	wh := NewWebhook(util.UUID().String(), ts.URL, []base.Address{sender}, util.UUID().String(), localtime.UTCNow())
+	t.NoError(st.AddWebhook(wh))
+
+	height := base.Height(3)
+	_ = t.newBlock(height, mst)
+
+	tf := t.newTransfer(sender, currency.MustAddress(util.UUID().String()))
+	doc, err := NewOperationDoc(tf, t.BSONEnc, height, localtime.UTCNow(), true, nil, 0)
+	t.NoError(err)
+	_ = t.insertDoc(st, defaultColNameOperation, doc)
+
+	// NOTE the dispatcher was stopped after the first attempt failed
+	dl := NewWebhookDelivery(util.UUID().String(), wh, height, localtime.UTCNow()).
+		attempted(http.StatusBadGateway, errors.Errorf("unexpected status code"), false, localtime.UTCNow())
+	t.NoError(st.SetWebhookDelivery(dl))
+
+	wd := NewWebhookDispatcher(st)
+	t.NoError(wd.Start())
+	defer wd.Stop()
+
+	select {
+	case <-time.After(time.Second * 3):
+		t.Fail("pending webhook not resumed")
+
+		return
+	case b := <-received:
+		t.Contains(string(b), tf.Fact().Hash().String())
+		t.Contains(string(b), dl.ID())
+	}
+
+	udl := t.waitDelivery(st, wh)
+	t.Equal(dl.ID(), udl.ID())
+	t.Equal(WebhookDeliveryStatusSuccess, udl.Status())
+	t.Equal(uint(2), udl.Attempts())
+}
+
+func TestHandlerWebhook(t *testing.T) {
+	suite.Run(t, new(testHandlerWebhook))
+}
@@ -0,0 +1,45 @@
+package digest
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/hint"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+var (
+	HolderValueType = hint.Type("mitum-currency-holder-value")
+	HolderValueHint = hint.NewHint(HolderValueType, "v0.0.1")
+)
+
+// HolderValue is the latest balance of account for one currency.
+type HolderValue struct {
+	address base.Address
+	amount  currency.Amount
+	height  base.Height
+}
+
+func NewHolderValue(address base.Address, amount currency.Amount, height base.Height) HolderValue {
+	return HolderValue{
+		address: address,
+		amount:  amount,
+		height:  height,
+	}
+}
+
+func (HolderValue) Hint() hint.Hint {
+	return HolderValueHint
+}
+
+func (va HolderValue) Address() base.Address {
+	return va.address
+}
+
+func (va HolderValue) Amount() currency.Amount {
+	return va.amount
+}
+
+// Height returns the height, when the balance was changed.
+func (va HolderValue) Height() base.Height {
+	return va.height
+}
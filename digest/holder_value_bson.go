@@ -0,0 +1,44 @@
+package digest
+
+import (
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+func (va HolderValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(va.Hint()),
+		bson.M{
+			"address": va.address,
+			"amount":  va.amount,
+			"height":  va.height,
+		},
+	))
+}
+
+type HolderValueBSONUnpacker struct {
+	AD base.AddressDecoder `bson:"address"`
+	AM currency.Amount     `bson:"amount"`
+	HT base.Height         `bson:"height"`
+}
+
+func (va *HolderValue) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uva HolderValueBSONUnpacker
+	if err := enc.Unmarshal(b, &uva); err != nil {
+		return err
+	}
+
+	a, err := uva.AD.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	va.address = a
+	va.amount = uva.AM
+	va.height = uva.HT
+
+	return nil
+}
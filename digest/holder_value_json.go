@@ -0,0 +1,48 @@
+package digest
+
+import (
+	"github.com/spikeekips/mitum/base"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+type HolderValueJSONPacker struct {
+	jsonenc.HintedHead
+	AD base.Address    `json:"address"`
+	AM currency.Amount `json:"amount"`
+	HT base.Height     `json:"height"`
+}
+
+func (va HolderValue) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(HolderValueJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(va.Hint()),
+		AD:         va.address,
+		AM:         va.amount,
+		HT:         va.height,
+	})
+}
+
+type HolderValueJSONUnpacker struct {
+	AD base.AddressDecoder `json:"address"`
+	AM currency.Amount     `json:"amount"`
+	HT base.Height         `json:"height"`
+}
+
+func (va *HolderValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uva HolderValueJSONUnpacker
+	if err := enc.Unmarshal(b, &uva); err != nil {
+		return err
+	}
+
+	a, err := uva.AD.Encode(enc)
+	if err != nil {
+		return err
+	}
+
+	va.address = a
+	va.amount = uva.AM
+	va.height = uva.HT
+
+	return nil
+}
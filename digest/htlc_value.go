@@ -0,0 +1,63 @@
+package digest
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+var (
+	HTLCValueType = hint.Type("mitum-currency-htlc-value")
+	HTLCValueHint = hint.NewHint(HTLCValueType, "v0.0.1")
+)
+
+type HTLCValue struct {
+	htlc   currency.HTLC
+	height base.Height
+}
+
+func NewHTLCValue(st state.State) (HTLCValue, error) {
+	hl, err := currency.StateHTLCValue(st)
+	if err != nil {
+		return HTLCValue{}, errors.Wrap(err, "HTLCValue needs HTLC state")
+	}
+
+	return HTLCValue{
+		htlc:   hl,
+		height: st.Height(),
+	}, nil
+}
+
+func (HTLCValue) Hint() hint.Hint {
+	return HTLCValueHint
+}
+
+func (va HTLCValue) HTLC() currency.HTLC {
+	return va.htlc
+}
+
+// Height returns the height, when the htlc was locked or settled.
+func (va HTLCValue) Height() base.Height {
+	return va.height
+}
+
+func (va HTLCValue) MarshalBSON() ([]byte, error) {
+	return marshalStateValueBSON(va.Hint(), "htlc", va.htlc, va.height)
+}
+
+func (va *HTLCValue) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	return unpackStateValueBSON(b, enc, "htlc", &va.htlc, &va.height)
+}
+
+func (va HTLCValue) MarshalJSON() ([]byte, error) {
+	return marshalStateValueJSON(va.Hint(), "htlc", va.htlc, va.height)
+}
+
+func (va *HTLCValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	return unpackStateValueJSON(b, enc, "htlc", &va.htlc, &va.height)
+}
@@ -48,6 +48,41 @@ var balanceIndexModels = []mongo.IndexModel{
 	},
 }
 
+var holderIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "currency", Value: 1},
+			bson.E{Key: "holding", Value: 1},
+			bson.E{Key: "balance_key", Value: -1},
+			bson.E{Key: "address", Value: 1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_holder"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_holder_height"),
+	},
+}
+
+var lockedBalanceIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "address", Value: 1},
+			bson.E{Key: "currency", Value: 1},
+			bson.E{Key: "height", Value: -1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_locked_balance_currency"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_locked_balance_height"),
+	},
+}
+
 var operationIndexModels = []mongo.IndexModel{
 	{
 		Keys: bson.D{bson.E{Key: "addresses", Value: 1}, bson.E{Key: "height", Value: 1}, bson.E{Key: "index", Value: 1}},
@@ -64,10 +99,198 @@ var operationIndexModels = []mongo.IndexModel{
 		Options: options.Index().
 			SetName("mitum_digest_operation_height"),
 	},
+	{
+		Keys: bson.D{
+			bson.E{Key: "addresses", Value: 1},
+			bson.E{Key: "type", Value: 1},
+			bson.E{Key: "height", Value: 1},
+			bson.E{Key: "index", Value: 1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_account_operation_type"),
+	},
+	{
+		Keys: bson.D{
+			bson.E{Key: "debits", Value: 1},
+			bson.E{Key: "height", Value: 1},
+			bson.E{Key: "index", Value: 1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_account_operation_debits"),
+	},
+	{
+		Keys: bson.D{
+			bson.E{Key: "credits", Value: 1},
+			bson.E{Key: "height", Value: 1},
+			bson.E{Key: "index", Value: 1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_account_operation_credits"),
+	},
+	{
+		Keys: bson.D{
+			bson.E{Key: "addresses", Value: 1},
+			bson.E{Key: "in_state", Value: 1},
+			bson.E{Key: "height", Value: 1},
+			bson.E{Key: "index", Value: 1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_account_operation_in_state"),
+	},
+	{
+		// NOTE compound index can not have 2 array fields, so currencies is
+		// not combined with addresses.
+		Keys: bson.D{
+			bson.E{Key: "currencies", Value: 1},
+			bson.E{Key: "height", Value: 1},
+			bson.E{Key: "index", Value: 1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_operation_currencies"),
+	},
+}
+
+var escrowIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "addresses", Value: 1}, bson.E{Key: "id", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_account_escrow"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "id", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_escrow"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_escrow_height"),
+	},
+}
+
+var scheduleIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "addresses", Value: 1}, bson.E{Key: "id", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_account_schedule"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "id", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_schedule"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_schedule_height"),
+	},
+}
+
+var htlcIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "addresses", Value: 1}, bson.E{Key: "id", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_account_htlc"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "id", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_htlc"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_htlc_height"),
+	},
+}
+
+var webhookIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "id", Value: 1}},
+		Options: options.Index().
+			SetName("mitum_digest_webhook"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "addresses", Value: 1}},
+		Options: options.Index().
+			SetName("mitum_digest_webhook_addresses"),
+	},
+}
+
+var webhookDeliveryIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "webhook", Value: 1}, bson.E{Key: "height", Value: -1}, bson.E{Key: "id", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_webhook_delivery"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "status", Value: 1}},
+		Options: options.Index().
+			SetName("mitum_digest_webhook_delivery_status"),
+	},
+}
+
+var allowanceIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "addresses", Value: 1}, bson.E{Key: "key", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_account_allowance"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_allowance_height"),
+	},
+}
+
+var aliasIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "name", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_alias"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "address", Value: 1}, bson.E{Key: "name", Value: 1}},
+		Options: options.Index().
+			SetName("mitum_digest_alias_address"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_alias_height"),
+	},
+}
+
+var accountMetadataIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "address", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_account_metadata"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "pairs", Value: 1}, bson.E{Key: "address", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_account_metadata_pairs"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_account_metadata_height"),
+	},
 }
 
 var defaultIndexes = map[string] /* collection */ []mongo.IndexModel{
-	defaultColNameAccount:   accountIndexModels,
-	defaultColNameBalance:   balanceIndexModels,
-	defaultColNameOperation: operationIndexModels,
+	defaultColNameAccount:         accountIndexModels,
+	defaultColNameBalance:         balanceIndexModels,
+	defaultColNameLockedBalance:   lockedBalanceIndexModels,
+	defaultColNameOperation:       operationIndexModels,
+	defaultColNameEscrow:          escrowIndexModels,
+	defaultColNameSchedule:        scheduleIndexModels,
+	defaultColNameHTLC:            htlcIndexModels,
+	defaultColNameAllowance:       allowanceIndexModels,
+	defaultColNameAlias:           aliasIndexModels,
+	defaultColNameAccountMetadata: accountMetadataIndexModels,
+	defaultColNameHolder:          holderIndexModels,
+	defaultColNameWebhook:         webhookIndexModels,
+	defaultColNameWebhookDelivery: webhookDeliveryIndexModels,
 }
@@ -0,0 +1,186 @@
+package digest
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	"github.com/spikeekips/mitum/util/valuehash"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+// accountResolver resolves the accounts, which are not carried by operation
+// fact, like the beneficiary of escrow and the account of alias.
+type accountResolver interface {
+	escrow(valuehash.Hash) (currency.Escrow, bool, error)
+	alias(string) (currency.Alias, bool, error)
+}
+
+// blockAccountResolver resolves the accounts from the states of block first
+// and then from the digested states of Database.
+type blockAccountResolver struct {
+	st     *Database
+	states map[string]state.State
+}
+
+func newBlockAccountResolver(st *Database, sts []state.State) blockAccountResolver {
+	states := map[string]state.State{}
+	for i := range sts {
+		states[sts[i].Key()] = sts[i]
+	}
+
+	return blockAccountResolver{st: st, states: states}
+}
+
+func (rs blockAccountResolver) escrow(id valuehash.Hash) (currency.Escrow, bool, error) {
+	if st, found := rs.states[currency.StateKeyEscrow(id)]; found {
+		es, err := currency.StateEscrowValue(st)
+		if err != nil {
+			return currency.Escrow{}, false, err
+		}
+
+		return es, true, nil
+	}
+
+	if rs.st == nil {
+		return currency.Escrow{}, false, nil
+	}
+
+	va, found, err := rs.st.Escrow(id)
+	if err != nil || !found {
+		return currency.Escrow{}, false, err
+	}
+
+	return va.Escrow(), true, nil
+}
+
+func (rs blockAccountResolver) alias(name string) (currency.Alias, bool, error) {
+	if st, found := rs.states[currency.StateKeyAlias(name)]; found {
+		al, err := currency.StateAliasValue(st)
+		if err != nil {
+			return currency.Alias{}, false, err
+		}
+
+		return al, true, nil
+	}
+
+	if rs.st == nil {
+		return currency.Alias{}, false, nil
+	}
+
+	va, found, err := rs.st.Alias(name)
+	if err != nil || !found {
+		return currency.Alias{}, false, err
+	}
+
+	return va.Alias(), true, nil
+}
+
+// resolveAccount returns the registered account of AliasAddress like
+// processing operation; when the alias can not be resolved, the AliasAddress
+// is returned as it is.
+func resolveAccount(a base.Address, rs accountResolver) (base.Address, error) {
+	aa, ok := a.(currency.AliasAddress)
+	if !ok {
+		return a, nil
+	}
+
+	switch al, found, err := rs.alias(aa.Name()); {
+	case err != nil:
+		return nil, err
+	case found:
+		return al.Address(), nil
+	default:
+		return a, nil
+	}
+}
+
+// factAccounts returns the accounts, whose balance is debited or credited by
+// the operation fact; the fee payer is also debited. The debited accounts are
+// for the "outgoing" direction of OperationFilter and the credited accounts are
+// for the "incoming" direction. The accounts, which can not be resolved by rs,
+// are ignored.
+func factAccounts(fact base.Fact, rs accountResolver) ([]base.Address, []base.Address, error) {
+	var debits, credits []base.Address
+
+	switch t := fact.(type) {
+	case currency.TransfersFact:
+		debits = []base.Address{t.Sender()}
+		for i := range t.Items() {
+			a, err := resolveAccount(t.Items()[i].Receiver(), rs)
+			if err != nil {
+				return nil, nil, err
+			}
+			credits = append(credits, a)
+		}
+	case currency.CreateAccountsFact:
+		as, err := t.Targets()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		debits = []base.Address{t.Sender()}
+		credits = as
+	case currency.TransferFromFact: // NOTE the fee is paid by owner
+		a, err := resolveAccount(t.Receiver(), rs)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		debits = []base.Address{t.Owner()}
+		credits = []base.Address{a}
+	case currency.AccountMergeFact:
+		debits = []base.Address{t.Sender()}
+		credits = []base.Address{t.Target()}
+	case currency.AtomicSwapFact:
+		debits = []base.Address{t.Sender(), t.Counterparty()}
+		credits = debits
+	case currency.BalanceUnlockFact:
+		debits = []base.Address{t.Sender()}
+		credits = debits
+	case currency.SuffrageInflationFact:
+		for i := range t.Items() {
+			credits = append(credits, t.Items()[i].Receiver())
+		}
+	case currency.GenesisCurrenciesFact:
+		a, err := t.Address()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		credits = []base.Address{a}
+	case currency.CurrencyRegisterFact:
+		credits = []base.Address{t.Currency().GenesisAccount()}
+	case currency.EscrowReleaseFact: // NOTE sender is sender or arbiter of escrow
+		switch es, found, err := rs.escrow(t.Escrow()); {
+		case err != nil:
+			return nil, nil, err
+		case found:
+			credits = []base.Address{es.Beneficiary()}
+		}
+	case currency.EscrowRefundFact:
+		switch es, found, err := rs.escrow(t.Escrow()); {
+		case err != nil:
+			return nil, nil, err
+		case found:
+			credits = []base.Address{es.Sender()}
+		}
+	case currency.HTLCClaimFact: // NOTE sender is receiver of htlc
+		credits = []base.Address{t.Sender()}
+	case currency.HTLCRefundFact, currency.CancelScheduleFact:
+		credits = []base.Address{t.(interface{ Sender() base.Address }).Sender()}
+	case currency.ScheduleRunOperationFact: // NOTE the amounts were reserved by SchedulePayment
+		for i := range t.Runs() {
+			credits = append(credits, t.Runs()[i].Receiver())
+		}
+	case currency.KeyUpdaterFact, currency.RecoveryUpdaterFact, currency.AccountMetadataUpdaterFact:
+		debits = []base.Address{t.(interface{ Target() base.Address }).Target()}
+	case currency.ApproveFact,
+		currency.RegisterAliasFact,
+		currency.EscrowCreateFact,
+		currency.HTLCLockFact,
+		currency.SchedulePaymentFact:
+		debits = []base.Address{t.(interface{ Sender() base.Address }).Sender()}
+	}
+
+	return debits, credits, nil
+}
@@ -0,0 +1,203 @@
+package digest
+
+import (
+	"net/url"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+var (
+	OperationDirectionIncoming = "incoming"
+	OperationDirectionOutgoing = "outgoing"
+	OperationStateInState      = "in_state"
+	OperationStateFailed       = "failed"
+)
+
+// OperationFilter filters the operations of account. Operation is matched
+// when it matches with all the given kinds of filter; within same kind, any
+// of the values can be matched. Empty OperationFilter matches every operation.
+// *      types: hint type of operation.
+// * currencies: one of the currencies, which the operation fact carries.
+// *  direction: "outgoing" when the balance of account is debited by the
+// operation, like the owner of TransferFrom, "incoming" when it is credited,
+// like the claimer of HTLCClaim. The operations, which change no balance of
+// the account, are matched by neither.
+// *      state: "in_state" or "failed".
+// *    heights: from and to height; both are inclusive.
+type OperationFilter struct {
+	types      []hint.Type
+	currencies []currency.CurrencyID
+	direction  string
+	state      string
+	fromHeight base.Height
+	toHeight   base.Height
+	hasHeights bool
+}
+
+func NewOperationFilter(
+	types []hint.Type,
+	cids []currency.CurrencyID,
+	direction,
+	state string,
+	fromHeight,
+	toHeight base.Height,
+) OperationFilter {
+	return OperationFilter{
+		types:      types,
+		currencies: cids,
+		direction:  direction,
+		state:      state,
+		fromHeight: fromHeight,
+		toHeight:   toHeight,
+		hasHeights: fromHeight > base.NilHeight || toHeight > base.NilHeight,
+	}
+}
+
+func (of OperationFilter) IsValid([]byte) error {
+	for i := range of.types {
+		if err := of.types[i].IsValid(nil); err != nil {
+			return isvalid.InvalidError.Errorf("invalid operation type, %q: %w", of.types[i], err)
+		}
+	}
+
+	for i := range of.currencies {
+		if err := of.currencies[i].IsValid(nil); err != nil {
+			return isvalid.InvalidError.Errorf("invalid currency, %q: %w", of.currencies[i], err)
+		}
+	}
+
+	switch of.direction {
+	case "", OperationDirectionIncoming, OperationDirectionOutgoing:
+	default:
+		return isvalid.InvalidError.Errorf("unknown direction, %q", of.direction)
+	}
+
+	switch of.state {
+	case "", OperationStateInState, OperationStateFailed:
+	default:
+		return isvalid.InvalidError.Errorf("unknown state, %q", of.state)
+	}
+
+	if from, to := of.Heights(); from > base.NilHeight && to > base.NilHeight && from > to {
+		return isvalid.InvalidError.Errorf("from height is higher than to height, %v > %v", from, to)
+	}
+
+	return nil
+}
+
+func (of OperationFilter) IsEmpty() bool {
+	return len(of.types) < 1 && len(of.currencies) < 1 &&
+		len(of.direction) < 1 && len(of.state) < 1 && !of.hasHeights
+}
+
+func (of OperationFilter) Types() []hint.Type {
+	return of.types
+}
+
+func (of OperationFilter) Currencies() []currency.CurrencyID {
+	return of.currencies
+}
+
+func (of OperationFilter) Direction() string {
+	return of.direction
+}
+
+func (of OperationFilter) State() string {
+	return of.state
+}
+
+// Heights returns from and to height; base.NilHeight means not bounded.
+func (of OperationFilter) Heights() (base.Height, base.Height) {
+	if !of.hasHeights {
+		return base.NilHeight, base.NilHeight
+	}
+
+	return of.fromHeight, of.toHeight
+}
+
+// Query returns the url query string of filter, which can be parsed by
+// parseOperationFilter.
+func (of OperationFilter) Query() string {
+	q := url.Values{}
+	for i := range of.types {
+		q.Add("type", of.types[i].String())
+	}
+
+	for i := range of.currencies {
+		q.Add("currency", of.currencies[i].String())
+	}
+
+	if len(of.direction) > 0 {
+		q.Set("direction", of.direction)
+	}
+
+	if len(of.state) > 0 {
+		q.Set("state", of.state)
+	}
+
+	from, to := of.Heights()
+	if from > base.NilHeight {
+		q.Set("from_height", from.String())
+	}
+
+	if to > base.NilHeight {
+		q.Set("to_height", to.String())
+	}
+
+	return q.Encode()
+}
+
+// addBSON adds the conditions of filter to the filter of the operations of
+// address.
+func (of OperationFilter) addBSON(filter bson.M, address base.Address) {
+	if len(of.types) > 0 {
+		ts := make([]string, len(of.types))
+		for i := range of.types {
+			ts[i] = of.types[i].String()
+		}
+
+		filter["type"] = bson.M{"$in": ts}
+	}
+
+	if len(of.currencies) > 0 {
+		cids := make([]string, len(of.currencies))
+		for i := range of.currencies {
+			cids[i] = of.currencies[i].String()
+		}
+
+		filter["currencies"] = bson.M{"$in": cids}
+	}
+
+	switch of.direction {
+	case OperationDirectionOutgoing:
+		filter["debits"] = address.String()
+	case OperationDirectionIncoming:
+		filter["credits"] = address.String()
+	}
+
+	switch of.state {
+	case OperationStateInState:
+		filter["in_state"] = true
+	case OperationStateFailed:
+		filter["in_state"] = false
+	}
+
+	// NOTE the height range is combined with the "$or" of offset
+	if from, to := of.Heights(); from > base.NilHeight || to > base.NilHeight {
+		h := bson.M{}
+		if from > base.NilHeight {
+			h["$gte"] = from
+		}
+
+		if to > base.NilHeight {
+			h["$lte"] = to
+		}
+
+		filter["height"] = h
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/spikeekips/mitum/base"
 	"github.com/spikeekips/mitum/base/operation"
 	"github.com/spikeekips/mitum/util/hint"
+
+	"github.com/spikeekips/mitum-currency/currency"
 )
 
 var (
@@ -20,6 +22,8 @@ type OperationValue struct {
 	inState     bool
 	reason      operation.ReasonError
 	index       uint64
+	debits      []base.Address
+	credits     []base.Address
 }
 
 func NewOperationValue(
@@ -68,3 +72,51 @@ func (va OperationValue) Reason() operation.ReasonError {
 func (va OperationValue) Index() uint64 {
 	return va.index
 }
+
+// Debits returns the accounts, whose balance is debited by Operation.
+func (va OperationValue) Debits() []base.Address {
+	return va.debits
+}
+
+// Credits returns the accounts, whose balance is credited by Operation.
+func (va OperationValue) Credits() []base.Address {
+	return va.credits
+}
+
+// Addresses returns the unique addresses of operation fact with the debited
+// and credited accounts, which the fact may not carry, like the beneficiary of
+// EscrowRelease.
+func (va OperationValue) Addresses() ([]base.Address, error) {
+	var as []base.Address
+	if ads, ok := va.op.Fact().(currency.Addresses); ok {
+		i, err := ads.Addresses()
+		if err != nil {
+			return nil, err
+		}
+		as = i
+	}
+
+	founds := map[string]struct{}{}
+
+	var addresses []base.Address
+	for _, l := range [][]base.Address{as, va.debits, va.credits} {
+		for i := range l {
+			s := l[i].String()
+			if _, found := founds[s]; found {
+				continue
+			}
+
+			founds[s] = struct{}{}
+			addresses = append(addresses, l[i])
+		}
+	}
+
+	return addresses, nil
+}
+
+func (va OperationValue) setAccounts(debits, credits []base.Address) OperationValue {
+	va.debits = debits
+	va.credits = credits
+
+	return va
+}
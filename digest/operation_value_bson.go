@@ -19,17 +19,21 @@ func (va OperationValue) MarshalBSON() ([]byte, error) {
 			"in_state":     va.inState,
 			"reason":       va.reason,
 			"index":        va.index,
+			"debits":       va.debits,
+			"credits":      va.credits,
 		},
 	))
 }
 
 type OperationValueBSONUnpacker struct {
-	OP bson.Raw    `bson:"op"`
-	HT base.Height `bson:"height"`
-	CT time.Time   `bson:"confirmed_at"`
-	IN bool        `bson:"in_state"`
-	RS bson.Raw    `bson:"reason"`
-	ID uint64      `bson:"index"`
+	OP bson.Raw              `bson:"op"`
+	HT base.Height           `bson:"height"`
+	CT time.Time             `bson:"confirmed_at"`
+	IN bool                  `bson:"in_state"`
+	RS bson.Raw              `bson:"reason"`
+	ID uint64                `bson:"index"`
+	DB []base.AddressDecoder `bson:"debits"`
+	CR []base.AddressDecoder `bson:"credits"`
 }
 
 func (va *OperationValue) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -51,5 +55,5 @@ func (va *OperationValue) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
 	va.inState = uva.IN
 	va.index = uva.ID
 
-	return nil
+	return va.unpackAccounts(enc, uva.DB, uva.CR)
 }
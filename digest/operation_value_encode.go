@@ -0,0 +1,40 @@
+package digest
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (va *OperationValue) unpackAccounts(enc encoder.Encoder, bdebits, bcredits []base.AddressDecoder) error {
+	debits, err := decodeAddresses(enc, bdebits)
+	if err != nil {
+		return err
+	}
+
+	credits, err := decodeAddresses(enc, bcredits)
+	if err != nil {
+		return err
+	}
+
+	va.debits = debits
+	va.credits = credits
+
+	return nil
+}
+
+func decodeAddresses(enc encoder.Encoder, bas []base.AddressDecoder) ([]base.Address, error) {
+	if len(bas) < 1 {
+		return nil, nil
+	}
+
+	as := make([]base.Address, len(bas))
+	for i := range bas {
+		a, err := bas[i].Encode(enc)
+		if err != nil {
+			return nil, err
+		}
+		as[i] = a
+	}
+
+	return as, nil
+}
@@ -20,6 +20,8 @@ type OperationValueJSONPacker struct {
 	RS operation.ReasonError `json:"reason"`
 	IN bool                  `json:"in_state"`
 	ID uint64                `json:"index"`
+	DB []base.Address        `json:"debits,omitempty"`
+	CR []base.Address        `json:"credits,omitempty"`
 }
 
 func (va OperationValue) MarshalJSON() ([]byte, error) {
@@ -32,16 +34,20 @@ func (va OperationValue) MarshalJSON() ([]byte, error) {
 		RS:         va.reason,
 		IN:         va.inState,
 		ID:         va.index,
+		DB:         va.debits,
+		CR:         va.credits,
 	})
 }
 
 type OperationValueJSONUnpacker struct {
-	OP json.RawMessage `json:"operation"`
-	HT base.Height     `json:"height"`
-	CF localtime.Time  `json:"confirmed_at"`
-	IN bool            `json:"in_state"`
-	RS json.RawMessage `json:"reason"`
-	ID uint64          `json:"index"`
+	OP json.RawMessage       `json:"operation"`
+	HT base.Height           `json:"height"`
+	CF localtime.Time        `json:"confirmed_at"`
+	IN bool                  `json:"in_state"`
+	RS json.RawMessage       `json:"reason"`
+	ID uint64                `json:"index"`
+	DB []base.AddressDecoder `json:"debits"`
+	CR []base.AddressDecoder `json:"credits"`
 }
 
 func (va *OperationValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -63,5 +69,5 @@ func (va *OperationValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
 	va.inState = uva.IN
 	va.index = uva.ID
 
-	return nil
+	return va.unpackAccounts(enc, uva.DB, uva.CR)
 }
@@ -0,0 +1,63 @@
+package digest
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/state"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+var (
+	ScheduleValueType = hint.Type("mitum-currency-schedule-value")
+	ScheduleValueHint = hint.NewHint(ScheduleValueType, "v0.0.1")
+)
+
+type ScheduleValue struct {
+	schedule currency.PaymentSchedule
+	height   base.Height
+}
+
+func NewScheduleValue(st state.State) (ScheduleValue, error) {
+	sc, err := currency.StateScheduleValue(st)
+	if err != nil {
+		return ScheduleValue{}, errors.Wrap(err, "ScheduleValue needs PaymentSchedule state")
+	}
+
+	return ScheduleValue{
+		schedule: sc,
+		height:   st.Height(),
+	}, nil
+}
+
+func (ScheduleValue) Hint() hint.Hint {
+	return ScheduleValueHint
+}
+
+func (va ScheduleValue) Schedule() currency.PaymentSchedule {
+	return va.schedule
+}
+
+// Height returns the height, when the schedule was created, run or cancelled.
+func (va ScheduleValue) Height() base.Height {
+	return va.height
+}
+
+func (va ScheduleValue) MarshalBSON() ([]byte, error) {
+	return marshalStateValueBSON(va.Hint(), "schedule", va.schedule, va.height)
+}
+
+func (va *ScheduleValue) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	return unpackStateValueBSON(b, enc, "schedule", &va.schedule, &va.height)
+}
+
+func (va ScheduleValue) MarshalJSON() ([]byte, error) {
+	return marshalStateValueJSON(va.Hint(), "schedule", va.schedule, va.height)
+}
+
+func (va *ScheduleValue) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	return unpackStateValueJSON(b, enc, "schedule", &va.schedule, &va.height)
+}
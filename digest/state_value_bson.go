@@ -0,0 +1,40 @@
+package digest
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"github.com/spikeekips/mitum/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// marshalStateValueBSON packs the value of state, like currency.Escrow, under
+// the field with the height, when the state was updated.
+func marshalStateValueBSON(ht hint.Hint, field string, v interface{}, height base.Height) ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(ht),
+		bson.M{
+			field:    v,
+			"height": height,
+		},
+	))
+}
+
+type StateValueBSONUnpacker struct {
+	HT base.Height `bson:"height"`
+}
+
+func unpackStateValueBSON(b []byte, enc *bsonenc.Encoder, field string, v interface{}, height *base.Height) error {
+	var uva StateValueBSONUnpacker
+	if err := enc.Unmarshal(b, &uva); err != nil {
+		return err
+	}
+
+	if err := encoder.Decode(bson.Raw(b).Lookup(field).Value, enc, v); err != nil {
+		return err
+	}
+
+	*height = uva.HT
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package digest
+
+import (
+	"encoding/json"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/hint"
+)
+
+// marshalStateValueJSON packs the value of state, like currency.Escrow, under
+// the field with the height, when the state was updated.
+func marshalStateValueJSON(ht hint.Hint, field string, v interface{}, height base.Height) ([]byte, error) {
+	return jsonenc.Marshal(map[string]interface{}{
+		"_hint":  ht,
+		field:    v,
+		"height": height,
+	})
+}
+
+type StateValueJSONUnpacker struct {
+	HT base.Height `json:"height"`
+}
+
+func unpackStateValueJSON(b []byte, enc *jsonenc.Encoder, field string, v interface{}, height *base.Height) error {
+	var uva StateValueJSONUnpacker
+	if err := enc.Unmarshal(b, &uva); err != nil {
+		return err
+	}
+
+	var m map[string]json.RawMessage
+	if err := enc.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	if err := encoder.Decode(m[field], enc, v); err != nil {
+		return err
+	}
+
+	*height = uva.HT
+
+	return nil
+}
@@ -0,0 +1,301 @@
+package digest
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/block"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/logging"
+	"github.com/spikeekips/mitum/util/valuehash"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/spikeekips/mitum-currency/currency"
+)
+
+var (
+	StreamBlockType = hint.Type("mitum-currency-stream-block")
+	StreamBlockHint = hint.NewHint(StreamBlockType, "v0.0.1")
+)
+
+var defaultStreamSubscriptionBuffer = 100
+
+// StreamBlock is the digested block, which is pushed to the stream
+// subscribers. The operations are filtered by the StreamFilter of each
+// subscriber.
+type StreamBlock struct {
+	manifest   block.Manifest
+	operations []OperationValue
+}
+
+func NewStreamBlock(manifest block.Manifest, operations []OperationValue) StreamBlock {
+	return StreamBlock{
+		manifest:   manifest,
+		operations: operations,
+	}
+}
+
+func (StreamBlock) Hint() hint.Hint {
+	return StreamBlockHint
+}
+
+func (sb StreamBlock) Manifest() block.Manifest {
+	return sb.manifest
+}
+
+func (sb StreamBlock) Height() base.Height {
+	return sb.manifest.Height()
+}
+
+func (sb StreamBlock) Operations() []OperationValue {
+	return sb.operations
+}
+
+func (sb StreamBlock) filter(filter StreamFilter) StreamBlock {
+	if filter.IsEmpty() {
+		return sb
+	}
+
+	var ops []OperationValue
+	for i := range sb.operations {
+		if filter.Match(sb.operations[i]) {
+			ops = append(ops, sb.operations[i])
+		}
+	}
+
+	return NewStreamBlock(sb.manifest, ops)
+}
+
+// loadStreamBlock loads the digested block of the given height from the digest
+// database; the operations are filtered by filter.
+func loadStreamBlock(st *Database, height base.Height, filter StreamFilter) (StreamBlock, error) {
+	m, found, err := st.ManifestByHeight(height)
+	switch {
+	case err != nil:
+		return StreamBlock{}, err
+	case !found:
+		return StreamBlock{}, errors.Errorf("manifest of height, %v not found", height)
+	}
+
+	var ops []OperationValue
+	if err := st.Operations(
+		bson.M{"height": height}, true, false, 0,
+		func(_ valuehash.Hash, va OperationValue) (bool, error) {
+			if filter.Match(va) {
+				ops = append(ops, va)
+			}
+
+			return true, nil
+		},
+	); err != nil {
+		return StreamBlock{}, err
+	}
+
+	return NewStreamBlock(m, ops), nil
+}
+
+// StreamFilter filters the operations of StreamBlock. Operation is matched
+// when it matches with all the given kinds of filter; within same kind, any
+// of the values can be matched. Empty StreamFilter matches every operation.
+// *   addresses: one of the addresses of operation fact.
+// *       types: hint type of operation.
+// *  currencies: one of the currencies, which the operation fact carries. The
+// operations, whose fact does not carry currency, like EscrowRelease, are not
+// matched.
+type StreamFilter struct {
+	addresses  map[string]struct{}
+	types      map[string]struct{}
+	currencies map[string]struct{}
+}
+
+func NewStreamFilter(addresses []base.Address, types []hint.Type, cids []currency.CurrencyID) StreamFilter {
+	sf := StreamFilter{}
+
+	if len(addresses) > 0 {
+		sf.addresses = map[string]struct{}{}
+		for i := range addresses {
+			sf.addresses[addresses[i].String()] = struct{}{}
+		}
+	}
+
+	if len(types) > 0 {
+		sf.types = map[string]struct{}{}
+		for i := range types {
+			sf.types[types[i].String()] = struct{}{}
+		}
+	}
+
+	if len(cids) > 0 {
+		sf.currencies = map[string]struct{}{}
+		for i := range cids {
+			sf.currencies[cids[i].String()] = struct{}{}
+		}
+	}
+
+	return sf
+}
+
+func (sf StreamFilter) IsEmpty() bool {
+	return len(sf.addresses) < 1 && len(sf.types) < 1 && len(sf.currencies) < 1
+}
+
+func (sf StreamFilter) Match(va OperationValue) bool {
+	op := va.Operation()
+	if op == nil {
+		return false
+	}
+
+	if len(sf.types) > 0 {
+		if _, found := sf.types[op.Hint().Type().String()]; !found {
+			return false
+		}
+	}
+
+	if len(sf.addresses) > 0 {
+		as, err := va.Addresses()
+		if err != nil || !sf.matchAny(sf.addresses, len(as), func(i int) string { return as[i].String() }) {
+			return false
+		}
+	}
+
+	if len(sf.currencies) > 0 {
+		cids := factCurrencies(op.Fact())
+		if !sf.matchAny(sf.currencies, len(cids), func(i int) string { return cids[i].String() }) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (StreamFilter) matchAny(m map[string]struct{}, n int, f func(int) string) bool {
+	for i := 0; i < n; i++ {
+		if _, found := m[f(i)]; found {
+			return true
+		}
+	}
+
+	return false
+}
+
+// factCurrencies returns the currencies, which the operation fact carries.
+func factCurrencies(fact base.Fact) []currency.CurrencyID {
+	var ams []currency.Amount
+	switch t := fact.(type) {
+	case currency.CreateAccountsFact:
+		for i := range t.Items() {
+			ams = append(ams, t.Items()[i].Amounts()...)
+		}
+	case currency.TransfersFact:
+		for i := range t.Items() {
+			ams = append(ams, t.Items()[i].Amounts()...)
+		}
+	case currency.SuffrageInflationFact:
+		for i := range t.Items() {
+			ams = append(ams, t.Items()[i].Amount())
+		}
+	case currency.FeeOperationFact:
+		ams = t.Amounts()
+	case currency.AtomicSwapFact:
+		ams = []currency.Amount{t.Amount(), t.CounterAmount()}
+	case currency.GenesisCurrenciesFact:
+		for i := range t.Currencies() {
+			ams = append(ams, t.Currencies()[i].Amount)
+		}
+	case currency.CurrencyRegisterFact:
+		ams = []currency.Amount{t.Currency().Amount}
+	case interface{ Amount() currency.Amount }:
+		ams = []currency.Amount{t.Amount()}
+	case interface{ Currency() currency.CurrencyID }:
+		return []currency.CurrencyID{t.Currency()}
+	}
+
+	cids := make([]currency.CurrencyID, len(ams))
+	for i := range ams {
+		cids[i] = ams[i].Currency()
+	}
+
+	return cids
+}
+
+// StreamSubscription receives the StreamBlocks from Streamer. When the
+// subscriber is too slow to receive, the subscription is closed by Streamer.
+type StreamSubscription struct {
+	filter StreamFilter
+	ch     chan StreamBlock
+	once   sync.Once
+}
+
+func (sub *StreamSubscription) Blocks() <-chan StreamBlock {
+	return sub.ch
+}
+
+func (sub *StreamSubscription) close() {
+	sub.once.Do(func() {
+		close(sub.ch)
+	})
+}
+
+// Streamer delivers the digested blocks to the subscribers.
+type Streamer struct {
+	sync.RWMutex
+	*logging.Logging
+	subs    map[*StreamSubscription]struct{}
+	bufsize int
+}
+
+func NewStreamer() *Streamer {
+	return &Streamer{
+		Logging: logging.NewLogging(func(c zerolog.Context) zerolog.Context {
+			return c.Str("module", "digest-streamer")
+		}),
+		subs:    map[*StreamSubscription]struct{}{},
+		bufsize: defaultStreamSubscriptionBuffer,
+	}
+}
+
+func (sr *Streamer) Subscribe(filter StreamFilter) *StreamSubscription {
+	sr.Lock()
+	defer sr.Unlock()
+
+	sub := &StreamSubscription{
+		filter: filter,
+		ch:     make(chan StreamBlock, sr.bufsize),
+	}
+	sr.subs[sub] = struct{}{}
+
+	return sub
+}
+
+func (sr *Streamer) Unsubscribe(sub *StreamSubscription) {
+	sr.Lock()
+	defer sr.Unlock()
+
+	delete(sr.subs, sub)
+	sub.close()
+}
+
+func (sr *Streamer) Publish(sb StreamBlock) {
+	sr.Lock()
+	defer sr.Unlock()
+
+	for sub := range sr.subs {
+		select {
+		case sub.ch <- sb.filter(sub.filter):
+		default:
+			sr.Log().Debug().Int64("block", sb.Height().Int64()).Msg("subscription too slow; closed")
+
+			delete(sr.subs, sub)
+			sub.close()
+		}
+	}
+}
+
+func (sr *Streamer) Len() int {
+	sr.RLock()
+	defer sr.RUnlock()
+
+	return len(sr.subs)
+}
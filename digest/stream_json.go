@@ -0,0 +1,25 @@
+package digest
+
+import (
+	"github.com/spikeekips/mitum/base/block"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+)
+
+type StreamBlockJSONPacker struct {
+	jsonenc.HintedHead
+	MF block.Manifest   `json:"manifest"`
+	OP []OperationValue `json:"operations"`
+}
+
+func (sb StreamBlock) MarshalJSON() ([]byte, error) {
+	ops := sb.operations
+	if ops == nil {
+		ops = []OperationValue{}
+	}
+
+	return jsonenc.Marshal(StreamBlockJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(sb.Hint()),
+		MF:         sb.manifest,
+		OP:         ops,
+	})
+}
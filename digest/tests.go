@@ -17,6 +17,7 @@ import (
 	"github.com/spikeekips/mitum/storage"
 	mongodbstorage "github.com/spikeekips/mitum/storage/mongodb"
 	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/hint"
 	"github.com/spikeekips/mitum/util/localtime"
 	"github.com/spikeekips/mitum/util/valuehash"
 	"github.com/stretchr/testify/suite"
@@ -40,18 +41,38 @@ func (t *baseTest) SetupSuite() {
 	}
 
 	_ = t.Encs.TestAddHinter(AccountValue{})
+	_ = t.Encs.TestAddHinter(AllowanceValue{})
+	_ = t.Encs.TestAddHinter(AliasValue{})
+	_ = t.Encs.TestAddHinter(AccountMetadataValue{})
 	_ = t.Encs.TestAddHinter(BaseHal{})
+	_ = t.Encs.TestAddHinter(EscrowValue{})
+	_ = t.Encs.TestAddHinter(HTLCValue{})
+	_ = t.Encs.TestAddHinter(Webhook{})
+	_ = t.Encs.TestAddHinter(WebhookDelivery{})
+	_ = t.Encs.TestAddHinter(BalanceHistoryValue{})
+	_ = t.Encs.TestAddHinter(AccountBalanceValue{})
+	_ = t.Encs.TestAddHinter(HolderValue{})
 	_ = t.Encs.TestAddHinter(NodeInfo{})
 	_ = t.Encs.TestAddHinter(OperationValue{})
+	_ = t.Encs.TestAddHinter(ScheduleValue{})
 	_ = t.Encs.TestAddHinter(Problem{})
 	_ = t.Encs.TestAddHinter(currency.AccountHinter)
 	_ = t.Encs.TestAddHinter(currency.AddressHinter)
+	_ = t.Encs.TestAddHinter(currency.AliasHinter)
+	_ = t.Encs.TestAddHinter(currency.AliasAddressHinter)
+	_ = t.Encs.TestAddHinter(currency.AccountMetadataHinter)
 	_ = t.Encs.TestAddHinter(currency.AmountHinter)
+	_ = t.Encs.TestAddHinter(currency.BalanceUnlockFactHinter)
+	_ = t.Encs.TestAddHinter(currency.BalanceUnlockHinter)
 	_ = t.Encs.TestAddHinter(currency.CreateAccountsFactHinter)
 	_ = t.Encs.TestAddHinter(currency.CreateAccountsItemMultiAmountsHinter)
 	_ = t.Encs.TestAddHinter(currency.CreateAccountsItemSingleAmountHinter)
 	_ = t.Encs.TestAddHinter(currency.CreateAccountsHinter)
 	_ = t.Encs.TestAddHinter(currency.CurrencyDesignHinter)
+	_ = t.Encs.TestAddHinter(currency.EscrowHinter)
+	_ = t.Encs.TestAddHinter(currency.PaymentScheduleHinter)
+	_ = t.Encs.TestAddHinter(currency.HTLCHinter)
+	_ = t.Encs.TestAddHinter(currency.AllowanceHinter)
 	_ = t.Encs.TestAddHinter(currency.CurrencyPolicyUpdaterFactHinter)
 	_ = t.Encs.TestAddHinter(currency.CurrencyPolicyUpdaterHinter)
 	_ = t.Encs.TestAddHinter(currency.CurrencyRegisterFactHinter)
@@ -65,14 +86,25 @@ func (t *baseTest) SetupSuite() {
 	_ = t.Encs.TestAddHinter(currency.KeyUpdaterHinter)
 	_ = t.Encs.TestAddHinter(currency.AccountKeysHinter)
 	_ = t.Encs.TestAddHinter(currency.AccountKeyHinter)
+	_ = t.Encs.TestAddHinter(currency.AccountIndexHinter)
+	_ = t.Encs.TestAddHinter(currency.AccountRecoveryHinter)
+	_ = t.Encs.TestAddHinter(currency.LockedAmountHinter)
 	_ = t.Encs.TestAddHinter(currency.NilFeeerHinter)
 	_ = t.Encs.TestAddHinter(currency.RatioFeeerHinter)
+	_ = t.Encs.TestAddHinter(currency.TieredFeeerHinter)
 	_ = t.Encs.TestAddHinter(currency.TransfersFactHinter)
+	_ = t.Encs.TestAddHinter(currency.TransfersItemLockedAmountsHinter)
 	_ = t.Encs.TestAddHinter(currency.TransfersItemMultiAmountsHinter)
 	_ = t.Encs.TestAddHinter(currency.TransfersItemSingleAmountHinter)
 	_ = t.Encs.TestAddHinter(currency.TransfersHinter)
 	_ = t.Encs.TestAddHinter(currency.CurrencyPolicyHinter)
+	_ = t.Encs.TestAddHinter(currency.MemoPolicyHinter)
+	_ = t.Encs.TestAddHinter(currency.AccountPolicyHinter)
 	_ = t.Encs.TestAddHinter(currency.SuffrageInflationHinter)
+	_ = t.Encs.TestAddHinter(currency.AccountFreezeFactHinter)
+	_ = t.Encs.TestAddHinter(currency.AccountFreezeHinter)
+	_ = t.Encs.TestAddHinter(currency.AccountUnfreezeFactHinter)
+	_ = t.Encs.TestAddHinter(currency.AccountUnfreezeHinter)
 
 	t.networkID = util.UUID().Bytes()
 
@@ -175,6 +207,27 @@ func (t *baseTest) newBalanceState(ac currency.Account, height base.Height, am c
 	return stu.GetState()
 }
 
+// newStateFromValue returns the state of value, which is updated at height.
+func (t *baseTest) newStateFromValue(key string, v hint.Hinter, height base.Height) state.State {
+	stv0, err := state.NewStateV0(key, nil, height-1)
+	t.NoError(err)
+
+	uv, err := state.NewHintedValue(v)
+	t.NoError(err)
+
+	st, err := stv0.SetValue(uv)
+	t.NoError(err)
+
+	stu := state.NewStateUpdater(st)
+
+	t.NoError(stu.SetHash(stu.GenerateHash()))
+	t.NoError(stu.AddOperation(valuehash.RandomSHA256()))
+	stu = stu.SetHeight(height)
+	t.NoError(stu.SetHash(stu.GenerateHash()))
+
+	return stu.GetState()
+}
+
 func (t *baseTest) insertDoc(st *Database, col string, doc mongodbstorage.Doc) interface{} {
 	id, err := st.database.Client().Add(col, doc)
 	t.NoError(err)
@@ -182,6 +235,21 @@ func (t *baseTest) insertDoc(st *Database, col string, doc mongodbstorage.Doc) i
 	return id
 }
 
+// insertHolder inserts the balance and replaces the holder like
+// BlockSession.
+func (t *baseTest) insertHolder(st *Database, ac currency.Account, big int64, height base.Height) {
+	sta := t.newBalanceState(ac, height, currency.MustNewAmount(currency.NewBig(big), t.cid))
+
+	doc, err := NewBalanceDoc(sta, t.BSONEnc)
+	t.NoError(err)
+	t.insertDoc(st, defaultColNameBalance, doc)
+
+	hdoc, err := NewHolderDoc(sta, t.BSONEnc)
+	t.NoError(err)
+	_, err = st.database.Client().Set(defaultColNameHolder, hdoc)
+	t.NoError(err)
+}
+
 func (t *baseTest) insertAccount(
 	st *Database, height base.Height, ac currency.Account, am currency.Amount,
 ) (AccountValue, []state.State) {
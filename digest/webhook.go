@@ -0,0 +1,265 @@
+package digest
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/hint"
+	"github.com/spikeekips/mitum/util/isvalid"
+)
+
+var (
+	WebhookType         = hint.Type("mitum-currency-webhook")
+	WebhookHint         = hint.NewHint(WebhookType, "v0.0.1")
+	WebhookDeliveryType = hint.Type("mitum-currency-webhook-delivery")
+	WebhookDeliveryHint = hint.NewHint(WebhookDeliveryType, "v0.0.1")
+	WebhookPayloadType  = hint.Type("mitum-currency-webhook-payload")
+	WebhookPayloadHint  = hint.NewHint(WebhookPayloadType, "v0.0.1")
+)
+
+var (
+	MinWebhookSecretSize         = 16
+	MaxWebhookSecretSize         = 256
+	MaxWebhookAddresses          = 100
+	MaxWebhookURLSize            = 1024
+	WebhookDeliveryStatusPending = "pending"
+	WebhookDeliveryStatusSuccess = "succeeded"
+	WebhookDeliveryStatusFailed  = "failed"
+)
+
+// Webhook is the subscription of account activity. When the operations of new
+// block contain one of the addresses, the payload is delivered to the url,
+// signed by the secret.
+type Webhook struct {
+	id        string
+	url       string
+	addresses []base.Address
+	secret    string
+	createdAt time.Time
+}
+
+func NewWebhook(id, u string, addresses []base.Address, secret string, createdAt time.Time) Webhook {
+	return Webhook{
+		id:        id,
+		url:       u,
+		addresses: addresses,
+		secret:    secret,
+		createdAt: createdAt,
+	}
+}
+
+func (Webhook) Hint() hint.Hint {
+	return WebhookHint
+}
+
+func (wh Webhook) IsValid([]byte) error {
+	if len(wh.id) < 1 {
+		return isvalid.InvalidError.Errorf("empty webhook id")
+	}
+
+	if err := isValidWebhookURL(wh.url); err != nil {
+		return err
+	}
+
+	switch n := len(wh.addresses); {
+	case n < 1:
+		return isvalid.InvalidError.Errorf("empty addresses")
+	case n > MaxWebhookAddresses:
+		return isvalid.InvalidError.Errorf("addresses over allowed, %d > %d", n, MaxWebhookAddresses)
+	}
+
+	founds := map[string]struct{}{}
+	for i := range wh.addresses {
+		a := wh.addresses[i]
+		if err := isvalid.Check(nil, false, a); err != nil {
+			return err
+		}
+
+		if _, found := founds[a.String()]; found {
+			return isvalid.InvalidError.Errorf("duplicated address found, %q", a)
+		}
+		founds[a.String()] = struct{}{}
+	}
+
+	switch n := len(wh.secret); {
+	case n < MinWebhookSecretSize:
+		return isvalid.InvalidError.Errorf("too short secret, %d < %d", n, MinWebhookSecretSize)
+	case n > MaxWebhookSecretSize:
+		return isvalid.InvalidError.Errorf("too long secret, %d > %d", n, MaxWebhookSecretSize)
+	}
+
+	return nil
+}
+
+func (wh Webhook) ID() string {
+	return wh.id
+}
+
+func (wh Webhook) URL() string {
+	return wh.url
+}
+
+func (wh Webhook) Addresses() []base.Address {
+	return wh.addresses
+}
+
+// Secret is used to sign the payload. Secret is not exposed by JSON.
+func (wh Webhook) Secret() string {
+	return wh.secret
+}
+
+func (wh Webhook) CreatedAt() time.Time {
+	return wh.createdAt
+}
+
+func (wh Webhook) Filter() StreamFilter {
+	return NewStreamFilter(wh.addresses, nil, nil)
+}
+
+// WebhookDelivery is the delivery log of the payload of one block to Webhook.
+type WebhookDelivery struct {
+	id         string
+	webhook    string
+	url        string
+	height     base.Height
+	status     string
+	attempts   uint
+	statusCode int
+	err        string
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+func NewWebhookDelivery(id string, wh Webhook, height base.Height, createdAt time.Time) WebhookDelivery {
+	return WebhookDelivery{
+		id:        id,
+		webhook:   wh.ID(),
+		url:       wh.URL(),
+		height:    height,
+		status:    WebhookDeliveryStatusPending,
+		createdAt: createdAt,
+		updatedAt: createdAt,
+	}
+}
+
+func (WebhookDelivery) Hint() hint.Hint {
+	return WebhookDeliveryHint
+}
+
+func (dl WebhookDelivery) ID() string {
+	return dl.id
+}
+
+// Webhook returns the id of Webhook.
+func (dl WebhookDelivery) Webhook() string {
+	return dl.webhook
+}
+
+func (dl WebhookDelivery) URL() string {
+	return dl.url
+}
+
+// Height returns the height of the delivered block.
+func (dl WebhookDelivery) Height() base.Height {
+	return dl.height
+}
+
+func (dl WebhookDelivery) Status() string {
+	return dl.status
+}
+
+func (dl WebhookDelivery) Attempts() uint {
+	return dl.attempts
+}
+
+// StatusCode returns the http status code of the last attempt.
+func (dl WebhookDelivery) StatusCode() int {
+	return dl.statusCode
+}
+
+// Error returns the error message of the last attempt.
+func (dl WebhookDelivery) Error() string {
+	return dl.err
+}
+
+func (dl WebhookDelivery) CreatedAt() time.Time {
+	return dl.createdAt
+}
+
+func (dl WebhookDelivery) UpdatedAt() time.Time {
+	return dl.updatedAt
+}
+
+// attempted records the result of attempt. When err is nil, the delivery
+// succeeded.
+func (dl WebhookDelivery) attempted(statusCode int, err error, final bool, t time.Time) WebhookDelivery {
+	dl.attempts++
+	dl.statusCode = statusCode
+	dl.updatedAt = t
+
+	switch {
+	case err == nil:
+		dl.status = WebhookDeliveryStatusSuccess
+		dl.err = ""
+	case final:
+		dl.status = WebhookDeliveryStatusFailed
+		dl.err = err.Error()
+	default:
+		dl.err = err.Error()
+	}
+
+	return dl
+}
+
+// WebhookPayload is delivered to Webhook. The operations are filtered by the
+// addresses of Webhook.
+type WebhookPayload struct {
+	delivery string
+	webhook  string
+	sb       StreamBlock
+}
+
+func NewWebhookPayload(dl WebhookDelivery, sb StreamBlock) WebhookPayload {
+	return WebhookPayload{
+		delivery: dl.ID(),
+		webhook:  dl.Webhook(),
+		sb:       sb,
+	}
+}
+
+func (WebhookPayload) Hint() hint.Hint {
+	return WebhookPayloadHint
+}
+
+func (pl WebhookPayload) Delivery() string {
+	return pl.delivery
+}
+
+func (pl WebhookPayload) Webhook() string {
+	return pl.webhook
+}
+
+func (pl WebhookPayload) Block() StreamBlock {
+	return pl.sb
+}
+
+func isValidWebhookURL(s string) error {
+	if n := len(s); n > MaxWebhookURLSize {
+		return isvalid.InvalidError.Errorf("too long url, %d > %d", n, MaxWebhookURLSize)
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return isvalid.InvalidError.Errorf("invalid url, %q: %w", s, err)
+	}
+
+	switch {
+	case u.Scheme != "http" && u.Scheme != "https":
+		return isvalid.InvalidError.Errorf("url should be http or https, %q", s)
+	case len(u.Host) < 1:
+		return isvalid.InvalidError.Errorf("empty host of url, %q", s)
+	}
+
+	return nil
+}
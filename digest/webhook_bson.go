@@ -0,0 +1,90 @@
+package digest
+
+import (
+	"time"
+
+	"github.com/spikeekips/mitum/base"
+	bsonenc "github.com/spikeekips/mitum/util/encoder/bson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (wh Webhook) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(wh.Hint()),
+		bson.M{
+			"id":         wh.id,
+			"url":        wh.url,
+			"addresses":  wh.addresses,
+			"secret":     wh.secret,
+			"created_at": wh.createdAt,
+		},
+	))
+}
+
+type WebhookBSONUnpacker struct {
+	ID string                `bson:"id"`
+	UL string                `bson:"url"`
+	AS []base.AddressDecoder `bson:"addresses"`
+	SC string                `bson:"secret"`
+	CA time.Time             `bson:"created_at"`
+}
+
+func (wh *Webhook) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var uwh WebhookBSONUnpacker
+	if err := enc.Unmarshal(b, &uwh); err != nil {
+		return err
+	}
+
+	return wh.unpack(enc, uwh.ID, uwh.UL, uwh.AS, uwh.SC, uwh.CA)
+}
+
+func (dl WebhookDelivery) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bsonenc.MergeBSONM(
+		bsonenc.NewHintedDoc(dl.Hint()),
+		bson.M{
+			"id":          dl.id,
+			"webhook":     dl.webhook,
+			"url":         dl.url,
+			"height":      dl.height,
+			"status":      dl.status,
+			"attempts":    dl.attempts,
+			"status_code": dl.statusCode,
+			"error":       dl.err,
+			"created_at":  dl.createdAt,
+			"updated_at":  dl.updatedAt,
+		},
+	))
+}
+
+type WebhookDeliveryBSONUnpacker struct {
+	ID string      `bson:"id"`
+	WH string      `bson:"webhook"`
+	UL string      `bson:"url"`
+	HT base.Height `bson:"height"`
+	ST string      `bson:"status"`
+	AT uint        `bson:"attempts"`
+	SC int         `bson:"status_code"`
+	ER string      `bson:"error"`
+	CA time.Time   `bson:"created_at"`
+	UA time.Time   `bson:"updated_at"`
+}
+
+func (dl *WebhookDelivery) UnpackBSON(b []byte, enc *bsonenc.Encoder) error {
+	var udl WebhookDeliveryBSONUnpacker
+	if err := enc.Unmarshal(b, &udl); err != nil {
+		return err
+	}
+
+	dl.id = udl.ID
+	dl.webhook = udl.WH
+	dl.url = udl.UL
+	dl.height = udl.HT
+	dl.status = udl.ST
+	dl.attempts = udl.AT
+	dl.statusCode = udl.SC
+	dl.err = udl.ER
+	dl.createdAt = udl.CA
+	dl.updatedAt = udl.UA
+
+	return nil
+}
@@ -0,0 +1,319 @@
+package digest
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/spikeekips/mitum/util"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/localtime"
+	"github.com/spikeekips/mitum/util/logging"
+)
+
+var (
+	WebhookIDHeader        = http.CanonicalHeaderKey("x-mitum-webhook-id")
+	WebhookDeliveryHeader  = http.CanonicalHeaderKey("x-mitum-webhook-delivery")
+	WebhookSignatureHeader = http.CanonicalHeaderKey("x-mitum-webhook-signature")
+)
+
+var (
+	DefaultWebhookMaxAttempts   uint = 5
+	DefaultWebhookRetryInterval      = time.Second * 3
+	defaultWebhookTimeout            = time.Second * 10
+)
+
+// WebhookDispatcher delivers the digested blocks to the Webhooks, which watch
+// the addresses of the operations. Each delivery is stored as pending
+// WebhookDelivery before it is sent and the failed delivery is retried with
+// exponential backoff. When the dispatcher is stopped, the unfinished
+// deliveries remain pending and they are resumed when the dispatcher starts
+// again.
+type WebhookDispatcher struct {
+	sync.RWMutex
+	*logging.Logging
+	*util.ContextDaemon
+	database    *Database
+	client      *http.Client
+	ctx         context.Context
+	maxAttempts uint
+	interval    time.Duration
+}
+
+func NewWebhookDispatcher(st *Database) *WebhookDispatcher {
+	wd := &WebhookDispatcher{
+		Logging: logging.NewLogging(func(c zerolog.Context) zerolog.Context {
+			return c.Str("module", "digest-webhook-dispatcher")
+		}),
+		database:    st,
+		client:      &http.Client{Timeout: defaultWebhookTimeout},
+		maxAttempts: DefaultWebhookMaxAttempts,
+		interval:    DefaultWebhookRetryInterval,
+	}
+
+	wd.ContextDaemon = util.NewContextDaemon("digest-webhook-dispatcher", wd.start)
+
+	return wd
+}
+
+// SetRetry sets the maximum number of attempts and the first interval between
+// attempts; the interval is doubled by each retry.
+func (wd *WebhookDispatcher) SetRetry(maxAttempts uint, interval time.Duration) *WebhookDispatcher {
+	wd.Lock()
+	defer wd.Unlock()
+
+	if maxAttempts > 0 {
+		wd.maxAttempts = maxAttempts
+	}
+
+	if interval > 0 {
+		wd.interval = interval
+	}
+
+	return wd
+}
+
+// Dispatch stores the pending deliveries of the digested block and starts to
+// deliver them. When the dispatcher is not running, the deliveries remain
+// pending until it starts.
+func (wd *WebhookDispatcher) Dispatch(sb StreamBlock) {
+	wd.RLock()
+	defer wd.RUnlock()
+
+	if err := wd.dispatch(wd.ctx, sb); err != nil {
+		wd.Log().Error().Err(err).Int64("block", sb.Height().Int64()).Msg("failed to dispatch webhooks")
+	}
+}
+
+func (wd *WebhookDispatcher) start(ctx context.Context) error {
+	if err := wd.resume(ctx); err != nil {
+		wd.Log().Error().Err(err).Msg("failed to resume pending webhook deliveries")
+	}
+
+	<-ctx.Done()
+
+	wd.Lock()
+	wd.ctx = nil
+	wd.Unlock()
+
+	wd.Log().Debug().Msg("stopped")
+
+	return nil
+}
+
+// resume delivers the pending deliveries, which were not finished before the
+// dispatcher stopped.
+func (wd *WebhookDispatcher) resume(ctx context.Context) error {
+	// NOTE Dispatch waits until the pending deliveries are loaded, so the new
+	// delivery is not resumed twice.
+	wd.Lock()
+	defer wd.Unlock()
+
+	defer func() {
+		wd.ctx = ctx
+	}()
+
+	var dls []WebhookDelivery
+	if err := wd.database.PendingWebhookDeliveries(func(dl WebhookDelivery) (bool, error) {
+		dls = append(dls, dl)
+
+		return true, nil
+	}); err != nil {
+		return err
+	}
+
+	whs := map[string]Webhook{}
+	for i := range dls {
+		dl := dls[i]
+
+		wh, found := whs[dl.Webhook()]
+		if !found {
+			switch uwh, found, err := wd.database.Webhook(dl.Webhook()); {
+			case err != nil:
+				return err
+			case !found: // NOTE removed webhook
+				continue
+			default:
+				wh = uwh
+				whs[dl.Webhook()] = wh
+			}
+		}
+
+		sb, err := loadStreamBlock(wd.database, dl.Height(), wh.Filter())
+		if err != nil {
+			wd.Log().Error().Err(err).Str("delivery", dl.ID()).Msg("failed to load block of pending webhook delivery")
+
+			continue
+		}
+
+		go wd.deliver(ctx, wh, dl, sb)
+	}
+
+	if len(dls) > 0 {
+		wd.Log().Debug().Int("deliveries", len(dls)).Msg("pending webhook deliveries resumed")
+	}
+
+	return nil
+}
+
+// dispatch stores the pending deliveries of block; if ctx is nil, the
+// deliveries are not sent.
+func (wd *WebhookDispatcher) dispatch(ctx context.Context, sb StreamBlock) error {
+	addresses := streamBlockAddresses(sb)
+	if len(addresses) < 1 {
+		return nil
+	}
+
+	var whs []Webhook
+	if err := wd.database.WebhooksByAddresses(addresses, func(wh Webhook) (bool, error) {
+		whs = append(whs, wh)
+
+		return true, nil
+	}); err != nil {
+		return err
+	}
+
+	for i := range whs {
+		wh := whs[i]
+
+		fsb := sb.filter(wh.Filter())
+		if len(fsb.Operations()) < 1 {
+			continue
+		}
+
+		dl := NewWebhookDelivery(util.UUID().String(), wh, sb.Height(), localtime.UTCNow())
+		if err := wd.database.SetWebhookDelivery(dl); err != nil {
+			return err
+		}
+
+		if ctx != nil {
+			go wd.deliver(ctx, wh, dl, fsb)
+		}
+	}
+
+	return nil
+}
+
+func (wd *WebhookDispatcher) deliver(ctx context.Context, wh Webhook, dl WebhookDelivery, sb StreamBlock) {
+	l := wd.Log().With().Str("webhook", wh.ID()).Str("delivery", dl.ID()).Int64("block", sb.Height().Int64()).Logger()
+
+	body, err := jsonenc.Marshal(NewWebhookPayload(dl, sb))
+	if err != nil {
+		l.Error().Err(err).Msg("failed to marshal webhook payload")
+
+		return
+	}
+
+	wd.RLock()
+	maxAttempts, interval := wd.maxAttempts, wd.interval
+	wd.RUnlock()
+
+	for i := dl.Attempts() + 1; ; i++ {
+		statusCode, err := wd.send(ctx, wh, dl, body)
+		if err != nil && ctx.Err() != nil { // NOTE stopped; the delivery remains pending
+			return
+		}
+
+		final := err == nil || i >= maxAttempts
+		dl = dl.attempted(statusCode, err, final, localtime.UTCNow())
+
+		if uerr := wd.database.SetWebhookDelivery(dl); uerr != nil {
+			l.Error().Err(uerr).Msg("failed to update webhook delivery")
+		}
+
+		switch {
+		case err == nil:
+			l.Debug().Uint("attempts", i).Msg("webhook delivered")
+
+			return
+		case final:
+			l.Error().Err(err).Uint("attempts", i).Msg("failed to deliver webhook")
+
+			return
+		}
+
+		l.Debug().Err(err).Uint("attempts", i).Msg("failed to deliver webhook; will retry")
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+			interval *= 2
+		}
+	}
+}
+
+func (wd *WebhookDispatcher) send(
+	ctx context.Context, wh Webhook, dl WebhookDelivery, body []byte,
+) (int /* status code */, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wh.URL(), bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookIDHeader, wh.ID())
+	req.Header.Set(WebhookDeliveryHeader, dl.ID())
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(wh.Secret(), body))
+
+	res, err := wd.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16))
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return res.StatusCode, errors.Errorf("unexpected status code, %d", res.StatusCode)
+	}
+
+	return res.StatusCode, nil
+}
+
+// SignWebhookPayload returns the signature of payload body, "sha256=<hex of
+// HMAC-SHA256 by secret>". The receiver can verify the payload by comparing
+// with WebhookSignatureHeader.
+func SignWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func streamBlockAddresses(sb StreamBlock) []string {
+	founds := map[string]struct{}{}
+
+	var addresses []string
+	for i := range sb.Operations() {
+		if sb.Operations()[i].Operation() == nil {
+			continue
+		}
+
+		as, err := sb.Operations()[i].Addresses()
+		if err != nil {
+			continue
+		}
+
+		for j := range as {
+			s := as[j].String()
+			if _, found := founds[s]; found {
+				continue
+			}
+
+			founds[s] = struct{}{}
+			addresses = append(addresses, s)
+		}
+	}
+
+	return addresses
+}
@@ -0,0 +1,34 @@
+package digest
+
+import (
+	"time"
+
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util/encoder"
+)
+
+func (wh *Webhook) unpack(
+	enc encoder.Encoder,
+	id,
+	u string,
+	bas []base.AddressDecoder,
+	secret string,
+	createdAt time.Time,
+) error {
+	as := make([]base.Address, len(bas))
+	for i := range bas {
+		a, err := bas[i].Encode(enc)
+		if err != nil {
+			return err
+		}
+		as[i] = a
+	}
+
+	wh.id = id
+	wh.url = u
+	wh.addresses = as
+	wh.secret = secret
+	wh.createdAt = createdAt
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package digest
+
+import (
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/base/block"
+	jsonenc "github.com/spikeekips/mitum/util/encoder/json"
+	"github.com/spikeekips/mitum/util/localtime"
+)
+
+// WebhookJSONPacker does not have the secret.
+type WebhookJSONPacker struct {
+	jsonenc.HintedHead
+	ID string         `json:"id"`
+	UL string         `json:"url"`
+	AS []base.Address `json:"addresses"`
+	CA localtime.Time `json:"created_at"`
+}
+
+func (wh Webhook) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(WebhookJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(wh.Hint()),
+		ID:         wh.id,
+		UL:         wh.url,
+		AS:         wh.addresses,
+		CA:         localtime.NewTime(wh.createdAt),
+	})
+}
+
+type WebhookJSONUnpacker struct {
+	ID string                `json:"id"`
+	UL string                `json:"url"`
+	AS []base.AddressDecoder `json:"addresses"`
+	CA localtime.Time        `json:"created_at"`
+}
+
+func (wh *Webhook) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var uwh WebhookJSONUnpacker
+	if err := enc.Unmarshal(b, &uwh); err != nil {
+		return err
+	}
+
+	return wh.unpack(enc, uwh.ID, uwh.UL, uwh.AS, "", uwh.CA.Time)
+}
+
+type WebhookDeliveryJSONPacker struct {
+	jsonenc.HintedHead
+	ID string         `json:"id"`
+	WH string         `json:"webhook"`
+	UL string         `json:"url"`
+	HT base.Height    `json:"height"`
+	ST string         `json:"status"`
+	AT uint           `json:"attempts"`
+	SC int            `json:"status_code,omitempty"`
+	ER string         `json:"error,omitempty"`
+	CA localtime.Time `json:"created_at"`
+	UA localtime.Time `json:"updated_at"`
+}
+
+func (dl WebhookDelivery) MarshalJSON() ([]byte, error) {
+	return jsonenc.Marshal(WebhookDeliveryJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(dl.Hint()),
+		ID:         dl.id,
+		WH:         dl.webhook,
+		UL:         dl.url,
+		HT:         dl.height,
+		ST:         dl.status,
+		AT:         dl.attempts,
+		SC:         dl.statusCode,
+		ER:         dl.err,
+		CA:         localtime.NewTime(dl.createdAt),
+		UA:         localtime.NewTime(dl.updatedAt),
+	})
+}
+
+type WebhookDeliveryJSONUnpacker struct {
+	ID string         `json:"id"`
+	WH string         `json:"webhook"`
+	UL string         `json:"url"`
+	HT base.Height    `json:"height"`
+	ST string         `json:"status"`
+	AT uint           `json:"attempts"`
+	SC int            `json:"status_code,omitempty"`
+	ER string         `json:"error,omitempty"`
+	CA localtime.Time `json:"created_at"`
+	UA localtime.Time `json:"updated_at"`
+}
+
+func (dl *WebhookDelivery) UnpackJSON(b []byte, enc *jsonenc.Encoder) error {
+	var udl WebhookDeliveryJSONUnpacker
+	if err := enc.Unmarshal(b, &udl); err != nil {
+		return err
+	}
+
+	dl.id = udl.ID
+	dl.webhook = udl.WH
+	dl.url = udl.UL
+	dl.height = udl.HT
+	dl.status = udl.ST
+	dl.attempts = udl.AT
+	dl.statusCode = udl.SC
+	dl.err = udl.ER
+	dl.createdAt = udl.CA.Time
+	dl.updatedAt = udl.UA.Time
+
+	return nil
+}
+
+type WebhookPayloadJSONPacker struct {
+	jsonenc.HintedHead
+	DL string           `json:"delivery"`
+	WH string           `json:"webhook"`
+	MF block.Manifest   `json:"manifest"`
+	OP []OperationValue `json:"operations"`
+}
+
+func (pl WebhookPayload) MarshalJSON() ([]byte, error) {
+	ops := pl.sb.Operations()
+	if ops == nil {
+		ops = []OperationValue{}
+	}
+
+	return jsonenc.Marshal(WebhookPayloadJSONPacker{
+		HintedHead: jsonenc.NewHintedHead(pl.Hint()),
+		DL:         pl.delivery,
+		WH:         pl.webhook,
+		MF:         pl.sb.Manifest(),
+		OP:         ops,
+	})
+}
@@ -0,0 +1,140 @@
+package digest
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/spikeekips/mitum-currency/currency"
+	"github.com/spikeekips/mitum/base"
+	"github.com/spikeekips/mitum/util"
+	"github.com/spikeekips/mitum/util/isvalid"
+	"github.com/spikeekips/mitum/util/localtime"
+	"github.com/stretchr/testify/suite"
+)
+
+type testWebhook struct {
+	suite.Suite
+}
+
+func (t *testWebhook) newWebhook(u string, as ...base.Address) Webhook {
+	if len(as) < 1 {
+		as = []base.Address{currency.MustAddress(util.UUID().String())}
+	}
+
+	return NewWebhook(util.UUID().String(), u, as, strings.Repeat("s", MinWebhookSecretSize), localtime.UTCNow())
+}
+
+func (t *testWebhook) TestIsValid() {
+	a := currency.MustAddress(util.UUID().String())
+
+	cases := []struct {
+		name string
+		wh   Webhook
+		err  string
+	}{
+		{name: "valid", wh: t.newWebhook("https://localhost/hook", a)},
+		{name: "empty id", wh: NewWebhook("", "https://localhost/hook", []base.Address{a}, strings.Repeat("s", 16), localtime.UTCNow()), err: "empty webhook id"},
+		{name: "not http", wh: t.newWebhook("ftp://localhost/hook", a), err: "should be http or https"},
+		{name: "empty host", wh: t.newWebhook("https:///hook", a), err: "empty host"},
+		{name: "empty addresses", wh: NewWebhook("a", "https://localhost", nil, strings.Repeat("s", 16), localtime.UTCNow()), err: "empty addresses"},
+		{name: "duplicated addresses", wh: t.newWebhook("https://localhost/hook", a, a), err: "duplicated address"},
+		{name: "short secret", wh: NewWebhook("a", "https://localhost", []base.Address{a}, "s", localtime.UTCNow()), err: "too short secret"},
+	}
+
+	for i, c := range cases {
+		i := i
+		c := c
+		t.Run(c.name, func() {
+			err := c.wh.IsValid(nil)
+			if len(c.err) < 1 {
+				t.NoError(err, "%d: %v", i, c.name)
+
+				return
+			}
+
+			t.True(errors.Is(err, isvalid.InvalidError), "%d: %v", i, c.name)
+			t.Contains(err.Error(), c.err, "%d: %v", i, c.name)
+		})
+	}
+}
+
+func (t *testWebhook) TestSign() {
+	secret := util.UUID().String()
+	body := []byte(util.UUID().String())
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+
+	t.Equal("sha256="+hex.EncodeToString(mac.Sum(nil)), SignWebhookPayload(secret, body))
+	t.NotEqual(SignWebhookPayload(secret, body), SignWebhookPayload(secret+"a", body))
+}
+
+func (t *testWebhook) TestAttempted() {
+	dl := NewWebhookDelivery(util.UUID().String(), t.newWebhook("https://localhost"), base.Height(3), localtime.UTCNow())
+	t.Equal(WebhookDeliveryStatusPending, dl.Status())
+	t.Equal(uint(0), dl.Attempts())
+
+	dl = dl.attempted(http.StatusInternalServerError, errors.Errorf("showme"), false, localtime.UTCNow())
+	t.Equal(WebhookDeliveryStatusPending, dl.Status())
+	t.Equal(uint(1), dl.Attempts())
+	t.Equal(http.StatusInternalServerError, dl.StatusCode())
+	t.Equal("showme", dl.Error())
+
+	sdl := dl.attempted(http.StatusOK, nil, true, localtime.UTCNow())
+	t.Equal(WebhookDeliveryStatusSuccess, sdl.Status())
+	t.Equal(uint(2), sdl.Attempts())
+	t.Empty(sdl.Error())
+
+	fdl := dl.attempted(0, errors.Errorf("findme"), true, localtime.UTCNow())
+	t.Equal(WebhookDeliveryStatusFailed, fdl.Status())
+	t.Equal("findme", fdl.Error())
+}
+
+func (t *testWebhook) TestSend() {
+	var received *http.Request
+	var receivedBody []byte
+	status := http.StatusOK
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		receivedBody, _ = io.ReadAll(r.Body)
+
+		w.WriteHeader(status)
+	}))
+	defer ts.Close()
+
+	wd := NewWebhookDispatcher(nil)
+
+	wh := t.newWebhook(ts.URL)
+	dl := NewWebhookDelivery(util.UUID().String(), wh, base.Height(3), localtime.UTCNow())
+	body := []byte(`{"a":1}`)
+
+	code, err := wd.send(context.Background(), wh, dl, body)
+	t.NoError(err)
+	t.Equal(http.StatusOK, code)
+
+	t.Equal(http.MethodPost, received.Method)
+	t.Equal(body, receivedBody)
+	t.Equal(wh.ID(), received.Header.Get(WebhookIDHeader))
+	t.Equal(dl.ID(), received.Header.Get(WebhookDeliveryHeader))
+	t.Equal(SignWebhookPayload(wh.Secret(), body), received.Header.Get(WebhookSignatureHeader))
+
+	status = http.StatusBadGateway
+
+	code, err = wd.send(context.Background(), wh, dl, body)
+	t.Error(err)
+	t.Contains(err.Error(), "unexpected status code")
+	t.Equal(http.StatusBadGateway, code)
+}
+
+func TestWebhook(t *testing.T) {
+	suite.Run(t, new(testWebhook))
+}